@@ -8,6 +8,7 @@ import (
 	"os"
 
 	"github.com/aki-13627/animalia/backend-go/ent"
+	"github.com/aki-13627/animalia/backend-go/internal/injector"
 	"github.com/aki-13627/animalia/backend-go/internal/routes"
 	"github.com/aws/aws-lambda-go/events"
 	"github.com/aws/aws-lambda-go/lambda"
@@ -76,21 +77,8 @@ func init() {
 	app.GET("/health", func(c echo.Context) error {
 		log.Printf("Health check endpoint called")
 		return c.JSON(200, map[string]interface{}{
-			"status": "healthy",
-		})
-	})
-
-	// Add debug route to check all routes
-	app.GET("/debug/routes", func(c echo.Context) error {
-		routes := []map[string]string{}
-		for _, r := range app.Routes() {
-			routes = append(routes, map[string]string{
-				"method": r.Method,
-				"path":   r.Path,
-			})
-		}
-		return c.JSON(200, map[string]interface{}{
-			"routes": routes,
+			"status":   "healthy",
+			"urlCache": injector.URLCacheStats(),
 		})
 	})
 
@@ -102,6 +90,10 @@ func init() {
 	routes.SetupUserRoutes(app)
 	routes.SetupLikeRoutes(app)
 	routes.SetupCommentRoutes(app)
+	routes.SetupUploadRoutes(app)
+	routes.SetupReportRoutes(app)
+	routes.SetupShareRoutes(app)
+	routes.SetupAdminRoutes(app)
 	log.Println("API routes setup completed")
 
 	// Initialize the Lambda adapter
@@ -0,0 +1,168 @@
+package main
+
+import (
+	"encoding/json"
+	"io"
+	"log"
+	"os"
+	"time"
+
+	"github.com/aki-13627/animalia/backend-go/ent/user"
+	"github.com/aki-13627/animalia/backend-go/internal/injector"
+	"github.com/aki-13627/animalia/backend-go/internal/usecase"
+	"github.com/joho/godotenv"
+	"github.com/spf13/cobra"
+)
+
+func main() {
+	if err := godotenv.Load(); err != nil {
+		log.Println("Warning: .env file not found")
+	}
+
+	rootCmd := &cobra.Command{
+		Use:          "maintenance",
+		Short:        "Animalia のメンテナンス用コマンド",
+		SilenceUsage: true,
+	}
+	rootCmd.AddCommand(newGCImagesCommand())
+	rootCmd.AddCommand(newPurgeAccountsCommand())
+	rootCmd.AddCommand(newGCExportsCommand())
+	rootCmd.AddCommand(newGCTimelineSessionsCommand())
+	rootCmd.AddCommand(newSetRoleCommand())
+
+	if err := rootCmd.Execute(); err != nil {
+		os.Exit(1)
+	}
+}
+
+func newGCImagesCommand() *cobra.Command {
+	var (
+		dryRun      bool
+		gracePeriod time.Duration
+		output      string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "gc-images",
+		Short: "DB から参照されていない画像をストレージから削除する",
+		Long: "posts/, pets/, profile/, uploads/ 配下のオブジェクトを列挙し、投稿・ペット・ユーザーのいずれからも参照されておらず、\n" +
+			"最終更新から --grace-period 以上経過したものを削除する。結果は JSON で出力する。",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			imageGCUsecase := injector.InjectImageGCUsecase()
+			report, err := imageGCUsecase.CollectOrphans(dryRun, gracePeriod)
+			if err != nil {
+				return err
+			}
+			log.Printf("Scanned %d objects, found %d orphans (%d bytes), deleted %d, failed %d",
+				report.Scanned, len(report.Orphans), report.OrphanBytes, report.Deleted, report.Failed)
+
+			var w io.Writer = cmd.OutOrStdout()
+			if output != "" {
+				f, err := os.Create(output)
+				if err != nil {
+					return err
+				}
+				defer f.Close()
+				w = f
+			}
+			encoder := json.NewEncoder(w)
+			encoder.SetIndent("", "  ")
+			return encoder.Encode(report)
+		},
+	}
+
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "削除せずに対象の画像を報告だけする")
+	cmd.Flags().DurationVar(&gracePeriod, "grace-period", 72*time.Hour, "最終更新からこの期間が経過していない画像は削除しない")
+	cmd.Flags().StringVarP(&output, "output", "o", "", "JSON レポートの出力先（省略時は標準出力）")
+	return cmd
+}
+
+func newPurgeAccountsCommand() *cobra.Command {
+	var output string
+
+	cmd := &cobra.Command{
+		Use:   "purge-accounts",
+		Short: "退会の猶予期間を過ぎたアカウントを削除する",
+		Long: "退会から30日を過ぎたユーザーの投稿・ペット・コメント・いいね・フォロー関係・デイリータスクを削除し、\n" +
+			"ユーザーを匿名化して認証プロバイダからも削除する。その後、削除キューの画像・動画をストレージから削除する。結果は JSON で出力する。",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			accountUsecase := injector.InjectAccountUsecase()
+			report, err := accountUsecase.Purge()
+			if err != nil {
+				return err
+			}
+			log.Printf("Purged %d accounts (%d failed), deleted %d objects (%d failed)",
+				report.PurgedAccounts, len(report.FailedAccounts), report.DeletedObjects, len(report.FailedObjects))
+
+			var w io.Writer = cmd.OutOrStdout()
+			if output != "" {
+				f, err := os.Create(output)
+				if err != nil {
+					return err
+				}
+				defer f.Close()
+				w = f
+			}
+			encoder := json.NewEncoder(w)
+			encoder.SetIndent("", "  ")
+			return encoder.Encode(report)
+		},
+	}
+
+	cmd.Flags().StringVarP(&output, "output", "o", "", "JSON レポートの出力先（省略時は標準出力）")
+	return cmd
+}
+
+func newGCExportsCommand() *cobra.Command {
+	var retention time.Duration
+
+	cmd := &cobra.Command{
+		Use:   "gc-exports",
+		Short: "保存期間を過ぎたデータエクスポートの ZIP を削除する",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			takeoutUsecase := injector.InjectTakeoutUsecase()
+			deleted, err := takeoutUsecase.DeleteExpired(retention)
+			if err != nil {
+				return err
+			}
+			log.Printf("Deleted %d exports older than %s", deleted, retention)
+			return nil
+		},
+	}
+
+	cmd.Flags().DurationVar(&retention, "retention", usecase.TakeoutRetention, "作成からこの期間を過ぎたエクスポートを削除する")
+	return cmd
+}
+
+func newGCTimelineSessionsCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "gc-timeline-sessions",
+		Short: "期限切れのタイムラインのセッションを削除する",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			timelineUsecase := injector.InjectTimelineUsecase()
+			deleted, err := timelineUsecase.DeleteExpired()
+			if err != nil {
+				return err
+			}
+			log.Printf("Deleted %d expired timeline sessions", deleted)
+			return nil
+		},
+	}
+}
+
+func newSetRoleCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "set-role <email> <user|moderator|admin>",
+		Short: "ユーザーのロールを変更する。最初の管理者の作成にも使う",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			adminUsecase := injector.InjectAdminUsecase()
+			updated, err := adminUsecase.SetRole(args[0], user.Role(args[1]))
+			if err != nil {
+				return err
+			}
+			log.Printf("Set role of %s (%s) to %s", updated.Email, updated.ID, updated.Role)
+			return nil
+		},
+	}
+}
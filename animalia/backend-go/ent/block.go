@@ -0,0 +1,172 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/aki-13627/animalia/backend-go/ent/block"
+	"github.com/aki-13627/animalia/backend-go/ent/user"
+	"github.com/google/uuid"
+)
+
+// Block is the model entity for the Block schema.
+type Block struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the BlockQuery when eager-loading is set.
+	Edges           BlockEdges `json:"edges"`
+	user_blocking   *uuid.UUID
+	user_blocked_by *uuid.UUID
+	selectValues    sql.SelectValues
+}
+
+// BlockEdges holds the relations/edges for other nodes in the graph.
+type BlockEdges struct {
+	// From holds the value of the from edge.
+	From *User `json:"from,omitempty"`
+	// To holds the value of the to edge.
+	To *User `json:"to,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// FromOrErr returns the From value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e BlockEdges) FromOrErr() (*User, error) {
+	if e.From != nil {
+		return e.From, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "from"}
+}
+
+// ToOrErr returns the To value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e BlockEdges) ToOrErr() (*User, error) {
+	if e.To != nil {
+		return e.To, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "to"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Block) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case block.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case block.FieldID:
+			values[i] = new(uuid.UUID)
+		case block.ForeignKeys[0]: // user_blocking
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case block.ForeignKeys[1]: // user_blocked_by
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Block fields.
+func (b *Block) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case block.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				b.ID = *value
+			}
+		case block.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				b.CreatedAt = value.Time
+			}
+		case block.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field user_blocking", values[i])
+			} else if value.Valid {
+				b.user_blocking = new(uuid.UUID)
+				*b.user_blocking = *value.S.(*uuid.UUID)
+			}
+		case block.ForeignKeys[1]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field user_blocked_by", values[i])
+			} else if value.Valid {
+				b.user_blocked_by = new(uuid.UUID)
+				*b.user_blocked_by = *value.S.(*uuid.UUID)
+			}
+		default:
+			b.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Block.
+// This includes values selected through modifiers, order, etc.
+func (b *Block) Value(name string) (ent.Value, error) {
+	return b.selectValues.Get(name)
+}
+
+// QueryFrom queries the "from" edge of the Block entity.
+func (b *Block) QueryFrom() *UserQuery {
+	return NewBlockClient(b.config).QueryFrom(b)
+}
+
+// QueryTo queries the "to" edge of the Block entity.
+func (b *Block) QueryTo() *UserQuery {
+	return NewBlockClient(b.config).QueryTo(b)
+}
+
+// Update returns a builder for updating this Block.
+// Note that you need to call Block.Unwrap() before calling this method if this Block
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (b *Block) Update() *BlockUpdateOne {
+	return NewBlockClient(b.config).UpdateOne(b)
+}
+
+// Unwrap unwraps the Block entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (b *Block) Unwrap() *Block {
+	_tx, ok := b.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Block is not a transactional entity")
+	}
+	b.config.driver = _tx.drv
+	return b
+}
+
+// String implements the fmt.Stringer.
+func (b *Block) String() string {
+	var builder strings.Builder
+	builder.WriteString("Block(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", b.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(b.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Blocks is a parsable slice of Block.
+type Blocks []*Block
@@ -0,0 +1,116 @@
+// Code generated by ent, DO NOT EDIT.
+
+package block
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the block type in the database.
+	Label = "block"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// EdgeFrom holds the string denoting the from edge name in mutations.
+	EdgeFrom = "from"
+	// EdgeTo holds the string denoting the to edge name in mutations.
+	EdgeTo = "to"
+	// Table holds the table name of the block in the database.
+	Table = "blocks"
+	// FromTable is the table that holds the from relation/edge.
+	FromTable = "blocks"
+	// FromInverseTable is the table name for the User entity.
+	// It exists in this package in order to avoid circular dependency with the "user" package.
+	FromInverseTable = "users"
+	// FromColumn is the table column denoting the from relation/edge.
+	FromColumn = "user_blocking"
+	// ToTable is the table that holds the to relation/edge.
+	ToTable = "blocks"
+	// ToInverseTable is the table name for the User entity.
+	// It exists in this package in order to avoid circular dependency with the "user" package.
+	ToInverseTable = "users"
+	// ToColumn is the table column denoting the to relation/edge.
+	ToColumn = "user_blocked_by"
+)
+
+// Columns holds all SQL columns for block fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "blocks"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"user_blocking",
+	"user_blocked_by",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// OrderOption defines the ordering options for the Block queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByFromField orders the results by from field.
+func ByFromField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newFromStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByToField orders the results by to field.
+func ByToField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newToStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newFromStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(FromInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, FromTable, FromColumn),
+	)
+}
+func newToStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ToInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, ToTable, ToColumn),
+	)
+}
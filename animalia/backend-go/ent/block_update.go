@@ -0,0 +1,440 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/aki-13627/animalia/backend-go/ent/block"
+	"github.com/aki-13627/animalia/backend-go/ent/predicate"
+	"github.com/aki-13627/animalia/backend-go/ent/user"
+	"github.com/google/uuid"
+)
+
+// BlockUpdate is the builder for updating Block entities.
+type BlockUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *BlockMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the BlockUpdate builder.
+func (bu *BlockUpdate) Where(ps ...predicate.Block) *BlockUpdate {
+	bu.mutation.Where(ps...)
+	return bu
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (bu *BlockUpdate) SetCreatedAt(t time.Time) *BlockUpdate {
+	bu.mutation.SetCreatedAt(t)
+	return bu
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (bu *BlockUpdate) SetNillableCreatedAt(t *time.Time) *BlockUpdate {
+	if t != nil {
+		bu.SetCreatedAt(*t)
+	}
+	return bu
+}
+
+// SetFromID sets the "from" edge to the User entity by ID.
+func (bu *BlockUpdate) SetFromID(id uuid.UUID) *BlockUpdate {
+	bu.mutation.SetFromID(id)
+	return bu
+}
+
+// SetFrom sets the "from" edge to the User entity.
+func (bu *BlockUpdate) SetFrom(u *User) *BlockUpdate {
+	return bu.SetFromID(u.ID)
+}
+
+// SetToID sets the "to" edge to the User entity by ID.
+func (bu *BlockUpdate) SetToID(id uuid.UUID) *BlockUpdate {
+	bu.mutation.SetToID(id)
+	return bu
+}
+
+// SetTo sets the "to" edge to the User entity.
+func (bu *BlockUpdate) SetTo(u *User) *BlockUpdate {
+	return bu.SetToID(u.ID)
+}
+
+// Mutation returns the BlockMutation object of the builder.
+func (bu *BlockUpdate) Mutation() *BlockMutation {
+	return bu.mutation
+}
+
+// ClearFrom clears the "from" edge to the User entity.
+func (bu *BlockUpdate) ClearFrom() *BlockUpdate {
+	bu.mutation.ClearFrom()
+	return bu
+}
+
+// ClearTo clears the "to" edge to the User entity.
+func (bu *BlockUpdate) ClearTo() *BlockUpdate {
+	bu.mutation.ClearTo()
+	return bu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (bu *BlockUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, bu.sqlSave, bu.mutation, bu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (bu *BlockUpdate) SaveX(ctx context.Context) int {
+	affected, err := bu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (bu *BlockUpdate) Exec(ctx context.Context) error {
+	_, err := bu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (bu *BlockUpdate) ExecX(ctx context.Context) {
+	if err := bu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (bu *BlockUpdate) check() error {
+	if bu.mutation.FromCleared() && len(bu.mutation.FromIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Block.from"`)
+	}
+	if bu.mutation.ToCleared() && len(bu.mutation.ToIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Block.to"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (bu *BlockUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *BlockUpdate {
+	bu.modifiers = append(bu.modifiers, modifiers...)
+	return bu
+}
+
+func (bu *BlockUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := bu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(block.Table, block.Columns, sqlgraph.NewFieldSpec(block.FieldID, field.TypeUUID))
+	if ps := bu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := bu.mutation.CreatedAt(); ok {
+		_spec.SetField(block.FieldCreatedAt, field.TypeTime, value)
+	}
+	if bu.mutation.FromCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   block.FromTable,
+			Columns: []string{block.FromColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := bu.mutation.FromIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   block.FromTable,
+			Columns: []string{block.FromColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if bu.mutation.ToCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   block.ToTable,
+			Columns: []string{block.ToColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := bu.mutation.ToIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   block.ToTable,
+			Columns: []string{block.ToColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(bu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, bu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{block.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	bu.mutation.done = true
+	return n, nil
+}
+
+// BlockUpdateOne is the builder for updating a single Block entity.
+type BlockUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *BlockMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (buo *BlockUpdateOne) SetCreatedAt(t time.Time) *BlockUpdateOne {
+	buo.mutation.SetCreatedAt(t)
+	return buo
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (buo *BlockUpdateOne) SetNillableCreatedAt(t *time.Time) *BlockUpdateOne {
+	if t != nil {
+		buo.SetCreatedAt(*t)
+	}
+	return buo
+}
+
+// SetFromID sets the "from" edge to the User entity by ID.
+func (buo *BlockUpdateOne) SetFromID(id uuid.UUID) *BlockUpdateOne {
+	buo.mutation.SetFromID(id)
+	return buo
+}
+
+// SetFrom sets the "from" edge to the User entity.
+func (buo *BlockUpdateOne) SetFrom(u *User) *BlockUpdateOne {
+	return buo.SetFromID(u.ID)
+}
+
+// SetToID sets the "to" edge to the User entity by ID.
+func (buo *BlockUpdateOne) SetToID(id uuid.UUID) *BlockUpdateOne {
+	buo.mutation.SetToID(id)
+	return buo
+}
+
+// SetTo sets the "to" edge to the User entity.
+func (buo *BlockUpdateOne) SetTo(u *User) *BlockUpdateOne {
+	return buo.SetToID(u.ID)
+}
+
+// Mutation returns the BlockMutation object of the builder.
+func (buo *BlockUpdateOne) Mutation() *BlockMutation {
+	return buo.mutation
+}
+
+// ClearFrom clears the "from" edge to the User entity.
+func (buo *BlockUpdateOne) ClearFrom() *BlockUpdateOne {
+	buo.mutation.ClearFrom()
+	return buo
+}
+
+// ClearTo clears the "to" edge to the User entity.
+func (buo *BlockUpdateOne) ClearTo() *BlockUpdateOne {
+	buo.mutation.ClearTo()
+	return buo
+}
+
+// Where appends a list predicates to the BlockUpdate builder.
+func (buo *BlockUpdateOne) Where(ps ...predicate.Block) *BlockUpdateOne {
+	buo.mutation.Where(ps...)
+	return buo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (buo *BlockUpdateOne) Select(field string, fields ...string) *BlockUpdateOne {
+	buo.fields = append([]string{field}, fields...)
+	return buo
+}
+
+// Save executes the query and returns the updated Block entity.
+func (buo *BlockUpdateOne) Save(ctx context.Context) (*Block, error) {
+	return withHooks(ctx, buo.sqlSave, buo.mutation, buo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (buo *BlockUpdateOne) SaveX(ctx context.Context) *Block {
+	node, err := buo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (buo *BlockUpdateOne) Exec(ctx context.Context) error {
+	_, err := buo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (buo *BlockUpdateOne) ExecX(ctx context.Context) {
+	if err := buo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (buo *BlockUpdateOne) check() error {
+	if buo.mutation.FromCleared() && len(buo.mutation.FromIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Block.from"`)
+	}
+	if buo.mutation.ToCleared() && len(buo.mutation.ToIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Block.to"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (buo *BlockUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *BlockUpdateOne {
+	buo.modifiers = append(buo.modifiers, modifiers...)
+	return buo
+}
+
+func (buo *BlockUpdateOne) sqlSave(ctx context.Context) (_node *Block, err error) {
+	if err := buo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(block.Table, block.Columns, sqlgraph.NewFieldSpec(block.FieldID, field.TypeUUID))
+	id, ok := buo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Block.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := buo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, block.FieldID)
+		for _, f := range fields {
+			if !block.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != block.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := buo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := buo.mutation.CreatedAt(); ok {
+		_spec.SetField(block.FieldCreatedAt, field.TypeTime, value)
+	}
+	if buo.mutation.FromCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   block.FromTable,
+			Columns: []string{block.FromColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := buo.mutation.FromIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   block.FromTable,
+			Columns: []string{block.FromColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if buo.mutation.ToCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   block.ToTable,
+			Columns: []string{block.ToColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := buo.mutation.ToIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   block.ToTable,
+			Columns: []string{block.ToColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(buo.modifiers...)
+	_node = &Block{config: buo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, buo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{block.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	buo.mutation.done = true
+	return _node, nil
+}
@@ -21,8 +21,9 @@ import (
 // CommentUpdate is the builder for updating Comment entities.
 type CommentUpdate struct {
 	config
-	hooks    []Hook
-	mutation *CommentMutation
+	hooks     []Hook
+	mutation  *CommentMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // Where appends a list predicates to the CommentUpdate builder.
@@ -59,6 +60,46 @@ func (cu *CommentUpdate) SetNillableCreatedAt(t *time.Time) *CommentUpdate {
 	return cu
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (cu *CommentUpdate) SetDeletedAt(t time.Time) *CommentUpdate {
+	cu.mutation.SetDeletedAt(t)
+	return cu
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (cu *CommentUpdate) SetNillableDeletedAt(t *time.Time) *CommentUpdate {
+	if t != nil {
+		cu.SetDeletedAt(*t)
+	}
+	return cu
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (cu *CommentUpdate) ClearDeletedAt() *CommentUpdate {
+	cu.mutation.ClearDeletedAt()
+	return cu
+}
+
+// SetHiddenAt sets the "hidden_at" field.
+func (cu *CommentUpdate) SetHiddenAt(t time.Time) *CommentUpdate {
+	cu.mutation.SetHiddenAt(t)
+	return cu
+}
+
+// SetNillableHiddenAt sets the "hidden_at" field if the given value is not nil.
+func (cu *CommentUpdate) SetNillableHiddenAt(t *time.Time) *CommentUpdate {
+	if t != nil {
+		cu.SetHiddenAt(*t)
+	}
+	return cu
+}
+
+// ClearHiddenAt clears the value of the "hidden_at" field.
+func (cu *CommentUpdate) ClearHiddenAt() *CommentUpdate {
+	cu.mutation.ClearHiddenAt()
+	return cu
+}
+
 // SetPostID sets the "post" edge to the Post entity by ID.
 func (cu *CommentUpdate) SetPostID(id uuid.UUID) *CommentUpdate {
 	cu.mutation.SetPostID(id)
@@ -141,6 +182,12 @@ func (cu *CommentUpdate) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (cu *CommentUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *CommentUpdate {
+	cu.modifiers = append(cu.modifiers, modifiers...)
+	return cu
+}
+
 func (cu *CommentUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if err := cu.check(); err != nil {
 		return n, err
@@ -159,6 +206,18 @@ func (cu *CommentUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := cu.mutation.CreatedAt(); ok {
 		_spec.SetField(comment.FieldCreatedAt, field.TypeTime, value)
 	}
+	if value, ok := cu.mutation.DeletedAt(); ok {
+		_spec.SetField(comment.FieldDeletedAt, field.TypeTime, value)
+	}
+	if cu.mutation.DeletedAtCleared() {
+		_spec.ClearField(comment.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := cu.mutation.HiddenAt(); ok {
+		_spec.SetField(comment.FieldHiddenAt, field.TypeTime, value)
+	}
+	if cu.mutation.HiddenAtCleared() {
+		_spec.ClearField(comment.FieldHiddenAt, field.TypeTime)
+	}
 	if cu.mutation.PostCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -217,6 +276,7 @@ func (cu *CommentUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	_spec.AddModifiers(cu.modifiers...)
 	if n, err = sqlgraph.UpdateNodes(ctx, cu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{comment.Label}
@@ -232,9 +292,10 @@ func (cu *CommentUpdate) sqlSave(ctx context.Context) (n int, err error) {
 // CommentUpdateOne is the builder for updating a single Comment entity.
 type CommentUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *CommentMutation
+	fields    []string
+	hooks     []Hook
+	mutation  *CommentMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // SetContent sets the "content" field.
@@ -265,6 +326,46 @@ func (cuo *CommentUpdateOne) SetNillableCreatedAt(t *time.Time) *CommentUpdateOn
 	return cuo
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (cuo *CommentUpdateOne) SetDeletedAt(t time.Time) *CommentUpdateOne {
+	cuo.mutation.SetDeletedAt(t)
+	return cuo
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (cuo *CommentUpdateOne) SetNillableDeletedAt(t *time.Time) *CommentUpdateOne {
+	if t != nil {
+		cuo.SetDeletedAt(*t)
+	}
+	return cuo
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (cuo *CommentUpdateOne) ClearDeletedAt() *CommentUpdateOne {
+	cuo.mutation.ClearDeletedAt()
+	return cuo
+}
+
+// SetHiddenAt sets the "hidden_at" field.
+func (cuo *CommentUpdateOne) SetHiddenAt(t time.Time) *CommentUpdateOne {
+	cuo.mutation.SetHiddenAt(t)
+	return cuo
+}
+
+// SetNillableHiddenAt sets the "hidden_at" field if the given value is not nil.
+func (cuo *CommentUpdateOne) SetNillableHiddenAt(t *time.Time) *CommentUpdateOne {
+	if t != nil {
+		cuo.SetHiddenAt(*t)
+	}
+	return cuo
+}
+
+// ClearHiddenAt clears the value of the "hidden_at" field.
+func (cuo *CommentUpdateOne) ClearHiddenAt() *CommentUpdateOne {
+	cuo.mutation.ClearHiddenAt()
+	return cuo
+}
+
 // SetPostID sets the "post" edge to the Post entity by ID.
 func (cuo *CommentUpdateOne) SetPostID(id uuid.UUID) *CommentUpdateOne {
 	cuo.mutation.SetPostID(id)
@@ -360,6 +461,12 @@ func (cuo *CommentUpdateOne) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (cuo *CommentUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *CommentUpdateOne {
+	cuo.modifiers = append(cuo.modifiers, modifiers...)
+	return cuo
+}
+
 func (cuo *CommentUpdateOne) sqlSave(ctx context.Context) (_node *Comment, err error) {
 	if err := cuo.check(); err != nil {
 		return _node, err
@@ -395,6 +502,18 @@ func (cuo *CommentUpdateOne) sqlSave(ctx context.Context) (_node *Comment, err e
 	if value, ok := cuo.mutation.CreatedAt(); ok {
 		_spec.SetField(comment.FieldCreatedAt, field.TypeTime, value)
 	}
+	if value, ok := cuo.mutation.DeletedAt(); ok {
+		_spec.SetField(comment.FieldDeletedAt, field.TypeTime, value)
+	}
+	if cuo.mutation.DeletedAtCleared() {
+		_spec.ClearField(comment.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := cuo.mutation.HiddenAt(); ok {
+		_spec.SetField(comment.FieldHiddenAt, field.TypeTime, value)
+	}
+	if cuo.mutation.HiddenAtCleared() {
+		_spec.ClearField(comment.FieldHiddenAt, field.TypeTime)
+	}
 	if cuo.mutation.PostCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -453,6 +572,7 @@ func (cuo *CommentUpdateOne) sqlSave(ctx context.Context) (_node *Comment, err e
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	_spec.AddModifiers(cuo.modifiers...)
 	_node = &Comment{config: cuo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
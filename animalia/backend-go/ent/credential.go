@@ -0,0 +1,246 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/aki-13627/animalia/backend-go/ent/credential"
+	"github.com/google/uuid"
+)
+
+// Credential is the model entity for the Credential schema.
+type Credential struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// Email holds the value of the "email" field.
+	Email string `json:"email,omitempty"`
+	// PasswordHash holds the value of the "password_hash" field.
+	PasswordHash string `json:"-"`
+	// EmailVerified holds the value of the "email_verified" field.
+	EmailVerified bool `json:"email_verified,omitempty"`
+	// VerificationCodeHash holds the value of the "verification_code_hash" field.
+	VerificationCodeHash string `json:"-"`
+	// VerificationExpiresAt holds the value of the "verification_expires_at" field.
+	VerificationExpiresAt *time.Time `json:"verification_expires_at,omitempty"`
+	// VerificationAttempts holds the value of the "verification_attempts" field.
+	VerificationAttempts int `json:"verification_attempts,omitempty"`
+	// PendingEmail holds the value of the "pending_email" field.
+	PendingEmail string `json:"pending_email,omitempty"`
+	// ResetCodeHash holds the value of the "reset_code_hash" field.
+	ResetCodeHash string `json:"-"`
+	// ResetExpiresAt holds the value of the "reset_expires_at" field.
+	ResetExpiresAt *time.Time `json:"reset_expires_at,omitempty"`
+	// ResetAttempts holds the value of the "reset_attempts" field.
+	ResetAttempts int `json:"reset_attempts,omitempty"`
+	// TokenVersion holds the value of the "token_version" field.
+	TokenVersion int `json:"token_version,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt    time.Time `json:"updated_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Credential) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case credential.FieldEmailVerified:
+			values[i] = new(sql.NullBool)
+		case credential.FieldVerificationAttempts, credential.FieldResetAttempts, credential.FieldTokenVersion:
+			values[i] = new(sql.NullInt64)
+		case credential.FieldEmail, credential.FieldPasswordHash, credential.FieldVerificationCodeHash, credential.FieldPendingEmail, credential.FieldResetCodeHash:
+			values[i] = new(sql.NullString)
+		case credential.FieldVerificationExpiresAt, credential.FieldResetExpiresAt, credential.FieldCreatedAt, credential.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case credential.FieldID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Credential fields.
+func (c *Credential) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case credential.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				c.ID = *value
+			}
+		case credential.FieldEmail:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field email", values[i])
+			} else if value.Valid {
+				c.Email = value.String
+			}
+		case credential.FieldPasswordHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field password_hash", values[i])
+			} else if value.Valid {
+				c.PasswordHash = value.String
+			}
+		case credential.FieldEmailVerified:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field email_verified", values[i])
+			} else if value.Valid {
+				c.EmailVerified = value.Bool
+			}
+		case credential.FieldVerificationCodeHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field verification_code_hash", values[i])
+			} else if value.Valid {
+				c.VerificationCodeHash = value.String
+			}
+		case credential.FieldVerificationExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field verification_expires_at", values[i])
+			} else if value.Valid {
+				c.VerificationExpiresAt = new(time.Time)
+				*c.VerificationExpiresAt = value.Time
+			}
+		case credential.FieldVerificationAttempts:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field verification_attempts", values[i])
+			} else if value.Valid {
+				c.VerificationAttempts = int(value.Int64)
+			}
+		case credential.FieldPendingEmail:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field pending_email", values[i])
+			} else if value.Valid {
+				c.PendingEmail = value.String
+			}
+		case credential.FieldResetCodeHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field reset_code_hash", values[i])
+			} else if value.Valid {
+				c.ResetCodeHash = value.String
+			}
+		case credential.FieldResetExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field reset_expires_at", values[i])
+			} else if value.Valid {
+				c.ResetExpiresAt = new(time.Time)
+				*c.ResetExpiresAt = value.Time
+			}
+		case credential.FieldResetAttempts:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field reset_attempts", values[i])
+			} else if value.Valid {
+				c.ResetAttempts = int(value.Int64)
+			}
+		case credential.FieldTokenVersion:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field token_version", values[i])
+			} else if value.Valid {
+				c.TokenVersion = int(value.Int64)
+			}
+		case credential.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				c.CreatedAt = value.Time
+			}
+		case credential.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				c.UpdatedAt = value.Time
+			}
+		default:
+			c.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Credential.
+// This includes values selected through modifiers, order, etc.
+func (c *Credential) Value(name string) (ent.Value, error) {
+	return c.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this Credential.
+// Note that you need to call Credential.Unwrap() before calling this method if this Credential
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (c *Credential) Update() *CredentialUpdateOne {
+	return NewCredentialClient(c.config).UpdateOne(c)
+}
+
+// Unwrap unwraps the Credential entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (c *Credential) Unwrap() *Credential {
+	_tx, ok := c.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Credential is not a transactional entity")
+	}
+	c.config.driver = _tx.drv
+	return c
+}
+
+// String implements the fmt.Stringer.
+func (c *Credential) String() string {
+	var builder strings.Builder
+	builder.WriteString("Credential(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", c.ID))
+	builder.WriteString("email=")
+	builder.WriteString(c.Email)
+	builder.WriteString(", ")
+	builder.WriteString("password_hash=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("email_verified=")
+	builder.WriteString(fmt.Sprintf("%v", c.EmailVerified))
+	builder.WriteString(", ")
+	builder.WriteString("verification_code_hash=<sensitive>")
+	builder.WriteString(", ")
+	if v := c.VerificationExpiresAt; v != nil {
+		builder.WriteString("verification_expires_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("verification_attempts=")
+	builder.WriteString(fmt.Sprintf("%v", c.VerificationAttempts))
+	builder.WriteString(", ")
+	builder.WriteString("pending_email=")
+	builder.WriteString(c.PendingEmail)
+	builder.WriteString(", ")
+	builder.WriteString("reset_code_hash=<sensitive>")
+	builder.WriteString(", ")
+	if v := c.ResetExpiresAt; v != nil {
+		builder.WriteString("reset_expires_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("reset_attempts=")
+	builder.WriteString(fmt.Sprintf("%v", c.ResetAttempts))
+	builder.WriteString(", ")
+	builder.WriteString("token_version=")
+	builder.WriteString(fmt.Sprintf("%v", c.TokenVersion))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(c.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(c.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Credentials is a parsable slice of Credential.
+type Credentials []*Credential
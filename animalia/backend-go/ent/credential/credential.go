@@ -0,0 +1,169 @@
+// Code generated by ent, DO NOT EDIT.
+
+package credential
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the credential type in the database.
+	Label = "credential"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldEmail holds the string denoting the email field in the database.
+	FieldEmail = "email"
+	// FieldPasswordHash holds the string denoting the password_hash field in the database.
+	FieldPasswordHash = "password_hash"
+	// FieldEmailVerified holds the string denoting the email_verified field in the database.
+	FieldEmailVerified = "email_verified"
+	// FieldVerificationCodeHash holds the string denoting the verification_code_hash field in the database.
+	FieldVerificationCodeHash = "verification_code_hash"
+	// FieldVerificationExpiresAt holds the string denoting the verification_expires_at field in the database.
+	FieldVerificationExpiresAt = "verification_expires_at"
+	// FieldVerificationAttempts holds the string denoting the verification_attempts field in the database.
+	FieldVerificationAttempts = "verification_attempts"
+	// FieldPendingEmail holds the string denoting the pending_email field in the database.
+	FieldPendingEmail = "pending_email"
+	// FieldResetCodeHash holds the string denoting the reset_code_hash field in the database.
+	FieldResetCodeHash = "reset_code_hash"
+	// FieldResetExpiresAt holds the string denoting the reset_expires_at field in the database.
+	FieldResetExpiresAt = "reset_expires_at"
+	// FieldResetAttempts holds the string denoting the reset_attempts field in the database.
+	FieldResetAttempts = "reset_attempts"
+	// FieldTokenVersion holds the string denoting the token_version field in the database.
+	FieldTokenVersion = "token_version"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// Table holds the table name of the credential in the database.
+	Table = "credentials"
+)
+
+// Columns holds all SQL columns for credential fields.
+var Columns = []string{
+	FieldID,
+	FieldEmail,
+	FieldPasswordHash,
+	FieldEmailVerified,
+	FieldVerificationCodeHash,
+	FieldVerificationExpiresAt,
+	FieldVerificationAttempts,
+	FieldPendingEmail,
+	FieldResetCodeHash,
+	FieldResetExpiresAt,
+	FieldResetAttempts,
+	FieldTokenVersion,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// EmailValidator is a validator for the "email" field. It is called by the builders before save.
+	EmailValidator func(string) error
+	// PasswordHashValidator is a validator for the "password_hash" field. It is called by the builders before save.
+	PasswordHashValidator func(string) error
+	// DefaultEmailVerified holds the default value on creation for the "email_verified" field.
+	DefaultEmailVerified bool
+	// DefaultVerificationAttempts holds the default value on creation for the "verification_attempts" field.
+	DefaultVerificationAttempts int
+	// DefaultResetAttempts holds the default value on creation for the "reset_attempts" field.
+	DefaultResetAttempts int
+	// DefaultTokenVersion holds the default value on creation for the "token_version" field.
+	DefaultTokenVersion int
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// OrderOption defines the ordering options for the Credential queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByEmail orders the results by the email field.
+func ByEmail(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEmail, opts...).ToFunc()
+}
+
+// ByPasswordHash orders the results by the password_hash field.
+func ByPasswordHash(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPasswordHash, opts...).ToFunc()
+}
+
+// ByEmailVerified orders the results by the email_verified field.
+func ByEmailVerified(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEmailVerified, opts...).ToFunc()
+}
+
+// ByVerificationCodeHash orders the results by the verification_code_hash field.
+func ByVerificationCodeHash(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVerificationCodeHash, opts...).ToFunc()
+}
+
+// ByVerificationExpiresAt orders the results by the verification_expires_at field.
+func ByVerificationExpiresAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVerificationExpiresAt, opts...).ToFunc()
+}
+
+// ByVerificationAttempts orders the results by the verification_attempts field.
+func ByVerificationAttempts(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVerificationAttempts, opts...).ToFunc()
+}
+
+// ByPendingEmail orders the results by the pending_email field.
+func ByPendingEmail(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPendingEmail, opts...).ToFunc()
+}
+
+// ByResetCodeHash orders the results by the reset_code_hash field.
+func ByResetCodeHash(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldResetCodeHash, opts...).ToFunc()
+}
+
+// ByResetExpiresAt orders the results by the reset_expires_at field.
+func ByResetExpiresAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldResetExpiresAt, opts...).ToFunc()
+}
+
+// ByResetAttempts orders the results by the reset_attempts field.
+func ByResetAttempts(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldResetAttempts, opts...).ToFunc()
+}
+
+// ByTokenVersion orders the results by the token_version field.
+func ByTokenVersion(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTokenVersion, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
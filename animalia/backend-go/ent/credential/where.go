@@ -0,0 +1,801 @@
+// Code generated by ent, DO NOT EDIT.
+
+package credential
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/aki-13627/animalia/backend-go/ent/predicate"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.Credential {
+	return predicate.Credential(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.Credential {
+	return predicate.Credential(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.Credential {
+	return predicate.Credential(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.Credential {
+	return predicate.Credential(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.Credential {
+	return predicate.Credential(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.Credential {
+	return predicate.Credential(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.Credential {
+	return predicate.Credential(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.Credential {
+	return predicate.Credential(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.Credential {
+	return predicate.Credential(sql.FieldLTE(FieldID, id))
+}
+
+// Email applies equality check predicate on the "email" field. It's identical to EmailEQ.
+func Email(v string) predicate.Credential {
+	return predicate.Credential(sql.FieldEQ(FieldEmail, v))
+}
+
+// PasswordHash applies equality check predicate on the "password_hash" field. It's identical to PasswordHashEQ.
+func PasswordHash(v string) predicate.Credential {
+	return predicate.Credential(sql.FieldEQ(FieldPasswordHash, v))
+}
+
+// EmailVerified applies equality check predicate on the "email_verified" field. It's identical to EmailVerifiedEQ.
+func EmailVerified(v bool) predicate.Credential {
+	return predicate.Credential(sql.FieldEQ(FieldEmailVerified, v))
+}
+
+// VerificationCodeHash applies equality check predicate on the "verification_code_hash" field. It's identical to VerificationCodeHashEQ.
+func VerificationCodeHash(v string) predicate.Credential {
+	return predicate.Credential(sql.FieldEQ(FieldVerificationCodeHash, v))
+}
+
+// VerificationExpiresAt applies equality check predicate on the "verification_expires_at" field. It's identical to VerificationExpiresAtEQ.
+func VerificationExpiresAt(v time.Time) predicate.Credential {
+	return predicate.Credential(sql.FieldEQ(FieldVerificationExpiresAt, v))
+}
+
+// VerificationAttempts applies equality check predicate on the "verification_attempts" field. It's identical to VerificationAttemptsEQ.
+func VerificationAttempts(v int) predicate.Credential {
+	return predicate.Credential(sql.FieldEQ(FieldVerificationAttempts, v))
+}
+
+// PendingEmail applies equality check predicate on the "pending_email" field. It's identical to PendingEmailEQ.
+func PendingEmail(v string) predicate.Credential {
+	return predicate.Credential(sql.FieldEQ(FieldPendingEmail, v))
+}
+
+// ResetCodeHash applies equality check predicate on the "reset_code_hash" field. It's identical to ResetCodeHashEQ.
+func ResetCodeHash(v string) predicate.Credential {
+	return predicate.Credential(sql.FieldEQ(FieldResetCodeHash, v))
+}
+
+// ResetExpiresAt applies equality check predicate on the "reset_expires_at" field. It's identical to ResetExpiresAtEQ.
+func ResetExpiresAt(v time.Time) predicate.Credential {
+	return predicate.Credential(sql.FieldEQ(FieldResetExpiresAt, v))
+}
+
+// ResetAttempts applies equality check predicate on the "reset_attempts" field. It's identical to ResetAttemptsEQ.
+func ResetAttempts(v int) predicate.Credential {
+	return predicate.Credential(sql.FieldEQ(FieldResetAttempts, v))
+}
+
+// TokenVersion applies equality check predicate on the "token_version" field. It's identical to TokenVersionEQ.
+func TokenVersion(v int) predicate.Credential {
+	return predicate.Credential(sql.FieldEQ(FieldTokenVersion, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.Credential {
+	return predicate.Credential(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.Credential {
+	return predicate.Credential(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// EmailEQ applies the EQ predicate on the "email" field.
+func EmailEQ(v string) predicate.Credential {
+	return predicate.Credential(sql.FieldEQ(FieldEmail, v))
+}
+
+// EmailNEQ applies the NEQ predicate on the "email" field.
+func EmailNEQ(v string) predicate.Credential {
+	return predicate.Credential(sql.FieldNEQ(FieldEmail, v))
+}
+
+// EmailIn applies the In predicate on the "email" field.
+func EmailIn(vs ...string) predicate.Credential {
+	return predicate.Credential(sql.FieldIn(FieldEmail, vs...))
+}
+
+// EmailNotIn applies the NotIn predicate on the "email" field.
+func EmailNotIn(vs ...string) predicate.Credential {
+	return predicate.Credential(sql.FieldNotIn(FieldEmail, vs...))
+}
+
+// EmailGT applies the GT predicate on the "email" field.
+func EmailGT(v string) predicate.Credential {
+	return predicate.Credential(sql.FieldGT(FieldEmail, v))
+}
+
+// EmailGTE applies the GTE predicate on the "email" field.
+func EmailGTE(v string) predicate.Credential {
+	return predicate.Credential(sql.FieldGTE(FieldEmail, v))
+}
+
+// EmailLT applies the LT predicate on the "email" field.
+func EmailLT(v string) predicate.Credential {
+	return predicate.Credential(sql.FieldLT(FieldEmail, v))
+}
+
+// EmailLTE applies the LTE predicate on the "email" field.
+func EmailLTE(v string) predicate.Credential {
+	return predicate.Credential(sql.FieldLTE(FieldEmail, v))
+}
+
+// EmailContains applies the Contains predicate on the "email" field.
+func EmailContains(v string) predicate.Credential {
+	return predicate.Credential(sql.FieldContains(FieldEmail, v))
+}
+
+// EmailHasPrefix applies the HasPrefix predicate on the "email" field.
+func EmailHasPrefix(v string) predicate.Credential {
+	return predicate.Credential(sql.FieldHasPrefix(FieldEmail, v))
+}
+
+// EmailHasSuffix applies the HasSuffix predicate on the "email" field.
+func EmailHasSuffix(v string) predicate.Credential {
+	return predicate.Credential(sql.FieldHasSuffix(FieldEmail, v))
+}
+
+// EmailEqualFold applies the EqualFold predicate on the "email" field.
+func EmailEqualFold(v string) predicate.Credential {
+	return predicate.Credential(sql.FieldEqualFold(FieldEmail, v))
+}
+
+// EmailContainsFold applies the ContainsFold predicate on the "email" field.
+func EmailContainsFold(v string) predicate.Credential {
+	return predicate.Credential(sql.FieldContainsFold(FieldEmail, v))
+}
+
+// PasswordHashEQ applies the EQ predicate on the "password_hash" field.
+func PasswordHashEQ(v string) predicate.Credential {
+	return predicate.Credential(sql.FieldEQ(FieldPasswordHash, v))
+}
+
+// PasswordHashNEQ applies the NEQ predicate on the "password_hash" field.
+func PasswordHashNEQ(v string) predicate.Credential {
+	return predicate.Credential(sql.FieldNEQ(FieldPasswordHash, v))
+}
+
+// PasswordHashIn applies the In predicate on the "password_hash" field.
+func PasswordHashIn(vs ...string) predicate.Credential {
+	return predicate.Credential(sql.FieldIn(FieldPasswordHash, vs...))
+}
+
+// PasswordHashNotIn applies the NotIn predicate on the "password_hash" field.
+func PasswordHashNotIn(vs ...string) predicate.Credential {
+	return predicate.Credential(sql.FieldNotIn(FieldPasswordHash, vs...))
+}
+
+// PasswordHashGT applies the GT predicate on the "password_hash" field.
+func PasswordHashGT(v string) predicate.Credential {
+	return predicate.Credential(sql.FieldGT(FieldPasswordHash, v))
+}
+
+// PasswordHashGTE applies the GTE predicate on the "password_hash" field.
+func PasswordHashGTE(v string) predicate.Credential {
+	return predicate.Credential(sql.FieldGTE(FieldPasswordHash, v))
+}
+
+// PasswordHashLT applies the LT predicate on the "password_hash" field.
+func PasswordHashLT(v string) predicate.Credential {
+	return predicate.Credential(sql.FieldLT(FieldPasswordHash, v))
+}
+
+// PasswordHashLTE applies the LTE predicate on the "password_hash" field.
+func PasswordHashLTE(v string) predicate.Credential {
+	return predicate.Credential(sql.FieldLTE(FieldPasswordHash, v))
+}
+
+// PasswordHashContains applies the Contains predicate on the "password_hash" field.
+func PasswordHashContains(v string) predicate.Credential {
+	return predicate.Credential(sql.FieldContains(FieldPasswordHash, v))
+}
+
+// PasswordHashHasPrefix applies the HasPrefix predicate on the "password_hash" field.
+func PasswordHashHasPrefix(v string) predicate.Credential {
+	return predicate.Credential(sql.FieldHasPrefix(FieldPasswordHash, v))
+}
+
+// PasswordHashHasSuffix applies the HasSuffix predicate on the "password_hash" field.
+func PasswordHashHasSuffix(v string) predicate.Credential {
+	return predicate.Credential(sql.FieldHasSuffix(FieldPasswordHash, v))
+}
+
+// PasswordHashEqualFold applies the EqualFold predicate on the "password_hash" field.
+func PasswordHashEqualFold(v string) predicate.Credential {
+	return predicate.Credential(sql.FieldEqualFold(FieldPasswordHash, v))
+}
+
+// PasswordHashContainsFold applies the ContainsFold predicate on the "password_hash" field.
+func PasswordHashContainsFold(v string) predicate.Credential {
+	return predicate.Credential(sql.FieldContainsFold(FieldPasswordHash, v))
+}
+
+// EmailVerifiedEQ applies the EQ predicate on the "email_verified" field.
+func EmailVerifiedEQ(v bool) predicate.Credential {
+	return predicate.Credential(sql.FieldEQ(FieldEmailVerified, v))
+}
+
+// EmailVerifiedNEQ applies the NEQ predicate on the "email_verified" field.
+func EmailVerifiedNEQ(v bool) predicate.Credential {
+	return predicate.Credential(sql.FieldNEQ(FieldEmailVerified, v))
+}
+
+// VerificationCodeHashEQ applies the EQ predicate on the "verification_code_hash" field.
+func VerificationCodeHashEQ(v string) predicate.Credential {
+	return predicate.Credential(sql.FieldEQ(FieldVerificationCodeHash, v))
+}
+
+// VerificationCodeHashNEQ applies the NEQ predicate on the "verification_code_hash" field.
+func VerificationCodeHashNEQ(v string) predicate.Credential {
+	return predicate.Credential(sql.FieldNEQ(FieldVerificationCodeHash, v))
+}
+
+// VerificationCodeHashIn applies the In predicate on the "verification_code_hash" field.
+func VerificationCodeHashIn(vs ...string) predicate.Credential {
+	return predicate.Credential(sql.FieldIn(FieldVerificationCodeHash, vs...))
+}
+
+// VerificationCodeHashNotIn applies the NotIn predicate on the "verification_code_hash" field.
+func VerificationCodeHashNotIn(vs ...string) predicate.Credential {
+	return predicate.Credential(sql.FieldNotIn(FieldVerificationCodeHash, vs...))
+}
+
+// VerificationCodeHashGT applies the GT predicate on the "verification_code_hash" field.
+func VerificationCodeHashGT(v string) predicate.Credential {
+	return predicate.Credential(sql.FieldGT(FieldVerificationCodeHash, v))
+}
+
+// VerificationCodeHashGTE applies the GTE predicate on the "verification_code_hash" field.
+func VerificationCodeHashGTE(v string) predicate.Credential {
+	return predicate.Credential(sql.FieldGTE(FieldVerificationCodeHash, v))
+}
+
+// VerificationCodeHashLT applies the LT predicate on the "verification_code_hash" field.
+func VerificationCodeHashLT(v string) predicate.Credential {
+	return predicate.Credential(sql.FieldLT(FieldVerificationCodeHash, v))
+}
+
+// VerificationCodeHashLTE applies the LTE predicate on the "verification_code_hash" field.
+func VerificationCodeHashLTE(v string) predicate.Credential {
+	return predicate.Credential(sql.FieldLTE(FieldVerificationCodeHash, v))
+}
+
+// VerificationCodeHashContains applies the Contains predicate on the "verification_code_hash" field.
+func VerificationCodeHashContains(v string) predicate.Credential {
+	return predicate.Credential(sql.FieldContains(FieldVerificationCodeHash, v))
+}
+
+// VerificationCodeHashHasPrefix applies the HasPrefix predicate on the "verification_code_hash" field.
+func VerificationCodeHashHasPrefix(v string) predicate.Credential {
+	return predicate.Credential(sql.FieldHasPrefix(FieldVerificationCodeHash, v))
+}
+
+// VerificationCodeHashHasSuffix applies the HasSuffix predicate on the "verification_code_hash" field.
+func VerificationCodeHashHasSuffix(v string) predicate.Credential {
+	return predicate.Credential(sql.FieldHasSuffix(FieldVerificationCodeHash, v))
+}
+
+// VerificationCodeHashIsNil applies the IsNil predicate on the "verification_code_hash" field.
+func VerificationCodeHashIsNil() predicate.Credential {
+	return predicate.Credential(sql.FieldIsNull(FieldVerificationCodeHash))
+}
+
+// VerificationCodeHashNotNil applies the NotNil predicate on the "verification_code_hash" field.
+func VerificationCodeHashNotNil() predicate.Credential {
+	return predicate.Credential(sql.FieldNotNull(FieldVerificationCodeHash))
+}
+
+// VerificationCodeHashEqualFold applies the EqualFold predicate on the "verification_code_hash" field.
+func VerificationCodeHashEqualFold(v string) predicate.Credential {
+	return predicate.Credential(sql.FieldEqualFold(FieldVerificationCodeHash, v))
+}
+
+// VerificationCodeHashContainsFold applies the ContainsFold predicate on the "verification_code_hash" field.
+func VerificationCodeHashContainsFold(v string) predicate.Credential {
+	return predicate.Credential(sql.FieldContainsFold(FieldVerificationCodeHash, v))
+}
+
+// VerificationExpiresAtEQ applies the EQ predicate on the "verification_expires_at" field.
+func VerificationExpiresAtEQ(v time.Time) predicate.Credential {
+	return predicate.Credential(sql.FieldEQ(FieldVerificationExpiresAt, v))
+}
+
+// VerificationExpiresAtNEQ applies the NEQ predicate on the "verification_expires_at" field.
+func VerificationExpiresAtNEQ(v time.Time) predicate.Credential {
+	return predicate.Credential(sql.FieldNEQ(FieldVerificationExpiresAt, v))
+}
+
+// VerificationExpiresAtIn applies the In predicate on the "verification_expires_at" field.
+func VerificationExpiresAtIn(vs ...time.Time) predicate.Credential {
+	return predicate.Credential(sql.FieldIn(FieldVerificationExpiresAt, vs...))
+}
+
+// VerificationExpiresAtNotIn applies the NotIn predicate on the "verification_expires_at" field.
+func VerificationExpiresAtNotIn(vs ...time.Time) predicate.Credential {
+	return predicate.Credential(sql.FieldNotIn(FieldVerificationExpiresAt, vs...))
+}
+
+// VerificationExpiresAtGT applies the GT predicate on the "verification_expires_at" field.
+func VerificationExpiresAtGT(v time.Time) predicate.Credential {
+	return predicate.Credential(sql.FieldGT(FieldVerificationExpiresAt, v))
+}
+
+// VerificationExpiresAtGTE applies the GTE predicate on the "verification_expires_at" field.
+func VerificationExpiresAtGTE(v time.Time) predicate.Credential {
+	return predicate.Credential(sql.FieldGTE(FieldVerificationExpiresAt, v))
+}
+
+// VerificationExpiresAtLT applies the LT predicate on the "verification_expires_at" field.
+func VerificationExpiresAtLT(v time.Time) predicate.Credential {
+	return predicate.Credential(sql.FieldLT(FieldVerificationExpiresAt, v))
+}
+
+// VerificationExpiresAtLTE applies the LTE predicate on the "verification_expires_at" field.
+func VerificationExpiresAtLTE(v time.Time) predicate.Credential {
+	return predicate.Credential(sql.FieldLTE(FieldVerificationExpiresAt, v))
+}
+
+// VerificationExpiresAtIsNil applies the IsNil predicate on the "verification_expires_at" field.
+func VerificationExpiresAtIsNil() predicate.Credential {
+	return predicate.Credential(sql.FieldIsNull(FieldVerificationExpiresAt))
+}
+
+// VerificationExpiresAtNotNil applies the NotNil predicate on the "verification_expires_at" field.
+func VerificationExpiresAtNotNil() predicate.Credential {
+	return predicate.Credential(sql.FieldNotNull(FieldVerificationExpiresAt))
+}
+
+// VerificationAttemptsEQ applies the EQ predicate on the "verification_attempts" field.
+func VerificationAttemptsEQ(v int) predicate.Credential {
+	return predicate.Credential(sql.FieldEQ(FieldVerificationAttempts, v))
+}
+
+// VerificationAttemptsNEQ applies the NEQ predicate on the "verification_attempts" field.
+func VerificationAttemptsNEQ(v int) predicate.Credential {
+	return predicate.Credential(sql.FieldNEQ(FieldVerificationAttempts, v))
+}
+
+// VerificationAttemptsIn applies the In predicate on the "verification_attempts" field.
+func VerificationAttemptsIn(vs ...int) predicate.Credential {
+	return predicate.Credential(sql.FieldIn(FieldVerificationAttempts, vs...))
+}
+
+// VerificationAttemptsNotIn applies the NotIn predicate on the "verification_attempts" field.
+func VerificationAttemptsNotIn(vs ...int) predicate.Credential {
+	return predicate.Credential(sql.FieldNotIn(FieldVerificationAttempts, vs...))
+}
+
+// VerificationAttemptsGT applies the GT predicate on the "verification_attempts" field.
+func VerificationAttemptsGT(v int) predicate.Credential {
+	return predicate.Credential(sql.FieldGT(FieldVerificationAttempts, v))
+}
+
+// VerificationAttemptsGTE applies the GTE predicate on the "verification_attempts" field.
+func VerificationAttemptsGTE(v int) predicate.Credential {
+	return predicate.Credential(sql.FieldGTE(FieldVerificationAttempts, v))
+}
+
+// VerificationAttemptsLT applies the LT predicate on the "verification_attempts" field.
+func VerificationAttemptsLT(v int) predicate.Credential {
+	return predicate.Credential(sql.FieldLT(FieldVerificationAttempts, v))
+}
+
+// VerificationAttemptsLTE applies the LTE predicate on the "verification_attempts" field.
+func VerificationAttemptsLTE(v int) predicate.Credential {
+	return predicate.Credential(sql.FieldLTE(FieldVerificationAttempts, v))
+}
+
+// PendingEmailEQ applies the EQ predicate on the "pending_email" field.
+func PendingEmailEQ(v string) predicate.Credential {
+	return predicate.Credential(sql.FieldEQ(FieldPendingEmail, v))
+}
+
+// PendingEmailNEQ applies the NEQ predicate on the "pending_email" field.
+func PendingEmailNEQ(v string) predicate.Credential {
+	return predicate.Credential(sql.FieldNEQ(FieldPendingEmail, v))
+}
+
+// PendingEmailIn applies the In predicate on the "pending_email" field.
+func PendingEmailIn(vs ...string) predicate.Credential {
+	return predicate.Credential(sql.FieldIn(FieldPendingEmail, vs...))
+}
+
+// PendingEmailNotIn applies the NotIn predicate on the "pending_email" field.
+func PendingEmailNotIn(vs ...string) predicate.Credential {
+	return predicate.Credential(sql.FieldNotIn(FieldPendingEmail, vs...))
+}
+
+// PendingEmailGT applies the GT predicate on the "pending_email" field.
+func PendingEmailGT(v string) predicate.Credential {
+	return predicate.Credential(sql.FieldGT(FieldPendingEmail, v))
+}
+
+// PendingEmailGTE applies the GTE predicate on the "pending_email" field.
+func PendingEmailGTE(v string) predicate.Credential {
+	return predicate.Credential(sql.FieldGTE(FieldPendingEmail, v))
+}
+
+// PendingEmailLT applies the LT predicate on the "pending_email" field.
+func PendingEmailLT(v string) predicate.Credential {
+	return predicate.Credential(sql.FieldLT(FieldPendingEmail, v))
+}
+
+// PendingEmailLTE applies the LTE predicate on the "pending_email" field.
+func PendingEmailLTE(v string) predicate.Credential {
+	return predicate.Credential(sql.FieldLTE(FieldPendingEmail, v))
+}
+
+// PendingEmailContains applies the Contains predicate on the "pending_email" field.
+func PendingEmailContains(v string) predicate.Credential {
+	return predicate.Credential(sql.FieldContains(FieldPendingEmail, v))
+}
+
+// PendingEmailHasPrefix applies the HasPrefix predicate on the "pending_email" field.
+func PendingEmailHasPrefix(v string) predicate.Credential {
+	return predicate.Credential(sql.FieldHasPrefix(FieldPendingEmail, v))
+}
+
+// PendingEmailHasSuffix applies the HasSuffix predicate on the "pending_email" field.
+func PendingEmailHasSuffix(v string) predicate.Credential {
+	return predicate.Credential(sql.FieldHasSuffix(FieldPendingEmail, v))
+}
+
+// PendingEmailIsNil applies the IsNil predicate on the "pending_email" field.
+func PendingEmailIsNil() predicate.Credential {
+	return predicate.Credential(sql.FieldIsNull(FieldPendingEmail))
+}
+
+// PendingEmailNotNil applies the NotNil predicate on the "pending_email" field.
+func PendingEmailNotNil() predicate.Credential {
+	return predicate.Credential(sql.FieldNotNull(FieldPendingEmail))
+}
+
+// PendingEmailEqualFold applies the EqualFold predicate on the "pending_email" field.
+func PendingEmailEqualFold(v string) predicate.Credential {
+	return predicate.Credential(sql.FieldEqualFold(FieldPendingEmail, v))
+}
+
+// PendingEmailContainsFold applies the ContainsFold predicate on the "pending_email" field.
+func PendingEmailContainsFold(v string) predicate.Credential {
+	return predicate.Credential(sql.FieldContainsFold(FieldPendingEmail, v))
+}
+
+// ResetCodeHashEQ applies the EQ predicate on the "reset_code_hash" field.
+func ResetCodeHashEQ(v string) predicate.Credential {
+	return predicate.Credential(sql.FieldEQ(FieldResetCodeHash, v))
+}
+
+// ResetCodeHashNEQ applies the NEQ predicate on the "reset_code_hash" field.
+func ResetCodeHashNEQ(v string) predicate.Credential {
+	return predicate.Credential(sql.FieldNEQ(FieldResetCodeHash, v))
+}
+
+// ResetCodeHashIn applies the In predicate on the "reset_code_hash" field.
+func ResetCodeHashIn(vs ...string) predicate.Credential {
+	return predicate.Credential(sql.FieldIn(FieldResetCodeHash, vs...))
+}
+
+// ResetCodeHashNotIn applies the NotIn predicate on the "reset_code_hash" field.
+func ResetCodeHashNotIn(vs ...string) predicate.Credential {
+	return predicate.Credential(sql.FieldNotIn(FieldResetCodeHash, vs...))
+}
+
+// ResetCodeHashGT applies the GT predicate on the "reset_code_hash" field.
+func ResetCodeHashGT(v string) predicate.Credential {
+	return predicate.Credential(sql.FieldGT(FieldResetCodeHash, v))
+}
+
+// ResetCodeHashGTE applies the GTE predicate on the "reset_code_hash" field.
+func ResetCodeHashGTE(v string) predicate.Credential {
+	return predicate.Credential(sql.FieldGTE(FieldResetCodeHash, v))
+}
+
+// ResetCodeHashLT applies the LT predicate on the "reset_code_hash" field.
+func ResetCodeHashLT(v string) predicate.Credential {
+	return predicate.Credential(sql.FieldLT(FieldResetCodeHash, v))
+}
+
+// ResetCodeHashLTE applies the LTE predicate on the "reset_code_hash" field.
+func ResetCodeHashLTE(v string) predicate.Credential {
+	return predicate.Credential(sql.FieldLTE(FieldResetCodeHash, v))
+}
+
+// ResetCodeHashContains applies the Contains predicate on the "reset_code_hash" field.
+func ResetCodeHashContains(v string) predicate.Credential {
+	return predicate.Credential(sql.FieldContains(FieldResetCodeHash, v))
+}
+
+// ResetCodeHashHasPrefix applies the HasPrefix predicate on the "reset_code_hash" field.
+func ResetCodeHashHasPrefix(v string) predicate.Credential {
+	return predicate.Credential(sql.FieldHasPrefix(FieldResetCodeHash, v))
+}
+
+// ResetCodeHashHasSuffix applies the HasSuffix predicate on the "reset_code_hash" field.
+func ResetCodeHashHasSuffix(v string) predicate.Credential {
+	return predicate.Credential(sql.FieldHasSuffix(FieldResetCodeHash, v))
+}
+
+// ResetCodeHashIsNil applies the IsNil predicate on the "reset_code_hash" field.
+func ResetCodeHashIsNil() predicate.Credential {
+	return predicate.Credential(sql.FieldIsNull(FieldResetCodeHash))
+}
+
+// ResetCodeHashNotNil applies the NotNil predicate on the "reset_code_hash" field.
+func ResetCodeHashNotNil() predicate.Credential {
+	return predicate.Credential(sql.FieldNotNull(FieldResetCodeHash))
+}
+
+// ResetCodeHashEqualFold applies the EqualFold predicate on the "reset_code_hash" field.
+func ResetCodeHashEqualFold(v string) predicate.Credential {
+	return predicate.Credential(sql.FieldEqualFold(FieldResetCodeHash, v))
+}
+
+// ResetCodeHashContainsFold applies the ContainsFold predicate on the "reset_code_hash" field.
+func ResetCodeHashContainsFold(v string) predicate.Credential {
+	return predicate.Credential(sql.FieldContainsFold(FieldResetCodeHash, v))
+}
+
+// ResetExpiresAtEQ applies the EQ predicate on the "reset_expires_at" field.
+func ResetExpiresAtEQ(v time.Time) predicate.Credential {
+	return predicate.Credential(sql.FieldEQ(FieldResetExpiresAt, v))
+}
+
+// ResetExpiresAtNEQ applies the NEQ predicate on the "reset_expires_at" field.
+func ResetExpiresAtNEQ(v time.Time) predicate.Credential {
+	return predicate.Credential(sql.FieldNEQ(FieldResetExpiresAt, v))
+}
+
+// ResetExpiresAtIn applies the In predicate on the "reset_expires_at" field.
+func ResetExpiresAtIn(vs ...time.Time) predicate.Credential {
+	return predicate.Credential(sql.FieldIn(FieldResetExpiresAt, vs...))
+}
+
+// ResetExpiresAtNotIn applies the NotIn predicate on the "reset_expires_at" field.
+func ResetExpiresAtNotIn(vs ...time.Time) predicate.Credential {
+	return predicate.Credential(sql.FieldNotIn(FieldResetExpiresAt, vs...))
+}
+
+// ResetExpiresAtGT applies the GT predicate on the "reset_expires_at" field.
+func ResetExpiresAtGT(v time.Time) predicate.Credential {
+	return predicate.Credential(sql.FieldGT(FieldResetExpiresAt, v))
+}
+
+// ResetExpiresAtGTE applies the GTE predicate on the "reset_expires_at" field.
+func ResetExpiresAtGTE(v time.Time) predicate.Credential {
+	return predicate.Credential(sql.FieldGTE(FieldResetExpiresAt, v))
+}
+
+// ResetExpiresAtLT applies the LT predicate on the "reset_expires_at" field.
+func ResetExpiresAtLT(v time.Time) predicate.Credential {
+	return predicate.Credential(sql.FieldLT(FieldResetExpiresAt, v))
+}
+
+// ResetExpiresAtLTE applies the LTE predicate on the "reset_expires_at" field.
+func ResetExpiresAtLTE(v time.Time) predicate.Credential {
+	return predicate.Credential(sql.FieldLTE(FieldResetExpiresAt, v))
+}
+
+// ResetExpiresAtIsNil applies the IsNil predicate on the "reset_expires_at" field.
+func ResetExpiresAtIsNil() predicate.Credential {
+	return predicate.Credential(sql.FieldIsNull(FieldResetExpiresAt))
+}
+
+// ResetExpiresAtNotNil applies the NotNil predicate on the "reset_expires_at" field.
+func ResetExpiresAtNotNil() predicate.Credential {
+	return predicate.Credential(sql.FieldNotNull(FieldResetExpiresAt))
+}
+
+// ResetAttemptsEQ applies the EQ predicate on the "reset_attempts" field.
+func ResetAttemptsEQ(v int) predicate.Credential {
+	return predicate.Credential(sql.FieldEQ(FieldResetAttempts, v))
+}
+
+// ResetAttemptsNEQ applies the NEQ predicate on the "reset_attempts" field.
+func ResetAttemptsNEQ(v int) predicate.Credential {
+	return predicate.Credential(sql.FieldNEQ(FieldResetAttempts, v))
+}
+
+// ResetAttemptsIn applies the In predicate on the "reset_attempts" field.
+func ResetAttemptsIn(vs ...int) predicate.Credential {
+	return predicate.Credential(sql.FieldIn(FieldResetAttempts, vs...))
+}
+
+// ResetAttemptsNotIn applies the NotIn predicate on the "reset_attempts" field.
+func ResetAttemptsNotIn(vs ...int) predicate.Credential {
+	return predicate.Credential(sql.FieldNotIn(FieldResetAttempts, vs...))
+}
+
+// ResetAttemptsGT applies the GT predicate on the "reset_attempts" field.
+func ResetAttemptsGT(v int) predicate.Credential {
+	return predicate.Credential(sql.FieldGT(FieldResetAttempts, v))
+}
+
+// ResetAttemptsGTE applies the GTE predicate on the "reset_attempts" field.
+func ResetAttemptsGTE(v int) predicate.Credential {
+	return predicate.Credential(sql.FieldGTE(FieldResetAttempts, v))
+}
+
+// ResetAttemptsLT applies the LT predicate on the "reset_attempts" field.
+func ResetAttemptsLT(v int) predicate.Credential {
+	return predicate.Credential(sql.FieldLT(FieldResetAttempts, v))
+}
+
+// ResetAttemptsLTE applies the LTE predicate on the "reset_attempts" field.
+func ResetAttemptsLTE(v int) predicate.Credential {
+	return predicate.Credential(sql.FieldLTE(FieldResetAttempts, v))
+}
+
+// TokenVersionEQ applies the EQ predicate on the "token_version" field.
+func TokenVersionEQ(v int) predicate.Credential {
+	return predicate.Credential(sql.FieldEQ(FieldTokenVersion, v))
+}
+
+// TokenVersionNEQ applies the NEQ predicate on the "token_version" field.
+func TokenVersionNEQ(v int) predicate.Credential {
+	return predicate.Credential(sql.FieldNEQ(FieldTokenVersion, v))
+}
+
+// TokenVersionIn applies the In predicate on the "token_version" field.
+func TokenVersionIn(vs ...int) predicate.Credential {
+	return predicate.Credential(sql.FieldIn(FieldTokenVersion, vs...))
+}
+
+// TokenVersionNotIn applies the NotIn predicate on the "token_version" field.
+func TokenVersionNotIn(vs ...int) predicate.Credential {
+	return predicate.Credential(sql.FieldNotIn(FieldTokenVersion, vs...))
+}
+
+// TokenVersionGT applies the GT predicate on the "token_version" field.
+func TokenVersionGT(v int) predicate.Credential {
+	return predicate.Credential(sql.FieldGT(FieldTokenVersion, v))
+}
+
+// TokenVersionGTE applies the GTE predicate on the "token_version" field.
+func TokenVersionGTE(v int) predicate.Credential {
+	return predicate.Credential(sql.FieldGTE(FieldTokenVersion, v))
+}
+
+// TokenVersionLT applies the LT predicate on the "token_version" field.
+func TokenVersionLT(v int) predicate.Credential {
+	return predicate.Credential(sql.FieldLT(FieldTokenVersion, v))
+}
+
+// TokenVersionLTE applies the LTE predicate on the "token_version" field.
+func TokenVersionLTE(v int) predicate.Credential {
+	return predicate.Credential(sql.FieldLTE(FieldTokenVersion, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.Credential {
+	return predicate.Credential(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.Credential {
+	return predicate.Credential(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.Credential {
+	return predicate.Credential(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Credential {
+	return predicate.Credential(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.Credential {
+	return predicate.Credential(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.Credential {
+	return predicate.Credential(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.Credential {
+	return predicate.Credential(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.Credential {
+	return predicate.Credential(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.Credential {
+	return predicate.Credential(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.Credential {
+	return predicate.Credential(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.Credential {
+	return predicate.Credential(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.Credential {
+	return predicate.Credential(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.Credential {
+	return predicate.Credential(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.Credential {
+	return predicate.Credential(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.Credential {
+	return predicate.Credential(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.Credential {
+	return predicate.Credential(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Credential) predicate.Credential {
+	return predicate.Credential(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Credential) predicate.Credential {
+	return predicate.Credential(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Credential) predicate.Credential {
+	return predicate.Credential(sql.NotPredicates(p))
+}
@@ -0,0 +1,1412 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/aki-13627/animalia/backend-go/ent/credential"
+	"github.com/google/uuid"
+)
+
+// CredentialCreate is the builder for creating a Credential entity.
+type CredentialCreate struct {
+	config
+	mutation *CredentialMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetEmail sets the "email" field.
+func (cc *CredentialCreate) SetEmail(s string) *CredentialCreate {
+	cc.mutation.SetEmail(s)
+	return cc
+}
+
+// SetPasswordHash sets the "password_hash" field.
+func (cc *CredentialCreate) SetPasswordHash(s string) *CredentialCreate {
+	cc.mutation.SetPasswordHash(s)
+	return cc
+}
+
+// SetEmailVerified sets the "email_verified" field.
+func (cc *CredentialCreate) SetEmailVerified(b bool) *CredentialCreate {
+	cc.mutation.SetEmailVerified(b)
+	return cc
+}
+
+// SetNillableEmailVerified sets the "email_verified" field if the given value is not nil.
+func (cc *CredentialCreate) SetNillableEmailVerified(b *bool) *CredentialCreate {
+	if b != nil {
+		cc.SetEmailVerified(*b)
+	}
+	return cc
+}
+
+// SetVerificationCodeHash sets the "verification_code_hash" field.
+func (cc *CredentialCreate) SetVerificationCodeHash(s string) *CredentialCreate {
+	cc.mutation.SetVerificationCodeHash(s)
+	return cc
+}
+
+// SetNillableVerificationCodeHash sets the "verification_code_hash" field if the given value is not nil.
+func (cc *CredentialCreate) SetNillableVerificationCodeHash(s *string) *CredentialCreate {
+	if s != nil {
+		cc.SetVerificationCodeHash(*s)
+	}
+	return cc
+}
+
+// SetVerificationExpiresAt sets the "verification_expires_at" field.
+func (cc *CredentialCreate) SetVerificationExpiresAt(t time.Time) *CredentialCreate {
+	cc.mutation.SetVerificationExpiresAt(t)
+	return cc
+}
+
+// SetNillableVerificationExpiresAt sets the "verification_expires_at" field if the given value is not nil.
+func (cc *CredentialCreate) SetNillableVerificationExpiresAt(t *time.Time) *CredentialCreate {
+	if t != nil {
+		cc.SetVerificationExpiresAt(*t)
+	}
+	return cc
+}
+
+// SetVerificationAttempts sets the "verification_attempts" field.
+func (cc *CredentialCreate) SetVerificationAttempts(i int) *CredentialCreate {
+	cc.mutation.SetVerificationAttempts(i)
+	return cc
+}
+
+// SetNillableVerificationAttempts sets the "verification_attempts" field if the given value is not nil.
+func (cc *CredentialCreate) SetNillableVerificationAttempts(i *int) *CredentialCreate {
+	if i != nil {
+		cc.SetVerificationAttempts(*i)
+	}
+	return cc
+}
+
+// SetPendingEmail sets the "pending_email" field.
+func (cc *CredentialCreate) SetPendingEmail(s string) *CredentialCreate {
+	cc.mutation.SetPendingEmail(s)
+	return cc
+}
+
+// SetNillablePendingEmail sets the "pending_email" field if the given value is not nil.
+func (cc *CredentialCreate) SetNillablePendingEmail(s *string) *CredentialCreate {
+	if s != nil {
+		cc.SetPendingEmail(*s)
+	}
+	return cc
+}
+
+// SetResetCodeHash sets the "reset_code_hash" field.
+func (cc *CredentialCreate) SetResetCodeHash(s string) *CredentialCreate {
+	cc.mutation.SetResetCodeHash(s)
+	return cc
+}
+
+// SetNillableResetCodeHash sets the "reset_code_hash" field if the given value is not nil.
+func (cc *CredentialCreate) SetNillableResetCodeHash(s *string) *CredentialCreate {
+	if s != nil {
+		cc.SetResetCodeHash(*s)
+	}
+	return cc
+}
+
+// SetResetExpiresAt sets the "reset_expires_at" field.
+func (cc *CredentialCreate) SetResetExpiresAt(t time.Time) *CredentialCreate {
+	cc.mutation.SetResetExpiresAt(t)
+	return cc
+}
+
+// SetNillableResetExpiresAt sets the "reset_expires_at" field if the given value is not nil.
+func (cc *CredentialCreate) SetNillableResetExpiresAt(t *time.Time) *CredentialCreate {
+	if t != nil {
+		cc.SetResetExpiresAt(*t)
+	}
+	return cc
+}
+
+// SetResetAttempts sets the "reset_attempts" field.
+func (cc *CredentialCreate) SetResetAttempts(i int) *CredentialCreate {
+	cc.mutation.SetResetAttempts(i)
+	return cc
+}
+
+// SetNillableResetAttempts sets the "reset_attempts" field if the given value is not nil.
+func (cc *CredentialCreate) SetNillableResetAttempts(i *int) *CredentialCreate {
+	if i != nil {
+		cc.SetResetAttempts(*i)
+	}
+	return cc
+}
+
+// SetTokenVersion sets the "token_version" field.
+func (cc *CredentialCreate) SetTokenVersion(i int) *CredentialCreate {
+	cc.mutation.SetTokenVersion(i)
+	return cc
+}
+
+// SetNillableTokenVersion sets the "token_version" field if the given value is not nil.
+func (cc *CredentialCreate) SetNillableTokenVersion(i *int) *CredentialCreate {
+	if i != nil {
+		cc.SetTokenVersion(*i)
+	}
+	return cc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (cc *CredentialCreate) SetCreatedAt(t time.Time) *CredentialCreate {
+	cc.mutation.SetCreatedAt(t)
+	return cc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (cc *CredentialCreate) SetNillableCreatedAt(t *time.Time) *CredentialCreate {
+	if t != nil {
+		cc.SetCreatedAt(*t)
+	}
+	return cc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (cc *CredentialCreate) SetUpdatedAt(t time.Time) *CredentialCreate {
+	cc.mutation.SetUpdatedAt(t)
+	return cc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (cc *CredentialCreate) SetNillableUpdatedAt(t *time.Time) *CredentialCreate {
+	if t != nil {
+		cc.SetUpdatedAt(*t)
+	}
+	return cc
+}
+
+// SetID sets the "id" field.
+func (cc *CredentialCreate) SetID(u uuid.UUID) *CredentialCreate {
+	cc.mutation.SetID(u)
+	return cc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (cc *CredentialCreate) SetNillableID(u *uuid.UUID) *CredentialCreate {
+	if u != nil {
+		cc.SetID(*u)
+	}
+	return cc
+}
+
+// Mutation returns the CredentialMutation object of the builder.
+func (cc *CredentialCreate) Mutation() *CredentialMutation {
+	return cc.mutation
+}
+
+// Save creates the Credential in the database.
+func (cc *CredentialCreate) Save(ctx context.Context) (*Credential, error) {
+	cc.defaults()
+	return withHooks(ctx, cc.sqlSave, cc.mutation, cc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (cc *CredentialCreate) SaveX(ctx context.Context) *Credential {
+	v, err := cc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (cc *CredentialCreate) Exec(ctx context.Context) error {
+	_, err := cc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cc *CredentialCreate) ExecX(ctx context.Context) {
+	if err := cc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (cc *CredentialCreate) defaults() {
+	if _, ok := cc.mutation.EmailVerified(); !ok {
+		v := credential.DefaultEmailVerified
+		cc.mutation.SetEmailVerified(v)
+	}
+	if _, ok := cc.mutation.VerificationAttempts(); !ok {
+		v := credential.DefaultVerificationAttempts
+		cc.mutation.SetVerificationAttempts(v)
+	}
+	if _, ok := cc.mutation.ResetAttempts(); !ok {
+		v := credential.DefaultResetAttempts
+		cc.mutation.SetResetAttempts(v)
+	}
+	if _, ok := cc.mutation.TokenVersion(); !ok {
+		v := credential.DefaultTokenVersion
+		cc.mutation.SetTokenVersion(v)
+	}
+	if _, ok := cc.mutation.CreatedAt(); !ok {
+		v := credential.DefaultCreatedAt()
+		cc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := cc.mutation.UpdatedAt(); !ok {
+		v := credential.DefaultUpdatedAt()
+		cc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := cc.mutation.ID(); !ok {
+		v := credential.DefaultID()
+		cc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cc *CredentialCreate) check() error {
+	if _, ok := cc.mutation.Email(); !ok {
+		return &ValidationError{Name: "email", err: errors.New(`ent: missing required field "Credential.email"`)}
+	}
+	if v, ok := cc.mutation.Email(); ok {
+		if err := credential.EmailValidator(v); err != nil {
+			return &ValidationError{Name: "email", err: fmt.Errorf(`ent: validator failed for field "Credential.email": %w`, err)}
+		}
+	}
+	if _, ok := cc.mutation.PasswordHash(); !ok {
+		return &ValidationError{Name: "password_hash", err: errors.New(`ent: missing required field "Credential.password_hash"`)}
+	}
+	if v, ok := cc.mutation.PasswordHash(); ok {
+		if err := credential.PasswordHashValidator(v); err != nil {
+			return &ValidationError{Name: "password_hash", err: fmt.Errorf(`ent: validator failed for field "Credential.password_hash": %w`, err)}
+		}
+	}
+	if _, ok := cc.mutation.EmailVerified(); !ok {
+		return &ValidationError{Name: "email_verified", err: errors.New(`ent: missing required field "Credential.email_verified"`)}
+	}
+	if _, ok := cc.mutation.VerificationAttempts(); !ok {
+		return &ValidationError{Name: "verification_attempts", err: errors.New(`ent: missing required field "Credential.verification_attempts"`)}
+	}
+	if _, ok := cc.mutation.ResetAttempts(); !ok {
+		return &ValidationError{Name: "reset_attempts", err: errors.New(`ent: missing required field "Credential.reset_attempts"`)}
+	}
+	if _, ok := cc.mutation.TokenVersion(); !ok {
+		return &ValidationError{Name: "token_version", err: errors.New(`ent: missing required field "Credential.token_version"`)}
+	}
+	if _, ok := cc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Credential.created_at"`)}
+	}
+	if _, ok := cc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Credential.updated_at"`)}
+	}
+	return nil
+}
+
+func (cc *CredentialCreate) sqlSave(ctx context.Context) (*Credential, error) {
+	if err := cc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := cc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, cc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	cc.mutation.id = &_node.ID
+	cc.mutation.done = true
+	return _node, nil
+}
+
+func (cc *CredentialCreate) createSpec() (*Credential, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Credential{config: cc.config}
+		_spec = sqlgraph.NewCreateSpec(credential.Table, sqlgraph.NewFieldSpec(credential.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = cc.conflict
+	if id, ok := cc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := cc.mutation.Email(); ok {
+		_spec.SetField(credential.FieldEmail, field.TypeString, value)
+		_node.Email = value
+	}
+	if value, ok := cc.mutation.PasswordHash(); ok {
+		_spec.SetField(credential.FieldPasswordHash, field.TypeString, value)
+		_node.PasswordHash = value
+	}
+	if value, ok := cc.mutation.EmailVerified(); ok {
+		_spec.SetField(credential.FieldEmailVerified, field.TypeBool, value)
+		_node.EmailVerified = value
+	}
+	if value, ok := cc.mutation.VerificationCodeHash(); ok {
+		_spec.SetField(credential.FieldVerificationCodeHash, field.TypeString, value)
+		_node.VerificationCodeHash = value
+	}
+	if value, ok := cc.mutation.VerificationExpiresAt(); ok {
+		_spec.SetField(credential.FieldVerificationExpiresAt, field.TypeTime, value)
+		_node.VerificationExpiresAt = &value
+	}
+	if value, ok := cc.mutation.VerificationAttempts(); ok {
+		_spec.SetField(credential.FieldVerificationAttempts, field.TypeInt, value)
+		_node.VerificationAttempts = value
+	}
+	if value, ok := cc.mutation.PendingEmail(); ok {
+		_spec.SetField(credential.FieldPendingEmail, field.TypeString, value)
+		_node.PendingEmail = value
+	}
+	if value, ok := cc.mutation.ResetCodeHash(); ok {
+		_spec.SetField(credential.FieldResetCodeHash, field.TypeString, value)
+		_node.ResetCodeHash = value
+	}
+	if value, ok := cc.mutation.ResetExpiresAt(); ok {
+		_spec.SetField(credential.FieldResetExpiresAt, field.TypeTime, value)
+		_node.ResetExpiresAt = &value
+	}
+	if value, ok := cc.mutation.ResetAttempts(); ok {
+		_spec.SetField(credential.FieldResetAttempts, field.TypeInt, value)
+		_node.ResetAttempts = value
+	}
+	if value, ok := cc.mutation.TokenVersion(); ok {
+		_spec.SetField(credential.FieldTokenVersion, field.TypeInt, value)
+		_node.TokenVersion = value
+	}
+	if value, ok := cc.mutation.CreatedAt(); ok {
+		_spec.SetField(credential.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := cc.mutation.UpdatedAt(); ok {
+		_spec.SetField(credential.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Credential.Create().
+//		SetEmail(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.CredentialUpsert) {
+//			SetEmail(v+v).
+//		}).
+//		Exec(ctx)
+func (cc *CredentialCreate) OnConflict(opts ...sql.ConflictOption) *CredentialUpsertOne {
+	cc.conflict = opts
+	return &CredentialUpsertOne{
+		create: cc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Credential.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (cc *CredentialCreate) OnConflictColumns(columns ...string) *CredentialUpsertOne {
+	cc.conflict = append(cc.conflict, sql.ConflictColumns(columns...))
+	return &CredentialUpsertOne{
+		create: cc,
+	}
+}
+
+type (
+	// CredentialUpsertOne is the builder for "upsert"-ing
+	//  one Credential node.
+	CredentialUpsertOne struct {
+		create *CredentialCreate
+	}
+
+	// CredentialUpsert is the "OnConflict" setter.
+	CredentialUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetEmail sets the "email" field.
+func (u *CredentialUpsert) SetEmail(v string) *CredentialUpsert {
+	u.Set(credential.FieldEmail, v)
+	return u
+}
+
+// UpdateEmail sets the "email" field to the value that was provided on create.
+func (u *CredentialUpsert) UpdateEmail() *CredentialUpsert {
+	u.SetExcluded(credential.FieldEmail)
+	return u
+}
+
+// SetPasswordHash sets the "password_hash" field.
+func (u *CredentialUpsert) SetPasswordHash(v string) *CredentialUpsert {
+	u.Set(credential.FieldPasswordHash, v)
+	return u
+}
+
+// UpdatePasswordHash sets the "password_hash" field to the value that was provided on create.
+func (u *CredentialUpsert) UpdatePasswordHash() *CredentialUpsert {
+	u.SetExcluded(credential.FieldPasswordHash)
+	return u
+}
+
+// SetEmailVerified sets the "email_verified" field.
+func (u *CredentialUpsert) SetEmailVerified(v bool) *CredentialUpsert {
+	u.Set(credential.FieldEmailVerified, v)
+	return u
+}
+
+// UpdateEmailVerified sets the "email_verified" field to the value that was provided on create.
+func (u *CredentialUpsert) UpdateEmailVerified() *CredentialUpsert {
+	u.SetExcluded(credential.FieldEmailVerified)
+	return u
+}
+
+// SetVerificationCodeHash sets the "verification_code_hash" field.
+func (u *CredentialUpsert) SetVerificationCodeHash(v string) *CredentialUpsert {
+	u.Set(credential.FieldVerificationCodeHash, v)
+	return u
+}
+
+// UpdateVerificationCodeHash sets the "verification_code_hash" field to the value that was provided on create.
+func (u *CredentialUpsert) UpdateVerificationCodeHash() *CredentialUpsert {
+	u.SetExcluded(credential.FieldVerificationCodeHash)
+	return u
+}
+
+// ClearVerificationCodeHash clears the value of the "verification_code_hash" field.
+func (u *CredentialUpsert) ClearVerificationCodeHash() *CredentialUpsert {
+	u.SetNull(credential.FieldVerificationCodeHash)
+	return u
+}
+
+// SetVerificationExpiresAt sets the "verification_expires_at" field.
+func (u *CredentialUpsert) SetVerificationExpiresAt(v time.Time) *CredentialUpsert {
+	u.Set(credential.FieldVerificationExpiresAt, v)
+	return u
+}
+
+// UpdateVerificationExpiresAt sets the "verification_expires_at" field to the value that was provided on create.
+func (u *CredentialUpsert) UpdateVerificationExpiresAt() *CredentialUpsert {
+	u.SetExcluded(credential.FieldVerificationExpiresAt)
+	return u
+}
+
+// ClearVerificationExpiresAt clears the value of the "verification_expires_at" field.
+func (u *CredentialUpsert) ClearVerificationExpiresAt() *CredentialUpsert {
+	u.SetNull(credential.FieldVerificationExpiresAt)
+	return u
+}
+
+// SetVerificationAttempts sets the "verification_attempts" field.
+func (u *CredentialUpsert) SetVerificationAttempts(v int) *CredentialUpsert {
+	u.Set(credential.FieldVerificationAttempts, v)
+	return u
+}
+
+// UpdateVerificationAttempts sets the "verification_attempts" field to the value that was provided on create.
+func (u *CredentialUpsert) UpdateVerificationAttempts() *CredentialUpsert {
+	u.SetExcluded(credential.FieldVerificationAttempts)
+	return u
+}
+
+// AddVerificationAttempts adds v to the "verification_attempts" field.
+func (u *CredentialUpsert) AddVerificationAttempts(v int) *CredentialUpsert {
+	u.Add(credential.FieldVerificationAttempts, v)
+	return u
+}
+
+// SetPendingEmail sets the "pending_email" field.
+func (u *CredentialUpsert) SetPendingEmail(v string) *CredentialUpsert {
+	u.Set(credential.FieldPendingEmail, v)
+	return u
+}
+
+// UpdatePendingEmail sets the "pending_email" field to the value that was provided on create.
+func (u *CredentialUpsert) UpdatePendingEmail() *CredentialUpsert {
+	u.SetExcluded(credential.FieldPendingEmail)
+	return u
+}
+
+// ClearPendingEmail clears the value of the "pending_email" field.
+func (u *CredentialUpsert) ClearPendingEmail() *CredentialUpsert {
+	u.SetNull(credential.FieldPendingEmail)
+	return u
+}
+
+// SetResetCodeHash sets the "reset_code_hash" field.
+func (u *CredentialUpsert) SetResetCodeHash(v string) *CredentialUpsert {
+	u.Set(credential.FieldResetCodeHash, v)
+	return u
+}
+
+// UpdateResetCodeHash sets the "reset_code_hash" field to the value that was provided on create.
+func (u *CredentialUpsert) UpdateResetCodeHash() *CredentialUpsert {
+	u.SetExcluded(credential.FieldResetCodeHash)
+	return u
+}
+
+// ClearResetCodeHash clears the value of the "reset_code_hash" field.
+func (u *CredentialUpsert) ClearResetCodeHash() *CredentialUpsert {
+	u.SetNull(credential.FieldResetCodeHash)
+	return u
+}
+
+// SetResetExpiresAt sets the "reset_expires_at" field.
+func (u *CredentialUpsert) SetResetExpiresAt(v time.Time) *CredentialUpsert {
+	u.Set(credential.FieldResetExpiresAt, v)
+	return u
+}
+
+// UpdateResetExpiresAt sets the "reset_expires_at" field to the value that was provided on create.
+func (u *CredentialUpsert) UpdateResetExpiresAt() *CredentialUpsert {
+	u.SetExcluded(credential.FieldResetExpiresAt)
+	return u
+}
+
+// ClearResetExpiresAt clears the value of the "reset_expires_at" field.
+func (u *CredentialUpsert) ClearResetExpiresAt() *CredentialUpsert {
+	u.SetNull(credential.FieldResetExpiresAt)
+	return u
+}
+
+// SetResetAttempts sets the "reset_attempts" field.
+func (u *CredentialUpsert) SetResetAttempts(v int) *CredentialUpsert {
+	u.Set(credential.FieldResetAttempts, v)
+	return u
+}
+
+// UpdateResetAttempts sets the "reset_attempts" field to the value that was provided on create.
+func (u *CredentialUpsert) UpdateResetAttempts() *CredentialUpsert {
+	u.SetExcluded(credential.FieldResetAttempts)
+	return u
+}
+
+// AddResetAttempts adds v to the "reset_attempts" field.
+func (u *CredentialUpsert) AddResetAttempts(v int) *CredentialUpsert {
+	u.Add(credential.FieldResetAttempts, v)
+	return u
+}
+
+// SetTokenVersion sets the "token_version" field.
+func (u *CredentialUpsert) SetTokenVersion(v int) *CredentialUpsert {
+	u.Set(credential.FieldTokenVersion, v)
+	return u
+}
+
+// UpdateTokenVersion sets the "token_version" field to the value that was provided on create.
+func (u *CredentialUpsert) UpdateTokenVersion() *CredentialUpsert {
+	u.SetExcluded(credential.FieldTokenVersion)
+	return u
+}
+
+// AddTokenVersion adds v to the "token_version" field.
+func (u *CredentialUpsert) AddTokenVersion(v int) *CredentialUpsert {
+	u.Add(credential.FieldTokenVersion, v)
+	return u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *CredentialUpsert) SetCreatedAt(v time.Time) *CredentialUpsert {
+	u.Set(credential.FieldCreatedAt, v)
+	return u
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *CredentialUpsert) UpdateCreatedAt() *CredentialUpsert {
+	u.SetExcluded(credential.FieldCreatedAt)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *CredentialUpsert) SetUpdatedAt(v time.Time) *CredentialUpsert {
+	u.Set(credential.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *CredentialUpsert) UpdateUpdatedAt() *CredentialUpsert {
+	u.SetExcluded(credential.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.Credential.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(credential.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *CredentialUpsertOne) UpdateNewValues() *CredentialUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(credential.FieldID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Credential.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *CredentialUpsertOne) Ignore() *CredentialUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *CredentialUpsertOne) DoNothing() *CredentialUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the CredentialCreate.OnConflict
+// documentation for more info.
+func (u *CredentialUpsertOne) Update(set func(*CredentialUpsert)) *CredentialUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&CredentialUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetEmail sets the "email" field.
+func (u *CredentialUpsertOne) SetEmail(v string) *CredentialUpsertOne {
+	return u.Update(func(s *CredentialUpsert) {
+		s.SetEmail(v)
+	})
+}
+
+// UpdateEmail sets the "email" field to the value that was provided on create.
+func (u *CredentialUpsertOne) UpdateEmail() *CredentialUpsertOne {
+	return u.Update(func(s *CredentialUpsert) {
+		s.UpdateEmail()
+	})
+}
+
+// SetPasswordHash sets the "password_hash" field.
+func (u *CredentialUpsertOne) SetPasswordHash(v string) *CredentialUpsertOne {
+	return u.Update(func(s *CredentialUpsert) {
+		s.SetPasswordHash(v)
+	})
+}
+
+// UpdatePasswordHash sets the "password_hash" field to the value that was provided on create.
+func (u *CredentialUpsertOne) UpdatePasswordHash() *CredentialUpsertOne {
+	return u.Update(func(s *CredentialUpsert) {
+		s.UpdatePasswordHash()
+	})
+}
+
+// SetEmailVerified sets the "email_verified" field.
+func (u *CredentialUpsertOne) SetEmailVerified(v bool) *CredentialUpsertOne {
+	return u.Update(func(s *CredentialUpsert) {
+		s.SetEmailVerified(v)
+	})
+}
+
+// UpdateEmailVerified sets the "email_verified" field to the value that was provided on create.
+func (u *CredentialUpsertOne) UpdateEmailVerified() *CredentialUpsertOne {
+	return u.Update(func(s *CredentialUpsert) {
+		s.UpdateEmailVerified()
+	})
+}
+
+// SetVerificationCodeHash sets the "verification_code_hash" field.
+func (u *CredentialUpsertOne) SetVerificationCodeHash(v string) *CredentialUpsertOne {
+	return u.Update(func(s *CredentialUpsert) {
+		s.SetVerificationCodeHash(v)
+	})
+}
+
+// UpdateVerificationCodeHash sets the "verification_code_hash" field to the value that was provided on create.
+func (u *CredentialUpsertOne) UpdateVerificationCodeHash() *CredentialUpsertOne {
+	return u.Update(func(s *CredentialUpsert) {
+		s.UpdateVerificationCodeHash()
+	})
+}
+
+// ClearVerificationCodeHash clears the value of the "verification_code_hash" field.
+func (u *CredentialUpsertOne) ClearVerificationCodeHash() *CredentialUpsertOne {
+	return u.Update(func(s *CredentialUpsert) {
+		s.ClearVerificationCodeHash()
+	})
+}
+
+// SetVerificationExpiresAt sets the "verification_expires_at" field.
+func (u *CredentialUpsertOne) SetVerificationExpiresAt(v time.Time) *CredentialUpsertOne {
+	return u.Update(func(s *CredentialUpsert) {
+		s.SetVerificationExpiresAt(v)
+	})
+}
+
+// UpdateVerificationExpiresAt sets the "verification_expires_at" field to the value that was provided on create.
+func (u *CredentialUpsertOne) UpdateVerificationExpiresAt() *CredentialUpsertOne {
+	return u.Update(func(s *CredentialUpsert) {
+		s.UpdateVerificationExpiresAt()
+	})
+}
+
+// ClearVerificationExpiresAt clears the value of the "verification_expires_at" field.
+func (u *CredentialUpsertOne) ClearVerificationExpiresAt() *CredentialUpsertOne {
+	return u.Update(func(s *CredentialUpsert) {
+		s.ClearVerificationExpiresAt()
+	})
+}
+
+// SetVerificationAttempts sets the "verification_attempts" field.
+func (u *CredentialUpsertOne) SetVerificationAttempts(v int) *CredentialUpsertOne {
+	return u.Update(func(s *CredentialUpsert) {
+		s.SetVerificationAttempts(v)
+	})
+}
+
+// AddVerificationAttempts adds v to the "verification_attempts" field.
+func (u *CredentialUpsertOne) AddVerificationAttempts(v int) *CredentialUpsertOne {
+	return u.Update(func(s *CredentialUpsert) {
+		s.AddVerificationAttempts(v)
+	})
+}
+
+// UpdateVerificationAttempts sets the "verification_attempts" field to the value that was provided on create.
+func (u *CredentialUpsertOne) UpdateVerificationAttempts() *CredentialUpsertOne {
+	return u.Update(func(s *CredentialUpsert) {
+		s.UpdateVerificationAttempts()
+	})
+}
+
+// SetPendingEmail sets the "pending_email" field.
+func (u *CredentialUpsertOne) SetPendingEmail(v string) *CredentialUpsertOne {
+	return u.Update(func(s *CredentialUpsert) {
+		s.SetPendingEmail(v)
+	})
+}
+
+// UpdatePendingEmail sets the "pending_email" field to the value that was provided on create.
+func (u *CredentialUpsertOne) UpdatePendingEmail() *CredentialUpsertOne {
+	return u.Update(func(s *CredentialUpsert) {
+		s.UpdatePendingEmail()
+	})
+}
+
+// ClearPendingEmail clears the value of the "pending_email" field.
+func (u *CredentialUpsertOne) ClearPendingEmail() *CredentialUpsertOne {
+	return u.Update(func(s *CredentialUpsert) {
+		s.ClearPendingEmail()
+	})
+}
+
+// SetResetCodeHash sets the "reset_code_hash" field.
+func (u *CredentialUpsertOne) SetResetCodeHash(v string) *CredentialUpsertOne {
+	return u.Update(func(s *CredentialUpsert) {
+		s.SetResetCodeHash(v)
+	})
+}
+
+// UpdateResetCodeHash sets the "reset_code_hash" field to the value that was provided on create.
+func (u *CredentialUpsertOne) UpdateResetCodeHash() *CredentialUpsertOne {
+	return u.Update(func(s *CredentialUpsert) {
+		s.UpdateResetCodeHash()
+	})
+}
+
+// ClearResetCodeHash clears the value of the "reset_code_hash" field.
+func (u *CredentialUpsertOne) ClearResetCodeHash() *CredentialUpsertOne {
+	return u.Update(func(s *CredentialUpsert) {
+		s.ClearResetCodeHash()
+	})
+}
+
+// SetResetExpiresAt sets the "reset_expires_at" field.
+func (u *CredentialUpsertOne) SetResetExpiresAt(v time.Time) *CredentialUpsertOne {
+	return u.Update(func(s *CredentialUpsert) {
+		s.SetResetExpiresAt(v)
+	})
+}
+
+// UpdateResetExpiresAt sets the "reset_expires_at" field to the value that was provided on create.
+func (u *CredentialUpsertOne) UpdateResetExpiresAt() *CredentialUpsertOne {
+	return u.Update(func(s *CredentialUpsert) {
+		s.UpdateResetExpiresAt()
+	})
+}
+
+// ClearResetExpiresAt clears the value of the "reset_expires_at" field.
+func (u *CredentialUpsertOne) ClearResetExpiresAt() *CredentialUpsertOne {
+	return u.Update(func(s *CredentialUpsert) {
+		s.ClearResetExpiresAt()
+	})
+}
+
+// SetResetAttempts sets the "reset_attempts" field.
+func (u *CredentialUpsertOne) SetResetAttempts(v int) *CredentialUpsertOne {
+	return u.Update(func(s *CredentialUpsert) {
+		s.SetResetAttempts(v)
+	})
+}
+
+// AddResetAttempts adds v to the "reset_attempts" field.
+func (u *CredentialUpsertOne) AddResetAttempts(v int) *CredentialUpsertOne {
+	return u.Update(func(s *CredentialUpsert) {
+		s.AddResetAttempts(v)
+	})
+}
+
+// UpdateResetAttempts sets the "reset_attempts" field to the value that was provided on create.
+func (u *CredentialUpsertOne) UpdateResetAttempts() *CredentialUpsertOne {
+	return u.Update(func(s *CredentialUpsert) {
+		s.UpdateResetAttempts()
+	})
+}
+
+// SetTokenVersion sets the "token_version" field.
+func (u *CredentialUpsertOne) SetTokenVersion(v int) *CredentialUpsertOne {
+	return u.Update(func(s *CredentialUpsert) {
+		s.SetTokenVersion(v)
+	})
+}
+
+// AddTokenVersion adds v to the "token_version" field.
+func (u *CredentialUpsertOne) AddTokenVersion(v int) *CredentialUpsertOne {
+	return u.Update(func(s *CredentialUpsert) {
+		s.AddTokenVersion(v)
+	})
+}
+
+// UpdateTokenVersion sets the "token_version" field to the value that was provided on create.
+func (u *CredentialUpsertOne) UpdateTokenVersion() *CredentialUpsertOne {
+	return u.Update(func(s *CredentialUpsert) {
+		s.UpdateTokenVersion()
+	})
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *CredentialUpsertOne) SetCreatedAt(v time.Time) *CredentialUpsertOne {
+	return u.Update(func(s *CredentialUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *CredentialUpsertOne) UpdateCreatedAt() *CredentialUpsertOne {
+	return u.Update(func(s *CredentialUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *CredentialUpsertOne) SetUpdatedAt(v time.Time) *CredentialUpsertOne {
+	return u.Update(func(s *CredentialUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *CredentialUpsertOne) UpdateUpdatedAt() *CredentialUpsertOne {
+	return u.Update(func(s *CredentialUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *CredentialUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for CredentialCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *CredentialUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *CredentialUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: CredentialUpsertOne.ID is not supported by MySQL driver. Use CredentialUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *CredentialUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// CredentialCreateBulk is the builder for creating many Credential entities in bulk.
+type CredentialCreateBulk struct {
+	config
+	err      error
+	builders []*CredentialCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the Credential entities in the database.
+func (ccb *CredentialCreateBulk) Save(ctx context.Context) ([]*Credential, error) {
+	if ccb.err != nil {
+		return nil, ccb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(ccb.builders))
+	nodes := make([]*Credential, len(ccb.builders))
+	mutators := make([]Mutator, len(ccb.builders))
+	for i := range ccb.builders {
+		func(i int, root context.Context) {
+			builder := ccb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*CredentialMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, ccb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = ccb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, ccb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, ccb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ccb *CredentialCreateBulk) SaveX(ctx context.Context) []*Credential {
+	v, err := ccb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ccb *CredentialCreateBulk) Exec(ctx context.Context) error {
+	_, err := ccb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ccb *CredentialCreateBulk) ExecX(ctx context.Context) {
+	if err := ccb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Credential.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.CredentialUpsert) {
+//			SetEmail(v+v).
+//		}).
+//		Exec(ctx)
+func (ccb *CredentialCreateBulk) OnConflict(opts ...sql.ConflictOption) *CredentialUpsertBulk {
+	ccb.conflict = opts
+	return &CredentialUpsertBulk{
+		create: ccb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Credential.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (ccb *CredentialCreateBulk) OnConflictColumns(columns ...string) *CredentialUpsertBulk {
+	ccb.conflict = append(ccb.conflict, sql.ConflictColumns(columns...))
+	return &CredentialUpsertBulk{
+		create: ccb,
+	}
+}
+
+// CredentialUpsertBulk is the builder for "upsert"-ing
+// a bulk of Credential nodes.
+type CredentialUpsertBulk struct {
+	create *CredentialCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Credential.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(credential.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *CredentialUpsertBulk) UpdateNewValues() *CredentialUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(credential.FieldID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Credential.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *CredentialUpsertBulk) Ignore() *CredentialUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *CredentialUpsertBulk) DoNothing() *CredentialUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the CredentialCreateBulk.OnConflict
+// documentation for more info.
+func (u *CredentialUpsertBulk) Update(set func(*CredentialUpsert)) *CredentialUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&CredentialUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetEmail sets the "email" field.
+func (u *CredentialUpsertBulk) SetEmail(v string) *CredentialUpsertBulk {
+	return u.Update(func(s *CredentialUpsert) {
+		s.SetEmail(v)
+	})
+}
+
+// UpdateEmail sets the "email" field to the value that was provided on create.
+func (u *CredentialUpsertBulk) UpdateEmail() *CredentialUpsertBulk {
+	return u.Update(func(s *CredentialUpsert) {
+		s.UpdateEmail()
+	})
+}
+
+// SetPasswordHash sets the "password_hash" field.
+func (u *CredentialUpsertBulk) SetPasswordHash(v string) *CredentialUpsertBulk {
+	return u.Update(func(s *CredentialUpsert) {
+		s.SetPasswordHash(v)
+	})
+}
+
+// UpdatePasswordHash sets the "password_hash" field to the value that was provided on create.
+func (u *CredentialUpsertBulk) UpdatePasswordHash() *CredentialUpsertBulk {
+	return u.Update(func(s *CredentialUpsert) {
+		s.UpdatePasswordHash()
+	})
+}
+
+// SetEmailVerified sets the "email_verified" field.
+func (u *CredentialUpsertBulk) SetEmailVerified(v bool) *CredentialUpsertBulk {
+	return u.Update(func(s *CredentialUpsert) {
+		s.SetEmailVerified(v)
+	})
+}
+
+// UpdateEmailVerified sets the "email_verified" field to the value that was provided on create.
+func (u *CredentialUpsertBulk) UpdateEmailVerified() *CredentialUpsertBulk {
+	return u.Update(func(s *CredentialUpsert) {
+		s.UpdateEmailVerified()
+	})
+}
+
+// SetVerificationCodeHash sets the "verification_code_hash" field.
+func (u *CredentialUpsertBulk) SetVerificationCodeHash(v string) *CredentialUpsertBulk {
+	return u.Update(func(s *CredentialUpsert) {
+		s.SetVerificationCodeHash(v)
+	})
+}
+
+// UpdateVerificationCodeHash sets the "verification_code_hash" field to the value that was provided on create.
+func (u *CredentialUpsertBulk) UpdateVerificationCodeHash() *CredentialUpsertBulk {
+	return u.Update(func(s *CredentialUpsert) {
+		s.UpdateVerificationCodeHash()
+	})
+}
+
+// ClearVerificationCodeHash clears the value of the "verification_code_hash" field.
+func (u *CredentialUpsertBulk) ClearVerificationCodeHash() *CredentialUpsertBulk {
+	return u.Update(func(s *CredentialUpsert) {
+		s.ClearVerificationCodeHash()
+	})
+}
+
+// SetVerificationExpiresAt sets the "verification_expires_at" field.
+func (u *CredentialUpsertBulk) SetVerificationExpiresAt(v time.Time) *CredentialUpsertBulk {
+	return u.Update(func(s *CredentialUpsert) {
+		s.SetVerificationExpiresAt(v)
+	})
+}
+
+// UpdateVerificationExpiresAt sets the "verification_expires_at" field to the value that was provided on create.
+func (u *CredentialUpsertBulk) UpdateVerificationExpiresAt() *CredentialUpsertBulk {
+	return u.Update(func(s *CredentialUpsert) {
+		s.UpdateVerificationExpiresAt()
+	})
+}
+
+// ClearVerificationExpiresAt clears the value of the "verification_expires_at" field.
+func (u *CredentialUpsertBulk) ClearVerificationExpiresAt() *CredentialUpsertBulk {
+	return u.Update(func(s *CredentialUpsert) {
+		s.ClearVerificationExpiresAt()
+	})
+}
+
+// SetVerificationAttempts sets the "verification_attempts" field.
+func (u *CredentialUpsertBulk) SetVerificationAttempts(v int) *CredentialUpsertBulk {
+	return u.Update(func(s *CredentialUpsert) {
+		s.SetVerificationAttempts(v)
+	})
+}
+
+// AddVerificationAttempts adds v to the "verification_attempts" field.
+func (u *CredentialUpsertBulk) AddVerificationAttempts(v int) *CredentialUpsertBulk {
+	return u.Update(func(s *CredentialUpsert) {
+		s.AddVerificationAttempts(v)
+	})
+}
+
+// UpdateVerificationAttempts sets the "verification_attempts" field to the value that was provided on create.
+func (u *CredentialUpsertBulk) UpdateVerificationAttempts() *CredentialUpsertBulk {
+	return u.Update(func(s *CredentialUpsert) {
+		s.UpdateVerificationAttempts()
+	})
+}
+
+// SetPendingEmail sets the "pending_email" field.
+func (u *CredentialUpsertBulk) SetPendingEmail(v string) *CredentialUpsertBulk {
+	return u.Update(func(s *CredentialUpsert) {
+		s.SetPendingEmail(v)
+	})
+}
+
+// UpdatePendingEmail sets the "pending_email" field to the value that was provided on create.
+func (u *CredentialUpsertBulk) UpdatePendingEmail() *CredentialUpsertBulk {
+	return u.Update(func(s *CredentialUpsert) {
+		s.UpdatePendingEmail()
+	})
+}
+
+// ClearPendingEmail clears the value of the "pending_email" field.
+func (u *CredentialUpsertBulk) ClearPendingEmail() *CredentialUpsertBulk {
+	return u.Update(func(s *CredentialUpsert) {
+		s.ClearPendingEmail()
+	})
+}
+
+// SetResetCodeHash sets the "reset_code_hash" field.
+func (u *CredentialUpsertBulk) SetResetCodeHash(v string) *CredentialUpsertBulk {
+	return u.Update(func(s *CredentialUpsert) {
+		s.SetResetCodeHash(v)
+	})
+}
+
+// UpdateResetCodeHash sets the "reset_code_hash" field to the value that was provided on create.
+func (u *CredentialUpsertBulk) UpdateResetCodeHash() *CredentialUpsertBulk {
+	return u.Update(func(s *CredentialUpsert) {
+		s.UpdateResetCodeHash()
+	})
+}
+
+// ClearResetCodeHash clears the value of the "reset_code_hash" field.
+func (u *CredentialUpsertBulk) ClearResetCodeHash() *CredentialUpsertBulk {
+	return u.Update(func(s *CredentialUpsert) {
+		s.ClearResetCodeHash()
+	})
+}
+
+// SetResetExpiresAt sets the "reset_expires_at" field.
+func (u *CredentialUpsertBulk) SetResetExpiresAt(v time.Time) *CredentialUpsertBulk {
+	return u.Update(func(s *CredentialUpsert) {
+		s.SetResetExpiresAt(v)
+	})
+}
+
+// UpdateResetExpiresAt sets the "reset_expires_at" field to the value that was provided on create.
+func (u *CredentialUpsertBulk) UpdateResetExpiresAt() *CredentialUpsertBulk {
+	return u.Update(func(s *CredentialUpsert) {
+		s.UpdateResetExpiresAt()
+	})
+}
+
+// ClearResetExpiresAt clears the value of the "reset_expires_at" field.
+func (u *CredentialUpsertBulk) ClearResetExpiresAt() *CredentialUpsertBulk {
+	return u.Update(func(s *CredentialUpsert) {
+		s.ClearResetExpiresAt()
+	})
+}
+
+// SetResetAttempts sets the "reset_attempts" field.
+func (u *CredentialUpsertBulk) SetResetAttempts(v int) *CredentialUpsertBulk {
+	return u.Update(func(s *CredentialUpsert) {
+		s.SetResetAttempts(v)
+	})
+}
+
+// AddResetAttempts adds v to the "reset_attempts" field.
+func (u *CredentialUpsertBulk) AddResetAttempts(v int) *CredentialUpsertBulk {
+	return u.Update(func(s *CredentialUpsert) {
+		s.AddResetAttempts(v)
+	})
+}
+
+// UpdateResetAttempts sets the "reset_attempts" field to the value that was provided on create.
+func (u *CredentialUpsertBulk) UpdateResetAttempts() *CredentialUpsertBulk {
+	return u.Update(func(s *CredentialUpsert) {
+		s.UpdateResetAttempts()
+	})
+}
+
+// SetTokenVersion sets the "token_version" field.
+func (u *CredentialUpsertBulk) SetTokenVersion(v int) *CredentialUpsertBulk {
+	return u.Update(func(s *CredentialUpsert) {
+		s.SetTokenVersion(v)
+	})
+}
+
+// AddTokenVersion adds v to the "token_version" field.
+func (u *CredentialUpsertBulk) AddTokenVersion(v int) *CredentialUpsertBulk {
+	return u.Update(func(s *CredentialUpsert) {
+		s.AddTokenVersion(v)
+	})
+}
+
+// UpdateTokenVersion sets the "token_version" field to the value that was provided on create.
+func (u *CredentialUpsertBulk) UpdateTokenVersion() *CredentialUpsertBulk {
+	return u.Update(func(s *CredentialUpsert) {
+		s.UpdateTokenVersion()
+	})
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *CredentialUpsertBulk) SetCreatedAt(v time.Time) *CredentialUpsertBulk {
+	return u.Update(func(s *CredentialUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *CredentialUpsertBulk) UpdateCreatedAt() *CredentialUpsertBulk {
+	return u.Update(func(s *CredentialUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *CredentialUpsertBulk) SetUpdatedAt(v time.Time) *CredentialUpsertBulk {
+	return u.Update(func(s *CredentialUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *CredentialUpsertBulk) UpdateUpdatedAt() *CredentialUpsertBulk {
+	return u.Update(func(s *CredentialUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *CredentialUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the CredentialCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for CredentialCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *CredentialUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
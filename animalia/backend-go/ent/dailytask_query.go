@@ -28,6 +28,7 @@ type DailyTaskQuery struct {
 	withUser   *UserQuery
 	withPost   *PostQuery
 	withFKs    bool
+	modifiers  []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -303,8 +304,9 @@ func (dtq *DailyTaskQuery) Clone() *DailyTaskQuery {
 		withUser:   dtq.withUser.Clone(),
 		withPost:   dtq.withPost.Clone(),
 		// clone intermediate query.
-		sql:  dtq.sql.Clone(),
-		path: dtq.path,
+		sql:       dtq.sql.Clone(),
+		path:      dtq.path,
+		modifiers: append([]func(*sql.Selector){}, dtq.modifiers...),
 	}
 }
 
@@ -429,6 +431,9 @@ func (dtq *DailyTaskQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*D
 		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
+	if len(dtq.modifiers) > 0 {
+		_spec.Modifiers = dtq.modifiers
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
@@ -520,6 +525,9 @@ func (dtq *DailyTaskQuery) loadPost(ctx context.Context, query *PostQuery, nodes
 
 func (dtq *DailyTaskQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := dtq.querySpec()
+	if len(dtq.modifiers) > 0 {
+		_spec.Modifiers = dtq.modifiers
+	}
 	_spec.Node.Columns = dtq.ctx.Fields
 	if len(dtq.ctx.Fields) > 0 {
 		_spec.Unique = dtq.ctx.Unique != nil && *dtq.ctx.Unique
@@ -582,6 +590,9 @@ func (dtq *DailyTaskQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	if dtq.ctx.Unique != nil && *dtq.ctx.Unique {
 		selector.Distinct()
 	}
+	for _, m := range dtq.modifiers {
+		m(selector)
+	}
 	for _, p := range dtq.predicates {
 		p(selector)
 	}
@@ -599,6 +610,12 @@ func (dtq *DailyTaskQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// Modify adds a query modifier for attaching custom logic to queries.
+func (dtq *DailyTaskQuery) Modify(modifiers ...func(s *sql.Selector)) *DailyTaskSelect {
+	dtq.modifiers = append(dtq.modifiers, modifiers...)
+	return dtq.Select()
+}
+
 // DailyTaskGroupBy is the group-by builder for DailyTask entities.
 type DailyTaskGroupBy struct {
 	selector
@@ -688,3 +705,9 @@ func (dts *DailyTaskSelect) sqlScan(ctx context.Context, root *DailyTaskQuery, v
 	defer rows.Close()
 	return sql.ScanSlice(rows, v)
 }
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (dts *DailyTaskSelect) Modify(modifiers ...func(s *sql.Selector)) *DailyTaskSelect {
+	dts.modifiers = append(dts.modifiers, modifiers...)
+	return dts
+}
@@ -22,8 +22,9 @@ import (
 // DailyTaskUpdate is the builder for updating DailyTask entities.
 type DailyTaskUpdate struct {
 	config
-	hooks    []Hook
-	mutation *DailyTaskMutation
+	hooks     []Hook
+	mutation  *DailyTaskMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // Where appends a list predicates to the DailyTaskUpdate builder.
@@ -142,6 +143,12 @@ func (dtu *DailyTaskUpdate) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (dtu *DailyTaskUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *DailyTaskUpdate {
+	dtu.modifiers = append(dtu.modifiers, modifiers...)
+	return dtu
+}
+
 func (dtu *DailyTaskUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if err := dtu.check(); err != nil {
 		return n, err
@@ -218,6 +225,7 @@ func (dtu *DailyTaskUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	_spec.AddModifiers(dtu.modifiers...)
 	if n, err = sqlgraph.UpdateNodes(ctx, dtu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{dailytask.Label}
@@ -233,9 +241,10 @@ func (dtu *DailyTaskUpdate) sqlSave(ctx context.Context) (n int, err error) {
 // DailyTaskUpdateOne is the builder for updating a single DailyTask entity.
 type DailyTaskUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *DailyTaskMutation
+	fields    []string
+	hooks     []Hook
+	mutation  *DailyTaskMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // SetCreatedAt sets the "created_at" field.
@@ -361,6 +370,12 @@ func (dtuo *DailyTaskUpdateOne) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (dtuo *DailyTaskUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *DailyTaskUpdateOne {
+	dtuo.modifiers = append(dtuo.modifiers, modifiers...)
+	return dtuo
+}
+
 func (dtuo *DailyTaskUpdateOne) sqlSave(ctx context.Context) (_node *DailyTask, err error) {
 	if err := dtuo.check(); err != nil {
 		return _node, err
@@ -454,6 +469,7 @@ func (dtuo *DailyTaskUpdateOne) sqlSave(ctx context.Context) (_node *DailyTask,
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	_spec.AddModifiers(dtuo.modifiers...)
 	_node = &DailyTask{config: dtuo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
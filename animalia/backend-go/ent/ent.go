@@ -12,13 +12,22 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/aki-13627/animalia/backend-go/ent/auditlog"
+	"github.com/aki-13627/animalia/backend-go/ent/block"
 	"github.com/aki-13627/animalia/backend-go/ent/comment"
+	"github.com/aki-13627/animalia/backend-go/ent/credential"
 	"github.com/aki-13627/animalia/backend-go/ent/dailytask"
 	"github.com/aki-13627/animalia/backend-go/ent/followrelation"
 	"github.com/aki-13627/animalia/backend-go/ent/like"
+	"github.com/aki-13627/animalia/backend-go/ent/mute"
 	"github.com/aki-13627/animalia/backend-go/ent/pet"
 	"github.com/aki-13627/animalia/backend-go/ent/post"
+	"github.com/aki-13627/animalia/backend-go/ent/postmedia"
+	"github.com/aki-13627/animalia/backend-go/ent/postrevision"
+	"github.com/aki-13627/animalia/backend-go/ent/report"
+	"github.com/aki-13627/animalia/backend-go/ent/storagedeletion"
 	"github.com/aki-13627/animalia/backend-go/ent/tasktype"
+	"github.com/aki-13627/animalia/backend-go/ent/timelinesession"
 	"github.com/aki-13627/animalia/backend-go/ent/user"
 )
 
@@ -80,14 +89,23 @@ var (
 func checkColumn(table, column string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			comment.Table:        comment.ValidColumn,
-			dailytask.Table:      dailytask.ValidColumn,
-			followrelation.Table: followrelation.ValidColumn,
-			like.Table:           like.ValidColumn,
-			pet.Table:            pet.ValidColumn,
-			post.Table:           post.ValidColumn,
-			tasktype.Table:       tasktype.ValidColumn,
-			user.Table:           user.ValidColumn,
+			auditlog.Table:        auditlog.ValidColumn,
+			block.Table:           block.ValidColumn,
+			comment.Table:         comment.ValidColumn,
+			credential.Table:      credential.ValidColumn,
+			dailytask.Table:       dailytask.ValidColumn,
+			followrelation.Table:  followrelation.ValidColumn,
+			like.Table:            like.ValidColumn,
+			mute.Table:            mute.ValidColumn,
+			pet.Table:             pet.ValidColumn,
+			post.Table:            post.ValidColumn,
+			postmedia.Table:       postmedia.ValidColumn,
+			postrevision.Table:    postrevision.ValidColumn,
+			report.Table:          report.ValidColumn,
+			storagedeletion.Table: storagedeletion.ValidColumn,
+			tasktype.Table:        tasktype.ValidColumn,
+			timelinesession.Table: timelinesession.ValidColumn,
+			user.Table:            user.ValidColumn,
 		})
 	})
 	return columnCheck(table, column)
@@ -21,6 +21,8 @@ type FollowRelation struct {
 	ID uuid.UUID `json:"id,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Status holds the value of the "status" field.
+	Status followrelation.Status `json:"status,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the FollowRelationQuery when eager-loading is set.
 	Edges          FollowRelationEdges `json:"edges"`
@@ -67,6 +69,8 @@ func (*FollowRelation) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
+		case followrelation.FieldStatus:
+			values[i] = new(sql.NullString)
 		case followrelation.FieldCreatedAt:
 			values[i] = new(sql.NullTime)
 		case followrelation.FieldID:
@@ -102,6 +106,12 @@ func (fr *FollowRelation) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				fr.CreatedAt = value.Time
 			}
+		case followrelation.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				fr.Status = followrelation.Status(value.String)
+			}
 		case followrelation.ForeignKeys[0]:
 			if value, ok := values[i].(*sql.NullScanner); !ok {
 				return fmt.Errorf("unexpected type %T for field user_following", values[i])
@@ -164,6 +174,9 @@ func (fr *FollowRelation) String() string {
 	builder.WriteString(fmt.Sprintf("id=%v, ", fr.ID))
 	builder.WriteString("created_at=")
 	builder.WriteString(fr.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", fr.Status))
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -101,6 +101,26 @@ func CreatedAtLTE(v time.Time) predicate.FollowRelation {
 	return predicate.FollowRelation(sql.FieldLTE(FieldCreatedAt, v))
 }
 
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.FollowRelation {
+	return predicate.FollowRelation(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.FollowRelation {
+	return predicate.FollowRelation(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.FollowRelation {
+	return predicate.FollowRelation(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.FollowRelation {
+	return predicate.FollowRelation(sql.FieldNotIn(FieldStatus, vs...))
+}
+
 // HasFrom applies the HasEdge predicate on the "from" edge.
 func HasFrom() predicate.FollowRelation {
 	return predicate.FollowRelation(func(s *sql.Selector) {
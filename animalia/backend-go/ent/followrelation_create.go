@@ -39,6 +39,20 @@ func (frc *FollowRelationCreate) SetNillableCreatedAt(t *time.Time) *FollowRelat
 	return frc
 }
 
+// SetStatus sets the "status" field.
+func (frc *FollowRelationCreate) SetStatus(f followrelation.Status) *FollowRelationCreate {
+	frc.mutation.SetStatus(f)
+	return frc
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (frc *FollowRelationCreate) SetNillableStatus(f *followrelation.Status) *FollowRelationCreate {
+	if f != nil {
+		frc.SetStatus(*f)
+	}
+	return frc
+}
+
 // SetID sets the "id" field.
 func (frc *FollowRelationCreate) SetID(u uuid.UUID) *FollowRelationCreate {
 	frc.mutation.SetID(u)
@@ -114,6 +128,10 @@ func (frc *FollowRelationCreate) defaults() {
 		v := followrelation.DefaultCreatedAt()
 		frc.mutation.SetCreatedAt(v)
 	}
+	if _, ok := frc.mutation.Status(); !ok {
+		v := followrelation.DefaultStatus
+		frc.mutation.SetStatus(v)
+	}
 	if _, ok := frc.mutation.ID(); !ok {
 		v := followrelation.DefaultID()
 		frc.mutation.SetID(v)
@@ -125,6 +143,14 @@ func (frc *FollowRelationCreate) check() error {
 	if _, ok := frc.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "FollowRelation.created_at"`)}
 	}
+	if _, ok := frc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "FollowRelation.status"`)}
+	}
+	if v, ok := frc.mutation.Status(); ok {
+		if err := followrelation.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "FollowRelation.status": %w`, err)}
+		}
+	}
 	if len(frc.mutation.FromIDs()) == 0 {
 		return &ValidationError{Name: "from", err: errors.New(`ent: missing required edge "FollowRelation.from"`)}
 	}
@@ -171,6 +197,10 @@ func (frc *FollowRelationCreate) createSpec() (*FollowRelation, *sqlgraph.Create
 		_spec.SetField(followrelation.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
 	}
+	if value, ok := frc.mutation.Status(); ok {
+		_spec.SetField(followrelation.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
 	if nodes := frc.mutation.FromIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -269,6 +299,18 @@ func (u *FollowRelationUpsert) UpdateCreatedAt() *FollowRelationUpsert {
 	return u
 }
 
+// SetStatus sets the "status" field.
+func (u *FollowRelationUpsert) SetStatus(v followrelation.Status) *FollowRelationUpsert {
+	u.Set(followrelation.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *FollowRelationUpsert) UpdateStatus() *FollowRelationUpsert {
+	u.SetExcluded(followrelation.FieldStatus)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
 // Using this option is equivalent to using:
 //
@@ -331,6 +373,20 @@ func (u *FollowRelationUpsertOne) UpdateCreatedAt() *FollowRelationUpsertOne {
 	})
 }
 
+// SetStatus sets the "status" field.
+func (u *FollowRelationUpsertOne) SetStatus(v followrelation.Status) *FollowRelationUpsertOne {
+	return u.Update(func(s *FollowRelationUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *FollowRelationUpsertOne) UpdateStatus() *FollowRelationUpsertOne {
+	return u.Update(func(s *FollowRelationUpsert) {
+		s.UpdateStatus()
+	})
+}
+
 // Exec executes the query.
 func (u *FollowRelationUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -560,6 +616,20 @@ func (u *FollowRelationUpsertBulk) UpdateCreatedAt() *FollowRelationUpsertBulk {
 	})
 }
 
+// SetStatus sets the "status" field.
+func (u *FollowRelationUpsertBulk) SetStatus(v followrelation.Status) *FollowRelationUpsertBulk {
+	return u.Update(func(s *FollowRelationUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *FollowRelationUpsertBulk) UpdateStatus() *FollowRelationUpsertBulk {
+	return u.Update(func(s *FollowRelationUpsert) {
+		s.UpdateStatus()
+	})
+}
+
 // Exec executes the query.
 func (u *FollowRelationUpsertBulk) Exec(ctx context.Context) error {
 	if u.create.err != nil {
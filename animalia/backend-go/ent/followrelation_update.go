@@ -20,8 +20,9 @@ import (
 // FollowRelationUpdate is the builder for updating FollowRelation entities.
 type FollowRelationUpdate struct {
 	config
-	hooks    []Hook
-	mutation *FollowRelationMutation
+	hooks     []Hook
+	mutation  *FollowRelationMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // Where appends a list predicates to the FollowRelationUpdate builder.
@@ -44,6 +45,20 @@ func (fru *FollowRelationUpdate) SetNillableCreatedAt(t *time.Time) *FollowRelat
 	return fru
 }
 
+// SetStatus sets the "status" field.
+func (fru *FollowRelationUpdate) SetStatus(f followrelation.Status) *FollowRelationUpdate {
+	fru.mutation.SetStatus(f)
+	return fru
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (fru *FollowRelationUpdate) SetNillableStatus(f *followrelation.Status) *FollowRelationUpdate {
+	if f != nil {
+		fru.SetStatus(*f)
+	}
+	return fru
+}
+
 // SetFromID sets the "from" edge to the User entity by ID.
 func (fru *FollowRelationUpdate) SetFromID(id uuid.UUID) *FollowRelationUpdate {
 	fru.mutation.SetFromID(id)
@@ -112,6 +127,11 @@ func (fru *FollowRelationUpdate) ExecX(ctx context.Context) {
 
 // check runs all checks and user-defined validators on the builder.
 func (fru *FollowRelationUpdate) check() error {
+	if v, ok := fru.mutation.Status(); ok {
+		if err := followrelation.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "FollowRelation.status": %w`, err)}
+		}
+	}
 	if fru.mutation.FromCleared() && len(fru.mutation.FromIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "FollowRelation.from"`)
 	}
@@ -121,6 +141,12 @@ func (fru *FollowRelationUpdate) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (fru *FollowRelationUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *FollowRelationUpdate {
+	fru.modifiers = append(fru.modifiers, modifiers...)
+	return fru
+}
+
 func (fru *FollowRelationUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if err := fru.check(); err != nil {
 		return n, err
@@ -136,6 +162,9 @@ func (fru *FollowRelationUpdate) sqlSave(ctx context.Context) (n int, err error)
 	if value, ok := fru.mutation.CreatedAt(); ok {
 		_spec.SetField(followrelation.FieldCreatedAt, field.TypeTime, value)
 	}
+	if value, ok := fru.mutation.Status(); ok {
+		_spec.SetField(followrelation.FieldStatus, field.TypeEnum, value)
+	}
 	if fru.mutation.FromCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -194,6 +223,7 @@ func (fru *FollowRelationUpdate) sqlSave(ctx context.Context) (n int, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	_spec.AddModifiers(fru.modifiers...)
 	if n, err = sqlgraph.UpdateNodes(ctx, fru.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{followrelation.Label}
@@ -209,9 +239,10 @@ func (fru *FollowRelationUpdate) sqlSave(ctx context.Context) (n int, err error)
 // FollowRelationUpdateOne is the builder for updating a single FollowRelation entity.
 type FollowRelationUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *FollowRelationMutation
+	fields    []string
+	hooks     []Hook
+	mutation  *FollowRelationMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // SetCreatedAt sets the "created_at" field.
@@ -228,6 +259,20 @@ func (fruo *FollowRelationUpdateOne) SetNillableCreatedAt(t *time.Time) *FollowR
 	return fruo
 }
 
+// SetStatus sets the "status" field.
+func (fruo *FollowRelationUpdateOne) SetStatus(f followrelation.Status) *FollowRelationUpdateOne {
+	fruo.mutation.SetStatus(f)
+	return fruo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (fruo *FollowRelationUpdateOne) SetNillableStatus(f *followrelation.Status) *FollowRelationUpdateOne {
+	if f != nil {
+		fruo.SetStatus(*f)
+	}
+	return fruo
+}
+
 // SetFromID sets the "from" edge to the User entity by ID.
 func (fruo *FollowRelationUpdateOne) SetFromID(id uuid.UUID) *FollowRelationUpdateOne {
 	fruo.mutation.SetFromID(id)
@@ -309,6 +354,11 @@ func (fruo *FollowRelationUpdateOne) ExecX(ctx context.Context) {
 
 // check runs all checks and user-defined validators on the builder.
 func (fruo *FollowRelationUpdateOne) check() error {
+	if v, ok := fruo.mutation.Status(); ok {
+		if err := followrelation.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "FollowRelation.status": %w`, err)}
+		}
+	}
 	if fruo.mutation.FromCleared() && len(fruo.mutation.FromIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "FollowRelation.from"`)
 	}
@@ -318,6 +368,12 @@ func (fruo *FollowRelationUpdateOne) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (fruo *FollowRelationUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *FollowRelationUpdateOne {
+	fruo.modifiers = append(fruo.modifiers, modifiers...)
+	return fruo
+}
+
 func (fruo *FollowRelationUpdateOne) sqlSave(ctx context.Context) (_node *FollowRelation, err error) {
 	if err := fruo.check(); err != nil {
 		return _node, err
@@ -350,6 +406,9 @@ func (fruo *FollowRelationUpdateOne) sqlSave(ctx context.Context) (_node *Follow
 	if value, ok := fruo.mutation.CreatedAt(); ok {
 		_spec.SetField(followrelation.FieldCreatedAt, field.TypeTime, value)
 	}
+	if value, ok := fruo.mutation.Status(); ok {
+		_spec.SetField(followrelation.FieldStatus, field.TypeEnum, value)
+	}
 	if fruo.mutation.FromCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -408,6 +467,7 @@ func (fruo *FollowRelationUpdateOne) sqlSave(ctx context.Context) (_node *Follow
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	_spec.AddModifiers(fruo.modifiers...)
 	_node = &FollowRelation{config: fruo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
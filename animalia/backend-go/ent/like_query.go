@@ -28,6 +28,7 @@ type LikeQuery struct {
 	withUser   *UserQuery
 	withPost   *PostQuery
 	withFKs    bool
+	modifiers  []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -303,8 +304,9 @@ func (lq *LikeQuery) Clone() *LikeQuery {
 		withUser:   lq.withUser.Clone(),
 		withPost:   lq.withPost.Clone(),
 		// clone intermediate query.
-		sql:  lq.sql.Clone(),
-		path: lq.path,
+		sql:       lq.sql.Clone(),
+		path:      lq.path,
+		modifiers: append([]func(*sql.Selector){}, lq.modifiers...),
 	}
 }
 
@@ -429,6 +431,9 @@ func (lq *LikeQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Like, e
 		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
+	if len(lq.modifiers) > 0 {
+		_spec.Modifiers = lq.modifiers
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
@@ -520,6 +525,9 @@ func (lq *LikeQuery) loadPost(ctx context.Context, query *PostQuery, nodes []*Li
 
 func (lq *LikeQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := lq.querySpec()
+	if len(lq.modifiers) > 0 {
+		_spec.Modifiers = lq.modifiers
+	}
 	_spec.Node.Columns = lq.ctx.Fields
 	if len(lq.ctx.Fields) > 0 {
 		_spec.Unique = lq.ctx.Unique != nil && *lq.ctx.Unique
@@ -582,6 +590,9 @@ func (lq *LikeQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	if lq.ctx.Unique != nil && *lq.ctx.Unique {
 		selector.Distinct()
 	}
+	for _, m := range lq.modifiers {
+		m(selector)
+	}
 	for _, p := range lq.predicates {
 		p(selector)
 	}
@@ -599,6 +610,12 @@ func (lq *LikeQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// Modify adds a query modifier for attaching custom logic to queries.
+func (lq *LikeQuery) Modify(modifiers ...func(s *sql.Selector)) *LikeSelect {
+	lq.modifiers = append(lq.modifiers, modifiers...)
+	return lq.Select()
+}
+
 // LikeGroupBy is the group-by builder for Like entities.
 type LikeGroupBy struct {
 	selector
@@ -688,3 +705,9 @@ func (ls *LikeSelect) sqlScan(ctx context.Context, root *LikeQuery, v any) error
 	defer rows.Close()
 	return sql.ScanSlice(rows, v)
 }
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (ls *LikeSelect) Modify(modifiers ...func(s *sql.Selector)) *LikeSelect {
+	ls.modifiers = append(ls.modifiers, modifiers...)
+	return ls
+}
@@ -8,11 +8,78 @@ import (
 )
 
 var (
+	// AuditLogsColumns holds the columns for the "audit_logs" table.
+	AuditLogsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "action", Type: field.TypeString},
+		{Name: "target_type", Type: field.TypeString},
+		{Name: "target_id", Type: field.TypeString},
+		{Name: "detail", Type: field.TypeString, Default: ""},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "user_audit_logs", Type: field.TypeUUID, Nullable: true},
+	}
+	// AuditLogsTable holds the schema information for the "audit_logs" table.
+	AuditLogsTable = &schema.Table{
+		Name:       "audit_logs",
+		Columns:    AuditLogsColumns,
+		PrimaryKey: []*schema.Column{AuditLogsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "audit_logs_users_audit_logs",
+				Columns:    []*schema.Column{AuditLogsColumns[6]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "auditlog_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{AuditLogsColumns[5]},
+			},
+		},
+	}
+	// BlocksColumns holds the columns for the "blocks" table.
+	BlocksColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "user_blocking", Type: field.TypeUUID},
+		{Name: "user_blocked_by", Type: field.TypeUUID},
+	}
+	// BlocksTable holds the schema information for the "blocks" table.
+	BlocksTable = &schema.Table{
+		Name:       "blocks",
+		Columns:    BlocksColumns,
+		PrimaryKey: []*schema.Column{BlocksColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "blocks_users_blocking",
+				Columns:    []*schema.Column{BlocksColumns[2]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "blocks_users_blocked_by",
+				Columns:    []*schema.Column{BlocksColumns[3]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "block_user_blocking_user_blocked_by",
+				Unique:  true,
+				Columns: []*schema.Column{BlocksColumns[2], BlocksColumns[3]},
+			},
+		},
+	}
 	// CommentsColumns holds the columns for the "comments" table.
 	CommentsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID, Unique: true},
 		{Name: "content", Type: field.TypeString},
 		{Name: "created_at", Type: field.TypeTime},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "hidden_at", Type: field.TypeTime, Nullable: true},
 		{Name: "post_comments", Type: field.TypeUUID},
 		{Name: "user_comments", Type: field.TypeUUID},
 	}
@@ -24,18 +91,41 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "comments_posts_comments",
-				Columns:    []*schema.Column{CommentsColumns[3]},
+				Columns:    []*schema.Column{CommentsColumns[5]},
 				RefColumns: []*schema.Column{PostsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 			{
 				Symbol:     "comments_users_comments",
-				Columns:    []*schema.Column{CommentsColumns[4]},
+				Columns:    []*schema.Column{CommentsColumns[6]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 		},
 	}
+	// CredentialsColumns holds the columns for the "credentials" table.
+	CredentialsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "email", Type: field.TypeString, Unique: true},
+		{Name: "password_hash", Type: field.TypeString},
+		{Name: "email_verified", Type: field.TypeBool, Default: false},
+		{Name: "verification_code_hash", Type: field.TypeString, Nullable: true},
+		{Name: "verification_expires_at", Type: field.TypeTime, Nullable: true},
+		{Name: "verification_attempts", Type: field.TypeInt, Default: 0},
+		{Name: "pending_email", Type: field.TypeString, Nullable: true},
+		{Name: "reset_code_hash", Type: field.TypeString, Nullable: true},
+		{Name: "reset_expires_at", Type: field.TypeTime, Nullable: true},
+		{Name: "reset_attempts", Type: field.TypeInt, Default: 0},
+		{Name: "token_version", Type: field.TypeInt, Default: 0},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// CredentialsTable holds the schema information for the "credentials" table.
+	CredentialsTable = &schema.Table{
+		Name:       "credentials",
+		Columns:    CredentialsColumns,
+		PrimaryKey: []*schema.Column{CredentialsColumns[0]},
+	}
 	// DailyTasksColumns holds the columns for the "daily_tasks" table.
 	DailyTasksColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID, Unique: true},
@@ -68,6 +158,7 @@ var (
 	FollowRelationsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID, Unique: true},
 		{Name: "created_at", Type: field.TypeTime},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"pending", "approved"}, Default: "approved"},
 		{Name: "user_following", Type: field.TypeUUID},
 		{Name: "user_followers", Type: field.TypeUUID},
 	}
@@ -79,13 +170,13 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "follow_relations_users_following",
-				Columns:    []*schema.Column{FollowRelationsColumns[2]},
+				Columns:    []*schema.Column{FollowRelationsColumns[3]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 			{
 				Symbol:     "follow_relations_users_followers",
-				Columns:    []*schema.Column{FollowRelationsColumns[3]},
+				Columns:    []*schema.Column{FollowRelationsColumns[4]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
@@ -94,7 +185,7 @@ var (
 			{
 				Name:    "followrelation_user_following_user_followers",
 				Unique:  true,
-				Columns: []*schema.Column{FollowRelationsColumns[2], FollowRelationsColumns[3]},
+				Columns: []*schema.Column{FollowRelationsColumns[3], FollowRelationsColumns[4]},
 			},
 		},
 	}
@@ -132,6 +223,40 @@ var (
 			},
 		},
 	}
+	// MutesColumns holds the columns for the "mutes" table.
+	MutesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "user_muting", Type: field.TypeUUID},
+		{Name: "user_muted_by", Type: field.TypeUUID},
+	}
+	// MutesTable holds the schema information for the "mutes" table.
+	MutesTable = &schema.Table{
+		Name:       "mutes",
+		Columns:    MutesColumns,
+		PrimaryKey: []*schema.Column{MutesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "mutes_users_muting",
+				Columns:    []*schema.Column{MutesColumns[2]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "mutes_users_muted_by",
+				Columns:    []*schema.Column{MutesColumns[3]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "mute_user_muting_user_muted_by",
+				Unique:  true,
+				Columns: []*schema.Column{MutesColumns[2], MutesColumns[3]},
+			},
+		},
+	}
 	// PetsColumns holds the columns for the "pets" table.
 	PetsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID, Unique: true},
@@ -166,6 +291,9 @@ var (
 		{Name: "image_key", Type: field.TypeString},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "hidden_at", Type: field.TypeTime, Nullable: true},
+		{Name: "edited_at", Type: field.TypeTime, Nullable: true},
+		{Name: "visibility", Type: field.TypeEnum, Enums: []string{"public", "followers", "only_me"}, Default: "public"},
 		{Name: "image_feature", Type: field.TypeOther, Nullable: true, SchemaType: map[string]string{"postgres": "vector(768)"}},
 		{Name: "user_posts", Type: field.TypeUUID},
 	}
@@ -177,11 +305,134 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "posts_users_posts",
-				Columns:    []*schema.Column{PostsColumns[7]},
+				Columns:    []*schema.Column{PostsColumns[10]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+	}
+	// PostMediaColumns holds the columns for the "post_media" table.
+	PostMediaColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "position", Type: field.TypeInt},
+		{Name: "type", Type: field.TypeEnum, Enums: []string{"image", "video"}, Default: "image"},
+		{Name: "image_key", Type: field.TypeString},
+		{Name: "poster_key", Type: field.TypeString, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "post_media", Type: field.TypeUUID},
+	}
+	// PostMediaTable holds the schema information for the "post_media" table.
+	PostMediaTable = &schema.Table{
+		Name:       "post_media",
+		Columns:    PostMediaColumns,
+		PrimaryKey: []*schema.Column{PostMediaColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "post_media_posts_media",
+				Columns:    []*schema.Column{PostMediaColumns[6]},
+				RefColumns: []*schema.Column{PostsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "postmedia_position_post_media",
+				Unique:  true,
+				Columns: []*schema.Column{PostMediaColumns[1], PostMediaColumns[6]},
+			},
+		},
+	}
+	// PostRevisionsColumns holds the columns for the "post_revisions" table.
+	PostRevisionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "caption", Type: field.TypeString},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "post_revisions", Type: field.TypeUUID},
+		{Name: "user_post_revisions", Type: field.TypeUUID, Nullable: true},
+	}
+	// PostRevisionsTable holds the schema information for the "post_revisions" table.
+	PostRevisionsTable = &schema.Table{
+		Name:       "post_revisions",
+		Columns:    PostRevisionsColumns,
+		PrimaryKey: []*schema.Column{PostRevisionsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "post_revisions_posts_revisions",
+				Columns:    []*schema.Column{PostRevisionsColumns[3]},
+				RefColumns: []*schema.Column{PostsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "post_revisions_users_post_revisions",
+				Columns:    []*schema.Column{PostRevisionsColumns[4]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+	}
+	// ReportsColumns holds the columns for the "reports" table.
+	ReportsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "target_type", Type: field.TypeEnum, Enums: []string{"post", "comment", "user"}},
+		{Name: "target_id", Type: field.TypeUUID},
+		{Name: "reason", Type: field.TypeEnum, Enums: []string{"spam", "harassment", "inappropriate", "other"}},
+		{Name: "detail", Type: field.TypeString, Default: ""},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"open", "dismissed", "actioned"}, Default: "open"},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "reviewed_at", Type: field.TypeTime, Nullable: true},
+		{Name: "user_reports", Type: field.TypeUUID},
+	}
+	// ReportsTable holds the schema information for the "reports" table.
+	ReportsTable = &schema.Table{
+		Name:       "reports",
+		Columns:    ReportsColumns,
+		PrimaryKey: []*schema.Column{ReportsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "reports_users_reports",
+				Columns:    []*schema.Column{ReportsColumns[8]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "report_target_type_target_id",
+				Unique:  false,
+				Columns: []*schema.Column{ReportsColumns[1], ReportsColumns[2]},
+			},
+			{
+				Name:    "report_status",
+				Unique:  false,
+				Columns: []*schema.Column{ReportsColumns[5]},
+			},
+		},
+	}
+	// StorageDeletionsColumns holds the columns for the "storage_deletions" table.
+	StorageDeletionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "key", Type: field.TypeString},
+		{Name: "user_id", Type: field.TypeUUID},
+		{Name: "delete_after", Type: field.TypeTime},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// StorageDeletionsTable holds the schema information for the "storage_deletions" table.
+	StorageDeletionsTable = &schema.Table{
+		Name:       "storage_deletions",
+		Columns:    StorageDeletionsColumns,
+		PrimaryKey: []*schema.Column{StorageDeletionsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "storagedeletion_user_id",
+				Unique:  false,
+				Columns: []*schema.Column{StorageDeletionsColumns[2]},
+			},
+			{
+				Name:    "storagedeletion_delete_after",
+				Unique:  false,
+				Columns: []*schema.Column{StorageDeletionsColumns[3]},
+			},
+		},
 	}
 	// TaskTypesColumns holds the columns for the "task_types" table.
 	TaskTypesColumns = []*schema.Column{
@@ -195,15 +446,48 @@ var (
 		Columns:    TaskTypesColumns,
 		PrimaryKey: []*schema.Column{TaskTypesColumns[0]},
 	}
+	// TimelineSessionsColumns holds the columns for the "timeline_sessions" table.
+	TimelineSessionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "user_id", Type: field.TypeUUID},
+		{Name: "post_ids", Type: field.TypeJSON},
+		{Name: "expires_at", Type: field.TypeTime},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// TimelineSessionsTable holds the schema information for the "timeline_sessions" table.
+	TimelineSessionsTable = &schema.Table{
+		Name:       "timeline_sessions",
+		Columns:    TimelineSessionsColumns,
+		PrimaryKey: []*schema.Column{TimelineSessionsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "timelinesession_user_id",
+				Unique:  false,
+				Columns: []*schema.Column{TimelineSessionsColumns[1]},
+			},
+			{
+				Name:    "timelinesession_expires_at",
+				Unique:  false,
+				Columns: []*schema.Column{TimelineSessionsColumns[3]},
+			},
+		},
+	}
 	// UsersColumns holds the columns for the "users" table.
 	UsersColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID, Unique: true},
 		{Name: "index", Type: field.TypeInt, Unique: true, Nullable: true},
 		{Name: "email", Type: field.TypeString, Unique: true},
+		{Name: "auth_subject", Type: field.TypeString, Unique: true, Nullable: true},
 		{Name: "name", Type: field.TypeString},
 		{Name: "bio", Type: field.TypeString, Default: ""},
 		{Name: "icon_image_key", Type: field.TypeString, Nullable: true},
 		{Name: "created_at", Type: field.TypeTime},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "purged_at", Type: field.TypeTime, Nullable: true},
+		{Name: "role", Type: field.TypeEnum, Enums: []string{"user", "moderator", "admin"}, Default: "user"},
+		{Name: "suspended_at", Type: field.TypeTime, Nullable: true},
+		{Name: "suspension_reason", Type: field.TypeString, Nullable: true},
+		{Name: "is_private", Type: field.TypeBool, Default: false},
 	}
 	// UsersTable holds the schema information for the "users" table.
 	UsersTable = &schema.Table{
@@ -213,18 +497,30 @@ var (
 	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
+		AuditLogsTable,
+		BlocksTable,
 		CommentsTable,
+		CredentialsTable,
 		DailyTasksTable,
 		FollowRelationsTable,
 		LikesTable,
+		MutesTable,
 		PetsTable,
 		PostsTable,
+		PostMediaTable,
+		PostRevisionsTable,
+		ReportsTable,
+		StorageDeletionsTable,
 		TaskTypesTable,
+		TimelineSessionsTable,
 		UsersTable,
 	}
 )
 
 func init() {
+	AuditLogsTable.ForeignKeys[0].RefTable = UsersTable
+	BlocksTable.ForeignKeys[0].RefTable = UsersTable
+	BlocksTable.ForeignKeys[1].RefTable = UsersTable
 	CommentsTable.ForeignKeys[0].RefTable = PostsTable
 	CommentsTable.ForeignKeys[1].RefTable = UsersTable
 	DailyTasksTable.ForeignKeys[0].RefTable = PostsTable
@@ -233,6 +529,12 @@ func init() {
 	FollowRelationsTable.ForeignKeys[1].RefTable = UsersTable
 	LikesTable.ForeignKeys[0].RefTable = PostsTable
 	LikesTable.ForeignKeys[1].RefTable = UsersTable
+	MutesTable.ForeignKeys[0].RefTable = UsersTable
+	MutesTable.ForeignKeys[1].RefTable = UsersTable
 	PetsTable.ForeignKeys[0].RefTable = UsersTable
 	PostsTable.ForeignKeys[0].RefTable = UsersTable
+	PostMediaTable.ForeignKeys[0].RefTable = PostsTable
+	PostRevisionsTable.ForeignKeys[0].RefTable = PostsTable
+	PostRevisionsTable.ForeignKeys[1].RefTable = UsersTable
+	ReportsTable.ForeignKeys[0].RefTable = UsersTable
 }
@@ -0,0 +1,172 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/aki-13627/animalia/backend-go/ent/mute"
+	"github.com/aki-13627/animalia/backend-go/ent/user"
+	"github.com/google/uuid"
+)
+
+// Mute is the model entity for the Mute schema.
+type Mute struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the MuteQuery when eager-loading is set.
+	Edges         MuteEdges `json:"edges"`
+	user_muting   *uuid.UUID
+	user_muted_by *uuid.UUID
+	selectValues  sql.SelectValues
+}
+
+// MuteEdges holds the relations/edges for other nodes in the graph.
+type MuteEdges struct {
+	// From holds the value of the from edge.
+	From *User `json:"from,omitempty"`
+	// To holds the value of the to edge.
+	To *User `json:"to,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// FromOrErr returns the From value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e MuteEdges) FromOrErr() (*User, error) {
+	if e.From != nil {
+		return e.From, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "from"}
+}
+
+// ToOrErr returns the To value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e MuteEdges) ToOrErr() (*User, error) {
+	if e.To != nil {
+		return e.To, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "to"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Mute) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case mute.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case mute.FieldID:
+			values[i] = new(uuid.UUID)
+		case mute.ForeignKeys[0]: // user_muting
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case mute.ForeignKeys[1]: // user_muted_by
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Mute fields.
+func (m *Mute) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case mute.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				m.ID = *value
+			}
+		case mute.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				m.CreatedAt = value.Time
+			}
+		case mute.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field user_muting", values[i])
+			} else if value.Valid {
+				m.user_muting = new(uuid.UUID)
+				*m.user_muting = *value.S.(*uuid.UUID)
+			}
+		case mute.ForeignKeys[1]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field user_muted_by", values[i])
+			} else if value.Valid {
+				m.user_muted_by = new(uuid.UUID)
+				*m.user_muted_by = *value.S.(*uuid.UUID)
+			}
+		default:
+			m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Mute.
+// This includes values selected through modifiers, order, etc.
+func (m *Mute) Value(name string) (ent.Value, error) {
+	return m.selectValues.Get(name)
+}
+
+// QueryFrom queries the "from" edge of the Mute entity.
+func (m *Mute) QueryFrom() *UserQuery {
+	return NewMuteClient(m.config).QueryFrom(m)
+}
+
+// QueryTo queries the "to" edge of the Mute entity.
+func (m *Mute) QueryTo() *UserQuery {
+	return NewMuteClient(m.config).QueryTo(m)
+}
+
+// Update returns a builder for updating this Mute.
+// Note that you need to call Mute.Unwrap() before calling this method if this Mute
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (m *Mute) Update() *MuteUpdateOne {
+	return NewMuteClient(m.config).UpdateOne(m)
+}
+
+// Unwrap unwraps the Mute entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (m *Mute) Unwrap() *Mute {
+	_tx, ok := m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Mute is not a transactional entity")
+	}
+	m.config.driver = _tx.drv
+	return m
+}
+
+// String implements the fmt.Stringer.
+func (m *Mute) String() string {
+	var builder strings.Builder
+	builder.WriteString("Mute(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Mutes is a parsable slice of Mute.
+type Mutes []*Mute
@@ -0,0 +1,440 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/aki-13627/animalia/backend-go/ent/mute"
+	"github.com/aki-13627/animalia/backend-go/ent/predicate"
+	"github.com/aki-13627/animalia/backend-go/ent/user"
+	"github.com/google/uuid"
+)
+
+// MuteUpdate is the builder for updating Mute entities.
+type MuteUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *MuteMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the MuteUpdate builder.
+func (mu *MuteUpdate) Where(ps ...predicate.Mute) *MuteUpdate {
+	mu.mutation.Where(ps...)
+	return mu
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (mu *MuteUpdate) SetCreatedAt(t time.Time) *MuteUpdate {
+	mu.mutation.SetCreatedAt(t)
+	return mu
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (mu *MuteUpdate) SetNillableCreatedAt(t *time.Time) *MuteUpdate {
+	if t != nil {
+		mu.SetCreatedAt(*t)
+	}
+	return mu
+}
+
+// SetFromID sets the "from" edge to the User entity by ID.
+func (mu *MuteUpdate) SetFromID(id uuid.UUID) *MuteUpdate {
+	mu.mutation.SetFromID(id)
+	return mu
+}
+
+// SetFrom sets the "from" edge to the User entity.
+func (mu *MuteUpdate) SetFrom(u *User) *MuteUpdate {
+	return mu.SetFromID(u.ID)
+}
+
+// SetToID sets the "to" edge to the User entity by ID.
+func (mu *MuteUpdate) SetToID(id uuid.UUID) *MuteUpdate {
+	mu.mutation.SetToID(id)
+	return mu
+}
+
+// SetTo sets the "to" edge to the User entity.
+func (mu *MuteUpdate) SetTo(u *User) *MuteUpdate {
+	return mu.SetToID(u.ID)
+}
+
+// Mutation returns the MuteMutation object of the builder.
+func (mu *MuteUpdate) Mutation() *MuteMutation {
+	return mu.mutation
+}
+
+// ClearFrom clears the "from" edge to the User entity.
+func (mu *MuteUpdate) ClearFrom() *MuteUpdate {
+	mu.mutation.ClearFrom()
+	return mu
+}
+
+// ClearTo clears the "to" edge to the User entity.
+func (mu *MuteUpdate) ClearTo() *MuteUpdate {
+	mu.mutation.ClearTo()
+	return mu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (mu *MuteUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, mu.sqlSave, mu.mutation, mu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mu *MuteUpdate) SaveX(ctx context.Context) int {
+	affected, err := mu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (mu *MuteUpdate) Exec(ctx context.Context) error {
+	_, err := mu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mu *MuteUpdate) ExecX(ctx context.Context) {
+	if err := mu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (mu *MuteUpdate) check() error {
+	if mu.mutation.FromCleared() && len(mu.mutation.FromIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Mute.from"`)
+	}
+	if mu.mutation.ToCleared() && len(mu.mutation.ToIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Mute.to"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (mu *MuteUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *MuteUpdate {
+	mu.modifiers = append(mu.modifiers, modifiers...)
+	return mu
+}
+
+func (mu *MuteUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := mu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(mute.Table, mute.Columns, sqlgraph.NewFieldSpec(mute.FieldID, field.TypeUUID))
+	if ps := mu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := mu.mutation.CreatedAt(); ok {
+		_spec.SetField(mute.FieldCreatedAt, field.TypeTime, value)
+	}
+	if mu.mutation.FromCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   mute.FromTable,
+			Columns: []string{mute.FromColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := mu.mutation.FromIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   mute.FromTable,
+			Columns: []string{mute.FromColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if mu.mutation.ToCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   mute.ToTable,
+			Columns: []string{mute.ToColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := mu.mutation.ToIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   mute.ToTable,
+			Columns: []string{mute.ToColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(mu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, mu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{mute.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	mu.mutation.done = true
+	return n, nil
+}
+
+// MuteUpdateOne is the builder for updating a single Mute entity.
+type MuteUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *MuteMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (muo *MuteUpdateOne) SetCreatedAt(t time.Time) *MuteUpdateOne {
+	muo.mutation.SetCreatedAt(t)
+	return muo
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (muo *MuteUpdateOne) SetNillableCreatedAt(t *time.Time) *MuteUpdateOne {
+	if t != nil {
+		muo.SetCreatedAt(*t)
+	}
+	return muo
+}
+
+// SetFromID sets the "from" edge to the User entity by ID.
+func (muo *MuteUpdateOne) SetFromID(id uuid.UUID) *MuteUpdateOne {
+	muo.mutation.SetFromID(id)
+	return muo
+}
+
+// SetFrom sets the "from" edge to the User entity.
+func (muo *MuteUpdateOne) SetFrom(u *User) *MuteUpdateOne {
+	return muo.SetFromID(u.ID)
+}
+
+// SetToID sets the "to" edge to the User entity by ID.
+func (muo *MuteUpdateOne) SetToID(id uuid.UUID) *MuteUpdateOne {
+	muo.mutation.SetToID(id)
+	return muo
+}
+
+// SetTo sets the "to" edge to the User entity.
+func (muo *MuteUpdateOne) SetTo(u *User) *MuteUpdateOne {
+	return muo.SetToID(u.ID)
+}
+
+// Mutation returns the MuteMutation object of the builder.
+func (muo *MuteUpdateOne) Mutation() *MuteMutation {
+	return muo.mutation
+}
+
+// ClearFrom clears the "from" edge to the User entity.
+func (muo *MuteUpdateOne) ClearFrom() *MuteUpdateOne {
+	muo.mutation.ClearFrom()
+	return muo
+}
+
+// ClearTo clears the "to" edge to the User entity.
+func (muo *MuteUpdateOne) ClearTo() *MuteUpdateOne {
+	muo.mutation.ClearTo()
+	return muo
+}
+
+// Where appends a list predicates to the MuteUpdate builder.
+func (muo *MuteUpdateOne) Where(ps ...predicate.Mute) *MuteUpdateOne {
+	muo.mutation.Where(ps...)
+	return muo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (muo *MuteUpdateOne) Select(field string, fields ...string) *MuteUpdateOne {
+	muo.fields = append([]string{field}, fields...)
+	return muo
+}
+
+// Save executes the query and returns the updated Mute entity.
+func (muo *MuteUpdateOne) Save(ctx context.Context) (*Mute, error) {
+	return withHooks(ctx, muo.sqlSave, muo.mutation, muo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (muo *MuteUpdateOne) SaveX(ctx context.Context) *Mute {
+	node, err := muo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (muo *MuteUpdateOne) Exec(ctx context.Context) error {
+	_, err := muo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (muo *MuteUpdateOne) ExecX(ctx context.Context) {
+	if err := muo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (muo *MuteUpdateOne) check() error {
+	if muo.mutation.FromCleared() && len(muo.mutation.FromIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Mute.from"`)
+	}
+	if muo.mutation.ToCleared() && len(muo.mutation.ToIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Mute.to"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (muo *MuteUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *MuteUpdateOne {
+	muo.modifiers = append(muo.modifiers, modifiers...)
+	return muo
+}
+
+func (muo *MuteUpdateOne) sqlSave(ctx context.Context) (_node *Mute, err error) {
+	if err := muo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(mute.Table, mute.Columns, sqlgraph.NewFieldSpec(mute.FieldID, field.TypeUUID))
+	id, ok := muo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Mute.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := muo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, mute.FieldID)
+		for _, f := range fields {
+			if !mute.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != mute.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := muo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := muo.mutation.CreatedAt(); ok {
+		_spec.SetField(mute.FieldCreatedAt, field.TypeTime, value)
+	}
+	if muo.mutation.FromCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   mute.FromTable,
+			Columns: []string{mute.FromColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := muo.mutation.FromIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   mute.FromTable,
+			Columns: []string{mute.FromColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if muo.mutation.ToCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   mute.ToTable,
+			Columns: []string{mute.ToColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := muo.mutation.ToIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   mute.ToTable,
+			Columns: []string{mute.ToColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(muo.modifiers...)
+	_node = &Mute{config: muo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, muo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{mute.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	muo.mutation.done = true
+	return _node, nil
+}
@@ -31,6 +31,12 @@ type Post struct {
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// DeletedAt holds the value of the "deleted_at" field.
 	DeletedAt time.Time `json:"deleted_at,omitempty"`
+	// HiddenAt holds the value of the "hidden_at" field.
+	HiddenAt time.Time `json:"hidden_at,omitempty"`
+	// EditedAt holds the value of the "edited_at" field.
+	EditedAt time.Time `json:"edited_at,omitempty"`
+	// Visibility holds the value of the "visibility" field.
+	Visibility post.Visibility `json:"visibility,omitempty"`
 	// ImageFeature holds the value of the "image_feature" field.
 	ImageFeature pgvector.Vector `json:"image_feature,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
@@ -50,9 +56,13 @@ type PostEdges struct {
 	Likes []*Like `json:"likes,omitempty"`
 	// DailyTask holds the value of the daily_task edge.
 	DailyTask *DailyTask `json:"daily_task,omitempty"`
+	// Media holds the value of the media edge.
+	Media []*PostMedia `json:"media,omitempty"`
+	// Revisions holds the value of the revisions edge.
+	Revisions []*PostRevision `json:"revisions,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [4]bool
+	loadedTypes [6]bool
 }
 
 // UserOrErr returns the User value or an error if the edge
@@ -95,6 +105,24 @@ func (e PostEdges) DailyTaskOrErr() (*DailyTask, error) {
 	return nil, &NotLoadedError{edge: "daily_task"}
 }
 
+// MediaOrErr returns the Media value or an error if the edge
+// was not loaded in eager-loading.
+func (e PostEdges) MediaOrErr() ([]*PostMedia, error) {
+	if e.loadedTypes[4] {
+		return e.Media, nil
+	}
+	return nil, &NotLoadedError{edge: "media"}
+}
+
+// RevisionsOrErr returns the Revisions value or an error if the edge
+// was not loaded in eager-loading.
+func (e PostEdges) RevisionsOrErr() ([]*PostRevision, error) {
+	if e.loadedTypes[5] {
+		return e.Revisions, nil
+	}
+	return nil, &NotLoadedError{edge: "revisions"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Post) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -104,9 +132,9 @@ func (*Post) scanValues(columns []string) ([]any, error) {
 			values[i] = new(pgvector.Vector)
 		case post.FieldIndex:
 			values[i] = new(sql.NullInt64)
-		case post.FieldCaption, post.FieldImageKey:
+		case post.FieldCaption, post.FieldImageKey, post.FieldVisibility:
 			values[i] = new(sql.NullString)
-		case post.FieldCreatedAt, post.FieldDeletedAt:
+		case post.FieldCreatedAt, post.FieldDeletedAt, post.FieldHiddenAt, post.FieldEditedAt:
 			values[i] = new(sql.NullTime)
 		case post.FieldID:
 			values[i] = new(uuid.UUID)
@@ -163,6 +191,24 @@ func (po *Post) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				po.DeletedAt = value.Time
 			}
+		case post.FieldHiddenAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field hidden_at", values[i])
+			} else if value.Valid {
+				po.HiddenAt = value.Time
+			}
+		case post.FieldEditedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field edited_at", values[i])
+			} else if value.Valid {
+				po.EditedAt = value.Time
+			}
+		case post.FieldVisibility:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field visibility", values[i])
+			} else if value.Valid {
+				po.Visibility = post.Visibility(value.String)
+			}
 		case post.FieldImageFeature:
 			if value, ok := values[i].(*pgvector.Vector); !ok {
 				return fmt.Errorf("unexpected type %T for field image_feature", values[i])
@@ -209,6 +255,16 @@ func (po *Post) QueryDailyTask() *DailyTaskQuery {
 	return NewPostClient(po.config).QueryDailyTask(po)
 }
 
+// QueryMedia queries the "media" edge of the Post entity.
+func (po *Post) QueryMedia() *PostMediaQuery {
+	return NewPostClient(po.config).QueryMedia(po)
+}
+
+// QueryRevisions queries the "revisions" edge of the Post entity.
+func (po *Post) QueryRevisions() *PostRevisionQuery {
+	return NewPostClient(po.config).QueryRevisions(po)
+}
+
 // Update returns a builder for updating this Post.
 // Note that you need to call Post.Unwrap() before calling this method if this Post
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -247,6 +303,15 @@ func (po *Post) String() string {
 	builder.WriteString("deleted_at=")
 	builder.WriteString(po.DeletedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
+	builder.WriteString("hidden_at=")
+	builder.WriteString(po.HiddenAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("edited_at=")
+	builder.WriteString(po.EditedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("visibility=")
+	builder.WriteString(fmt.Sprintf("%v", po.Visibility))
+	builder.WriteString(", ")
 	builder.WriteString("image_feature=")
 	builder.WriteString(fmt.Sprintf("%v", po.ImageFeature))
 	builder.WriteByte(')')
@@ -16,6 +16,8 @@ import (
 	"github.com/aki-13627/animalia/backend-go/ent/dailytask"
 	"github.com/aki-13627/animalia/backend-go/ent/like"
 	"github.com/aki-13627/animalia/backend-go/ent/post"
+	"github.com/aki-13627/animalia/backend-go/ent/postmedia"
+	"github.com/aki-13627/animalia/backend-go/ent/postrevision"
 	"github.com/aki-13627/animalia/backend-go/ent/user"
 	"github.com/google/uuid"
 	pgvector "github.com/pgvector/pgvector-go"
@@ -83,6 +85,48 @@ func (pc *PostCreate) SetNillableDeletedAt(t *time.Time) *PostCreate {
 	return pc
 }
 
+// SetHiddenAt sets the "hidden_at" field.
+func (pc *PostCreate) SetHiddenAt(t time.Time) *PostCreate {
+	pc.mutation.SetHiddenAt(t)
+	return pc
+}
+
+// SetNillableHiddenAt sets the "hidden_at" field if the given value is not nil.
+func (pc *PostCreate) SetNillableHiddenAt(t *time.Time) *PostCreate {
+	if t != nil {
+		pc.SetHiddenAt(*t)
+	}
+	return pc
+}
+
+// SetEditedAt sets the "edited_at" field.
+func (pc *PostCreate) SetEditedAt(t time.Time) *PostCreate {
+	pc.mutation.SetEditedAt(t)
+	return pc
+}
+
+// SetNillableEditedAt sets the "edited_at" field if the given value is not nil.
+func (pc *PostCreate) SetNillableEditedAt(t *time.Time) *PostCreate {
+	if t != nil {
+		pc.SetEditedAt(*t)
+	}
+	return pc
+}
+
+// SetVisibility sets the "visibility" field.
+func (pc *PostCreate) SetVisibility(po post.Visibility) *PostCreate {
+	pc.mutation.SetVisibility(po)
+	return pc
+}
+
+// SetNillableVisibility sets the "visibility" field if the given value is not nil.
+func (pc *PostCreate) SetNillableVisibility(po *post.Visibility) *PostCreate {
+	if po != nil {
+		pc.SetVisibility(*po)
+	}
+	return pc
+}
+
 // SetImageFeature sets the "image_feature" field.
 func (pc *PostCreate) SetImageFeature(pg pgvector.Vector) *PostCreate {
 	pc.mutation.SetImageFeature(pg)
@@ -171,6 +215,36 @@ func (pc *PostCreate) SetDailyTask(d *DailyTask) *PostCreate {
 	return pc.SetDailyTaskID(d.ID)
 }
 
+// AddMediumIDs adds the "media" edge to the PostMedia entity by IDs.
+func (pc *PostCreate) AddMediumIDs(ids ...uuid.UUID) *PostCreate {
+	pc.mutation.AddMediumIDs(ids...)
+	return pc
+}
+
+// AddMedia adds the "media" edges to the PostMedia entity.
+func (pc *PostCreate) AddMedia(p ...*PostMedia) *PostCreate {
+	ids := make([]uuid.UUID, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return pc.AddMediumIDs(ids...)
+}
+
+// AddRevisionIDs adds the "revisions" edge to the PostRevision entity by IDs.
+func (pc *PostCreate) AddRevisionIDs(ids ...uuid.UUID) *PostCreate {
+	pc.mutation.AddRevisionIDs(ids...)
+	return pc
+}
+
+// AddRevisions adds the "revisions" edges to the PostRevision entity.
+func (pc *PostCreate) AddRevisions(p ...*PostRevision) *PostCreate {
+	ids := make([]uuid.UUID, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return pc.AddRevisionIDs(ids...)
+}
+
 // Mutation returns the PostMutation object of the builder.
 func (pc *PostCreate) Mutation() *PostMutation {
 	return pc.mutation
@@ -210,6 +284,10 @@ func (pc *PostCreate) defaults() {
 		v := post.DefaultCreatedAt()
 		pc.mutation.SetCreatedAt(v)
 	}
+	if _, ok := pc.mutation.Visibility(); !ok {
+		v := post.DefaultVisibility
+		pc.mutation.SetVisibility(v)
+	}
 	if _, ok := pc.mutation.ID(); !ok {
 		v := post.DefaultID()
 		pc.mutation.SetID(v)
@@ -242,6 +320,14 @@ func (pc *PostCreate) check() error {
 	if _, ok := pc.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Post.created_at"`)}
 	}
+	if _, ok := pc.mutation.Visibility(); !ok {
+		return &ValidationError{Name: "visibility", err: errors.New(`ent: missing required field "Post.visibility"`)}
+	}
+	if v, ok := pc.mutation.Visibility(); ok {
+		if err := post.VisibilityValidator(v); err != nil {
+			return &ValidationError{Name: "visibility", err: fmt.Errorf(`ent: validator failed for field "Post.visibility": %w`, err)}
+		}
+	}
 	if len(pc.mutation.UserIDs()) == 0 {
 		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "Post.user"`)}
 	}
@@ -301,6 +387,18 @@ func (pc *PostCreate) createSpec() (*Post, *sqlgraph.CreateSpec) {
 		_spec.SetField(post.FieldDeletedAt, field.TypeTime, value)
 		_node.DeletedAt = value
 	}
+	if value, ok := pc.mutation.HiddenAt(); ok {
+		_spec.SetField(post.FieldHiddenAt, field.TypeTime, value)
+		_node.HiddenAt = value
+	}
+	if value, ok := pc.mutation.EditedAt(); ok {
+		_spec.SetField(post.FieldEditedAt, field.TypeTime, value)
+		_node.EditedAt = value
+	}
+	if value, ok := pc.mutation.Visibility(); ok {
+		_spec.SetField(post.FieldVisibility, field.TypeEnum, value)
+		_node.Visibility = value
+	}
 	if value, ok := pc.mutation.ImageFeature(); ok {
 		_spec.SetField(post.FieldImageFeature, field.TypeOther, value)
 		_node.ImageFeature = value
@@ -370,6 +468,38 @@ func (pc *PostCreate) createSpec() (*Post, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := pc.mutation.MediaIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   post.MediaTable,
+			Columns: []string{post.MediaColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(postmedia.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := pc.mutation.RevisionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   post.RevisionsTable,
+			Columns: []string{post.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(postrevision.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -476,6 +606,54 @@ func (u *PostUpsert) ClearDeletedAt() *PostUpsert {
 	return u
 }
 
+// SetHiddenAt sets the "hidden_at" field.
+func (u *PostUpsert) SetHiddenAt(v time.Time) *PostUpsert {
+	u.Set(post.FieldHiddenAt, v)
+	return u
+}
+
+// UpdateHiddenAt sets the "hidden_at" field to the value that was provided on create.
+func (u *PostUpsert) UpdateHiddenAt() *PostUpsert {
+	u.SetExcluded(post.FieldHiddenAt)
+	return u
+}
+
+// ClearHiddenAt clears the value of the "hidden_at" field.
+func (u *PostUpsert) ClearHiddenAt() *PostUpsert {
+	u.SetNull(post.FieldHiddenAt)
+	return u
+}
+
+// SetEditedAt sets the "edited_at" field.
+func (u *PostUpsert) SetEditedAt(v time.Time) *PostUpsert {
+	u.Set(post.FieldEditedAt, v)
+	return u
+}
+
+// UpdateEditedAt sets the "edited_at" field to the value that was provided on create.
+func (u *PostUpsert) UpdateEditedAt() *PostUpsert {
+	u.SetExcluded(post.FieldEditedAt)
+	return u
+}
+
+// ClearEditedAt clears the value of the "edited_at" field.
+func (u *PostUpsert) ClearEditedAt() *PostUpsert {
+	u.SetNull(post.FieldEditedAt)
+	return u
+}
+
+// SetVisibility sets the "visibility" field.
+func (u *PostUpsert) SetVisibility(v post.Visibility) *PostUpsert {
+	u.Set(post.FieldVisibility, v)
+	return u
+}
+
+// UpdateVisibility sets the "visibility" field to the value that was provided on create.
+func (u *PostUpsert) UpdateVisibility() *PostUpsert {
+	u.SetExcluded(post.FieldVisibility)
+	return u
+}
+
 // SetImageFeature sets the "image_feature" field.
 func (u *PostUpsert) SetImageFeature(v pgvector.Vector) *PostUpsert {
 	u.Set(post.FieldImageFeature, v)
@@ -608,6 +786,62 @@ func (u *PostUpsertOne) ClearDeletedAt() *PostUpsertOne {
 	})
 }
 
+// SetHiddenAt sets the "hidden_at" field.
+func (u *PostUpsertOne) SetHiddenAt(v time.Time) *PostUpsertOne {
+	return u.Update(func(s *PostUpsert) {
+		s.SetHiddenAt(v)
+	})
+}
+
+// UpdateHiddenAt sets the "hidden_at" field to the value that was provided on create.
+func (u *PostUpsertOne) UpdateHiddenAt() *PostUpsertOne {
+	return u.Update(func(s *PostUpsert) {
+		s.UpdateHiddenAt()
+	})
+}
+
+// ClearHiddenAt clears the value of the "hidden_at" field.
+func (u *PostUpsertOne) ClearHiddenAt() *PostUpsertOne {
+	return u.Update(func(s *PostUpsert) {
+		s.ClearHiddenAt()
+	})
+}
+
+// SetEditedAt sets the "edited_at" field.
+func (u *PostUpsertOne) SetEditedAt(v time.Time) *PostUpsertOne {
+	return u.Update(func(s *PostUpsert) {
+		s.SetEditedAt(v)
+	})
+}
+
+// UpdateEditedAt sets the "edited_at" field to the value that was provided on create.
+func (u *PostUpsertOne) UpdateEditedAt() *PostUpsertOne {
+	return u.Update(func(s *PostUpsert) {
+		s.UpdateEditedAt()
+	})
+}
+
+// ClearEditedAt clears the value of the "edited_at" field.
+func (u *PostUpsertOne) ClearEditedAt() *PostUpsertOne {
+	return u.Update(func(s *PostUpsert) {
+		s.ClearEditedAt()
+	})
+}
+
+// SetVisibility sets the "visibility" field.
+func (u *PostUpsertOne) SetVisibility(v post.Visibility) *PostUpsertOne {
+	return u.Update(func(s *PostUpsert) {
+		s.SetVisibility(v)
+	})
+}
+
+// UpdateVisibility sets the "visibility" field to the value that was provided on create.
+func (u *PostUpsertOne) UpdateVisibility() *PostUpsertOne {
+	return u.Update(func(s *PostUpsert) {
+		s.UpdateVisibility()
+	})
+}
+
 // SetImageFeature sets the "image_feature" field.
 func (u *PostUpsertOne) SetImageFeature(v pgvector.Vector) *PostUpsertOne {
 	return u.Update(func(s *PostUpsert) {
@@ -910,6 +1144,62 @@ func (u *PostUpsertBulk) ClearDeletedAt() *PostUpsertBulk {
 	})
 }
 
+// SetHiddenAt sets the "hidden_at" field.
+func (u *PostUpsertBulk) SetHiddenAt(v time.Time) *PostUpsertBulk {
+	return u.Update(func(s *PostUpsert) {
+		s.SetHiddenAt(v)
+	})
+}
+
+// UpdateHiddenAt sets the "hidden_at" field to the value that was provided on create.
+func (u *PostUpsertBulk) UpdateHiddenAt() *PostUpsertBulk {
+	return u.Update(func(s *PostUpsert) {
+		s.UpdateHiddenAt()
+	})
+}
+
+// ClearHiddenAt clears the value of the "hidden_at" field.
+func (u *PostUpsertBulk) ClearHiddenAt() *PostUpsertBulk {
+	return u.Update(func(s *PostUpsert) {
+		s.ClearHiddenAt()
+	})
+}
+
+// SetEditedAt sets the "edited_at" field.
+func (u *PostUpsertBulk) SetEditedAt(v time.Time) *PostUpsertBulk {
+	return u.Update(func(s *PostUpsert) {
+		s.SetEditedAt(v)
+	})
+}
+
+// UpdateEditedAt sets the "edited_at" field to the value that was provided on create.
+func (u *PostUpsertBulk) UpdateEditedAt() *PostUpsertBulk {
+	return u.Update(func(s *PostUpsert) {
+		s.UpdateEditedAt()
+	})
+}
+
+// ClearEditedAt clears the value of the "edited_at" field.
+func (u *PostUpsertBulk) ClearEditedAt() *PostUpsertBulk {
+	return u.Update(func(s *PostUpsert) {
+		s.ClearEditedAt()
+	})
+}
+
+// SetVisibility sets the "visibility" field.
+func (u *PostUpsertBulk) SetVisibility(v post.Visibility) *PostUpsertBulk {
+	return u.Update(func(s *PostUpsert) {
+		s.SetVisibility(v)
+	})
+}
+
+// UpdateVisibility sets the "visibility" field to the value that was provided on create.
+func (u *PostUpsertBulk) UpdateVisibility() *PostUpsertBulk {
+	return u.Update(func(s *PostUpsert) {
+		s.UpdateVisibility()
+	})
+}
+
 // SetImageFeature sets the "image_feature" field.
 func (u *PostUpsertBulk) SetImageFeature(v pgvector.Vector) *PostUpsertBulk {
 	return u.Update(func(s *PostUpsert) {
@@ -0,0 +1,192 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/aki-13627/animalia/backend-go/ent/post"
+	"github.com/aki-13627/animalia/backend-go/ent/postmedia"
+	"github.com/google/uuid"
+)
+
+// PostMedia is the model entity for the PostMedia schema.
+type PostMedia struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// Position holds the value of the "position" field.
+	Position int `json:"position,omitempty"`
+	// Type holds the value of the "type" field.
+	Type postmedia.Type `json:"type,omitempty"`
+	// ImageKey holds the value of the "image_key" field.
+	ImageKey string `json:"image_key,omitempty"`
+	// PosterKey holds the value of the "poster_key" field.
+	PosterKey string `json:"poster_key,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the PostMediaQuery when eager-loading is set.
+	Edges        PostMediaEdges `json:"edges"`
+	post_media   *uuid.UUID
+	selectValues sql.SelectValues
+}
+
+// PostMediaEdges holds the relations/edges for other nodes in the graph.
+type PostMediaEdges struct {
+	// Post holds the value of the post edge.
+	Post *Post `json:"post,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// PostOrErr returns the Post value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e PostMediaEdges) PostOrErr() (*Post, error) {
+	if e.Post != nil {
+		return e.Post, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: post.Label}
+	}
+	return nil, &NotLoadedError{edge: "post"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*PostMedia) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case postmedia.FieldPosition:
+			values[i] = new(sql.NullInt64)
+		case postmedia.FieldType, postmedia.FieldImageKey, postmedia.FieldPosterKey:
+			values[i] = new(sql.NullString)
+		case postmedia.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case postmedia.FieldID:
+			values[i] = new(uuid.UUID)
+		case postmedia.ForeignKeys[0]: // post_media
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the PostMedia fields.
+func (pm *PostMedia) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case postmedia.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				pm.ID = *value
+			}
+		case postmedia.FieldPosition:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field position", values[i])
+			} else if value.Valid {
+				pm.Position = int(value.Int64)
+			}
+		case postmedia.FieldType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field type", values[i])
+			} else if value.Valid {
+				pm.Type = postmedia.Type(value.String)
+			}
+		case postmedia.FieldImageKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field image_key", values[i])
+			} else if value.Valid {
+				pm.ImageKey = value.String
+			}
+		case postmedia.FieldPosterKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field poster_key", values[i])
+			} else if value.Valid {
+				pm.PosterKey = value.String
+			}
+		case postmedia.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				pm.CreatedAt = value.Time
+			}
+		case postmedia.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field post_media", values[i])
+			} else if value.Valid {
+				pm.post_media = new(uuid.UUID)
+				*pm.post_media = *value.S.(*uuid.UUID)
+			}
+		default:
+			pm.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the PostMedia.
+// This includes values selected through modifiers, order, etc.
+func (pm *PostMedia) Value(name string) (ent.Value, error) {
+	return pm.selectValues.Get(name)
+}
+
+// QueryPost queries the "post" edge of the PostMedia entity.
+func (pm *PostMedia) QueryPost() *PostQuery {
+	return NewPostMediaClient(pm.config).QueryPost(pm)
+}
+
+// Update returns a builder for updating this PostMedia.
+// Note that you need to call PostMedia.Unwrap() before calling this method if this PostMedia
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (pm *PostMedia) Update() *PostMediaUpdateOne {
+	return NewPostMediaClient(pm.config).UpdateOne(pm)
+}
+
+// Unwrap unwraps the PostMedia entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (pm *PostMedia) Unwrap() *PostMedia {
+	_tx, ok := pm.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: PostMedia is not a transactional entity")
+	}
+	pm.config.driver = _tx.drv
+	return pm
+}
+
+// String implements the fmt.Stringer.
+func (pm *PostMedia) String() string {
+	var builder strings.Builder
+	builder.WriteString("PostMedia(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", pm.ID))
+	builder.WriteString("position=")
+	builder.WriteString(fmt.Sprintf("%v", pm.Position))
+	builder.WriteString(", ")
+	builder.WriteString("type=")
+	builder.WriteString(fmt.Sprintf("%v", pm.Type))
+	builder.WriteString(", ")
+	builder.WriteString("image_key=")
+	builder.WriteString(pm.ImageKey)
+	builder.WriteString(", ")
+	builder.WriteString("poster_key=")
+	builder.WriteString(pm.PosterKey)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(pm.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// PostMediaSlice is a parsable slice of PostMedia.
+type PostMediaSlice []*PostMedia
@@ -0,0 +1,155 @@
+// Code generated by ent, DO NOT EDIT.
+
+package postmedia
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the postmedia type in the database.
+	Label = "post_media"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldPosition holds the string denoting the position field in the database.
+	FieldPosition = "position"
+	// FieldType holds the string denoting the type field in the database.
+	FieldType = "type"
+	// FieldImageKey holds the string denoting the image_key field in the database.
+	FieldImageKey = "image_key"
+	// FieldPosterKey holds the string denoting the poster_key field in the database.
+	FieldPosterKey = "poster_key"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// EdgePost holds the string denoting the post edge name in mutations.
+	EdgePost = "post"
+	// Table holds the table name of the postmedia in the database.
+	Table = "post_media"
+	// PostTable is the table that holds the post relation/edge.
+	PostTable = "post_media"
+	// PostInverseTable is the table name for the Post entity.
+	// It exists in this package in order to avoid circular dependency with the "post" package.
+	PostInverseTable = "posts"
+	// PostColumn is the table column denoting the post relation/edge.
+	PostColumn = "post_media"
+)
+
+// Columns holds all SQL columns for postmedia fields.
+var Columns = []string{
+	FieldID,
+	FieldPosition,
+	FieldType,
+	FieldImageKey,
+	FieldPosterKey,
+	FieldCreatedAt,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "post_media"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"post_media",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// PositionValidator is a validator for the "position" field. It is called by the builders before save.
+	PositionValidator func(int) error
+	// ImageKeyValidator is a validator for the "image_key" field. It is called by the builders before save.
+	ImageKeyValidator func(string) error
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// Type defines the type for the "type" enum field.
+type Type string
+
+// TypeImage is the default value of the Type enum.
+const DefaultType = TypeImage
+
+// Type values.
+const (
+	TypeImage Type = "image"
+	TypeVideo Type = "video"
+)
+
+func (_type Type) String() string {
+	return string(_type)
+}
+
+// TypeValidator is a validator for the "type" field enum values. It is called by the builders before save.
+func TypeValidator(_type Type) error {
+	switch _type {
+	case TypeImage, TypeVideo:
+		return nil
+	default:
+		return fmt.Errorf("postmedia: invalid enum value for type field: %q", _type)
+	}
+}
+
+// OrderOption defines the ordering options for the PostMedia queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByPosition orders the results by the position field.
+func ByPosition(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPosition, opts...).ToFunc()
+}
+
+// ByType orders the results by the type field.
+func ByType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldType, opts...).ToFunc()
+}
+
+// ByImageKey orders the results by the image_key field.
+func ByImageKey(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldImageKey, opts...).ToFunc()
+}
+
+// ByPosterKey orders the results by the poster_key field.
+func ByPosterKey(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPosterKey, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByPostField orders the results by post field.
+func ByPostField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newPostStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newPostStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(PostInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, PostTable, PostColumn),
+	)
+}
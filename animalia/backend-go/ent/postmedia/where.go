@@ -0,0 +1,355 @@
+// Code generated by ent, DO NOT EDIT.
+
+package postmedia
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/aki-13627/animalia/backend-go/ent/predicate"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.PostMedia {
+	return predicate.PostMedia(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.PostMedia {
+	return predicate.PostMedia(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.PostMedia {
+	return predicate.PostMedia(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.PostMedia {
+	return predicate.PostMedia(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.PostMedia {
+	return predicate.PostMedia(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.PostMedia {
+	return predicate.PostMedia(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.PostMedia {
+	return predicate.PostMedia(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.PostMedia {
+	return predicate.PostMedia(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.PostMedia {
+	return predicate.PostMedia(sql.FieldLTE(FieldID, id))
+}
+
+// Position applies equality check predicate on the "position" field. It's identical to PositionEQ.
+func Position(v int) predicate.PostMedia {
+	return predicate.PostMedia(sql.FieldEQ(FieldPosition, v))
+}
+
+// ImageKey applies equality check predicate on the "image_key" field. It's identical to ImageKeyEQ.
+func ImageKey(v string) predicate.PostMedia {
+	return predicate.PostMedia(sql.FieldEQ(FieldImageKey, v))
+}
+
+// PosterKey applies equality check predicate on the "poster_key" field. It's identical to PosterKeyEQ.
+func PosterKey(v string) predicate.PostMedia {
+	return predicate.PostMedia(sql.FieldEQ(FieldPosterKey, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.PostMedia {
+	return predicate.PostMedia(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// PositionEQ applies the EQ predicate on the "position" field.
+func PositionEQ(v int) predicate.PostMedia {
+	return predicate.PostMedia(sql.FieldEQ(FieldPosition, v))
+}
+
+// PositionNEQ applies the NEQ predicate on the "position" field.
+func PositionNEQ(v int) predicate.PostMedia {
+	return predicate.PostMedia(sql.FieldNEQ(FieldPosition, v))
+}
+
+// PositionIn applies the In predicate on the "position" field.
+func PositionIn(vs ...int) predicate.PostMedia {
+	return predicate.PostMedia(sql.FieldIn(FieldPosition, vs...))
+}
+
+// PositionNotIn applies the NotIn predicate on the "position" field.
+func PositionNotIn(vs ...int) predicate.PostMedia {
+	return predicate.PostMedia(sql.FieldNotIn(FieldPosition, vs...))
+}
+
+// PositionGT applies the GT predicate on the "position" field.
+func PositionGT(v int) predicate.PostMedia {
+	return predicate.PostMedia(sql.FieldGT(FieldPosition, v))
+}
+
+// PositionGTE applies the GTE predicate on the "position" field.
+func PositionGTE(v int) predicate.PostMedia {
+	return predicate.PostMedia(sql.FieldGTE(FieldPosition, v))
+}
+
+// PositionLT applies the LT predicate on the "position" field.
+func PositionLT(v int) predicate.PostMedia {
+	return predicate.PostMedia(sql.FieldLT(FieldPosition, v))
+}
+
+// PositionLTE applies the LTE predicate on the "position" field.
+func PositionLTE(v int) predicate.PostMedia {
+	return predicate.PostMedia(sql.FieldLTE(FieldPosition, v))
+}
+
+// TypeEQ applies the EQ predicate on the "type" field.
+func TypeEQ(v Type) predicate.PostMedia {
+	return predicate.PostMedia(sql.FieldEQ(FieldType, v))
+}
+
+// TypeNEQ applies the NEQ predicate on the "type" field.
+func TypeNEQ(v Type) predicate.PostMedia {
+	return predicate.PostMedia(sql.FieldNEQ(FieldType, v))
+}
+
+// TypeIn applies the In predicate on the "type" field.
+func TypeIn(vs ...Type) predicate.PostMedia {
+	return predicate.PostMedia(sql.FieldIn(FieldType, vs...))
+}
+
+// TypeNotIn applies the NotIn predicate on the "type" field.
+func TypeNotIn(vs ...Type) predicate.PostMedia {
+	return predicate.PostMedia(sql.FieldNotIn(FieldType, vs...))
+}
+
+// ImageKeyEQ applies the EQ predicate on the "image_key" field.
+func ImageKeyEQ(v string) predicate.PostMedia {
+	return predicate.PostMedia(sql.FieldEQ(FieldImageKey, v))
+}
+
+// ImageKeyNEQ applies the NEQ predicate on the "image_key" field.
+func ImageKeyNEQ(v string) predicate.PostMedia {
+	return predicate.PostMedia(sql.FieldNEQ(FieldImageKey, v))
+}
+
+// ImageKeyIn applies the In predicate on the "image_key" field.
+func ImageKeyIn(vs ...string) predicate.PostMedia {
+	return predicate.PostMedia(sql.FieldIn(FieldImageKey, vs...))
+}
+
+// ImageKeyNotIn applies the NotIn predicate on the "image_key" field.
+func ImageKeyNotIn(vs ...string) predicate.PostMedia {
+	return predicate.PostMedia(sql.FieldNotIn(FieldImageKey, vs...))
+}
+
+// ImageKeyGT applies the GT predicate on the "image_key" field.
+func ImageKeyGT(v string) predicate.PostMedia {
+	return predicate.PostMedia(sql.FieldGT(FieldImageKey, v))
+}
+
+// ImageKeyGTE applies the GTE predicate on the "image_key" field.
+func ImageKeyGTE(v string) predicate.PostMedia {
+	return predicate.PostMedia(sql.FieldGTE(FieldImageKey, v))
+}
+
+// ImageKeyLT applies the LT predicate on the "image_key" field.
+func ImageKeyLT(v string) predicate.PostMedia {
+	return predicate.PostMedia(sql.FieldLT(FieldImageKey, v))
+}
+
+// ImageKeyLTE applies the LTE predicate on the "image_key" field.
+func ImageKeyLTE(v string) predicate.PostMedia {
+	return predicate.PostMedia(sql.FieldLTE(FieldImageKey, v))
+}
+
+// ImageKeyContains applies the Contains predicate on the "image_key" field.
+func ImageKeyContains(v string) predicate.PostMedia {
+	return predicate.PostMedia(sql.FieldContains(FieldImageKey, v))
+}
+
+// ImageKeyHasPrefix applies the HasPrefix predicate on the "image_key" field.
+func ImageKeyHasPrefix(v string) predicate.PostMedia {
+	return predicate.PostMedia(sql.FieldHasPrefix(FieldImageKey, v))
+}
+
+// ImageKeyHasSuffix applies the HasSuffix predicate on the "image_key" field.
+func ImageKeyHasSuffix(v string) predicate.PostMedia {
+	return predicate.PostMedia(sql.FieldHasSuffix(FieldImageKey, v))
+}
+
+// ImageKeyEqualFold applies the EqualFold predicate on the "image_key" field.
+func ImageKeyEqualFold(v string) predicate.PostMedia {
+	return predicate.PostMedia(sql.FieldEqualFold(FieldImageKey, v))
+}
+
+// ImageKeyContainsFold applies the ContainsFold predicate on the "image_key" field.
+func ImageKeyContainsFold(v string) predicate.PostMedia {
+	return predicate.PostMedia(sql.FieldContainsFold(FieldImageKey, v))
+}
+
+// PosterKeyEQ applies the EQ predicate on the "poster_key" field.
+func PosterKeyEQ(v string) predicate.PostMedia {
+	return predicate.PostMedia(sql.FieldEQ(FieldPosterKey, v))
+}
+
+// PosterKeyNEQ applies the NEQ predicate on the "poster_key" field.
+func PosterKeyNEQ(v string) predicate.PostMedia {
+	return predicate.PostMedia(sql.FieldNEQ(FieldPosterKey, v))
+}
+
+// PosterKeyIn applies the In predicate on the "poster_key" field.
+func PosterKeyIn(vs ...string) predicate.PostMedia {
+	return predicate.PostMedia(sql.FieldIn(FieldPosterKey, vs...))
+}
+
+// PosterKeyNotIn applies the NotIn predicate on the "poster_key" field.
+func PosterKeyNotIn(vs ...string) predicate.PostMedia {
+	return predicate.PostMedia(sql.FieldNotIn(FieldPosterKey, vs...))
+}
+
+// PosterKeyGT applies the GT predicate on the "poster_key" field.
+func PosterKeyGT(v string) predicate.PostMedia {
+	return predicate.PostMedia(sql.FieldGT(FieldPosterKey, v))
+}
+
+// PosterKeyGTE applies the GTE predicate on the "poster_key" field.
+func PosterKeyGTE(v string) predicate.PostMedia {
+	return predicate.PostMedia(sql.FieldGTE(FieldPosterKey, v))
+}
+
+// PosterKeyLT applies the LT predicate on the "poster_key" field.
+func PosterKeyLT(v string) predicate.PostMedia {
+	return predicate.PostMedia(sql.FieldLT(FieldPosterKey, v))
+}
+
+// PosterKeyLTE applies the LTE predicate on the "poster_key" field.
+func PosterKeyLTE(v string) predicate.PostMedia {
+	return predicate.PostMedia(sql.FieldLTE(FieldPosterKey, v))
+}
+
+// PosterKeyContains applies the Contains predicate on the "poster_key" field.
+func PosterKeyContains(v string) predicate.PostMedia {
+	return predicate.PostMedia(sql.FieldContains(FieldPosterKey, v))
+}
+
+// PosterKeyHasPrefix applies the HasPrefix predicate on the "poster_key" field.
+func PosterKeyHasPrefix(v string) predicate.PostMedia {
+	return predicate.PostMedia(sql.FieldHasPrefix(FieldPosterKey, v))
+}
+
+// PosterKeyHasSuffix applies the HasSuffix predicate on the "poster_key" field.
+func PosterKeyHasSuffix(v string) predicate.PostMedia {
+	return predicate.PostMedia(sql.FieldHasSuffix(FieldPosterKey, v))
+}
+
+// PosterKeyIsNil applies the IsNil predicate on the "poster_key" field.
+func PosterKeyIsNil() predicate.PostMedia {
+	return predicate.PostMedia(sql.FieldIsNull(FieldPosterKey))
+}
+
+// PosterKeyNotNil applies the NotNil predicate on the "poster_key" field.
+func PosterKeyNotNil() predicate.PostMedia {
+	return predicate.PostMedia(sql.FieldNotNull(FieldPosterKey))
+}
+
+// PosterKeyEqualFold applies the EqualFold predicate on the "poster_key" field.
+func PosterKeyEqualFold(v string) predicate.PostMedia {
+	return predicate.PostMedia(sql.FieldEqualFold(FieldPosterKey, v))
+}
+
+// PosterKeyContainsFold applies the ContainsFold predicate on the "poster_key" field.
+func PosterKeyContainsFold(v string) predicate.PostMedia {
+	return predicate.PostMedia(sql.FieldContainsFold(FieldPosterKey, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.PostMedia {
+	return predicate.PostMedia(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.PostMedia {
+	return predicate.PostMedia(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.PostMedia {
+	return predicate.PostMedia(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.PostMedia {
+	return predicate.PostMedia(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.PostMedia {
+	return predicate.PostMedia(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.PostMedia {
+	return predicate.PostMedia(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.PostMedia {
+	return predicate.PostMedia(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.PostMedia {
+	return predicate.PostMedia(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasPost applies the HasEdge predicate on the "post" edge.
+func HasPost() predicate.PostMedia {
+	return predicate.PostMedia(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, PostTable, PostColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasPostWith applies the HasEdge predicate on the "post" edge with a given conditions (other predicates).
+func HasPostWith(preds ...predicate.Post) predicate.PostMedia {
+	return predicate.PostMedia(func(s *sql.Selector) {
+		step := newPostStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.PostMedia) predicate.PostMedia {
+	return predicate.PostMedia(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.PostMedia) predicate.PostMedia {
+	return predicate.PostMedia(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.PostMedia) predicate.PostMedia {
+	return predicate.PostMedia(sql.NotPredicates(p))
+}
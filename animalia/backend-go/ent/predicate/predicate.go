@@ -6,9 +6,18 @@ import (
 	"entgo.io/ent/dialect/sql"
 )
 
+// AuditLog is the predicate function for auditlog builders.
+type AuditLog func(*sql.Selector)
+
+// Block is the predicate function for block builders.
+type Block func(*sql.Selector)
+
 // Comment is the predicate function for comment builders.
 type Comment func(*sql.Selector)
 
+// Credential is the predicate function for credential builders.
+type Credential func(*sql.Selector)
+
 // DailyTask is the predicate function for dailytask builders.
 type DailyTask func(*sql.Selector)
 
@@ -18,14 +27,32 @@ type FollowRelation func(*sql.Selector)
 // Like is the predicate function for like builders.
 type Like func(*sql.Selector)
 
+// Mute is the predicate function for mute builders.
+type Mute func(*sql.Selector)
+
 // Pet is the predicate function for pet builders.
 type Pet func(*sql.Selector)
 
 // Post is the predicate function for post builders.
 type Post func(*sql.Selector)
 
+// PostMedia is the predicate function for postmedia builders.
+type PostMedia func(*sql.Selector)
+
+// PostRevision is the predicate function for postrevision builders.
+type PostRevision func(*sql.Selector)
+
+// Report is the predicate function for report builders.
+type Report func(*sql.Selector)
+
+// StorageDeletion is the predicate function for storagedeletion builders.
+type StorageDeletion func(*sql.Selector)
+
 // TaskType is the predicate function for tasktype builders.
 type TaskType func(*sql.Selector)
 
+// TimelineSession is the predicate function for timelinesession builders.
+type TimelineSession func(*sql.Selector)
+
 // User is the predicate function for user builders.
 type User func(*sql.Selector)
@@ -0,0 +1,594 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/aki-13627/animalia/backend-go/ent/predicate"
+	"github.com/aki-13627/animalia/backend-go/ent/report"
+	"github.com/aki-13627/animalia/backend-go/ent/user"
+	"github.com/google/uuid"
+)
+
+// ReportUpdate is the builder for updating Report entities.
+type ReportUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *ReportMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the ReportUpdate builder.
+func (ru *ReportUpdate) Where(ps ...predicate.Report) *ReportUpdate {
+	ru.mutation.Where(ps...)
+	return ru
+}
+
+// SetTargetType sets the "target_type" field.
+func (ru *ReportUpdate) SetTargetType(rt report.TargetType) *ReportUpdate {
+	ru.mutation.SetTargetType(rt)
+	return ru
+}
+
+// SetNillableTargetType sets the "target_type" field if the given value is not nil.
+func (ru *ReportUpdate) SetNillableTargetType(rt *report.TargetType) *ReportUpdate {
+	if rt != nil {
+		ru.SetTargetType(*rt)
+	}
+	return ru
+}
+
+// SetTargetID sets the "target_id" field.
+func (ru *ReportUpdate) SetTargetID(u uuid.UUID) *ReportUpdate {
+	ru.mutation.SetTargetID(u)
+	return ru
+}
+
+// SetNillableTargetID sets the "target_id" field if the given value is not nil.
+func (ru *ReportUpdate) SetNillableTargetID(u *uuid.UUID) *ReportUpdate {
+	if u != nil {
+		ru.SetTargetID(*u)
+	}
+	return ru
+}
+
+// SetReason sets the "reason" field.
+func (ru *ReportUpdate) SetReason(r report.Reason) *ReportUpdate {
+	ru.mutation.SetReason(r)
+	return ru
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (ru *ReportUpdate) SetNillableReason(r *report.Reason) *ReportUpdate {
+	if r != nil {
+		ru.SetReason(*r)
+	}
+	return ru
+}
+
+// SetDetail sets the "detail" field.
+func (ru *ReportUpdate) SetDetail(s string) *ReportUpdate {
+	ru.mutation.SetDetail(s)
+	return ru
+}
+
+// SetNillableDetail sets the "detail" field if the given value is not nil.
+func (ru *ReportUpdate) SetNillableDetail(s *string) *ReportUpdate {
+	if s != nil {
+		ru.SetDetail(*s)
+	}
+	return ru
+}
+
+// SetStatus sets the "status" field.
+func (ru *ReportUpdate) SetStatus(r report.Status) *ReportUpdate {
+	ru.mutation.SetStatus(r)
+	return ru
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (ru *ReportUpdate) SetNillableStatus(r *report.Status) *ReportUpdate {
+	if r != nil {
+		ru.SetStatus(*r)
+	}
+	return ru
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (ru *ReportUpdate) SetCreatedAt(t time.Time) *ReportUpdate {
+	ru.mutation.SetCreatedAt(t)
+	return ru
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (ru *ReportUpdate) SetNillableCreatedAt(t *time.Time) *ReportUpdate {
+	if t != nil {
+		ru.SetCreatedAt(*t)
+	}
+	return ru
+}
+
+// SetReviewedAt sets the "reviewed_at" field.
+func (ru *ReportUpdate) SetReviewedAt(t time.Time) *ReportUpdate {
+	ru.mutation.SetReviewedAt(t)
+	return ru
+}
+
+// SetNillableReviewedAt sets the "reviewed_at" field if the given value is not nil.
+func (ru *ReportUpdate) SetNillableReviewedAt(t *time.Time) *ReportUpdate {
+	if t != nil {
+		ru.SetReviewedAt(*t)
+	}
+	return ru
+}
+
+// ClearReviewedAt clears the value of the "reviewed_at" field.
+func (ru *ReportUpdate) ClearReviewedAt() *ReportUpdate {
+	ru.mutation.ClearReviewedAt()
+	return ru
+}
+
+// SetReporterID sets the "reporter" edge to the User entity by ID.
+func (ru *ReportUpdate) SetReporterID(id uuid.UUID) *ReportUpdate {
+	ru.mutation.SetReporterID(id)
+	return ru
+}
+
+// SetReporter sets the "reporter" edge to the User entity.
+func (ru *ReportUpdate) SetReporter(u *User) *ReportUpdate {
+	return ru.SetReporterID(u.ID)
+}
+
+// Mutation returns the ReportMutation object of the builder.
+func (ru *ReportUpdate) Mutation() *ReportMutation {
+	return ru.mutation
+}
+
+// ClearReporter clears the "reporter" edge to the User entity.
+func (ru *ReportUpdate) ClearReporter() *ReportUpdate {
+	ru.mutation.ClearReporter()
+	return ru
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (ru *ReportUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, ru.sqlSave, ru.mutation, ru.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ru *ReportUpdate) SaveX(ctx context.Context) int {
+	affected, err := ru.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (ru *ReportUpdate) Exec(ctx context.Context) error {
+	_, err := ru.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ru *ReportUpdate) ExecX(ctx context.Context) {
+	if err := ru.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ru *ReportUpdate) check() error {
+	if v, ok := ru.mutation.TargetType(); ok {
+		if err := report.TargetTypeValidator(v); err != nil {
+			return &ValidationError{Name: "target_type", err: fmt.Errorf(`ent: validator failed for field "Report.target_type": %w`, err)}
+		}
+	}
+	if v, ok := ru.mutation.Reason(); ok {
+		if err := report.ReasonValidator(v); err != nil {
+			return &ValidationError{Name: "reason", err: fmt.Errorf(`ent: validator failed for field "Report.reason": %w`, err)}
+		}
+	}
+	if v, ok := ru.mutation.Status(); ok {
+		if err := report.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Report.status": %w`, err)}
+		}
+	}
+	if ru.mutation.ReporterCleared() && len(ru.mutation.ReporterIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Report.reporter"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (ru *ReportUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ReportUpdate {
+	ru.modifiers = append(ru.modifiers, modifiers...)
+	return ru
+}
+
+func (ru *ReportUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := ru.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(report.Table, report.Columns, sqlgraph.NewFieldSpec(report.FieldID, field.TypeUUID))
+	if ps := ru.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ru.mutation.TargetType(); ok {
+		_spec.SetField(report.FieldTargetType, field.TypeEnum, value)
+	}
+	if value, ok := ru.mutation.TargetID(); ok {
+		_spec.SetField(report.FieldTargetID, field.TypeUUID, value)
+	}
+	if value, ok := ru.mutation.Reason(); ok {
+		_spec.SetField(report.FieldReason, field.TypeEnum, value)
+	}
+	if value, ok := ru.mutation.Detail(); ok {
+		_spec.SetField(report.FieldDetail, field.TypeString, value)
+	}
+	if value, ok := ru.mutation.Status(); ok {
+		_spec.SetField(report.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := ru.mutation.CreatedAt(); ok {
+		_spec.SetField(report.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := ru.mutation.ReviewedAt(); ok {
+		_spec.SetField(report.FieldReviewedAt, field.TypeTime, value)
+	}
+	if ru.mutation.ReviewedAtCleared() {
+		_spec.ClearField(report.FieldReviewedAt, field.TypeTime)
+	}
+	if ru.mutation.ReporterCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   report.ReporterTable,
+			Columns: []string{report.ReporterColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ru.mutation.ReporterIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   report.ReporterTable,
+			Columns: []string{report.ReporterColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(ru.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, ru.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{report.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	ru.mutation.done = true
+	return n, nil
+}
+
+// ReportUpdateOne is the builder for updating a single Report entity.
+type ReportUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *ReportMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetTargetType sets the "target_type" field.
+func (ruo *ReportUpdateOne) SetTargetType(rt report.TargetType) *ReportUpdateOne {
+	ruo.mutation.SetTargetType(rt)
+	return ruo
+}
+
+// SetNillableTargetType sets the "target_type" field if the given value is not nil.
+func (ruo *ReportUpdateOne) SetNillableTargetType(rt *report.TargetType) *ReportUpdateOne {
+	if rt != nil {
+		ruo.SetTargetType(*rt)
+	}
+	return ruo
+}
+
+// SetTargetID sets the "target_id" field.
+func (ruo *ReportUpdateOne) SetTargetID(u uuid.UUID) *ReportUpdateOne {
+	ruo.mutation.SetTargetID(u)
+	return ruo
+}
+
+// SetNillableTargetID sets the "target_id" field if the given value is not nil.
+func (ruo *ReportUpdateOne) SetNillableTargetID(u *uuid.UUID) *ReportUpdateOne {
+	if u != nil {
+		ruo.SetTargetID(*u)
+	}
+	return ruo
+}
+
+// SetReason sets the "reason" field.
+func (ruo *ReportUpdateOne) SetReason(r report.Reason) *ReportUpdateOne {
+	ruo.mutation.SetReason(r)
+	return ruo
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (ruo *ReportUpdateOne) SetNillableReason(r *report.Reason) *ReportUpdateOne {
+	if r != nil {
+		ruo.SetReason(*r)
+	}
+	return ruo
+}
+
+// SetDetail sets the "detail" field.
+func (ruo *ReportUpdateOne) SetDetail(s string) *ReportUpdateOne {
+	ruo.mutation.SetDetail(s)
+	return ruo
+}
+
+// SetNillableDetail sets the "detail" field if the given value is not nil.
+func (ruo *ReportUpdateOne) SetNillableDetail(s *string) *ReportUpdateOne {
+	if s != nil {
+		ruo.SetDetail(*s)
+	}
+	return ruo
+}
+
+// SetStatus sets the "status" field.
+func (ruo *ReportUpdateOne) SetStatus(r report.Status) *ReportUpdateOne {
+	ruo.mutation.SetStatus(r)
+	return ruo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (ruo *ReportUpdateOne) SetNillableStatus(r *report.Status) *ReportUpdateOne {
+	if r != nil {
+		ruo.SetStatus(*r)
+	}
+	return ruo
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (ruo *ReportUpdateOne) SetCreatedAt(t time.Time) *ReportUpdateOne {
+	ruo.mutation.SetCreatedAt(t)
+	return ruo
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (ruo *ReportUpdateOne) SetNillableCreatedAt(t *time.Time) *ReportUpdateOne {
+	if t != nil {
+		ruo.SetCreatedAt(*t)
+	}
+	return ruo
+}
+
+// SetReviewedAt sets the "reviewed_at" field.
+func (ruo *ReportUpdateOne) SetReviewedAt(t time.Time) *ReportUpdateOne {
+	ruo.mutation.SetReviewedAt(t)
+	return ruo
+}
+
+// SetNillableReviewedAt sets the "reviewed_at" field if the given value is not nil.
+func (ruo *ReportUpdateOne) SetNillableReviewedAt(t *time.Time) *ReportUpdateOne {
+	if t != nil {
+		ruo.SetReviewedAt(*t)
+	}
+	return ruo
+}
+
+// ClearReviewedAt clears the value of the "reviewed_at" field.
+func (ruo *ReportUpdateOne) ClearReviewedAt() *ReportUpdateOne {
+	ruo.mutation.ClearReviewedAt()
+	return ruo
+}
+
+// SetReporterID sets the "reporter" edge to the User entity by ID.
+func (ruo *ReportUpdateOne) SetReporterID(id uuid.UUID) *ReportUpdateOne {
+	ruo.mutation.SetReporterID(id)
+	return ruo
+}
+
+// SetReporter sets the "reporter" edge to the User entity.
+func (ruo *ReportUpdateOne) SetReporter(u *User) *ReportUpdateOne {
+	return ruo.SetReporterID(u.ID)
+}
+
+// Mutation returns the ReportMutation object of the builder.
+func (ruo *ReportUpdateOne) Mutation() *ReportMutation {
+	return ruo.mutation
+}
+
+// ClearReporter clears the "reporter" edge to the User entity.
+func (ruo *ReportUpdateOne) ClearReporter() *ReportUpdateOne {
+	ruo.mutation.ClearReporter()
+	return ruo
+}
+
+// Where appends a list predicates to the ReportUpdate builder.
+func (ruo *ReportUpdateOne) Where(ps ...predicate.Report) *ReportUpdateOne {
+	ruo.mutation.Where(ps...)
+	return ruo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (ruo *ReportUpdateOne) Select(field string, fields ...string) *ReportUpdateOne {
+	ruo.fields = append([]string{field}, fields...)
+	return ruo
+}
+
+// Save executes the query and returns the updated Report entity.
+func (ruo *ReportUpdateOne) Save(ctx context.Context) (*Report, error) {
+	return withHooks(ctx, ruo.sqlSave, ruo.mutation, ruo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ruo *ReportUpdateOne) SaveX(ctx context.Context) *Report {
+	node, err := ruo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (ruo *ReportUpdateOne) Exec(ctx context.Context) error {
+	_, err := ruo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ruo *ReportUpdateOne) ExecX(ctx context.Context) {
+	if err := ruo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ruo *ReportUpdateOne) check() error {
+	if v, ok := ruo.mutation.TargetType(); ok {
+		if err := report.TargetTypeValidator(v); err != nil {
+			return &ValidationError{Name: "target_type", err: fmt.Errorf(`ent: validator failed for field "Report.target_type": %w`, err)}
+		}
+	}
+	if v, ok := ruo.mutation.Reason(); ok {
+		if err := report.ReasonValidator(v); err != nil {
+			return &ValidationError{Name: "reason", err: fmt.Errorf(`ent: validator failed for field "Report.reason": %w`, err)}
+		}
+	}
+	if v, ok := ruo.mutation.Status(); ok {
+		if err := report.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Report.status": %w`, err)}
+		}
+	}
+	if ruo.mutation.ReporterCleared() && len(ruo.mutation.ReporterIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Report.reporter"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (ruo *ReportUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ReportUpdateOne {
+	ruo.modifiers = append(ruo.modifiers, modifiers...)
+	return ruo
+}
+
+func (ruo *ReportUpdateOne) sqlSave(ctx context.Context) (_node *Report, err error) {
+	if err := ruo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(report.Table, report.Columns, sqlgraph.NewFieldSpec(report.FieldID, field.TypeUUID))
+	id, ok := ruo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Report.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := ruo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, report.FieldID)
+		for _, f := range fields {
+			if !report.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != report.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := ruo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ruo.mutation.TargetType(); ok {
+		_spec.SetField(report.FieldTargetType, field.TypeEnum, value)
+	}
+	if value, ok := ruo.mutation.TargetID(); ok {
+		_spec.SetField(report.FieldTargetID, field.TypeUUID, value)
+	}
+	if value, ok := ruo.mutation.Reason(); ok {
+		_spec.SetField(report.FieldReason, field.TypeEnum, value)
+	}
+	if value, ok := ruo.mutation.Detail(); ok {
+		_spec.SetField(report.FieldDetail, field.TypeString, value)
+	}
+	if value, ok := ruo.mutation.Status(); ok {
+		_spec.SetField(report.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := ruo.mutation.CreatedAt(); ok {
+		_spec.SetField(report.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := ruo.mutation.ReviewedAt(); ok {
+		_spec.SetField(report.FieldReviewedAt, field.TypeTime, value)
+	}
+	if ruo.mutation.ReviewedAtCleared() {
+		_spec.ClearField(report.FieldReviewedAt, field.TypeTime)
+	}
+	if ruo.mutation.ReporterCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   report.ReporterTable,
+			Columns: []string{report.ReporterColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ruo.mutation.ReporterIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   report.ReporterTable,
+			Columns: []string{report.ReporterColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(ruo.modifiers...)
+	_node = &Report{config: ruo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, ruo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{report.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	ruo.mutation.done = true
+	return _node, nil
+}
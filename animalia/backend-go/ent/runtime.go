@@ -5,13 +5,22 @@ package ent
 import (
 	"time"
 
+	"github.com/aki-13627/animalia/backend-go/ent/auditlog"
+	"github.com/aki-13627/animalia/backend-go/ent/block"
 	"github.com/aki-13627/animalia/backend-go/ent/comment"
+	"github.com/aki-13627/animalia/backend-go/ent/credential"
 	"github.com/aki-13627/animalia/backend-go/ent/dailytask"
 	"github.com/aki-13627/animalia/backend-go/ent/followrelation"
 	"github.com/aki-13627/animalia/backend-go/ent/like"
+	"github.com/aki-13627/animalia/backend-go/ent/mute"
 	"github.com/aki-13627/animalia/backend-go/ent/pet"
 	"github.com/aki-13627/animalia/backend-go/ent/post"
+	"github.com/aki-13627/animalia/backend-go/ent/postmedia"
+	"github.com/aki-13627/animalia/backend-go/ent/postrevision"
+	"github.com/aki-13627/animalia/backend-go/ent/report"
 	"github.com/aki-13627/animalia/backend-go/ent/schema"
+	"github.com/aki-13627/animalia/backend-go/ent/storagedeletion"
+	"github.com/aki-13627/animalia/backend-go/ent/timelinesession"
 	"github.com/aki-13627/animalia/backend-go/ent/user"
 	"github.com/google/uuid"
 )
@@ -20,6 +29,42 @@ import (
 // (default values, validators, hooks and policies) and stitches it
 // to their package variables.
 func init() {
+	auditlogFields := schema.AuditLog{}.Fields()
+	_ = auditlogFields
+	// auditlogDescAction is the schema descriptor for action field.
+	auditlogDescAction := auditlogFields[1].Descriptor()
+	// auditlog.ActionValidator is a validator for the "action" field. It is called by the builders before save.
+	auditlog.ActionValidator = auditlogDescAction.Validators[0].(func(string) error)
+	// auditlogDescTargetType is the schema descriptor for target_type field.
+	auditlogDescTargetType := auditlogFields[2].Descriptor()
+	// auditlog.TargetTypeValidator is a validator for the "target_type" field. It is called by the builders before save.
+	auditlog.TargetTypeValidator = auditlogDescTargetType.Validators[0].(func(string) error)
+	// auditlogDescTargetID is the schema descriptor for target_id field.
+	auditlogDescTargetID := auditlogFields[3].Descriptor()
+	// auditlog.TargetIDValidator is a validator for the "target_id" field. It is called by the builders before save.
+	auditlog.TargetIDValidator = auditlogDescTargetID.Validators[0].(func(string) error)
+	// auditlogDescDetail is the schema descriptor for detail field.
+	auditlogDescDetail := auditlogFields[4].Descriptor()
+	// auditlog.DefaultDetail holds the default value on creation for the detail field.
+	auditlog.DefaultDetail = auditlogDescDetail.Default.(string)
+	// auditlogDescCreatedAt is the schema descriptor for created_at field.
+	auditlogDescCreatedAt := auditlogFields[5].Descriptor()
+	// auditlog.DefaultCreatedAt holds the default value on creation for the created_at field.
+	auditlog.DefaultCreatedAt = auditlogDescCreatedAt.Default.(func() time.Time)
+	// auditlogDescID is the schema descriptor for id field.
+	auditlogDescID := auditlogFields[0].Descriptor()
+	// auditlog.DefaultID holds the default value on creation for the id field.
+	auditlog.DefaultID = auditlogDescID.Default.(func() uuid.UUID)
+	blockFields := schema.Block{}.Fields()
+	_ = blockFields
+	// blockDescCreatedAt is the schema descriptor for created_at field.
+	blockDescCreatedAt := blockFields[1].Descriptor()
+	// block.DefaultCreatedAt holds the default value on creation for the created_at field.
+	block.DefaultCreatedAt = blockDescCreatedAt.Default.(func() time.Time)
+	// blockDescID is the schema descriptor for id field.
+	blockDescID := blockFields[0].Descriptor()
+	// block.DefaultID holds the default value on creation for the id field.
+	block.DefaultID = blockDescID.Default.(func() uuid.UUID)
 	commentFields := schema.Comment{}.Fields()
 	_ = commentFields
 	// commentDescContent is the schema descriptor for content field.
@@ -34,6 +79,46 @@ func init() {
 	commentDescID := commentFields[0].Descriptor()
 	// comment.DefaultID holds the default value on creation for the id field.
 	comment.DefaultID = commentDescID.Default.(func() uuid.UUID)
+	credentialFields := schema.Credential{}.Fields()
+	_ = credentialFields
+	// credentialDescEmail is the schema descriptor for email field.
+	credentialDescEmail := credentialFields[1].Descriptor()
+	// credential.EmailValidator is a validator for the "email" field. It is called by the builders before save.
+	credential.EmailValidator = credentialDescEmail.Validators[0].(func(string) error)
+	// credentialDescPasswordHash is the schema descriptor for password_hash field.
+	credentialDescPasswordHash := credentialFields[2].Descriptor()
+	// credential.PasswordHashValidator is a validator for the "password_hash" field. It is called by the builders before save.
+	credential.PasswordHashValidator = credentialDescPasswordHash.Validators[0].(func(string) error)
+	// credentialDescEmailVerified is the schema descriptor for email_verified field.
+	credentialDescEmailVerified := credentialFields[3].Descriptor()
+	// credential.DefaultEmailVerified holds the default value on creation for the email_verified field.
+	credential.DefaultEmailVerified = credentialDescEmailVerified.Default.(bool)
+	// credentialDescVerificationAttempts is the schema descriptor for verification_attempts field.
+	credentialDescVerificationAttempts := credentialFields[6].Descriptor()
+	// credential.DefaultVerificationAttempts holds the default value on creation for the verification_attempts field.
+	credential.DefaultVerificationAttempts = credentialDescVerificationAttempts.Default.(int)
+	// credentialDescResetAttempts is the schema descriptor for reset_attempts field.
+	credentialDescResetAttempts := credentialFields[10].Descriptor()
+	// credential.DefaultResetAttempts holds the default value on creation for the reset_attempts field.
+	credential.DefaultResetAttempts = credentialDescResetAttempts.Default.(int)
+	// credentialDescTokenVersion is the schema descriptor for token_version field.
+	credentialDescTokenVersion := credentialFields[11].Descriptor()
+	// credential.DefaultTokenVersion holds the default value on creation for the token_version field.
+	credential.DefaultTokenVersion = credentialDescTokenVersion.Default.(int)
+	// credentialDescCreatedAt is the schema descriptor for created_at field.
+	credentialDescCreatedAt := credentialFields[12].Descriptor()
+	// credential.DefaultCreatedAt holds the default value on creation for the created_at field.
+	credential.DefaultCreatedAt = credentialDescCreatedAt.Default.(func() time.Time)
+	// credentialDescUpdatedAt is the schema descriptor for updated_at field.
+	credentialDescUpdatedAt := credentialFields[13].Descriptor()
+	// credential.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	credential.DefaultUpdatedAt = credentialDescUpdatedAt.Default.(func() time.Time)
+	// credential.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	credential.UpdateDefaultUpdatedAt = credentialDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// credentialDescID is the schema descriptor for id field.
+	credentialDescID := credentialFields[0].Descriptor()
+	// credential.DefaultID holds the default value on creation for the id field.
+	credential.DefaultID = credentialDescID.Default.(func() uuid.UUID)
 	dailytaskFields := schema.DailyTask{}.Fields()
 	_ = dailytaskFields
 	// dailytaskDescCreatedAt is the schema descriptor for created_at field.
@@ -64,6 +149,16 @@ func init() {
 	likeDescID := likeFields[0].Descriptor()
 	// like.DefaultID holds the default value on creation for the id field.
 	like.DefaultID = likeDescID.Default.(func() uuid.UUID)
+	muteFields := schema.Mute{}.Fields()
+	_ = muteFields
+	// muteDescCreatedAt is the schema descriptor for created_at field.
+	muteDescCreatedAt := muteFields[1].Descriptor()
+	// mute.DefaultCreatedAt holds the default value on creation for the created_at field.
+	mute.DefaultCreatedAt = muteDescCreatedAt.Default.(func() time.Time)
+	// muteDescID is the schema descriptor for id field.
+	muteDescID := muteFields[0].Descriptor()
+	// mute.DefaultID holds the default value on creation for the id field.
+	mute.DefaultID = muteDescID.Default.(func() uuid.UUID)
 	petFields := schema.Pet{}.Fields()
 	_ = petFields
 	// petDescName is the schema descriptor for name field.
@@ -108,6 +203,76 @@ func init() {
 	postDescID := postFields[0].Descriptor()
 	// post.DefaultID holds the default value on creation for the id field.
 	post.DefaultID = postDescID.Default.(func() uuid.UUID)
+	postmediaFields := schema.PostMedia{}.Fields()
+	_ = postmediaFields
+	// postmediaDescPosition is the schema descriptor for position field.
+	postmediaDescPosition := postmediaFields[1].Descriptor()
+	// postmedia.PositionValidator is a validator for the "position" field. It is called by the builders before save.
+	postmedia.PositionValidator = postmediaDescPosition.Validators[0].(func(int) error)
+	// postmediaDescImageKey is the schema descriptor for image_key field.
+	postmediaDescImageKey := postmediaFields[3].Descriptor()
+	// postmedia.ImageKeyValidator is a validator for the "image_key" field. It is called by the builders before save.
+	postmedia.ImageKeyValidator = postmediaDescImageKey.Validators[0].(func(string) error)
+	// postmediaDescCreatedAt is the schema descriptor for created_at field.
+	postmediaDescCreatedAt := postmediaFields[5].Descriptor()
+	// postmedia.DefaultCreatedAt holds the default value on creation for the created_at field.
+	postmedia.DefaultCreatedAt = postmediaDescCreatedAt.Default.(func() time.Time)
+	// postmediaDescID is the schema descriptor for id field.
+	postmediaDescID := postmediaFields[0].Descriptor()
+	// postmedia.DefaultID holds the default value on creation for the id field.
+	postmedia.DefaultID = postmediaDescID.Default.(func() uuid.UUID)
+	postrevisionFields := schema.PostRevision{}.Fields()
+	_ = postrevisionFields
+	// postrevisionDescCaption is the schema descriptor for caption field.
+	postrevisionDescCaption := postrevisionFields[1].Descriptor()
+	// postrevision.CaptionValidator is a validator for the "caption" field. It is called by the builders before save.
+	postrevision.CaptionValidator = postrevisionDescCaption.Validators[0].(func(string) error)
+	// postrevisionDescCreatedAt is the schema descriptor for created_at field.
+	postrevisionDescCreatedAt := postrevisionFields[2].Descriptor()
+	// postrevision.DefaultCreatedAt holds the default value on creation for the created_at field.
+	postrevision.DefaultCreatedAt = postrevisionDescCreatedAt.Default.(func() time.Time)
+	// postrevisionDescID is the schema descriptor for id field.
+	postrevisionDescID := postrevisionFields[0].Descriptor()
+	// postrevision.DefaultID holds the default value on creation for the id field.
+	postrevision.DefaultID = postrevisionDescID.Default.(func() uuid.UUID)
+	reportFields := schema.Report{}.Fields()
+	_ = reportFields
+	// reportDescDetail is the schema descriptor for detail field.
+	reportDescDetail := reportFields[4].Descriptor()
+	// report.DefaultDetail holds the default value on creation for the detail field.
+	report.DefaultDetail = reportDescDetail.Default.(string)
+	// reportDescCreatedAt is the schema descriptor for created_at field.
+	reportDescCreatedAt := reportFields[6].Descriptor()
+	// report.DefaultCreatedAt holds the default value on creation for the created_at field.
+	report.DefaultCreatedAt = reportDescCreatedAt.Default.(func() time.Time)
+	// reportDescID is the schema descriptor for id field.
+	reportDescID := reportFields[0].Descriptor()
+	// report.DefaultID holds the default value on creation for the id field.
+	report.DefaultID = reportDescID.Default.(func() uuid.UUID)
+	storagedeletionFields := schema.StorageDeletion{}.Fields()
+	_ = storagedeletionFields
+	// storagedeletionDescKey is the schema descriptor for key field.
+	storagedeletionDescKey := storagedeletionFields[1].Descriptor()
+	// storagedeletion.KeyValidator is a validator for the "key" field. It is called by the builders before save.
+	storagedeletion.KeyValidator = storagedeletionDescKey.Validators[0].(func(string) error)
+	// storagedeletionDescCreatedAt is the schema descriptor for created_at field.
+	storagedeletionDescCreatedAt := storagedeletionFields[4].Descriptor()
+	// storagedeletion.DefaultCreatedAt holds the default value on creation for the created_at field.
+	storagedeletion.DefaultCreatedAt = storagedeletionDescCreatedAt.Default.(func() time.Time)
+	// storagedeletionDescID is the schema descriptor for id field.
+	storagedeletionDescID := storagedeletionFields[0].Descriptor()
+	// storagedeletion.DefaultID holds the default value on creation for the id field.
+	storagedeletion.DefaultID = storagedeletionDescID.Default.(func() uuid.UUID)
+	timelinesessionFields := schema.TimelineSession{}.Fields()
+	_ = timelinesessionFields
+	// timelinesessionDescCreatedAt is the schema descriptor for created_at field.
+	timelinesessionDescCreatedAt := timelinesessionFields[4].Descriptor()
+	// timelinesession.DefaultCreatedAt holds the default value on creation for the created_at field.
+	timelinesession.DefaultCreatedAt = timelinesessionDescCreatedAt.Default.(func() time.Time)
+	// timelinesessionDescID is the schema descriptor for id field.
+	timelinesessionDescID := timelinesessionFields[0].Descriptor()
+	// timelinesession.DefaultID holds the default value on creation for the id field.
+	timelinesession.DefaultID = timelinesessionDescID.Default.(func() uuid.UUID)
 	userFields := schema.User{}.Fields()
 	_ = userFields
 	// userDescIndex is the schema descriptor for index field.
@@ -119,17 +284,21 @@ func init() {
 	// user.EmailValidator is a validator for the "email" field. It is called by the builders before save.
 	user.EmailValidator = userDescEmail.Validators[0].(func(string) error)
 	// userDescName is the schema descriptor for name field.
-	userDescName := userFields[3].Descriptor()
+	userDescName := userFields[4].Descriptor()
 	// user.NameValidator is a validator for the "name" field. It is called by the builders before save.
 	user.NameValidator = userDescName.Validators[0].(func(string) error)
 	// userDescBio is the schema descriptor for bio field.
-	userDescBio := userFields[4].Descriptor()
+	userDescBio := userFields[5].Descriptor()
 	// user.DefaultBio holds the default value on creation for the bio field.
 	user.DefaultBio = userDescBio.Default.(string)
 	// userDescCreatedAt is the schema descriptor for created_at field.
-	userDescCreatedAt := userFields[6].Descriptor()
+	userDescCreatedAt := userFields[7].Descriptor()
 	// user.DefaultCreatedAt holds the default value on creation for the created_at field.
 	user.DefaultCreatedAt = userDescCreatedAt.Default.(func() time.Time)
+	// userDescIsPrivate is the schema descriptor for is_private field.
+	userDescIsPrivate := userFields[13].Descriptor()
+	// user.DefaultIsPrivate holds the default value on creation for the is_private field.
+	user.DefaultIsPrivate = userDescIsPrivate.Default.(bool)
 	// userDescID is the schema descriptor for id field.
 	userDescID := userFields[0].Descriptor()
 	// user.DefaultID holds the default value on creation for the id field.
@@ -0,0 +1,38 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// Block holds the schema definition for the Block entity.
+type Block struct {
+	ent.Schema
+}
+
+// Fields of the Block.
+func (Block) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New).Unique(),
+		field.Time("created_at").Default(time.Now),
+	}
+}
+
+// Edges of the Block.
+func (Block) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("from", User.Type).Ref("blocking").Unique().Required(),
+		edge.From("to", User.Type).Ref("blocked_by").Unique().Required(),
+	}
+}
+
+func (Block) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Edges("from", "to").Unique(),
+	}
+}
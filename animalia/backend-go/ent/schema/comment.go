@@ -20,6 +20,9 @@ func (Comment) Fields() []ent.Field {
 		field.UUID("id", uuid.UUID{}).Default(uuid.New).Unique(),
 		field.String("content").NotEmpty(),
 		field.Time("created_at").Default(time.Now),
+		field.Time("deleted_at").Optional(),
+		// hidden_at は通報によって非表示にした日時。レビューで却下されると解除する
+		field.Time("hidden_at").Optional(),
 	}
 }
 
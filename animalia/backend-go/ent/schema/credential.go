@@ -0,0 +1,42 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// Credential holds the schema definition for the Credential entity.
+// AUTH_PROVIDER=password のときにパスワードとメール認証の状態を保持する
+type Credential struct {
+	ent.Schema
+}
+
+// Fields of the Credential.
+func (Credential) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New).Unique(),
+		field.String("email").NotEmpty().Unique(),
+		field.String("password_hash").NotEmpty().Sensitive(),
+		field.Bool("email_verified").Default(false),
+		field.String("verification_code_hash").Optional().Sensitive(),
+		field.Time("verification_expires_at").Optional().Nillable(),
+		field.Int("verification_attempts").Default(0),
+		// メールアドレス変更の確認待ちのアドレス。確認コードは verification_* を使う
+		field.String("pending_email").Optional(),
+		field.String("reset_code_hash").Optional().Sensitive(),
+		field.Time("reset_expires_at").Optional().Nillable(),
+		field.Int("reset_attempts").Default(0),
+		// サインアウトでインクリメントし、発行済みのリフレッシュトークンを無効にする
+		field.Int("token_version").Default(0),
+		field.Time("created_at").Default(time.Now),
+		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the Credential.
+func (Credential) Edges() []ent.Edge {
+	return nil
+}
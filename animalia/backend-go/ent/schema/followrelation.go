@@ -20,6 +20,8 @@ func (FollowRelation) Fields() []ent.Field {
 	return []ent.Field{
 		field.UUID("id", uuid.UUID{}).Default(uuid.New).Unique(),
 		field.Time("created_at").Default(time.Now),
+		// 非公開アカウントへのフォローは承認されるまで pending になる
+		field.Enum("status").Values("pending", "approved").Default("approved"),
 	}
 }
 
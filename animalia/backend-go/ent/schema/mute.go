@@ -0,0 +1,38 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// Mute holds the schema definition for the Mute entity.
+type Mute struct {
+	ent.Schema
+}
+
+// Fields of the Mute.
+func (Mute) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New).Unique(),
+		field.Time("created_at").Default(time.Now),
+	}
+}
+
+// Edges of the Mute.
+func (Mute) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("from", User.Type).Ref("muting").Unique().Required(),
+		edge.From("to", User.Type).Ref("muted_by").Unique().Required(),
+	}
+}
+
+func (Mute) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Edges("from", "to").Unique(),
+	}
+}
@@ -25,6 +25,12 @@ func (Post) Fields() []ent.Field {
 		field.String("image_key").NotEmpty(),
 		field.Time("created_at").Default(time.Now),
 		field.Time("deleted_at").Optional(),
+		// hidden_at は通報によって非表示にした日時。レビューで却下されると解除する
+		field.Time("hidden_at").Optional(),
+		// edited_at はキャプションを最後に編集した日時。編集されていなければ未設定
+		field.Time("edited_at").Optional(),
+		// visibility は投稿を見られる範囲。followers は本人と承認済みのフォロワー、only_me は本人だけに見せる
+		field.Enum("visibility").Values("public", "followers", "only_me").Default("public"),
 
 		field.Other("image_feature", pgvector.Vector{}).
 			SchemaType(map[string]string{
@@ -40,5 +46,7 @@ func (Post) Edges() []ent.Edge {
 		edge.To("comments", Comment.Type),
 		edge.To("likes", Like.Type),
 		edge.To("daily_task", DailyTask.Type).Unique(),
+		edge.To("media", PostMedia.Type),
+		edge.To("revisions", PostRevision.Type),
 	}
 }
@@ -0,0 +1,43 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// PostMedia holds the schema definition for the PostMedia entity.
+type PostMedia struct {
+	ent.Schema
+}
+
+// Fields of the PostMedia.
+func (PostMedia) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New).Unique(),
+		field.Int("position").NonNegative(),
+		field.Enum("type").Values("image", "video").Default("image"),
+		// 動画の場合は動画ファイルのキー
+		field.String("image_key").NotEmpty(),
+		// 動画のポスターフレーム（画像と同じく thumb/medium/full のバリアントを持つ）
+		field.String("poster_key").Optional(),
+		field.Time("created_at").Default(time.Now),
+	}
+}
+
+// Edges of the PostMedia.
+func (PostMedia) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("post", Post.Type).Ref("media").Unique().Required(),
+	}
+}
+
+func (PostMedia) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("position").Edges("post").Unique(),
+	}
+}
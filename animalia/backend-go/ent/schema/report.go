@@ -0,0 +1,44 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// Report holds the schema definition for the Report entity.
+type Report struct {
+	ent.Schema
+}
+
+// Fields of the Report.
+func (Report) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New).Unique(),
+		field.Enum("target_type").Values("post", "comment", "user"),
+		field.UUID("target_id", uuid.UUID{}),
+		field.Enum("reason").Values("spam", "harassment", "inappropriate", "other"),
+		field.String("detail").Default(""),
+		field.Enum("status").Values("open", "dismissed", "actioned").Default("open"),
+		field.Time("created_at").Default(time.Now),
+		field.Time("reviewed_at").Optional(),
+	}
+}
+
+// Edges of the Report.
+func (Report) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("reporter", User.Type).Ref("reports").Unique().Required(),
+	}
+}
+
+func (Report) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("target_type", "target_id"),
+		index.Fields("status"),
+	}
+}
@@ -0,0 +1,35 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// StorageDeletion holds the schema definition for the StorageDeletion entity.
+// 退会したユーザーの画像・動画のキーを、猶予期間の経過後に削除するためのキュー
+type StorageDeletion struct {
+	ent.Schema
+}
+
+// Fields of the StorageDeletion.
+func (StorageDeletion) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New).Unique(),
+		field.String("key").NotEmpty(),
+		// 退会したユーザー。アカウントを復元するとこのユーザーのキーはキューから外す
+		field.UUID("user_id", uuid.UUID{}),
+		field.Time("delete_after"),
+		field.Time("created_at").Default(time.Now),
+	}
+}
+
+func (StorageDeletion) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id"),
+		index.Fields("delete_after"),
+	}
+}
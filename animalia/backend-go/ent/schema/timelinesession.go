@@ -0,0 +1,35 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// TimelineSession holds the schema definition for the TimelineSession entity.
+// 推薦 API が返した投稿の並びを保存し、インスタンスをまたいでタイムラインの続きを返せるようにする
+type TimelineSession struct {
+	ent.Schema
+}
+
+// Fields of the TimelineSession.
+func (TimelineSession) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New).Unique(),
+		field.UUID("user_id", uuid.UUID{}).Immutable(),
+		// 推薦順の投稿 ID
+		field.JSON("post_ids", []uuid.UUID{}).Immutable(),
+		field.Time("expires_at").Immutable(),
+		field.Time("created_at").Default(time.Now).Immutable(),
+	}
+}
+
+func (TimelineSession) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id"),
+		index.Fields("expires_at"),
+	}
+}
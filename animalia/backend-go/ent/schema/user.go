@@ -20,10 +20,22 @@ func (User) Fields() []ent.Field {
 		field.UUID("id", uuid.UUID{}).Default(uuid.New).Unique(),
 		field.Int("index").Immutable().NonNegative().Unique().Optional(),
 		field.String("email").NotEmpty().Unique(),
+		// 認証プロバイダのユーザー ID (sub)。メールアドレスを変更しても変わらないため、トークンとユーザーの対応付けに使う
+		field.String("auth_subject").Optional().Nillable().Unique(),
 		field.String("name").NotEmpty(),
 		field.String("bio").Default(""),
 		field.String("icon_image_key").Optional(),
 		field.Time("created_at").Default(time.Now),
+		// 退会した日時。猶予期間中は復元でき、投稿・ペット・コメントも同じ日時で論理削除される
+		field.Time("deleted_at").Optional(),
+		// 猶予期間が過ぎてデータを削除し、匿名化した日時
+		field.Time("purged_at").Optional(),
+		field.Enum("role").Values("user", "moderator", "admin").Default("user"),
+		// 管理者によるアカウント停止。停止中は API を利用できない
+		field.Time("suspended_at").Optional(),
+		field.String("suspension_reason").Optional(),
+		// 非公開アカウントは承認したフォロワーにだけ投稿やフォロー一覧を見せる
+		field.Bool("is_private").Default(false),
 	}
 }
 
@@ -36,6 +48,13 @@ func (User) Edges() []ent.Edge {
 		edge.To("pets", Pet.Type),
 		edge.To("following", FollowRelation.Type),
 		edge.To("followers", FollowRelation.Type),
+		edge.To("blocking", Block.Type),
+		edge.To("blocked_by", Block.Type),
+		edge.To("muting", Mute.Type),
+		edge.To("muted_by", Mute.Type),
 		edge.To("daily_tasks", DailyTask.Type),
+		edge.To("reports", Report.Type),
+		edge.To("audit_logs", AuditLog.Type),
+		edge.To("post_revisions", PostRevision.Type),
 	}
 }
@@ -0,0 +1,140 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/aki-13627/animalia/backend-go/ent/storagedeletion"
+	"github.com/google/uuid"
+)
+
+// StorageDeletion is the model entity for the StorageDeletion schema.
+type StorageDeletion struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// Key holds the value of the "key" field.
+	Key string `json:"key,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID uuid.UUID `json:"user_id,omitempty"`
+	// DeleteAfter holds the value of the "delete_after" field.
+	DeleteAfter time.Time `json:"delete_after,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt    time.Time `json:"created_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*StorageDeletion) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case storagedeletion.FieldKey:
+			values[i] = new(sql.NullString)
+		case storagedeletion.FieldDeleteAfter, storagedeletion.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case storagedeletion.FieldID, storagedeletion.FieldUserID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the StorageDeletion fields.
+func (sd *StorageDeletion) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case storagedeletion.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				sd.ID = *value
+			}
+		case storagedeletion.FieldKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field key", values[i])
+			} else if value.Valid {
+				sd.Key = value.String
+			}
+		case storagedeletion.FieldUserID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value != nil {
+				sd.UserID = *value
+			}
+		case storagedeletion.FieldDeleteAfter:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field delete_after", values[i])
+			} else if value.Valid {
+				sd.DeleteAfter = value.Time
+			}
+		case storagedeletion.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				sd.CreatedAt = value.Time
+			}
+		default:
+			sd.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the StorageDeletion.
+// This includes values selected through modifiers, order, etc.
+func (sd *StorageDeletion) Value(name string) (ent.Value, error) {
+	return sd.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this StorageDeletion.
+// Note that you need to call StorageDeletion.Unwrap() before calling this method if this StorageDeletion
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (sd *StorageDeletion) Update() *StorageDeletionUpdateOne {
+	return NewStorageDeletionClient(sd.config).UpdateOne(sd)
+}
+
+// Unwrap unwraps the StorageDeletion entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (sd *StorageDeletion) Unwrap() *StorageDeletion {
+	_tx, ok := sd.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: StorageDeletion is not a transactional entity")
+	}
+	sd.config.driver = _tx.drv
+	return sd
+}
+
+// String implements the fmt.Stringer.
+func (sd *StorageDeletion) String() string {
+	var builder strings.Builder
+	builder.WriteString("StorageDeletion(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", sd.ID))
+	builder.WriteString("key=")
+	builder.WriteString(sd.Key)
+	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", sd.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("delete_after=")
+	builder.WriteString(sd.DeleteAfter.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(sd.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// StorageDeletions is a parsable slice of StorageDeletion.
+type StorageDeletions []*StorageDeletion
@@ -0,0 +1,276 @@
+// Code generated by ent, DO NOT EDIT.
+
+package storagedeletion
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/aki-13627/animalia/backend-go/ent/predicate"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.StorageDeletion {
+	return predicate.StorageDeletion(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.StorageDeletion {
+	return predicate.StorageDeletion(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.StorageDeletion {
+	return predicate.StorageDeletion(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.StorageDeletion {
+	return predicate.StorageDeletion(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.StorageDeletion {
+	return predicate.StorageDeletion(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.StorageDeletion {
+	return predicate.StorageDeletion(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.StorageDeletion {
+	return predicate.StorageDeletion(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.StorageDeletion {
+	return predicate.StorageDeletion(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.StorageDeletion {
+	return predicate.StorageDeletion(sql.FieldLTE(FieldID, id))
+}
+
+// Key applies equality check predicate on the "key" field. It's identical to KeyEQ.
+func Key(v string) predicate.StorageDeletion {
+	return predicate.StorageDeletion(sql.FieldEQ(FieldKey, v))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v uuid.UUID) predicate.StorageDeletion {
+	return predicate.StorageDeletion(sql.FieldEQ(FieldUserID, v))
+}
+
+// DeleteAfter applies equality check predicate on the "delete_after" field. It's identical to DeleteAfterEQ.
+func DeleteAfter(v time.Time) predicate.StorageDeletion {
+	return predicate.StorageDeletion(sql.FieldEQ(FieldDeleteAfter, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.StorageDeletion {
+	return predicate.StorageDeletion(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// KeyEQ applies the EQ predicate on the "key" field.
+func KeyEQ(v string) predicate.StorageDeletion {
+	return predicate.StorageDeletion(sql.FieldEQ(FieldKey, v))
+}
+
+// KeyNEQ applies the NEQ predicate on the "key" field.
+func KeyNEQ(v string) predicate.StorageDeletion {
+	return predicate.StorageDeletion(sql.FieldNEQ(FieldKey, v))
+}
+
+// KeyIn applies the In predicate on the "key" field.
+func KeyIn(vs ...string) predicate.StorageDeletion {
+	return predicate.StorageDeletion(sql.FieldIn(FieldKey, vs...))
+}
+
+// KeyNotIn applies the NotIn predicate on the "key" field.
+func KeyNotIn(vs ...string) predicate.StorageDeletion {
+	return predicate.StorageDeletion(sql.FieldNotIn(FieldKey, vs...))
+}
+
+// KeyGT applies the GT predicate on the "key" field.
+func KeyGT(v string) predicate.StorageDeletion {
+	return predicate.StorageDeletion(sql.FieldGT(FieldKey, v))
+}
+
+// KeyGTE applies the GTE predicate on the "key" field.
+func KeyGTE(v string) predicate.StorageDeletion {
+	return predicate.StorageDeletion(sql.FieldGTE(FieldKey, v))
+}
+
+// KeyLT applies the LT predicate on the "key" field.
+func KeyLT(v string) predicate.StorageDeletion {
+	return predicate.StorageDeletion(sql.FieldLT(FieldKey, v))
+}
+
+// KeyLTE applies the LTE predicate on the "key" field.
+func KeyLTE(v string) predicate.StorageDeletion {
+	return predicate.StorageDeletion(sql.FieldLTE(FieldKey, v))
+}
+
+// KeyContains applies the Contains predicate on the "key" field.
+func KeyContains(v string) predicate.StorageDeletion {
+	return predicate.StorageDeletion(sql.FieldContains(FieldKey, v))
+}
+
+// KeyHasPrefix applies the HasPrefix predicate on the "key" field.
+func KeyHasPrefix(v string) predicate.StorageDeletion {
+	return predicate.StorageDeletion(sql.FieldHasPrefix(FieldKey, v))
+}
+
+// KeyHasSuffix applies the HasSuffix predicate on the "key" field.
+func KeyHasSuffix(v string) predicate.StorageDeletion {
+	return predicate.StorageDeletion(sql.FieldHasSuffix(FieldKey, v))
+}
+
+// KeyEqualFold applies the EqualFold predicate on the "key" field.
+func KeyEqualFold(v string) predicate.StorageDeletion {
+	return predicate.StorageDeletion(sql.FieldEqualFold(FieldKey, v))
+}
+
+// KeyContainsFold applies the ContainsFold predicate on the "key" field.
+func KeyContainsFold(v string) predicate.StorageDeletion {
+	return predicate.StorageDeletion(sql.FieldContainsFold(FieldKey, v))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v uuid.UUID) predicate.StorageDeletion {
+	return predicate.StorageDeletion(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v uuid.UUID) predicate.StorageDeletion {
+	return predicate.StorageDeletion(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...uuid.UUID) predicate.StorageDeletion {
+	return predicate.StorageDeletion(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...uuid.UUID) predicate.StorageDeletion {
+	return predicate.StorageDeletion(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// UserIDGT applies the GT predicate on the "user_id" field.
+func UserIDGT(v uuid.UUID) predicate.StorageDeletion {
+	return predicate.StorageDeletion(sql.FieldGT(FieldUserID, v))
+}
+
+// UserIDGTE applies the GTE predicate on the "user_id" field.
+func UserIDGTE(v uuid.UUID) predicate.StorageDeletion {
+	return predicate.StorageDeletion(sql.FieldGTE(FieldUserID, v))
+}
+
+// UserIDLT applies the LT predicate on the "user_id" field.
+func UserIDLT(v uuid.UUID) predicate.StorageDeletion {
+	return predicate.StorageDeletion(sql.FieldLT(FieldUserID, v))
+}
+
+// UserIDLTE applies the LTE predicate on the "user_id" field.
+func UserIDLTE(v uuid.UUID) predicate.StorageDeletion {
+	return predicate.StorageDeletion(sql.FieldLTE(FieldUserID, v))
+}
+
+// DeleteAfterEQ applies the EQ predicate on the "delete_after" field.
+func DeleteAfterEQ(v time.Time) predicate.StorageDeletion {
+	return predicate.StorageDeletion(sql.FieldEQ(FieldDeleteAfter, v))
+}
+
+// DeleteAfterNEQ applies the NEQ predicate on the "delete_after" field.
+func DeleteAfterNEQ(v time.Time) predicate.StorageDeletion {
+	return predicate.StorageDeletion(sql.FieldNEQ(FieldDeleteAfter, v))
+}
+
+// DeleteAfterIn applies the In predicate on the "delete_after" field.
+func DeleteAfterIn(vs ...time.Time) predicate.StorageDeletion {
+	return predicate.StorageDeletion(sql.FieldIn(FieldDeleteAfter, vs...))
+}
+
+// DeleteAfterNotIn applies the NotIn predicate on the "delete_after" field.
+func DeleteAfterNotIn(vs ...time.Time) predicate.StorageDeletion {
+	return predicate.StorageDeletion(sql.FieldNotIn(FieldDeleteAfter, vs...))
+}
+
+// DeleteAfterGT applies the GT predicate on the "delete_after" field.
+func DeleteAfterGT(v time.Time) predicate.StorageDeletion {
+	return predicate.StorageDeletion(sql.FieldGT(FieldDeleteAfter, v))
+}
+
+// DeleteAfterGTE applies the GTE predicate on the "delete_after" field.
+func DeleteAfterGTE(v time.Time) predicate.StorageDeletion {
+	return predicate.StorageDeletion(sql.FieldGTE(FieldDeleteAfter, v))
+}
+
+// DeleteAfterLT applies the LT predicate on the "delete_after" field.
+func DeleteAfterLT(v time.Time) predicate.StorageDeletion {
+	return predicate.StorageDeletion(sql.FieldLT(FieldDeleteAfter, v))
+}
+
+// DeleteAfterLTE applies the LTE predicate on the "delete_after" field.
+func DeleteAfterLTE(v time.Time) predicate.StorageDeletion {
+	return predicate.StorageDeletion(sql.FieldLTE(FieldDeleteAfter, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.StorageDeletion {
+	return predicate.StorageDeletion(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.StorageDeletion {
+	return predicate.StorageDeletion(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.StorageDeletion {
+	return predicate.StorageDeletion(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.StorageDeletion {
+	return predicate.StorageDeletion(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.StorageDeletion {
+	return predicate.StorageDeletion(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.StorageDeletion {
+	return predicate.StorageDeletion(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.StorageDeletion {
+	return predicate.StorageDeletion(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.StorageDeletion {
+	return predicate.StorageDeletion(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.StorageDeletion) predicate.StorageDeletion {
+	return predicate.StorageDeletion(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.StorageDeletion) predicate.StorageDeletion {
+	return predicate.StorageDeletion(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.StorageDeletion) predicate.StorageDeletion {
+	return predicate.StorageDeletion(sql.NotPredicates(p))
+}
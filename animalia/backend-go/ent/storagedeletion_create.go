@@ -0,0 +1,685 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/aki-13627/animalia/backend-go/ent/storagedeletion"
+	"github.com/google/uuid"
+)
+
+// StorageDeletionCreate is the builder for creating a StorageDeletion entity.
+type StorageDeletionCreate struct {
+	config
+	mutation *StorageDeletionMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetKey sets the "key" field.
+func (sdc *StorageDeletionCreate) SetKey(s string) *StorageDeletionCreate {
+	sdc.mutation.SetKey(s)
+	return sdc
+}
+
+// SetUserID sets the "user_id" field.
+func (sdc *StorageDeletionCreate) SetUserID(u uuid.UUID) *StorageDeletionCreate {
+	sdc.mutation.SetUserID(u)
+	return sdc
+}
+
+// SetDeleteAfter sets the "delete_after" field.
+func (sdc *StorageDeletionCreate) SetDeleteAfter(t time.Time) *StorageDeletionCreate {
+	sdc.mutation.SetDeleteAfter(t)
+	return sdc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (sdc *StorageDeletionCreate) SetCreatedAt(t time.Time) *StorageDeletionCreate {
+	sdc.mutation.SetCreatedAt(t)
+	return sdc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (sdc *StorageDeletionCreate) SetNillableCreatedAt(t *time.Time) *StorageDeletionCreate {
+	if t != nil {
+		sdc.SetCreatedAt(*t)
+	}
+	return sdc
+}
+
+// SetID sets the "id" field.
+func (sdc *StorageDeletionCreate) SetID(u uuid.UUID) *StorageDeletionCreate {
+	sdc.mutation.SetID(u)
+	return sdc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (sdc *StorageDeletionCreate) SetNillableID(u *uuid.UUID) *StorageDeletionCreate {
+	if u != nil {
+		sdc.SetID(*u)
+	}
+	return sdc
+}
+
+// Mutation returns the StorageDeletionMutation object of the builder.
+func (sdc *StorageDeletionCreate) Mutation() *StorageDeletionMutation {
+	return sdc.mutation
+}
+
+// Save creates the StorageDeletion in the database.
+func (sdc *StorageDeletionCreate) Save(ctx context.Context) (*StorageDeletion, error) {
+	sdc.defaults()
+	return withHooks(ctx, sdc.sqlSave, sdc.mutation, sdc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (sdc *StorageDeletionCreate) SaveX(ctx context.Context) *StorageDeletion {
+	v, err := sdc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (sdc *StorageDeletionCreate) Exec(ctx context.Context) error {
+	_, err := sdc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sdc *StorageDeletionCreate) ExecX(ctx context.Context) {
+	if err := sdc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (sdc *StorageDeletionCreate) defaults() {
+	if _, ok := sdc.mutation.CreatedAt(); !ok {
+		v := storagedeletion.DefaultCreatedAt()
+		sdc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := sdc.mutation.ID(); !ok {
+		v := storagedeletion.DefaultID()
+		sdc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (sdc *StorageDeletionCreate) check() error {
+	if _, ok := sdc.mutation.Key(); !ok {
+		return &ValidationError{Name: "key", err: errors.New(`ent: missing required field "StorageDeletion.key"`)}
+	}
+	if v, ok := sdc.mutation.Key(); ok {
+		if err := storagedeletion.KeyValidator(v); err != nil {
+			return &ValidationError{Name: "key", err: fmt.Errorf(`ent: validator failed for field "StorageDeletion.key": %w`, err)}
+		}
+	}
+	if _, ok := sdc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "StorageDeletion.user_id"`)}
+	}
+	if _, ok := sdc.mutation.DeleteAfter(); !ok {
+		return &ValidationError{Name: "delete_after", err: errors.New(`ent: missing required field "StorageDeletion.delete_after"`)}
+	}
+	if _, ok := sdc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "StorageDeletion.created_at"`)}
+	}
+	return nil
+}
+
+func (sdc *StorageDeletionCreate) sqlSave(ctx context.Context) (*StorageDeletion, error) {
+	if err := sdc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := sdc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, sdc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	sdc.mutation.id = &_node.ID
+	sdc.mutation.done = true
+	return _node, nil
+}
+
+func (sdc *StorageDeletionCreate) createSpec() (*StorageDeletion, *sqlgraph.CreateSpec) {
+	var (
+		_node = &StorageDeletion{config: sdc.config}
+		_spec = sqlgraph.NewCreateSpec(storagedeletion.Table, sqlgraph.NewFieldSpec(storagedeletion.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = sdc.conflict
+	if id, ok := sdc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := sdc.mutation.Key(); ok {
+		_spec.SetField(storagedeletion.FieldKey, field.TypeString, value)
+		_node.Key = value
+	}
+	if value, ok := sdc.mutation.UserID(); ok {
+		_spec.SetField(storagedeletion.FieldUserID, field.TypeUUID, value)
+		_node.UserID = value
+	}
+	if value, ok := sdc.mutation.DeleteAfter(); ok {
+		_spec.SetField(storagedeletion.FieldDeleteAfter, field.TypeTime, value)
+		_node.DeleteAfter = value
+	}
+	if value, ok := sdc.mutation.CreatedAt(); ok {
+		_spec.SetField(storagedeletion.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.StorageDeletion.Create().
+//		SetKey(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.StorageDeletionUpsert) {
+//			SetKey(v+v).
+//		}).
+//		Exec(ctx)
+func (sdc *StorageDeletionCreate) OnConflict(opts ...sql.ConflictOption) *StorageDeletionUpsertOne {
+	sdc.conflict = opts
+	return &StorageDeletionUpsertOne{
+		create: sdc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.StorageDeletion.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (sdc *StorageDeletionCreate) OnConflictColumns(columns ...string) *StorageDeletionUpsertOne {
+	sdc.conflict = append(sdc.conflict, sql.ConflictColumns(columns...))
+	return &StorageDeletionUpsertOne{
+		create: sdc,
+	}
+}
+
+type (
+	// StorageDeletionUpsertOne is the builder for "upsert"-ing
+	//  one StorageDeletion node.
+	StorageDeletionUpsertOne struct {
+		create *StorageDeletionCreate
+	}
+
+	// StorageDeletionUpsert is the "OnConflict" setter.
+	StorageDeletionUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetKey sets the "key" field.
+func (u *StorageDeletionUpsert) SetKey(v string) *StorageDeletionUpsert {
+	u.Set(storagedeletion.FieldKey, v)
+	return u
+}
+
+// UpdateKey sets the "key" field to the value that was provided on create.
+func (u *StorageDeletionUpsert) UpdateKey() *StorageDeletionUpsert {
+	u.SetExcluded(storagedeletion.FieldKey)
+	return u
+}
+
+// SetUserID sets the "user_id" field.
+func (u *StorageDeletionUpsert) SetUserID(v uuid.UUID) *StorageDeletionUpsert {
+	u.Set(storagedeletion.FieldUserID, v)
+	return u
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *StorageDeletionUpsert) UpdateUserID() *StorageDeletionUpsert {
+	u.SetExcluded(storagedeletion.FieldUserID)
+	return u
+}
+
+// SetDeleteAfter sets the "delete_after" field.
+func (u *StorageDeletionUpsert) SetDeleteAfter(v time.Time) *StorageDeletionUpsert {
+	u.Set(storagedeletion.FieldDeleteAfter, v)
+	return u
+}
+
+// UpdateDeleteAfter sets the "delete_after" field to the value that was provided on create.
+func (u *StorageDeletionUpsert) UpdateDeleteAfter() *StorageDeletionUpsert {
+	u.SetExcluded(storagedeletion.FieldDeleteAfter)
+	return u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *StorageDeletionUpsert) SetCreatedAt(v time.Time) *StorageDeletionUpsert {
+	u.Set(storagedeletion.FieldCreatedAt, v)
+	return u
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *StorageDeletionUpsert) UpdateCreatedAt() *StorageDeletionUpsert {
+	u.SetExcluded(storagedeletion.FieldCreatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.StorageDeletion.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(storagedeletion.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *StorageDeletionUpsertOne) UpdateNewValues() *StorageDeletionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(storagedeletion.FieldID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.StorageDeletion.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *StorageDeletionUpsertOne) Ignore() *StorageDeletionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *StorageDeletionUpsertOne) DoNothing() *StorageDeletionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the StorageDeletionCreate.OnConflict
+// documentation for more info.
+func (u *StorageDeletionUpsertOne) Update(set func(*StorageDeletionUpsert)) *StorageDeletionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&StorageDeletionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetKey sets the "key" field.
+func (u *StorageDeletionUpsertOne) SetKey(v string) *StorageDeletionUpsertOne {
+	return u.Update(func(s *StorageDeletionUpsert) {
+		s.SetKey(v)
+	})
+}
+
+// UpdateKey sets the "key" field to the value that was provided on create.
+func (u *StorageDeletionUpsertOne) UpdateKey() *StorageDeletionUpsertOne {
+	return u.Update(func(s *StorageDeletionUpsert) {
+		s.UpdateKey()
+	})
+}
+
+// SetUserID sets the "user_id" field.
+func (u *StorageDeletionUpsertOne) SetUserID(v uuid.UUID) *StorageDeletionUpsertOne {
+	return u.Update(func(s *StorageDeletionUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *StorageDeletionUpsertOne) UpdateUserID() *StorageDeletionUpsertOne {
+	return u.Update(func(s *StorageDeletionUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetDeleteAfter sets the "delete_after" field.
+func (u *StorageDeletionUpsertOne) SetDeleteAfter(v time.Time) *StorageDeletionUpsertOne {
+	return u.Update(func(s *StorageDeletionUpsert) {
+		s.SetDeleteAfter(v)
+	})
+}
+
+// UpdateDeleteAfter sets the "delete_after" field to the value that was provided on create.
+func (u *StorageDeletionUpsertOne) UpdateDeleteAfter() *StorageDeletionUpsertOne {
+	return u.Update(func(s *StorageDeletionUpsert) {
+		s.UpdateDeleteAfter()
+	})
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *StorageDeletionUpsertOne) SetCreatedAt(v time.Time) *StorageDeletionUpsertOne {
+	return u.Update(func(s *StorageDeletionUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *StorageDeletionUpsertOne) UpdateCreatedAt() *StorageDeletionUpsertOne {
+	return u.Update(func(s *StorageDeletionUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *StorageDeletionUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for StorageDeletionCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *StorageDeletionUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *StorageDeletionUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: StorageDeletionUpsertOne.ID is not supported by MySQL driver. Use StorageDeletionUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *StorageDeletionUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// StorageDeletionCreateBulk is the builder for creating many StorageDeletion entities in bulk.
+type StorageDeletionCreateBulk struct {
+	config
+	err      error
+	builders []*StorageDeletionCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the StorageDeletion entities in the database.
+func (sdcb *StorageDeletionCreateBulk) Save(ctx context.Context) ([]*StorageDeletion, error) {
+	if sdcb.err != nil {
+		return nil, sdcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(sdcb.builders))
+	nodes := make([]*StorageDeletion, len(sdcb.builders))
+	mutators := make([]Mutator, len(sdcb.builders))
+	for i := range sdcb.builders {
+		func(i int, root context.Context) {
+			builder := sdcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*StorageDeletionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, sdcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = sdcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, sdcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, sdcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (sdcb *StorageDeletionCreateBulk) SaveX(ctx context.Context) []*StorageDeletion {
+	v, err := sdcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (sdcb *StorageDeletionCreateBulk) Exec(ctx context.Context) error {
+	_, err := sdcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sdcb *StorageDeletionCreateBulk) ExecX(ctx context.Context) {
+	if err := sdcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.StorageDeletion.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.StorageDeletionUpsert) {
+//			SetKey(v+v).
+//		}).
+//		Exec(ctx)
+func (sdcb *StorageDeletionCreateBulk) OnConflict(opts ...sql.ConflictOption) *StorageDeletionUpsertBulk {
+	sdcb.conflict = opts
+	return &StorageDeletionUpsertBulk{
+		create: sdcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.StorageDeletion.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (sdcb *StorageDeletionCreateBulk) OnConflictColumns(columns ...string) *StorageDeletionUpsertBulk {
+	sdcb.conflict = append(sdcb.conflict, sql.ConflictColumns(columns...))
+	return &StorageDeletionUpsertBulk{
+		create: sdcb,
+	}
+}
+
+// StorageDeletionUpsertBulk is the builder for "upsert"-ing
+// a bulk of StorageDeletion nodes.
+type StorageDeletionUpsertBulk struct {
+	create *StorageDeletionCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.StorageDeletion.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(storagedeletion.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *StorageDeletionUpsertBulk) UpdateNewValues() *StorageDeletionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(storagedeletion.FieldID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.StorageDeletion.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *StorageDeletionUpsertBulk) Ignore() *StorageDeletionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *StorageDeletionUpsertBulk) DoNothing() *StorageDeletionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the StorageDeletionCreateBulk.OnConflict
+// documentation for more info.
+func (u *StorageDeletionUpsertBulk) Update(set func(*StorageDeletionUpsert)) *StorageDeletionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&StorageDeletionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetKey sets the "key" field.
+func (u *StorageDeletionUpsertBulk) SetKey(v string) *StorageDeletionUpsertBulk {
+	return u.Update(func(s *StorageDeletionUpsert) {
+		s.SetKey(v)
+	})
+}
+
+// UpdateKey sets the "key" field to the value that was provided on create.
+func (u *StorageDeletionUpsertBulk) UpdateKey() *StorageDeletionUpsertBulk {
+	return u.Update(func(s *StorageDeletionUpsert) {
+		s.UpdateKey()
+	})
+}
+
+// SetUserID sets the "user_id" field.
+func (u *StorageDeletionUpsertBulk) SetUserID(v uuid.UUID) *StorageDeletionUpsertBulk {
+	return u.Update(func(s *StorageDeletionUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *StorageDeletionUpsertBulk) UpdateUserID() *StorageDeletionUpsertBulk {
+	return u.Update(func(s *StorageDeletionUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetDeleteAfter sets the "delete_after" field.
+func (u *StorageDeletionUpsertBulk) SetDeleteAfter(v time.Time) *StorageDeletionUpsertBulk {
+	return u.Update(func(s *StorageDeletionUpsert) {
+		s.SetDeleteAfter(v)
+	})
+}
+
+// UpdateDeleteAfter sets the "delete_after" field to the value that was provided on create.
+func (u *StorageDeletionUpsertBulk) UpdateDeleteAfter() *StorageDeletionUpsertBulk {
+	return u.Update(func(s *StorageDeletionUpsert) {
+		s.UpdateDeleteAfter()
+	})
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *StorageDeletionUpsertBulk) SetCreatedAt(v time.Time) *StorageDeletionUpsertBulk {
+	return u.Update(func(s *StorageDeletionUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *StorageDeletionUpsertBulk) UpdateCreatedAt() *StorageDeletionUpsertBulk {
+	return u.Update(func(s *StorageDeletionUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *StorageDeletionUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the StorageDeletionCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for StorageDeletionCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *StorageDeletionUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
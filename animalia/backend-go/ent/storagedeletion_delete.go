@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/aki-13627/animalia/backend-go/ent/predicate"
+	"github.com/aki-13627/animalia/backend-go/ent/storagedeletion"
+)
+
+// StorageDeletionDelete is the builder for deleting a StorageDeletion entity.
+type StorageDeletionDelete struct {
+	config
+	hooks    []Hook
+	mutation *StorageDeletionMutation
+}
+
+// Where appends a list predicates to the StorageDeletionDelete builder.
+func (sdd *StorageDeletionDelete) Where(ps ...predicate.StorageDeletion) *StorageDeletionDelete {
+	sdd.mutation.Where(ps...)
+	return sdd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (sdd *StorageDeletionDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, sdd.sqlExec, sdd.mutation, sdd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sdd *StorageDeletionDelete) ExecX(ctx context.Context) int {
+	n, err := sdd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (sdd *StorageDeletionDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(storagedeletion.Table, sqlgraph.NewFieldSpec(storagedeletion.FieldID, field.TypeUUID))
+	if ps := sdd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, sdd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	sdd.mutation.done = true
+	return affected, err
+}
+
+// StorageDeletionDeleteOne is the builder for deleting a single StorageDeletion entity.
+type StorageDeletionDeleteOne struct {
+	sdd *StorageDeletionDelete
+}
+
+// Where appends a list predicates to the StorageDeletionDelete builder.
+func (sddo *StorageDeletionDeleteOne) Where(ps ...predicate.StorageDeletion) *StorageDeletionDeleteOne {
+	sddo.sdd.mutation.Where(ps...)
+	return sddo
+}
+
+// Exec executes the deletion query.
+func (sddo *StorageDeletionDeleteOne) Exec(ctx context.Context) error {
+	n, err := sddo.sdd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{storagedeletion.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sddo *StorageDeletionDeleteOne) ExecX(ctx context.Context) {
+	if err := sddo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,355 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/aki-13627/animalia/backend-go/ent/predicate"
+	"github.com/aki-13627/animalia/backend-go/ent/storagedeletion"
+	"github.com/google/uuid"
+)
+
+// StorageDeletionUpdate is the builder for updating StorageDeletion entities.
+type StorageDeletionUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *StorageDeletionMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the StorageDeletionUpdate builder.
+func (sdu *StorageDeletionUpdate) Where(ps ...predicate.StorageDeletion) *StorageDeletionUpdate {
+	sdu.mutation.Where(ps...)
+	return sdu
+}
+
+// SetKey sets the "key" field.
+func (sdu *StorageDeletionUpdate) SetKey(s string) *StorageDeletionUpdate {
+	sdu.mutation.SetKey(s)
+	return sdu
+}
+
+// SetNillableKey sets the "key" field if the given value is not nil.
+func (sdu *StorageDeletionUpdate) SetNillableKey(s *string) *StorageDeletionUpdate {
+	if s != nil {
+		sdu.SetKey(*s)
+	}
+	return sdu
+}
+
+// SetUserID sets the "user_id" field.
+func (sdu *StorageDeletionUpdate) SetUserID(u uuid.UUID) *StorageDeletionUpdate {
+	sdu.mutation.SetUserID(u)
+	return sdu
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (sdu *StorageDeletionUpdate) SetNillableUserID(u *uuid.UUID) *StorageDeletionUpdate {
+	if u != nil {
+		sdu.SetUserID(*u)
+	}
+	return sdu
+}
+
+// SetDeleteAfter sets the "delete_after" field.
+func (sdu *StorageDeletionUpdate) SetDeleteAfter(t time.Time) *StorageDeletionUpdate {
+	sdu.mutation.SetDeleteAfter(t)
+	return sdu
+}
+
+// SetNillableDeleteAfter sets the "delete_after" field if the given value is not nil.
+func (sdu *StorageDeletionUpdate) SetNillableDeleteAfter(t *time.Time) *StorageDeletionUpdate {
+	if t != nil {
+		sdu.SetDeleteAfter(*t)
+	}
+	return sdu
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (sdu *StorageDeletionUpdate) SetCreatedAt(t time.Time) *StorageDeletionUpdate {
+	sdu.mutation.SetCreatedAt(t)
+	return sdu
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (sdu *StorageDeletionUpdate) SetNillableCreatedAt(t *time.Time) *StorageDeletionUpdate {
+	if t != nil {
+		sdu.SetCreatedAt(*t)
+	}
+	return sdu
+}
+
+// Mutation returns the StorageDeletionMutation object of the builder.
+func (sdu *StorageDeletionUpdate) Mutation() *StorageDeletionMutation {
+	return sdu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (sdu *StorageDeletionUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, sdu.sqlSave, sdu.mutation, sdu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (sdu *StorageDeletionUpdate) SaveX(ctx context.Context) int {
+	affected, err := sdu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (sdu *StorageDeletionUpdate) Exec(ctx context.Context) error {
+	_, err := sdu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sdu *StorageDeletionUpdate) ExecX(ctx context.Context) {
+	if err := sdu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (sdu *StorageDeletionUpdate) check() error {
+	if v, ok := sdu.mutation.Key(); ok {
+		if err := storagedeletion.KeyValidator(v); err != nil {
+			return &ValidationError{Name: "key", err: fmt.Errorf(`ent: validator failed for field "StorageDeletion.key": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (sdu *StorageDeletionUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *StorageDeletionUpdate {
+	sdu.modifiers = append(sdu.modifiers, modifiers...)
+	return sdu
+}
+
+func (sdu *StorageDeletionUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := sdu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(storagedeletion.Table, storagedeletion.Columns, sqlgraph.NewFieldSpec(storagedeletion.FieldID, field.TypeUUID))
+	if ps := sdu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := sdu.mutation.Key(); ok {
+		_spec.SetField(storagedeletion.FieldKey, field.TypeString, value)
+	}
+	if value, ok := sdu.mutation.UserID(); ok {
+		_spec.SetField(storagedeletion.FieldUserID, field.TypeUUID, value)
+	}
+	if value, ok := sdu.mutation.DeleteAfter(); ok {
+		_spec.SetField(storagedeletion.FieldDeleteAfter, field.TypeTime, value)
+	}
+	if value, ok := sdu.mutation.CreatedAt(); ok {
+		_spec.SetField(storagedeletion.FieldCreatedAt, field.TypeTime, value)
+	}
+	_spec.AddModifiers(sdu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, sdu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{storagedeletion.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	sdu.mutation.done = true
+	return n, nil
+}
+
+// StorageDeletionUpdateOne is the builder for updating a single StorageDeletion entity.
+type StorageDeletionUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *StorageDeletionMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetKey sets the "key" field.
+func (sduo *StorageDeletionUpdateOne) SetKey(s string) *StorageDeletionUpdateOne {
+	sduo.mutation.SetKey(s)
+	return sduo
+}
+
+// SetNillableKey sets the "key" field if the given value is not nil.
+func (sduo *StorageDeletionUpdateOne) SetNillableKey(s *string) *StorageDeletionUpdateOne {
+	if s != nil {
+		sduo.SetKey(*s)
+	}
+	return sduo
+}
+
+// SetUserID sets the "user_id" field.
+func (sduo *StorageDeletionUpdateOne) SetUserID(u uuid.UUID) *StorageDeletionUpdateOne {
+	sduo.mutation.SetUserID(u)
+	return sduo
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (sduo *StorageDeletionUpdateOne) SetNillableUserID(u *uuid.UUID) *StorageDeletionUpdateOne {
+	if u != nil {
+		sduo.SetUserID(*u)
+	}
+	return sduo
+}
+
+// SetDeleteAfter sets the "delete_after" field.
+func (sduo *StorageDeletionUpdateOne) SetDeleteAfter(t time.Time) *StorageDeletionUpdateOne {
+	sduo.mutation.SetDeleteAfter(t)
+	return sduo
+}
+
+// SetNillableDeleteAfter sets the "delete_after" field if the given value is not nil.
+func (sduo *StorageDeletionUpdateOne) SetNillableDeleteAfter(t *time.Time) *StorageDeletionUpdateOne {
+	if t != nil {
+		sduo.SetDeleteAfter(*t)
+	}
+	return sduo
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (sduo *StorageDeletionUpdateOne) SetCreatedAt(t time.Time) *StorageDeletionUpdateOne {
+	sduo.mutation.SetCreatedAt(t)
+	return sduo
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (sduo *StorageDeletionUpdateOne) SetNillableCreatedAt(t *time.Time) *StorageDeletionUpdateOne {
+	if t != nil {
+		sduo.SetCreatedAt(*t)
+	}
+	return sduo
+}
+
+// Mutation returns the StorageDeletionMutation object of the builder.
+func (sduo *StorageDeletionUpdateOne) Mutation() *StorageDeletionMutation {
+	return sduo.mutation
+}
+
+// Where appends a list predicates to the StorageDeletionUpdate builder.
+func (sduo *StorageDeletionUpdateOne) Where(ps ...predicate.StorageDeletion) *StorageDeletionUpdateOne {
+	sduo.mutation.Where(ps...)
+	return sduo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (sduo *StorageDeletionUpdateOne) Select(field string, fields ...string) *StorageDeletionUpdateOne {
+	sduo.fields = append([]string{field}, fields...)
+	return sduo
+}
+
+// Save executes the query and returns the updated StorageDeletion entity.
+func (sduo *StorageDeletionUpdateOne) Save(ctx context.Context) (*StorageDeletion, error) {
+	return withHooks(ctx, sduo.sqlSave, sduo.mutation, sduo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (sduo *StorageDeletionUpdateOne) SaveX(ctx context.Context) *StorageDeletion {
+	node, err := sduo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (sduo *StorageDeletionUpdateOne) Exec(ctx context.Context) error {
+	_, err := sduo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sduo *StorageDeletionUpdateOne) ExecX(ctx context.Context) {
+	if err := sduo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (sduo *StorageDeletionUpdateOne) check() error {
+	if v, ok := sduo.mutation.Key(); ok {
+		if err := storagedeletion.KeyValidator(v); err != nil {
+			return &ValidationError{Name: "key", err: fmt.Errorf(`ent: validator failed for field "StorageDeletion.key": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (sduo *StorageDeletionUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *StorageDeletionUpdateOne {
+	sduo.modifiers = append(sduo.modifiers, modifiers...)
+	return sduo
+}
+
+func (sduo *StorageDeletionUpdateOne) sqlSave(ctx context.Context) (_node *StorageDeletion, err error) {
+	if err := sduo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(storagedeletion.Table, storagedeletion.Columns, sqlgraph.NewFieldSpec(storagedeletion.FieldID, field.TypeUUID))
+	id, ok := sduo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "StorageDeletion.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := sduo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, storagedeletion.FieldID)
+		for _, f := range fields {
+			if !storagedeletion.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != storagedeletion.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := sduo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := sduo.mutation.Key(); ok {
+		_spec.SetField(storagedeletion.FieldKey, field.TypeString, value)
+	}
+	if value, ok := sduo.mutation.UserID(); ok {
+		_spec.SetField(storagedeletion.FieldUserID, field.TypeUUID, value)
+	}
+	if value, ok := sduo.mutation.DeleteAfter(); ok {
+		_spec.SetField(storagedeletion.FieldDeleteAfter, field.TypeTime, value)
+	}
+	if value, ok := sduo.mutation.CreatedAt(); ok {
+		_spec.SetField(storagedeletion.FieldCreatedAt, field.TypeTime, value)
+	}
+	_spec.AddModifiers(sduo.modifiers...)
+	_node = &StorageDeletion{config: sduo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, sduo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{storagedeletion.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	sduo.mutation.done = true
+	return _node, nil
+}
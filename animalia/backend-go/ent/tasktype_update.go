@@ -19,8 +19,9 @@ import (
 // TaskTypeUpdate is the builder for updating TaskType entities.
 type TaskTypeUpdate struct {
 	config
-	hooks    []Hook
-	mutation *TaskTypeMutation
+	hooks     []Hook
+	mutation  *TaskTypeMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // Where appends a list predicates to the TaskTypeUpdate builder.
@@ -95,6 +96,12 @@ func (ttu *TaskTypeUpdate) ExecX(ctx context.Context) {
 	}
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (ttu *TaskTypeUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *TaskTypeUpdate {
+	ttu.modifiers = append(ttu.modifiers, modifiers...)
+	return ttu
+}
+
 func (ttu *TaskTypeUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	_spec := sqlgraph.NewUpdateSpec(tasktype.Table, tasktype.Columns, sqlgraph.NewFieldSpec(tasktype.FieldID, field.TypeInt))
 	if ps := ttu.mutation.predicates; len(ps) > 0 {
@@ -113,6 +120,7 @@ func (ttu *TaskTypeUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if ttu.mutation.TextFeatureCleared() {
 		_spec.ClearField(tasktype.FieldTextFeature, field.TypeOther)
 	}
+	_spec.AddModifiers(ttu.modifiers...)
 	if n, err = sqlgraph.UpdateNodes(ctx, ttu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{tasktype.Label}
@@ -128,9 +136,10 @@ func (ttu *TaskTypeUpdate) sqlSave(ctx context.Context) (n int, err error) {
 // TaskTypeUpdateOne is the builder for updating a single TaskType entity.
 type TaskTypeUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *TaskTypeMutation
+	fields    []string
+	hooks     []Hook
+	mutation  *TaskTypeMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // SetType sets the "type" field.
@@ -212,6 +221,12 @@ func (ttuo *TaskTypeUpdateOne) ExecX(ctx context.Context) {
 	}
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (ttuo *TaskTypeUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *TaskTypeUpdateOne {
+	ttuo.modifiers = append(ttuo.modifiers, modifiers...)
+	return ttuo
+}
+
 func (ttuo *TaskTypeUpdateOne) sqlSave(ctx context.Context) (_node *TaskType, err error) {
 	_spec := sqlgraph.NewUpdateSpec(tasktype.Table, tasktype.Columns, sqlgraph.NewFieldSpec(tasktype.FieldID, field.TypeInt))
 	id, ok := ttuo.mutation.ID()
@@ -247,6 +262,7 @@ func (ttuo *TaskTypeUpdateOne) sqlSave(ctx context.Context) (_node *TaskType, er
 	if ttuo.mutation.TextFeatureCleared() {
 		_spec.ClearField(tasktype.FieldTextFeature, field.TypeOther)
 	}
+	_spec.AddModifiers(ttuo.modifiers...)
 	_node = &TaskType{config: ttuo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
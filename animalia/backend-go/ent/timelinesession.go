@@ -0,0 +1,143 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/aki-13627/animalia/backend-go/ent/timelinesession"
+	"github.com/google/uuid"
+)
+
+// TimelineSession is the model entity for the TimelineSession schema.
+type TimelineSession struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID uuid.UUID `json:"user_id,omitempty"`
+	// PostIds holds the value of the "post_ids" field.
+	PostIds []uuid.UUID `json:"post_ids,omitempty"`
+	// ExpiresAt holds the value of the "expires_at" field.
+	ExpiresAt time.Time `json:"expires_at,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt    time.Time `json:"created_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*TimelineSession) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case timelinesession.FieldPostIds:
+			values[i] = new([]byte)
+		case timelinesession.FieldExpiresAt, timelinesession.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case timelinesession.FieldID, timelinesession.FieldUserID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the TimelineSession fields.
+func (ts *TimelineSession) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case timelinesession.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				ts.ID = *value
+			}
+		case timelinesession.FieldUserID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value != nil {
+				ts.UserID = *value
+			}
+		case timelinesession.FieldPostIds:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field post_ids", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &ts.PostIds); err != nil {
+					return fmt.Errorf("unmarshal field post_ids: %w", err)
+				}
+			}
+		case timelinesession.FieldExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires_at", values[i])
+			} else if value.Valid {
+				ts.ExpiresAt = value.Time
+			}
+		case timelinesession.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				ts.CreatedAt = value.Time
+			}
+		default:
+			ts.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the TimelineSession.
+// This includes values selected through modifiers, order, etc.
+func (ts *TimelineSession) Value(name string) (ent.Value, error) {
+	return ts.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this TimelineSession.
+// Note that you need to call TimelineSession.Unwrap() before calling this method if this TimelineSession
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ts *TimelineSession) Update() *TimelineSessionUpdateOne {
+	return NewTimelineSessionClient(ts.config).UpdateOne(ts)
+}
+
+// Unwrap unwraps the TimelineSession entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ts *TimelineSession) Unwrap() *TimelineSession {
+	_tx, ok := ts.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: TimelineSession is not a transactional entity")
+	}
+	ts.config.driver = _tx.drv
+	return ts
+}
+
+// String implements the fmt.Stringer.
+func (ts *TimelineSession) String() string {
+	var builder strings.Builder
+	builder.WriteString("TimelineSession(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ts.ID))
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", ts.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("post_ids=")
+	builder.WriteString(fmt.Sprintf("%v", ts.PostIds))
+	builder.WriteString(", ")
+	builder.WriteString("expires_at=")
+	builder.WriteString(ts.ExpiresAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(ts.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// TimelineSessions is a parsable slice of TimelineSession.
+type TimelineSessions []*TimelineSession
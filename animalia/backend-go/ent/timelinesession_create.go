@@ -0,0 +1,544 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/aki-13627/animalia/backend-go/ent/timelinesession"
+	"github.com/google/uuid"
+)
+
+// TimelineSessionCreate is the builder for creating a TimelineSession entity.
+type TimelineSessionCreate struct {
+	config
+	mutation *TimelineSessionMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetUserID sets the "user_id" field.
+func (tsc *TimelineSessionCreate) SetUserID(u uuid.UUID) *TimelineSessionCreate {
+	tsc.mutation.SetUserID(u)
+	return tsc
+}
+
+// SetPostIds sets the "post_ids" field.
+func (tsc *TimelineSessionCreate) SetPostIds(u []uuid.UUID) *TimelineSessionCreate {
+	tsc.mutation.SetPostIds(u)
+	return tsc
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (tsc *TimelineSessionCreate) SetExpiresAt(t time.Time) *TimelineSessionCreate {
+	tsc.mutation.SetExpiresAt(t)
+	return tsc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (tsc *TimelineSessionCreate) SetCreatedAt(t time.Time) *TimelineSessionCreate {
+	tsc.mutation.SetCreatedAt(t)
+	return tsc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (tsc *TimelineSessionCreate) SetNillableCreatedAt(t *time.Time) *TimelineSessionCreate {
+	if t != nil {
+		tsc.SetCreatedAt(*t)
+	}
+	return tsc
+}
+
+// SetID sets the "id" field.
+func (tsc *TimelineSessionCreate) SetID(u uuid.UUID) *TimelineSessionCreate {
+	tsc.mutation.SetID(u)
+	return tsc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (tsc *TimelineSessionCreate) SetNillableID(u *uuid.UUID) *TimelineSessionCreate {
+	if u != nil {
+		tsc.SetID(*u)
+	}
+	return tsc
+}
+
+// Mutation returns the TimelineSessionMutation object of the builder.
+func (tsc *TimelineSessionCreate) Mutation() *TimelineSessionMutation {
+	return tsc.mutation
+}
+
+// Save creates the TimelineSession in the database.
+func (tsc *TimelineSessionCreate) Save(ctx context.Context) (*TimelineSession, error) {
+	tsc.defaults()
+	return withHooks(ctx, tsc.sqlSave, tsc.mutation, tsc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (tsc *TimelineSessionCreate) SaveX(ctx context.Context) *TimelineSession {
+	v, err := tsc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (tsc *TimelineSessionCreate) Exec(ctx context.Context) error {
+	_, err := tsc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tsc *TimelineSessionCreate) ExecX(ctx context.Context) {
+	if err := tsc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (tsc *TimelineSessionCreate) defaults() {
+	if _, ok := tsc.mutation.CreatedAt(); !ok {
+		v := timelinesession.DefaultCreatedAt()
+		tsc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := tsc.mutation.ID(); !ok {
+		v := timelinesession.DefaultID()
+		tsc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (tsc *TimelineSessionCreate) check() error {
+	if _, ok := tsc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "TimelineSession.user_id"`)}
+	}
+	if _, ok := tsc.mutation.PostIds(); !ok {
+		return &ValidationError{Name: "post_ids", err: errors.New(`ent: missing required field "TimelineSession.post_ids"`)}
+	}
+	if _, ok := tsc.mutation.ExpiresAt(); !ok {
+		return &ValidationError{Name: "expires_at", err: errors.New(`ent: missing required field "TimelineSession.expires_at"`)}
+	}
+	if _, ok := tsc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "TimelineSession.created_at"`)}
+	}
+	return nil
+}
+
+func (tsc *TimelineSessionCreate) sqlSave(ctx context.Context) (*TimelineSession, error) {
+	if err := tsc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := tsc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, tsc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	tsc.mutation.id = &_node.ID
+	tsc.mutation.done = true
+	return _node, nil
+}
+
+func (tsc *TimelineSessionCreate) createSpec() (*TimelineSession, *sqlgraph.CreateSpec) {
+	var (
+		_node = &TimelineSession{config: tsc.config}
+		_spec = sqlgraph.NewCreateSpec(timelinesession.Table, sqlgraph.NewFieldSpec(timelinesession.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = tsc.conflict
+	if id, ok := tsc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := tsc.mutation.UserID(); ok {
+		_spec.SetField(timelinesession.FieldUserID, field.TypeUUID, value)
+		_node.UserID = value
+	}
+	if value, ok := tsc.mutation.PostIds(); ok {
+		_spec.SetField(timelinesession.FieldPostIds, field.TypeJSON, value)
+		_node.PostIds = value
+	}
+	if value, ok := tsc.mutation.ExpiresAt(); ok {
+		_spec.SetField(timelinesession.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = value
+	}
+	if value, ok := tsc.mutation.CreatedAt(); ok {
+		_spec.SetField(timelinesession.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.TimelineSession.Create().
+//		SetUserID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.TimelineSessionUpsert) {
+//			SetUserID(v+v).
+//		}).
+//		Exec(ctx)
+func (tsc *TimelineSessionCreate) OnConflict(opts ...sql.ConflictOption) *TimelineSessionUpsertOne {
+	tsc.conflict = opts
+	return &TimelineSessionUpsertOne{
+		create: tsc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.TimelineSession.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (tsc *TimelineSessionCreate) OnConflictColumns(columns ...string) *TimelineSessionUpsertOne {
+	tsc.conflict = append(tsc.conflict, sql.ConflictColumns(columns...))
+	return &TimelineSessionUpsertOne{
+		create: tsc,
+	}
+}
+
+type (
+	// TimelineSessionUpsertOne is the builder for "upsert"-ing
+	//  one TimelineSession node.
+	TimelineSessionUpsertOne struct {
+		create *TimelineSessionCreate
+	}
+
+	// TimelineSessionUpsert is the "OnConflict" setter.
+	TimelineSessionUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.TimelineSession.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(timelinesession.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *TimelineSessionUpsertOne) UpdateNewValues() *TimelineSessionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(timelinesession.FieldID)
+		}
+		if _, exists := u.create.mutation.UserID(); exists {
+			s.SetIgnore(timelinesession.FieldUserID)
+		}
+		if _, exists := u.create.mutation.PostIds(); exists {
+			s.SetIgnore(timelinesession.FieldPostIds)
+		}
+		if _, exists := u.create.mutation.ExpiresAt(); exists {
+			s.SetIgnore(timelinesession.FieldExpiresAt)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(timelinesession.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.TimelineSession.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *TimelineSessionUpsertOne) Ignore() *TimelineSessionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *TimelineSessionUpsertOne) DoNothing() *TimelineSessionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the TimelineSessionCreate.OnConflict
+// documentation for more info.
+func (u *TimelineSessionUpsertOne) Update(set func(*TimelineSessionUpsert)) *TimelineSessionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&TimelineSessionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// Exec executes the query.
+func (u *TimelineSessionUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for TimelineSessionCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *TimelineSessionUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *TimelineSessionUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: TimelineSessionUpsertOne.ID is not supported by MySQL driver. Use TimelineSessionUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *TimelineSessionUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// TimelineSessionCreateBulk is the builder for creating many TimelineSession entities in bulk.
+type TimelineSessionCreateBulk struct {
+	config
+	err      error
+	builders []*TimelineSessionCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the TimelineSession entities in the database.
+func (tscb *TimelineSessionCreateBulk) Save(ctx context.Context) ([]*TimelineSession, error) {
+	if tscb.err != nil {
+		return nil, tscb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(tscb.builders))
+	nodes := make([]*TimelineSession, len(tscb.builders))
+	mutators := make([]Mutator, len(tscb.builders))
+	for i := range tscb.builders {
+		func(i int, root context.Context) {
+			builder := tscb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*TimelineSessionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, tscb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = tscb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, tscb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, tscb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tscb *TimelineSessionCreateBulk) SaveX(ctx context.Context) []*TimelineSession {
+	v, err := tscb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (tscb *TimelineSessionCreateBulk) Exec(ctx context.Context) error {
+	_, err := tscb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tscb *TimelineSessionCreateBulk) ExecX(ctx context.Context) {
+	if err := tscb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.TimelineSession.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.TimelineSessionUpsert) {
+//			SetUserID(v+v).
+//		}).
+//		Exec(ctx)
+func (tscb *TimelineSessionCreateBulk) OnConflict(opts ...sql.ConflictOption) *TimelineSessionUpsertBulk {
+	tscb.conflict = opts
+	return &TimelineSessionUpsertBulk{
+		create: tscb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.TimelineSession.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (tscb *TimelineSessionCreateBulk) OnConflictColumns(columns ...string) *TimelineSessionUpsertBulk {
+	tscb.conflict = append(tscb.conflict, sql.ConflictColumns(columns...))
+	return &TimelineSessionUpsertBulk{
+		create: tscb,
+	}
+}
+
+// TimelineSessionUpsertBulk is the builder for "upsert"-ing
+// a bulk of TimelineSession nodes.
+type TimelineSessionUpsertBulk struct {
+	create *TimelineSessionCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.TimelineSession.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(timelinesession.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *TimelineSessionUpsertBulk) UpdateNewValues() *TimelineSessionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(timelinesession.FieldID)
+			}
+			if _, exists := b.mutation.UserID(); exists {
+				s.SetIgnore(timelinesession.FieldUserID)
+			}
+			if _, exists := b.mutation.PostIds(); exists {
+				s.SetIgnore(timelinesession.FieldPostIds)
+			}
+			if _, exists := b.mutation.ExpiresAt(); exists {
+				s.SetIgnore(timelinesession.FieldExpiresAt)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(timelinesession.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.TimelineSession.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *TimelineSessionUpsertBulk) Ignore() *TimelineSessionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *TimelineSessionUpsertBulk) DoNothing() *TimelineSessionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the TimelineSessionCreateBulk.OnConflict
+// documentation for more info.
+func (u *TimelineSessionUpsertBulk) Update(set func(*TimelineSessionUpsert)) *TimelineSessionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&TimelineSessionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// Exec executes the query.
+func (u *TimelineSessionUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the TimelineSessionCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for TimelineSessionCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *TimelineSessionUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,191 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/aki-13627/animalia/backend-go/ent/predicate"
+	"github.com/aki-13627/animalia/backend-go/ent/timelinesession"
+)
+
+// TimelineSessionUpdate is the builder for updating TimelineSession entities.
+type TimelineSessionUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *TimelineSessionMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the TimelineSessionUpdate builder.
+func (tsu *TimelineSessionUpdate) Where(ps ...predicate.TimelineSession) *TimelineSessionUpdate {
+	tsu.mutation.Where(ps...)
+	return tsu
+}
+
+// Mutation returns the TimelineSessionMutation object of the builder.
+func (tsu *TimelineSessionUpdate) Mutation() *TimelineSessionMutation {
+	return tsu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (tsu *TimelineSessionUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, tsu.sqlSave, tsu.mutation, tsu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tsu *TimelineSessionUpdate) SaveX(ctx context.Context) int {
+	affected, err := tsu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (tsu *TimelineSessionUpdate) Exec(ctx context.Context) error {
+	_, err := tsu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tsu *TimelineSessionUpdate) ExecX(ctx context.Context) {
+	if err := tsu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (tsu *TimelineSessionUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *TimelineSessionUpdate {
+	tsu.modifiers = append(tsu.modifiers, modifiers...)
+	return tsu
+}
+
+func (tsu *TimelineSessionUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(timelinesession.Table, timelinesession.Columns, sqlgraph.NewFieldSpec(timelinesession.FieldID, field.TypeUUID))
+	if ps := tsu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	_spec.AddModifiers(tsu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, tsu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{timelinesession.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	tsu.mutation.done = true
+	return n, nil
+}
+
+// TimelineSessionUpdateOne is the builder for updating a single TimelineSession entity.
+type TimelineSessionUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *TimelineSessionMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Mutation returns the TimelineSessionMutation object of the builder.
+func (tsuo *TimelineSessionUpdateOne) Mutation() *TimelineSessionMutation {
+	return tsuo.mutation
+}
+
+// Where appends a list predicates to the TimelineSessionUpdate builder.
+func (tsuo *TimelineSessionUpdateOne) Where(ps ...predicate.TimelineSession) *TimelineSessionUpdateOne {
+	tsuo.mutation.Where(ps...)
+	return tsuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (tsuo *TimelineSessionUpdateOne) Select(field string, fields ...string) *TimelineSessionUpdateOne {
+	tsuo.fields = append([]string{field}, fields...)
+	return tsuo
+}
+
+// Save executes the query and returns the updated TimelineSession entity.
+func (tsuo *TimelineSessionUpdateOne) Save(ctx context.Context) (*TimelineSession, error) {
+	return withHooks(ctx, tsuo.sqlSave, tsuo.mutation, tsuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tsuo *TimelineSessionUpdateOne) SaveX(ctx context.Context) *TimelineSession {
+	node, err := tsuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (tsuo *TimelineSessionUpdateOne) Exec(ctx context.Context) error {
+	_, err := tsuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tsuo *TimelineSessionUpdateOne) ExecX(ctx context.Context) {
+	if err := tsuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (tsuo *TimelineSessionUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *TimelineSessionUpdateOne {
+	tsuo.modifiers = append(tsuo.modifiers, modifiers...)
+	return tsuo
+}
+
+func (tsuo *TimelineSessionUpdateOne) sqlSave(ctx context.Context) (_node *TimelineSession, err error) {
+	_spec := sqlgraph.NewUpdateSpec(timelinesession.Table, timelinesession.Columns, sqlgraph.NewFieldSpec(timelinesession.FieldID, field.TypeUUID))
+	id, ok := tsuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "TimelineSession.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := tsuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, timelinesession.FieldID)
+		for _, f := range fields {
+			if !timelinesession.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != timelinesession.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := tsuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	_spec.AddModifiers(tsuo.modifiers...)
+	_node = &TimelineSession{config: tsuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, tsuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{timelinesession.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	tsuo.mutation.done = true
+	return _node, nil
+}
@@ -12,20 +12,38 @@ import (
 // Tx is a transactional client that is created by calling Client.Tx().
 type Tx struct {
 	config
+	// AuditLog is the client for interacting with the AuditLog builders.
+	AuditLog *AuditLogClient
+	// Block is the client for interacting with the Block builders.
+	Block *BlockClient
 	// Comment is the client for interacting with the Comment builders.
 	Comment *CommentClient
+	// Credential is the client for interacting with the Credential builders.
+	Credential *CredentialClient
 	// DailyTask is the client for interacting with the DailyTask builders.
 	DailyTask *DailyTaskClient
 	// FollowRelation is the client for interacting with the FollowRelation builders.
 	FollowRelation *FollowRelationClient
 	// Like is the client for interacting with the Like builders.
 	Like *LikeClient
+	// Mute is the client for interacting with the Mute builders.
+	Mute *MuteClient
 	// Pet is the client for interacting with the Pet builders.
 	Pet *PetClient
 	// Post is the client for interacting with the Post builders.
 	Post *PostClient
+	// PostMedia is the client for interacting with the PostMedia builders.
+	PostMedia *PostMediaClient
+	// PostRevision is the client for interacting with the PostRevision builders.
+	PostRevision *PostRevisionClient
+	// Report is the client for interacting with the Report builders.
+	Report *ReportClient
+	// StorageDeletion is the client for interacting with the StorageDeletion builders.
+	StorageDeletion *StorageDeletionClient
 	// TaskType is the client for interacting with the TaskType builders.
 	TaskType *TaskTypeClient
+	// TimelineSession is the client for interacting with the TimelineSession builders.
+	TimelineSession *TimelineSessionClient
 	// User is the client for interacting with the User builders.
 	User *UserClient
 
@@ -159,13 +177,22 @@ func (tx *Tx) Client() *Client {
 }
 
 func (tx *Tx) init() {
+	tx.AuditLog = NewAuditLogClient(tx.config)
+	tx.Block = NewBlockClient(tx.config)
 	tx.Comment = NewCommentClient(tx.config)
+	tx.Credential = NewCredentialClient(tx.config)
 	tx.DailyTask = NewDailyTaskClient(tx.config)
 	tx.FollowRelation = NewFollowRelationClient(tx.config)
 	tx.Like = NewLikeClient(tx.config)
+	tx.Mute = NewMuteClient(tx.config)
 	tx.Pet = NewPetClient(tx.config)
 	tx.Post = NewPostClient(tx.config)
+	tx.PostMedia = NewPostMediaClient(tx.config)
+	tx.PostRevision = NewPostRevisionClient(tx.config)
+	tx.Report = NewReportClient(tx.config)
+	tx.StorageDeletion = NewStorageDeletionClient(tx.config)
 	tx.TaskType = NewTaskTypeClient(tx.config)
+	tx.TimelineSession = NewTimelineSessionClient(tx.config)
 	tx.User = NewUserClient(tx.config)
 }
 
@@ -176,7 +203,7 @@ func (tx *Tx) init() {
 // of them in order to commit or rollback the transaction.
 //
 // If a closed transaction is embedded in one of the generated entities, and the entity
-// applies a query, for example: Comment.QueryXXX(), the query will be executed
+// applies a query, for example: AuditLog.QueryXXX(), the query will be executed
 // through the driver which created this transaction.
 //
 // Note that txDriver is not goroutine safe.
@@ -22,6 +22,8 @@ type User struct {
 	Index int `json:"index,omitempty"`
 	// Email holds the value of the "email" field.
 	Email string `json:"email,omitempty"`
+	// AuthSubject holds the value of the "auth_subject" field.
+	AuthSubject *string `json:"auth_subject,omitempty"`
 	// Name holds the value of the "name" field.
 	Name string `json:"name,omitempty"`
 	// Bio holds the value of the "bio" field.
@@ -30,6 +32,18 @@ type User struct {
 	IconImageKey string `json:"icon_image_key,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
+	// DeletedAt holds the value of the "deleted_at" field.
+	DeletedAt time.Time `json:"deleted_at,omitempty"`
+	// PurgedAt holds the value of the "purged_at" field.
+	PurgedAt time.Time `json:"purged_at,omitempty"`
+	// Role holds the value of the "role" field.
+	Role user.Role `json:"role,omitempty"`
+	// SuspendedAt holds the value of the "suspended_at" field.
+	SuspendedAt time.Time `json:"suspended_at,omitempty"`
+	// SuspensionReason holds the value of the "suspension_reason" field.
+	SuspensionReason string `json:"suspension_reason,omitempty"`
+	// IsPrivate holds the value of the "is_private" field.
+	IsPrivate bool `json:"is_private,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the UserQuery when eager-loading is set.
 	Edges        UserEdges `json:"edges"`
@@ -50,11 +64,25 @@ type UserEdges struct {
 	Following []*FollowRelation `json:"following,omitempty"`
 	// Followers holds the value of the followers edge.
 	Followers []*FollowRelation `json:"followers,omitempty"`
+	// Blocking holds the value of the blocking edge.
+	Blocking []*Block `json:"blocking,omitempty"`
+	// BlockedBy holds the value of the blocked_by edge.
+	BlockedBy []*Block `json:"blocked_by,omitempty"`
+	// Muting holds the value of the muting edge.
+	Muting []*Mute `json:"muting,omitempty"`
+	// MutedBy holds the value of the muted_by edge.
+	MutedBy []*Mute `json:"muted_by,omitempty"`
 	// DailyTasks holds the value of the daily_tasks edge.
 	DailyTasks []*DailyTask `json:"daily_tasks,omitempty"`
+	// Reports holds the value of the reports edge.
+	Reports []*Report `json:"reports,omitempty"`
+	// AuditLogs holds the value of the audit_logs edge.
+	AuditLogs []*AuditLog `json:"audit_logs,omitempty"`
+	// PostRevisions holds the value of the post_revisions edge.
+	PostRevisions []*PostRevision `json:"post_revisions,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [7]bool
+	loadedTypes [14]bool
 }
 
 // PostsOrErr returns the Posts value or an error if the edge
@@ -111,25 +139,90 @@ func (e UserEdges) FollowersOrErr() ([]*FollowRelation, error) {
 	return nil, &NotLoadedError{edge: "followers"}
 }
 
+// BlockingOrErr returns the Blocking value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) BlockingOrErr() ([]*Block, error) {
+	if e.loadedTypes[6] {
+		return e.Blocking, nil
+	}
+	return nil, &NotLoadedError{edge: "blocking"}
+}
+
+// BlockedByOrErr returns the BlockedBy value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) BlockedByOrErr() ([]*Block, error) {
+	if e.loadedTypes[7] {
+		return e.BlockedBy, nil
+	}
+	return nil, &NotLoadedError{edge: "blocked_by"}
+}
+
+// MutingOrErr returns the Muting value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) MutingOrErr() ([]*Mute, error) {
+	if e.loadedTypes[8] {
+		return e.Muting, nil
+	}
+	return nil, &NotLoadedError{edge: "muting"}
+}
+
+// MutedByOrErr returns the MutedBy value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) MutedByOrErr() ([]*Mute, error) {
+	if e.loadedTypes[9] {
+		return e.MutedBy, nil
+	}
+	return nil, &NotLoadedError{edge: "muted_by"}
+}
+
 // DailyTasksOrErr returns the DailyTasks value or an error if the edge
 // was not loaded in eager-loading.
 func (e UserEdges) DailyTasksOrErr() ([]*DailyTask, error) {
-	if e.loadedTypes[6] {
+	if e.loadedTypes[10] {
 		return e.DailyTasks, nil
 	}
 	return nil, &NotLoadedError{edge: "daily_tasks"}
 }
 
+// ReportsOrErr returns the Reports value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) ReportsOrErr() ([]*Report, error) {
+	if e.loadedTypes[11] {
+		return e.Reports, nil
+	}
+	return nil, &NotLoadedError{edge: "reports"}
+}
+
+// AuditLogsOrErr returns the AuditLogs value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) AuditLogsOrErr() ([]*AuditLog, error) {
+	if e.loadedTypes[12] {
+		return e.AuditLogs, nil
+	}
+	return nil, &NotLoadedError{edge: "audit_logs"}
+}
+
+// PostRevisionsOrErr returns the PostRevisions value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) PostRevisionsOrErr() ([]*PostRevision, error) {
+	if e.loadedTypes[13] {
+		return e.PostRevisions, nil
+	}
+	return nil, &NotLoadedError{edge: "post_revisions"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*User) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
+		case user.FieldIsPrivate:
+			values[i] = new(sql.NullBool)
 		case user.FieldIndex:
 			values[i] = new(sql.NullInt64)
-		case user.FieldEmail, user.FieldName, user.FieldBio, user.FieldIconImageKey:
+		case user.FieldEmail, user.FieldAuthSubject, user.FieldName, user.FieldBio, user.FieldIconImageKey, user.FieldRole, user.FieldSuspensionReason:
 			values[i] = new(sql.NullString)
-		case user.FieldCreatedAt:
+		case user.FieldCreatedAt, user.FieldDeletedAt, user.FieldPurgedAt, user.FieldSuspendedAt:
 			values[i] = new(sql.NullTime)
 		case user.FieldID:
 			values[i] = new(uuid.UUID)
@@ -166,6 +259,13 @@ func (u *User) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				u.Email = value.String
 			}
+		case user.FieldAuthSubject:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field auth_subject", values[i])
+			} else if value.Valid {
+				u.AuthSubject = new(string)
+				*u.AuthSubject = value.String
+			}
 		case user.FieldName:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field name", values[i])
@@ -190,6 +290,42 @@ func (u *User) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				u.CreatedAt = value.Time
 			}
+		case user.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				u.DeletedAt = value.Time
+			}
+		case user.FieldPurgedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field purged_at", values[i])
+			} else if value.Valid {
+				u.PurgedAt = value.Time
+			}
+		case user.FieldRole:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field role", values[i])
+			} else if value.Valid {
+				u.Role = user.Role(value.String)
+			}
+		case user.FieldSuspendedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field suspended_at", values[i])
+			} else if value.Valid {
+				u.SuspendedAt = value.Time
+			}
+		case user.FieldSuspensionReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field suspension_reason", values[i])
+			} else if value.Valid {
+				u.SuspensionReason = value.String
+			}
+		case user.FieldIsPrivate:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field is_private", values[i])
+			} else if value.Valid {
+				u.IsPrivate = value.Bool
+			}
 		default:
 			u.selectValues.Set(columns[i], values[i])
 		}
@@ -233,11 +369,46 @@ func (u *User) QueryFollowers() *FollowRelationQuery {
 	return NewUserClient(u.config).QueryFollowers(u)
 }
 
+// QueryBlocking queries the "blocking" edge of the User entity.
+func (u *User) QueryBlocking() *BlockQuery {
+	return NewUserClient(u.config).QueryBlocking(u)
+}
+
+// QueryBlockedBy queries the "blocked_by" edge of the User entity.
+func (u *User) QueryBlockedBy() *BlockQuery {
+	return NewUserClient(u.config).QueryBlockedBy(u)
+}
+
+// QueryMuting queries the "muting" edge of the User entity.
+func (u *User) QueryMuting() *MuteQuery {
+	return NewUserClient(u.config).QueryMuting(u)
+}
+
+// QueryMutedBy queries the "muted_by" edge of the User entity.
+func (u *User) QueryMutedBy() *MuteQuery {
+	return NewUserClient(u.config).QueryMutedBy(u)
+}
+
 // QueryDailyTasks queries the "daily_tasks" edge of the User entity.
 func (u *User) QueryDailyTasks() *DailyTaskQuery {
 	return NewUserClient(u.config).QueryDailyTasks(u)
 }
 
+// QueryReports queries the "reports" edge of the User entity.
+func (u *User) QueryReports() *ReportQuery {
+	return NewUserClient(u.config).QueryReports(u)
+}
+
+// QueryAuditLogs queries the "audit_logs" edge of the User entity.
+func (u *User) QueryAuditLogs() *AuditLogQuery {
+	return NewUserClient(u.config).QueryAuditLogs(u)
+}
+
+// QueryPostRevisions queries the "post_revisions" edge of the User entity.
+func (u *User) QueryPostRevisions() *PostRevisionQuery {
+	return NewUserClient(u.config).QueryPostRevisions(u)
+}
+
 // Update returns a builder for updating this User.
 // Note that you need to call User.Unwrap() before calling this method if this User
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -267,6 +438,11 @@ func (u *User) String() string {
 	builder.WriteString("email=")
 	builder.WriteString(u.Email)
 	builder.WriteString(", ")
+	if v := u.AuthSubject; v != nil {
+		builder.WriteString("auth_subject=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
 	builder.WriteString("name=")
 	builder.WriteString(u.Name)
 	builder.WriteString(", ")
@@ -278,6 +454,24 @@ func (u *User) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(u.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("deleted_at=")
+	builder.WriteString(u.DeletedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("purged_at=")
+	builder.WriteString(u.PurgedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("role=")
+	builder.WriteString(fmt.Sprintf("%v", u.Role))
+	builder.WriteString(", ")
+	builder.WriteString("suspended_at=")
+	builder.WriteString(u.SuspendedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("suspension_reason=")
+	builder.WriteString(u.SuspensionReason)
+	builder.WriteString(", ")
+	builder.WriteString("is_private=")
+	builder.WriteString(fmt.Sprintf("%v", u.IsPrivate))
 	builder.WriteByte(')')
 	return builder.String()
 }
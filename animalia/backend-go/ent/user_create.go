@@ -12,12 +12,17 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/aki-13627/animalia/backend-go/ent/auditlog"
+	"github.com/aki-13627/animalia/backend-go/ent/block"
 	"github.com/aki-13627/animalia/backend-go/ent/comment"
 	"github.com/aki-13627/animalia/backend-go/ent/dailytask"
 	"github.com/aki-13627/animalia/backend-go/ent/followrelation"
 	"github.com/aki-13627/animalia/backend-go/ent/like"
+	"github.com/aki-13627/animalia/backend-go/ent/mute"
 	"github.com/aki-13627/animalia/backend-go/ent/pet"
 	"github.com/aki-13627/animalia/backend-go/ent/post"
+	"github.com/aki-13627/animalia/backend-go/ent/postrevision"
+	"github.com/aki-13627/animalia/backend-go/ent/report"
 	"github.com/aki-13627/animalia/backend-go/ent/user"
 	"github.com/google/uuid"
 )
@@ -50,6 +55,20 @@ func (uc *UserCreate) SetEmail(s string) *UserCreate {
 	return uc
 }
 
+// SetAuthSubject sets the "auth_subject" field.
+func (uc *UserCreate) SetAuthSubject(s string) *UserCreate {
+	uc.mutation.SetAuthSubject(s)
+	return uc
+}
+
+// SetNillableAuthSubject sets the "auth_subject" field if the given value is not nil.
+func (uc *UserCreate) SetNillableAuthSubject(s *string) *UserCreate {
+	if s != nil {
+		uc.SetAuthSubject(*s)
+	}
+	return uc
+}
+
 // SetName sets the "name" field.
 func (uc *UserCreate) SetName(s string) *UserCreate {
 	uc.mutation.SetName(s)
@@ -98,6 +117,90 @@ func (uc *UserCreate) SetNillableCreatedAt(t *time.Time) *UserCreate {
 	return uc
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (uc *UserCreate) SetDeletedAt(t time.Time) *UserCreate {
+	uc.mutation.SetDeletedAt(t)
+	return uc
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (uc *UserCreate) SetNillableDeletedAt(t *time.Time) *UserCreate {
+	if t != nil {
+		uc.SetDeletedAt(*t)
+	}
+	return uc
+}
+
+// SetPurgedAt sets the "purged_at" field.
+func (uc *UserCreate) SetPurgedAt(t time.Time) *UserCreate {
+	uc.mutation.SetPurgedAt(t)
+	return uc
+}
+
+// SetNillablePurgedAt sets the "purged_at" field if the given value is not nil.
+func (uc *UserCreate) SetNillablePurgedAt(t *time.Time) *UserCreate {
+	if t != nil {
+		uc.SetPurgedAt(*t)
+	}
+	return uc
+}
+
+// SetRole sets the "role" field.
+func (uc *UserCreate) SetRole(u user.Role) *UserCreate {
+	uc.mutation.SetRole(u)
+	return uc
+}
+
+// SetNillableRole sets the "role" field if the given value is not nil.
+func (uc *UserCreate) SetNillableRole(u *user.Role) *UserCreate {
+	if u != nil {
+		uc.SetRole(*u)
+	}
+	return uc
+}
+
+// SetSuspendedAt sets the "suspended_at" field.
+func (uc *UserCreate) SetSuspendedAt(t time.Time) *UserCreate {
+	uc.mutation.SetSuspendedAt(t)
+	return uc
+}
+
+// SetNillableSuspendedAt sets the "suspended_at" field if the given value is not nil.
+func (uc *UserCreate) SetNillableSuspendedAt(t *time.Time) *UserCreate {
+	if t != nil {
+		uc.SetSuspendedAt(*t)
+	}
+	return uc
+}
+
+// SetSuspensionReason sets the "suspension_reason" field.
+func (uc *UserCreate) SetSuspensionReason(s string) *UserCreate {
+	uc.mutation.SetSuspensionReason(s)
+	return uc
+}
+
+// SetNillableSuspensionReason sets the "suspension_reason" field if the given value is not nil.
+func (uc *UserCreate) SetNillableSuspensionReason(s *string) *UserCreate {
+	if s != nil {
+		uc.SetSuspensionReason(*s)
+	}
+	return uc
+}
+
+// SetIsPrivate sets the "is_private" field.
+func (uc *UserCreate) SetIsPrivate(b bool) *UserCreate {
+	uc.mutation.SetIsPrivate(b)
+	return uc
+}
+
+// SetNillableIsPrivate sets the "is_private" field if the given value is not nil.
+func (uc *UserCreate) SetNillableIsPrivate(b *bool) *UserCreate {
+	if b != nil {
+		uc.SetIsPrivate(*b)
+	}
+	return uc
+}
+
 // SetID sets the "id" field.
 func (uc *UserCreate) SetID(u uuid.UUID) *UserCreate {
 	uc.mutation.SetID(u)
@@ -202,6 +305,66 @@ func (uc *UserCreate) AddFollowers(f ...*FollowRelation) *UserCreate {
 	return uc.AddFollowerIDs(ids...)
 }
 
+// AddBlockingIDs adds the "blocking" edge to the Block entity by IDs.
+func (uc *UserCreate) AddBlockingIDs(ids ...uuid.UUID) *UserCreate {
+	uc.mutation.AddBlockingIDs(ids...)
+	return uc
+}
+
+// AddBlocking adds the "blocking" edges to the Block entity.
+func (uc *UserCreate) AddBlocking(b ...*Block) *UserCreate {
+	ids := make([]uuid.UUID, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return uc.AddBlockingIDs(ids...)
+}
+
+// AddBlockedByIDs adds the "blocked_by" edge to the Block entity by IDs.
+func (uc *UserCreate) AddBlockedByIDs(ids ...uuid.UUID) *UserCreate {
+	uc.mutation.AddBlockedByIDs(ids...)
+	return uc
+}
+
+// AddBlockedBy adds the "blocked_by" edges to the Block entity.
+func (uc *UserCreate) AddBlockedBy(b ...*Block) *UserCreate {
+	ids := make([]uuid.UUID, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return uc.AddBlockedByIDs(ids...)
+}
+
+// AddMutingIDs adds the "muting" edge to the Mute entity by IDs.
+func (uc *UserCreate) AddMutingIDs(ids ...uuid.UUID) *UserCreate {
+	uc.mutation.AddMutingIDs(ids...)
+	return uc
+}
+
+// AddMuting adds the "muting" edges to the Mute entity.
+func (uc *UserCreate) AddMuting(m ...*Mute) *UserCreate {
+	ids := make([]uuid.UUID, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return uc.AddMutingIDs(ids...)
+}
+
+// AddMutedByIDs adds the "muted_by" edge to the Mute entity by IDs.
+func (uc *UserCreate) AddMutedByIDs(ids ...uuid.UUID) *UserCreate {
+	uc.mutation.AddMutedByIDs(ids...)
+	return uc
+}
+
+// AddMutedBy adds the "muted_by" edges to the Mute entity.
+func (uc *UserCreate) AddMutedBy(m ...*Mute) *UserCreate {
+	ids := make([]uuid.UUID, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return uc.AddMutedByIDs(ids...)
+}
+
 // AddDailyTaskIDs adds the "daily_tasks" edge to the DailyTask entity by IDs.
 func (uc *UserCreate) AddDailyTaskIDs(ids ...uuid.UUID) *UserCreate {
 	uc.mutation.AddDailyTaskIDs(ids...)
@@ -217,6 +380,51 @@ func (uc *UserCreate) AddDailyTasks(d ...*DailyTask) *UserCreate {
 	return uc.AddDailyTaskIDs(ids...)
 }
 
+// AddReportIDs adds the "reports" edge to the Report entity by IDs.
+func (uc *UserCreate) AddReportIDs(ids ...uuid.UUID) *UserCreate {
+	uc.mutation.AddReportIDs(ids...)
+	return uc
+}
+
+// AddReports adds the "reports" edges to the Report entity.
+func (uc *UserCreate) AddReports(r ...*Report) *UserCreate {
+	ids := make([]uuid.UUID, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return uc.AddReportIDs(ids...)
+}
+
+// AddAuditLogIDs adds the "audit_logs" edge to the AuditLog entity by IDs.
+func (uc *UserCreate) AddAuditLogIDs(ids ...uuid.UUID) *UserCreate {
+	uc.mutation.AddAuditLogIDs(ids...)
+	return uc
+}
+
+// AddAuditLogs adds the "audit_logs" edges to the AuditLog entity.
+func (uc *UserCreate) AddAuditLogs(a ...*AuditLog) *UserCreate {
+	ids := make([]uuid.UUID, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return uc.AddAuditLogIDs(ids...)
+}
+
+// AddPostRevisionIDs adds the "post_revisions" edge to the PostRevision entity by IDs.
+func (uc *UserCreate) AddPostRevisionIDs(ids ...uuid.UUID) *UserCreate {
+	uc.mutation.AddPostRevisionIDs(ids...)
+	return uc
+}
+
+// AddPostRevisions adds the "post_revisions" edges to the PostRevision entity.
+func (uc *UserCreate) AddPostRevisions(p ...*PostRevision) *UserCreate {
+	ids := make([]uuid.UUID, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return uc.AddPostRevisionIDs(ids...)
+}
+
 // Mutation returns the UserMutation object of the builder.
 func (uc *UserCreate) Mutation() *UserMutation {
 	return uc.mutation
@@ -260,6 +468,14 @@ func (uc *UserCreate) defaults() {
 		v := user.DefaultCreatedAt()
 		uc.mutation.SetCreatedAt(v)
 	}
+	if _, ok := uc.mutation.Role(); !ok {
+		v := user.DefaultRole
+		uc.mutation.SetRole(v)
+	}
+	if _, ok := uc.mutation.IsPrivate(); !ok {
+		v := user.DefaultIsPrivate
+		uc.mutation.SetIsPrivate(v)
+	}
 	if _, ok := uc.mutation.ID(); !ok {
 		v := user.DefaultID()
 		uc.mutation.SetID(v)
@@ -295,6 +511,17 @@ func (uc *UserCreate) check() error {
 	if _, ok := uc.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "User.created_at"`)}
 	}
+	if _, ok := uc.mutation.Role(); !ok {
+		return &ValidationError{Name: "role", err: errors.New(`ent: missing required field "User.role"`)}
+	}
+	if v, ok := uc.mutation.Role(); ok {
+		if err := user.RoleValidator(v); err != nil {
+			return &ValidationError{Name: "role", err: fmt.Errorf(`ent: validator failed for field "User.role": %w`, err)}
+		}
+	}
+	if _, ok := uc.mutation.IsPrivate(); !ok {
+		return &ValidationError{Name: "is_private", err: errors.New(`ent: missing required field "User.is_private"`)}
+	}
 	return nil
 }
 
@@ -339,6 +566,10 @@ func (uc *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 		_spec.SetField(user.FieldEmail, field.TypeString, value)
 		_node.Email = value
 	}
+	if value, ok := uc.mutation.AuthSubject(); ok {
+		_spec.SetField(user.FieldAuthSubject, field.TypeString, value)
+		_node.AuthSubject = &value
+	}
 	if value, ok := uc.mutation.Name(); ok {
 		_spec.SetField(user.FieldName, field.TypeString, value)
 		_node.Name = value
@@ -355,6 +586,30 @@ func (uc *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 		_spec.SetField(user.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
 	}
+	if value, ok := uc.mutation.DeletedAt(); ok {
+		_spec.SetField(user.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = value
+	}
+	if value, ok := uc.mutation.PurgedAt(); ok {
+		_spec.SetField(user.FieldPurgedAt, field.TypeTime, value)
+		_node.PurgedAt = value
+	}
+	if value, ok := uc.mutation.Role(); ok {
+		_spec.SetField(user.FieldRole, field.TypeEnum, value)
+		_node.Role = value
+	}
+	if value, ok := uc.mutation.SuspendedAt(); ok {
+		_spec.SetField(user.FieldSuspendedAt, field.TypeTime, value)
+		_node.SuspendedAt = value
+	}
+	if value, ok := uc.mutation.SuspensionReason(); ok {
+		_spec.SetField(user.FieldSuspensionReason, field.TypeString, value)
+		_node.SuspensionReason = value
+	}
+	if value, ok := uc.mutation.IsPrivate(); ok {
+		_spec.SetField(user.FieldIsPrivate, field.TypeBool, value)
+		_node.IsPrivate = value
+	}
 	if nodes := uc.mutation.PostsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -451,6 +706,70 @@ func (uc *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := uc.mutation.BlockingIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.BlockingTable,
+			Columns: []string{user.BlockingColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(block.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.BlockedByIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.BlockedByTable,
+			Columns: []string{user.BlockedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(block.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.MutingIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.MutingTable,
+			Columns: []string{user.MutingColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(mute.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.MutedByIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.MutedByTable,
+			Columns: []string{user.MutedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(mute.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	if nodes := uc.mutation.DailyTasksIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -467,6 +786,54 @@ func (uc *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := uc.mutation.ReportsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ReportsTable,
+			Columns: []string{user.ReportsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(report.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.AuditLogsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.AuditLogsTable,
+			Columns: []string{user.AuditLogsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(auditlog.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.PostRevisionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.PostRevisionsTable,
+			Columns: []string{user.PostRevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(postrevision.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -531,6 +898,24 @@ func (u *UserUpsert) UpdateEmail() *UserUpsert {
 	return u
 }
 
+// SetAuthSubject sets the "auth_subject" field.
+func (u *UserUpsert) SetAuthSubject(v string) *UserUpsert {
+	u.Set(user.FieldAuthSubject, v)
+	return u
+}
+
+// UpdateAuthSubject sets the "auth_subject" field to the value that was provided on create.
+func (u *UserUpsert) UpdateAuthSubject() *UserUpsert {
+	u.SetExcluded(user.FieldAuthSubject)
+	return u
+}
+
+// ClearAuthSubject clears the value of the "auth_subject" field.
+func (u *UserUpsert) ClearAuthSubject() *UserUpsert {
+	u.SetNull(user.FieldAuthSubject)
+	return u
+}
+
 // SetName sets the "name" field.
 func (u *UserUpsert) SetName(v string) *UserUpsert {
 	u.Set(user.FieldName, v)
@@ -585,6 +970,102 @@ func (u *UserUpsert) UpdateCreatedAt() *UserUpsert {
 	return u
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (u *UserUpsert) SetDeletedAt(v time.Time) *UserUpsert {
+	u.Set(user.FieldDeletedAt, v)
+	return u
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *UserUpsert) UpdateDeletedAt() *UserUpsert {
+	u.SetExcluded(user.FieldDeletedAt)
+	return u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *UserUpsert) ClearDeletedAt() *UserUpsert {
+	u.SetNull(user.FieldDeletedAt)
+	return u
+}
+
+// SetPurgedAt sets the "purged_at" field.
+func (u *UserUpsert) SetPurgedAt(v time.Time) *UserUpsert {
+	u.Set(user.FieldPurgedAt, v)
+	return u
+}
+
+// UpdatePurgedAt sets the "purged_at" field to the value that was provided on create.
+func (u *UserUpsert) UpdatePurgedAt() *UserUpsert {
+	u.SetExcluded(user.FieldPurgedAt)
+	return u
+}
+
+// ClearPurgedAt clears the value of the "purged_at" field.
+func (u *UserUpsert) ClearPurgedAt() *UserUpsert {
+	u.SetNull(user.FieldPurgedAt)
+	return u
+}
+
+// SetRole sets the "role" field.
+func (u *UserUpsert) SetRole(v user.Role) *UserUpsert {
+	u.Set(user.FieldRole, v)
+	return u
+}
+
+// UpdateRole sets the "role" field to the value that was provided on create.
+func (u *UserUpsert) UpdateRole() *UserUpsert {
+	u.SetExcluded(user.FieldRole)
+	return u
+}
+
+// SetSuspendedAt sets the "suspended_at" field.
+func (u *UserUpsert) SetSuspendedAt(v time.Time) *UserUpsert {
+	u.Set(user.FieldSuspendedAt, v)
+	return u
+}
+
+// UpdateSuspendedAt sets the "suspended_at" field to the value that was provided on create.
+func (u *UserUpsert) UpdateSuspendedAt() *UserUpsert {
+	u.SetExcluded(user.FieldSuspendedAt)
+	return u
+}
+
+// ClearSuspendedAt clears the value of the "suspended_at" field.
+func (u *UserUpsert) ClearSuspendedAt() *UserUpsert {
+	u.SetNull(user.FieldSuspendedAt)
+	return u
+}
+
+// SetSuspensionReason sets the "suspension_reason" field.
+func (u *UserUpsert) SetSuspensionReason(v string) *UserUpsert {
+	u.Set(user.FieldSuspensionReason, v)
+	return u
+}
+
+// UpdateSuspensionReason sets the "suspension_reason" field to the value that was provided on create.
+func (u *UserUpsert) UpdateSuspensionReason() *UserUpsert {
+	u.SetExcluded(user.FieldSuspensionReason)
+	return u
+}
+
+// ClearSuspensionReason clears the value of the "suspension_reason" field.
+func (u *UserUpsert) ClearSuspensionReason() *UserUpsert {
+	u.SetNull(user.FieldSuspensionReason)
+	return u
+}
+
+// SetIsPrivate sets the "is_private" field.
+func (u *UserUpsert) SetIsPrivate(v bool) *UserUpsert {
+	u.Set(user.FieldIsPrivate, v)
+	return u
+}
+
+// UpdateIsPrivate sets the "is_private" field to the value that was provided on create.
+func (u *UserUpsert) UpdateIsPrivate() *UserUpsert {
+	u.SetExcluded(user.FieldIsPrivate)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
 // Using this option is equivalent to using:
 //
@@ -650,6 +1131,27 @@ func (u *UserUpsertOne) UpdateEmail() *UserUpsertOne {
 	})
 }
 
+// SetAuthSubject sets the "auth_subject" field.
+func (u *UserUpsertOne) SetAuthSubject(v string) *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.SetAuthSubject(v)
+	})
+}
+
+// UpdateAuthSubject sets the "auth_subject" field to the value that was provided on create.
+func (u *UserUpsertOne) UpdateAuthSubject() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateAuthSubject()
+	})
+}
+
+// ClearAuthSubject clears the value of the "auth_subject" field.
+func (u *UserUpsertOne) ClearAuthSubject() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.ClearAuthSubject()
+	})
+}
+
 // SetName sets the "name" field.
 func (u *UserUpsertOne) SetName(v string) *UserUpsertOne {
 	return u.Update(func(s *UserUpsert) {
@@ -713,6 +1215,118 @@ func (u *UserUpsertOne) UpdateCreatedAt() *UserUpsertOne {
 	})
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (u *UserUpsertOne) SetDeletedAt(v time.Time) *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *UserUpsertOne) UpdateDeletedAt() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *UserUpsertOne) ClearDeletedAt() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// SetPurgedAt sets the "purged_at" field.
+func (u *UserUpsertOne) SetPurgedAt(v time.Time) *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.SetPurgedAt(v)
+	})
+}
+
+// UpdatePurgedAt sets the "purged_at" field to the value that was provided on create.
+func (u *UserUpsertOne) UpdatePurgedAt() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdatePurgedAt()
+	})
+}
+
+// ClearPurgedAt clears the value of the "purged_at" field.
+func (u *UserUpsertOne) ClearPurgedAt() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.ClearPurgedAt()
+	})
+}
+
+// SetRole sets the "role" field.
+func (u *UserUpsertOne) SetRole(v user.Role) *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.SetRole(v)
+	})
+}
+
+// UpdateRole sets the "role" field to the value that was provided on create.
+func (u *UserUpsertOne) UpdateRole() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateRole()
+	})
+}
+
+// SetSuspendedAt sets the "suspended_at" field.
+func (u *UserUpsertOne) SetSuspendedAt(v time.Time) *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.SetSuspendedAt(v)
+	})
+}
+
+// UpdateSuspendedAt sets the "suspended_at" field to the value that was provided on create.
+func (u *UserUpsertOne) UpdateSuspendedAt() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateSuspendedAt()
+	})
+}
+
+// ClearSuspendedAt clears the value of the "suspended_at" field.
+func (u *UserUpsertOne) ClearSuspendedAt() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.ClearSuspendedAt()
+	})
+}
+
+// SetSuspensionReason sets the "suspension_reason" field.
+func (u *UserUpsertOne) SetSuspensionReason(v string) *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.SetSuspensionReason(v)
+	})
+}
+
+// UpdateSuspensionReason sets the "suspension_reason" field to the value that was provided on create.
+func (u *UserUpsertOne) UpdateSuspensionReason() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateSuspensionReason()
+	})
+}
+
+// ClearSuspensionReason clears the value of the "suspension_reason" field.
+func (u *UserUpsertOne) ClearSuspensionReason() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.ClearSuspensionReason()
+	})
+}
+
+// SetIsPrivate sets the "is_private" field.
+func (u *UserUpsertOne) SetIsPrivate(v bool) *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.SetIsPrivate(v)
+	})
+}
+
+// UpdateIsPrivate sets the "is_private" field to the value that was provided on create.
+func (u *UserUpsertOne) UpdateIsPrivate() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateIsPrivate()
+	})
+}
+
 // Exec executes the query.
 func (u *UserUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -945,6 +1559,27 @@ func (u *UserUpsertBulk) UpdateEmail() *UserUpsertBulk {
 	})
 }
 
+// SetAuthSubject sets the "auth_subject" field.
+func (u *UserUpsertBulk) SetAuthSubject(v string) *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.SetAuthSubject(v)
+	})
+}
+
+// UpdateAuthSubject sets the "auth_subject" field to the value that was provided on create.
+func (u *UserUpsertBulk) UpdateAuthSubject() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateAuthSubject()
+	})
+}
+
+// ClearAuthSubject clears the value of the "auth_subject" field.
+func (u *UserUpsertBulk) ClearAuthSubject() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.ClearAuthSubject()
+	})
+}
+
 // SetName sets the "name" field.
 func (u *UserUpsertBulk) SetName(v string) *UserUpsertBulk {
 	return u.Update(func(s *UserUpsert) {
@@ -1008,6 +1643,118 @@ func (u *UserUpsertBulk) UpdateCreatedAt() *UserUpsertBulk {
 	})
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (u *UserUpsertBulk) SetDeletedAt(v time.Time) *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *UserUpsertBulk) UpdateDeletedAt() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *UserUpsertBulk) ClearDeletedAt() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// SetPurgedAt sets the "purged_at" field.
+func (u *UserUpsertBulk) SetPurgedAt(v time.Time) *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.SetPurgedAt(v)
+	})
+}
+
+// UpdatePurgedAt sets the "purged_at" field to the value that was provided on create.
+func (u *UserUpsertBulk) UpdatePurgedAt() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdatePurgedAt()
+	})
+}
+
+// ClearPurgedAt clears the value of the "purged_at" field.
+func (u *UserUpsertBulk) ClearPurgedAt() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.ClearPurgedAt()
+	})
+}
+
+// SetRole sets the "role" field.
+func (u *UserUpsertBulk) SetRole(v user.Role) *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.SetRole(v)
+	})
+}
+
+// UpdateRole sets the "role" field to the value that was provided on create.
+func (u *UserUpsertBulk) UpdateRole() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateRole()
+	})
+}
+
+// SetSuspendedAt sets the "suspended_at" field.
+func (u *UserUpsertBulk) SetSuspendedAt(v time.Time) *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.SetSuspendedAt(v)
+	})
+}
+
+// UpdateSuspendedAt sets the "suspended_at" field to the value that was provided on create.
+func (u *UserUpsertBulk) UpdateSuspendedAt() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateSuspendedAt()
+	})
+}
+
+// ClearSuspendedAt clears the value of the "suspended_at" field.
+func (u *UserUpsertBulk) ClearSuspendedAt() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.ClearSuspendedAt()
+	})
+}
+
+// SetSuspensionReason sets the "suspension_reason" field.
+func (u *UserUpsertBulk) SetSuspensionReason(v string) *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.SetSuspensionReason(v)
+	})
+}
+
+// UpdateSuspensionReason sets the "suspension_reason" field to the value that was provided on create.
+func (u *UserUpsertBulk) UpdateSuspensionReason() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateSuspensionReason()
+	})
+}
+
+// ClearSuspensionReason clears the value of the "suspension_reason" field.
+func (u *UserUpsertBulk) ClearSuspensionReason() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.ClearSuspensionReason()
+	})
+}
+
+// SetIsPrivate sets the "is_private" field.
+func (u *UserUpsertBulk) SetIsPrivate(v bool) *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.SetIsPrivate(v)
+	})
+}
+
+// UpdateIsPrivate sets the "is_private" field to the value that was provided on create.
+func (u *UserUpsertBulk) UpdateIsPrivate() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateIsPrivate()
+	})
+}
+
 // Exec executes the query.
 func (u *UserUpsertBulk) Exec(ctx context.Context) error {
 	if u.create.err != nil {
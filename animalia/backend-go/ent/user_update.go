@@ -11,13 +11,18 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/aki-13627/animalia/backend-go/ent/auditlog"
+	"github.com/aki-13627/animalia/backend-go/ent/block"
 	"github.com/aki-13627/animalia/backend-go/ent/comment"
 	"github.com/aki-13627/animalia/backend-go/ent/dailytask"
 	"github.com/aki-13627/animalia/backend-go/ent/followrelation"
 	"github.com/aki-13627/animalia/backend-go/ent/like"
+	"github.com/aki-13627/animalia/backend-go/ent/mute"
 	"github.com/aki-13627/animalia/backend-go/ent/pet"
 	"github.com/aki-13627/animalia/backend-go/ent/post"
+	"github.com/aki-13627/animalia/backend-go/ent/postrevision"
 	"github.com/aki-13627/animalia/backend-go/ent/predicate"
+	"github.com/aki-13627/animalia/backend-go/ent/report"
 	"github.com/aki-13627/animalia/backend-go/ent/user"
 	"github.com/google/uuid"
 )
@@ -25,8 +30,9 @@ import (
 // UserUpdate is the builder for updating User entities.
 type UserUpdate struct {
 	config
-	hooks    []Hook
-	mutation *UserMutation
+	hooks     []Hook
+	mutation  *UserMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // Where appends a list predicates to the UserUpdate builder.
@@ -49,6 +55,26 @@ func (uu *UserUpdate) SetNillableEmail(s *string) *UserUpdate {
 	return uu
 }
 
+// SetAuthSubject sets the "auth_subject" field.
+func (uu *UserUpdate) SetAuthSubject(s string) *UserUpdate {
+	uu.mutation.SetAuthSubject(s)
+	return uu
+}
+
+// SetNillableAuthSubject sets the "auth_subject" field if the given value is not nil.
+func (uu *UserUpdate) SetNillableAuthSubject(s *string) *UserUpdate {
+	if s != nil {
+		uu.SetAuthSubject(*s)
+	}
+	return uu
+}
+
+// ClearAuthSubject clears the value of the "auth_subject" field.
+func (uu *UserUpdate) ClearAuthSubject() *UserUpdate {
+	uu.mutation.ClearAuthSubject()
+	return uu
+}
+
 // SetName sets the "name" field.
 func (uu *UserUpdate) SetName(s string) *UserUpdate {
 	uu.mutation.SetName(s)
@@ -111,6 +137,114 @@ func (uu *UserUpdate) SetNillableCreatedAt(t *time.Time) *UserUpdate {
 	return uu
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (uu *UserUpdate) SetDeletedAt(t time.Time) *UserUpdate {
+	uu.mutation.SetDeletedAt(t)
+	return uu
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (uu *UserUpdate) SetNillableDeletedAt(t *time.Time) *UserUpdate {
+	if t != nil {
+		uu.SetDeletedAt(*t)
+	}
+	return uu
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (uu *UserUpdate) ClearDeletedAt() *UserUpdate {
+	uu.mutation.ClearDeletedAt()
+	return uu
+}
+
+// SetPurgedAt sets the "purged_at" field.
+func (uu *UserUpdate) SetPurgedAt(t time.Time) *UserUpdate {
+	uu.mutation.SetPurgedAt(t)
+	return uu
+}
+
+// SetNillablePurgedAt sets the "purged_at" field if the given value is not nil.
+func (uu *UserUpdate) SetNillablePurgedAt(t *time.Time) *UserUpdate {
+	if t != nil {
+		uu.SetPurgedAt(*t)
+	}
+	return uu
+}
+
+// ClearPurgedAt clears the value of the "purged_at" field.
+func (uu *UserUpdate) ClearPurgedAt() *UserUpdate {
+	uu.mutation.ClearPurgedAt()
+	return uu
+}
+
+// SetRole sets the "role" field.
+func (uu *UserUpdate) SetRole(u user.Role) *UserUpdate {
+	uu.mutation.SetRole(u)
+	return uu
+}
+
+// SetNillableRole sets the "role" field if the given value is not nil.
+func (uu *UserUpdate) SetNillableRole(u *user.Role) *UserUpdate {
+	if u != nil {
+		uu.SetRole(*u)
+	}
+	return uu
+}
+
+// SetSuspendedAt sets the "suspended_at" field.
+func (uu *UserUpdate) SetSuspendedAt(t time.Time) *UserUpdate {
+	uu.mutation.SetSuspendedAt(t)
+	return uu
+}
+
+// SetNillableSuspendedAt sets the "suspended_at" field if the given value is not nil.
+func (uu *UserUpdate) SetNillableSuspendedAt(t *time.Time) *UserUpdate {
+	if t != nil {
+		uu.SetSuspendedAt(*t)
+	}
+	return uu
+}
+
+// ClearSuspendedAt clears the value of the "suspended_at" field.
+func (uu *UserUpdate) ClearSuspendedAt() *UserUpdate {
+	uu.mutation.ClearSuspendedAt()
+	return uu
+}
+
+// SetSuspensionReason sets the "suspension_reason" field.
+func (uu *UserUpdate) SetSuspensionReason(s string) *UserUpdate {
+	uu.mutation.SetSuspensionReason(s)
+	return uu
+}
+
+// SetNillableSuspensionReason sets the "suspension_reason" field if the given value is not nil.
+func (uu *UserUpdate) SetNillableSuspensionReason(s *string) *UserUpdate {
+	if s != nil {
+		uu.SetSuspensionReason(*s)
+	}
+	return uu
+}
+
+// ClearSuspensionReason clears the value of the "suspension_reason" field.
+func (uu *UserUpdate) ClearSuspensionReason() *UserUpdate {
+	uu.mutation.ClearSuspensionReason()
+	return uu
+}
+
+// SetIsPrivate sets the "is_private" field.
+func (uu *UserUpdate) SetIsPrivate(b bool) *UserUpdate {
+	uu.mutation.SetIsPrivate(b)
+	return uu
+}
+
+// SetNillableIsPrivate sets the "is_private" field if the given value is not nil.
+func (uu *UserUpdate) SetNillableIsPrivate(b *bool) *UserUpdate {
+	if b != nil {
+		uu.SetIsPrivate(*b)
+	}
+	return uu
+}
+
 // AddPostIDs adds the "posts" edge to the Post entity by IDs.
 func (uu *UserUpdate) AddPostIDs(ids ...uuid.UUID) *UserUpdate {
 	uu.mutation.AddPostIDs(ids...)
@@ -201,6 +335,66 @@ func (uu *UserUpdate) AddFollowers(f ...*FollowRelation) *UserUpdate {
 	return uu.AddFollowerIDs(ids...)
 }
 
+// AddBlockingIDs adds the "blocking" edge to the Block entity by IDs.
+func (uu *UserUpdate) AddBlockingIDs(ids ...uuid.UUID) *UserUpdate {
+	uu.mutation.AddBlockingIDs(ids...)
+	return uu
+}
+
+// AddBlocking adds the "blocking" edges to the Block entity.
+func (uu *UserUpdate) AddBlocking(b ...*Block) *UserUpdate {
+	ids := make([]uuid.UUID, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return uu.AddBlockingIDs(ids...)
+}
+
+// AddBlockedByIDs adds the "blocked_by" edge to the Block entity by IDs.
+func (uu *UserUpdate) AddBlockedByIDs(ids ...uuid.UUID) *UserUpdate {
+	uu.mutation.AddBlockedByIDs(ids...)
+	return uu
+}
+
+// AddBlockedBy adds the "blocked_by" edges to the Block entity.
+func (uu *UserUpdate) AddBlockedBy(b ...*Block) *UserUpdate {
+	ids := make([]uuid.UUID, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return uu.AddBlockedByIDs(ids...)
+}
+
+// AddMutingIDs adds the "muting" edge to the Mute entity by IDs.
+func (uu *UserUpdate) AddMutingIDs(ids ...uuid.UUID) *UserUpdate {
+	uu.mutation.AddMutingIDs(ids...)
+	return uu
+}
+
+// AddMuting adds the "muting" edges to the Mute entity.
+func (uu *UserUpdate) AddMuting(m ...*Mute) *UserUpdate {
+	ids := make([]uuid.UUID, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return uu.AddMutingIDs(ids...)
+}
+
+// AddMutedByIDs adds the "muted_by" edge to the Mute entity by IDs.
+func (uu *UserUpdate) AddMutedByIDs(ids ...uuid.UUID) *UserUpdate {
+	uu.mutation.AddMutedByIDs(ids...)
+	return uu
+}
+
+// AddMutedBy adds the "muted_by" edges to the Mute entity.
+func (uu *UserUpdate) AddMutedBy(m ...*Mute) *UserUpdate {
+	ids := make([]uuid.UUID, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return uu.AddMutedByIDs(ids...)
+}
+
 // AddDailyTaskIDs adds the "daily_tasks" edge to the DailyTask entity by IDs.
 func (uu *UserUpdate) AddDailyTaskIDs(ids ...uuid.UUID) *UserUpdate {
 	uu.mutation.AddDailyTaskIDs(ids...)
@@ -216,6 +410,51 @@ func (uu *UserUpdate) AddDailyTasks(d ...*DailyTask) *UserUpdate {
 	return uu.AddDailyTaskIDs(ids...)
 }
 
+// AddReportIDs adds the "reports" edge to the Report entity by IDs.
+func (uu *UserUpdate) AddReportIDs(ids ...uuid.UUID) *UserUpdate {
+	uu.mutation.AddReportIDs(ids...)
+	return uu
+}
+
+// AddReports adds the "reports" edges to the Report entity.
+func (uu *UserUpdate) AddReports(r ...*Report) *UserUpdate {
+	ids := make([]uuid.UUID, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return uu.AddReportIDs(ids...)
+}
+
+// AddAuditLogIDs adds the "audit_logs" edge to the AuditLog entity by IDs.
+func (uu *UserUpdate) AddAuditLogIDs(ids ...uuid.UUID) *UserUpdate {
+	uu.mutation.AddAuditLogIDs(ids...)
+	return uu
+}
+
+// AddAuditLogs adds the "audit_logs" edges to the AuditLog entity.
+func (uu *UserUpdate) AddAuditLogs(a ...*AuditLog) *UserUpdate {
+	ids := make([]uuid.UUID, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return uu.AddAuditLogIDs(ids...)
+}
+
+// AddPostRevisionIDs adds the "post_revisions" edge to the PostRevision entity by IDs.
+func (uu *UserUpdate) AddPostRevisionIDs(ids ...uuid.UUID) *UserUpdate {
+	uu.mutation.AddPostRevisionIDs(ids...)
+	return uu
+}
+
+// AddPostRevisions adds the "post_revisions" edges to the PostRevision entity.
+func (uu *UserUpdate) AddPostRevisions(p ...*PostRevision) *UserUpdate {
+	ids := make([]uuid.UUID, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return uu.AddPostRevisionIDs(ids...)
+}
+
 // Mutation returns the UserMutation object of the builder.
 func (uu *UserUpdate) Mutation() *UserMutation {
 	return uu.mutation
@@ -347,6 +586,90 @@ func (uu *UserUpdate) RemoveFollowers(f ...*FollowRelation) *UserUpdate {
 	return uu.RemoveFollowerIDs(ids...)
 }
 
+// ClearBlocking clears all "blocking" edges to the Block entity.
+func (uu *UserUpdate) ClearBlocking() *UserUpdate {
+	uu.mutation.ClearBlocking()
+	return uu
+}
+
+// RemoveBlockingIDs removes the "blocking" edge to Block entities by IDs.
+func (uu *UserUpdate) RemoveBlockingIDs(ids ...uuid.UUID) *UserUpdate {
+	uu.mutation.RemoveBlockingIDs(ids...)
+	return uu
+}
+
+// RemoveBlocking removes "blocking" edges to Block entities.
+func (uu *UserUpdate) RemoveBlocking(b ...*Block) *UserUpdate {
+	ids := make([]uuid.UUID, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return uu.RemoveBlockingIDs(ids...)
+}
+
+// ClearBlockedBy clears all "blocked_by" edges to the Block entity.
+func (uu *UserUpdate) ClearBlockedBy() *UserUpdate {
+	uu.mutation.ClearBlockedBy()
+	return uu
+}
+
+// RemoveBlockedByIDs removes the "blocked_by" edge to Block entities by IDs.
+func (uu *UserUpdate) RemoveBlockedByIDs(ids ...uuid.UUID) *UserUpdate {
+	uu.mutation.RemoveBlockedByIDs(ids...)
+	return uu
+}
+
+// RemoveBlockedBy removes "blocked_by" edges to Block entities.
+func (uu *UserUpdate) RemoveBlockedBy(b ...*Block) *UserUpdate {
+	ids := make([]uuid.UUID, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return uu.RemoveBlockedByIDs(ids...)
+}
+
+// ClearMuting clears all "muting" edges to the Mute entity.
+func (uu *UserUpdate) ClearMuting() *UserUpdate {
+	uu.mutation.ClearMuting()
+	return uu
+}
+
+// RemoveMutingIDs removes the "muting" edge to Mute entities by IDs.
+func (uu *UserUpdate) RemoveMutingIDs(ids ...uuid.UUID) *UserUpdate {
+	uu.mutation.RemoveMutingIDs(ids...)
+	return uu
+}
+
+// RemoveMuting removes "muting" edges to Mute entities.
+func (uu *UserUpdate) RemoveMuting(m ...*Mute) *UserUpdate {
+	ids := make([]uuid.UUID, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return uu.RemoveMutingIDs(ids...)
+}
+
+// ClearMutedBy clears all "muted_by" edges to the Mute entity.
+func (uu *UserUpdate) ClearMutedBy() *UserUpdate {
+	uu.mutation.ClearMutedBy()
+	return uu
+}
+
+// RemoveMutedByIDs removes the "muted_by" edge to Mute entities by IDs.
+func (uu *UserUpdate) RemoveMutedByIDs(ids ...uuid.UUID) *UserUpdate {
+	uu.mutation.RemoveMutedByIDs(ids...)
+	return uu
+}
+
+// RemoveMutedBy removes "muted_by" edges to Mute entities.
+func (uu *UserUpdate) RemoveMutedBy(m ...*Mute) *UserUpdate {
+	ids := make([]uuid.UUID, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return uu.RemoveMutedByIDs(ids...)
+}
+
 // ClearDailyTasks clears all "daily_tasks" edges to the DailyTask entity.
 func (uu *UserUpdate) ClearDailyTasks() *UserUpdate {
 	uu.mutation.ClearDailyTasks()
@@ -368,6 +691,69 @@ func (uu *UserUpdate) RemoveDailyTasks(d ...*DailyTask) *UserUpdate {
 	return uu.RemoveDailyTaskIDs(ids...)
 }
 
+// ClearReports clears all "reports" edges to the Report entity.
+func (uu *UserUpdate) ClearReports() *UserUpdate {
+	uu.mutation.ClearReports()
+	return uu
+}
+
+// RemoveReportIDs removes the "reports" edge to Report entities by IDs.
+func (uu *UserUpdate) RemoveReportIDs(ids ...uuid.UUID) *UserUpdate {
+	uu.mutation.RemoveReportIDs(ids...)
+	return uu
+}
+
+// RemoveReports removes "reports" edges to Report entities.
+func (uu *UserUpdate) RemoveReports(r ...*Report) *UserUpdate {
+	ids := make([]uuid.UUID, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return uu.RemoveReportIDs(ids...)
+}
+
+// ClearAuditLogs clears all "audit_logs" edges to the AuditLog entity.
+func (uu *UserUpdate) ClearAuditLogs() *UserUpdate {
+	uu.mutation.ClearAuditLogs()
+	return uu
+}
+
+// RemoveAuditLogIDs removes the "audit_logs" edge to AuditLog entities by IDs.
+func (uu *UserUpdate) RemoveAuditLogIDs(ids ...uuid.UUID) *UserUpdate {
+	uu.mutation.RemoveAuditLogIDs(ids...)
+	return uu
+}
+
+// RemoveAuditLogs removes "audit_logs" edges to AuditLog entities.
+func (uu *UserUpdate) RemoveAuditLogs(a ...*AuditLog) *UserUpdate {
+	ids := make([]uuid.UUID, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return uu.RemoveAuditLogIDs(ids...)
+}
+
+// ClearPostRevisions clears all "post_revisions" edges to the PostRevision entity.
+func (uu *UserUpdate) ClearPostRevisions() *UserUpdate {
+	uu.mutation.ClearPostRevisions()
+	return uu
+}
+
+// RemovePostRevisionIDs removes the "post_revisions" edge to PostRevision entities by IDs.
+func (uu *UserUpdate) RemovePostRevisionIDs(ids ...uuid.UUID) *UserUpdate {
+	uu.mutation.RemovePostRevisionIDs(ids...)
+	return uu
+}
+
+// RemovePostRevisions removes "post_revisions" edges to PostRevision entities.
+func (uu *UserUpdate) RemovePostRevisions(p ...*PostRevision) *UserUpdate {
+	ids := make([]uuid.UUID, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return uu.RemovePostRevisionIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (uu *UserUpdate) Save(ctx context.Context) (int, error) {
 	return withHooks(ctx, uu.sqlSave, uu.mutation, uu.hooks)
@@ -407,9 +793,20 @@ func (uu *UserUpdate) check() error {
 			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "User.name": %w`, err)}
 		}
 	}
+	if v, ok := uu.mutation.Role(); ok {
+		if err := user.RoleValidator(v); err != nil {
+			return &ValidationError{Name: "role", err: fmt.Errorf(`ent: validator failed for field "User.role": %w`, err)}
+		}
+	}
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (uu *UserUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *UserUpdate {
+	uu.modifiers = append(uu.modifiers, modifiers...)
+	return uu
+}
+
 func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if err := uu.check(); err != nil {
 		return n, err
@@ -428,6 +825,12 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := uu.mutation.Email(); ok {
 		_spec.SetField(user.FieldEmail, field.TypeString, value)
 	}
+	if value, ok := uu.mutation.AuthSubject(); ok {
+		_spec.SetField(user.FieldAuthSubject, field.TypeString, value)
+	}
+	if uu.mutation.AuthSubjectCleared() {
+		_spec.ClearField(user.FieldAuthSubject, field.TypeString)
+	}
 	if value, ok := uu.mutation.Name(); ok {
 		_spec.SetField(user.FieldName, field.TypeString, value)
 	}
@@ -443,6 +846,36 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := uu.mutation.CreatedAt(); ok {
 		_spec.SetField(user.FieldCreatedAt, field.TypeTime, value)
 	}
+	if value, ok := uu.mutation.DeletedAt(); ok {
+		_spec.SetField(user.FieldDeletedAt, field.TypeTime, value)
+	}
+	if uu.mutation.DeletedAtCleared() {
+		_spec.ClearField(user.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := uu.mutation.PurgedAt(); ok {
+		_spec.SetField(user.FieldPurgedAt, field.TypeTime, value)
+	}
+	if uu.mutation.PurgedAtCleared() {
+		_spec.ClearField(user.FieldPurgedAt, field.TypeTime)
+	}
+	if value, ok := uu.mutation.Role(); ok {
+		_spec.SetField(user.FieldRole, field.TypeEnum, value)
+	}
+	if value, ok := uu.mutation.SuspendedAt(); ok {
+		_spec.SetField(user.FieldSuspendedAt, field.TypeTime, value)
+	}
+	if uu.mutation.SuspendedAtCleared() {
+		_spec.ClearField(user.FieldSuspendedAt, field.TypeTime)
+	}
+	if value, ok := uu.mutation.SuspensionReason(); ok {
+		_spec.SetField(user.FieldSuspensionReason, field.TypeString, value)
+	}
+	if uu.mutation.SuspensionReasonCleared() {
+		_spec.ClearField(user.FieldSuspensionReason, field.TypeString)
+	}
+	if value, ok := uu.mutation.IsPrivate(); ok {
+		_spec.SetField(user.FieldIsPrivate, field.TypeBool, value)
+	}
 	if uu.mutation.PostsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -713,28 +1146,28 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if uu.mutation.DailyTasksCleared() {
+	if uu.mutation.BlockingCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.DailyTasksTable,
-			Columns: []string{user.DailyTasksColumn},
+			Table:   user.BlockingTable,
+			Columns: []string{user.BlockingColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(dailytask.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(block.FieldID, field.TypeUUID),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := uu.mutation.RemovedDailyTasksIDs(); len(nodes) > 0 && !uu.mutation.DailyTasksCleared() {
+	if nodes := uu.mutation.RemovedBlockingIDs(); len(nodes) > 0 && !uu.mutation.BlockingCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.DailyTasksTable,
-			Columns: []string{user.DailyTasksColumn},
+			Table:   user.BlockingTable,
+			Columns: []string{user.BlockingColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(dailytask.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(block.FieldID, field.TypeUUID),
 			},
 		}
 		for _, k := range nodes {
@@ -742,15 +1175,15 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := uu.mutation.DailyTasksIDs(); len(nodes) > 0 {
+	if nodes := uu.mutation.BlockingIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.DailyTasksTable,
-			Columns: []string{user.DailyTasksColumn},
+			Table:   user.BlockingTable,
+			Columns: []string{user.BlockingColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(dailytask.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(block.FieldID, field.TypeUUID),
 			},
 		}
 		for _, k := range nodes {
@@ -758,41 +1191,378 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if n, err = sqlgraph.UpdateNodes(ctx, uu.driver, _spec); err != nil {
-		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{user.Label}
-		} else if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
+	if uu.mutation.BlockedByCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.BlockedByTable,
+			Columns: []string{user.BlockedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(block.FieldID, field.TypeUUID),
+			},
 		}
-		return 0, err
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	uu.mutation.done = true
-	return n, nil
-}
-
-// UserUpdateOne is the builder for updating a single User entity.
-type UserUpdateOne struct {
-	config
-	fields   []string
-	hooks    []Hook
-	mutation *UserMutation
-}
-
-// SetEmail sets the "email" field.
-func (uuo *UserUpdateOne) SetEmail(s string) *UserUpdateOne {
-	uuo.mutation.SetEmail(s)
-	return uuo
-}
-
-// SetNillableEmail sets the "email" field if the given value is not nil.
-func (uuo *UserUpdateOne) SetNillableEmail(s *string) *UserUpdateOne {
-	if s != nil {
-		uuo.SetEmail(*s)
+	if nodes := uu.mutation.RemovedBlockedByIDs(); len(nodes) > 0 && !uu.mutation.BlockedByCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.BlockedByTable,
+			Columns: []string{user.BlockedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(block.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return uuo
-}
-
-// SetName sets the "name" field.
+	if nodes := uu.mutation.BlockedByIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.BlockedByTable,
+			Columns: []string{user.BlockedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(block.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uu.mutation.MutingCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.MutingTable,
+			Columns: []string{user.MutingColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(mute.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.RemovedMutingIDs(); len(nodes) > 0 && !uu.mutation.MutingCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.MutingTable,
+			Columns: []string{user.MutingColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(mute.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.MutingIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.MutingTable,
+			Columns: []string{user.MutingColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(mute.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uu.mutation.MutedByCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.MutedByTable,
+			Columns: []string{user.MutedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(mute.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.RemovedMutedByIDs(); len(nodes) > 0 && !uu.mutation.MutedByCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.MutedByTable,
+			Columns: []string{user.MutedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(mute.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.MutedByIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.MutedByTable,
+			Columns: []string{user.MutedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(mute.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uu.mutation.DailyTasksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.DailyTasksTable,
+			Columns: []string{user.DailyTasksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(dailytask.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.RemovedDailyTasksIDs(); len(nodes) > 0 && !uu.mutation.DailyTasksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.DailyTasksTable,
+			Columns: []string{user.DailyTasksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(dailytask.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.DailyTasksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.DailyTasksTable,
+			Columns: []string{user.DailyTasksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(dailytask.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uu.mutation.ReportsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ReportsTable,
+			Columns: []string{user.ReportsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(report.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.RemovedReportsIDs(); len(nodes) > 0 && !uu.mutation.ReportsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ReportsTable,
+			Columns: []string{user.ReportsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(report.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.ReportsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ReportsTable,
+			Columns: []string{user.ReportsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(report.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uu.mutation.AuditLogsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.AuditLogsTable,
+			Columns: []string{user.AuditLogsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(auditlog.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.RemovedAuditLogsIDs(); len(nodes) > 0 && !uu.mutation.AuditLogsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.AuditLogsTable,
+			Columns: []string{user.AuditLogsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(auditlog.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.AuditLogsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.AuditLogsTable,
+			Columns: []string{user.AuditLogsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(auditlog.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uu.mutation.PostRevisionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.PostRevisionsTable,
+			Columns: []string{user.PostRevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(postrevision.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.RemovedPostRevisionsIDs(); len(nodes) > 0 && !uu.mutation.PostRevisionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.PostRevisionsTable,
+			Columns: []string{user.PostRevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(postrevision.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.PostRevisionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.PostRevisionsTable,
+			Columns: []string{user.PostRevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(postrevision.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(uu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, uu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{user.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	uu.mutation.done = true
+	return n, nil
+}
+
+// UserUpdateOne is the builder for updating a single User entity.
+type UserUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *UserMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetEmail sets the "email" field.
+func (uuo *UserUpdateOne) SetEmail(s string) *UserUpdateOne {
+	uuo.mutation.SetEmail(s)
+	return uuo
+}
+
+// SetNillableEmail sets the "email" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableEmail(s *string) *UserUpdateOne {
+	if s != nil {
+		uuo.SetEmail(*s)
+	}
+	return uuo
+}
+
+// SetAuthSubject sets the "auth_subject" field.
+func (uuo *UserUpdateOne) SetAuthSubject(s string) *UserUpdateOne {
+	uuo.mutation.SetAuthSubject(s)
+	return uuo
+}
+
+// SetNillableAuthSubject sets the "auth_subject" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableAuthSubject(s *string) *UserUpdateOne {
+	if s != nil {
+		uuo.SetAuthSubject(*s)
+	}
+	return uuo
+}
+
+// ClearAuthSubject clears the value of the "auth_subject" field.
+func (uuo *UserUpdateOne) ClearAuthSubject() *UserUpdateOne {
+	uuo.mutation.ClearAuthSubject()
+	return uuo
+}
+
+// SetName sets the "name" field.
 func (uuo *UserUpdateOne) SetName(s string) *UserUpdateOne {
 	uuo.mutation.SetName(s)
 	return uuo
@@ -854,6 +1624,114 @@ func (uuo *UserUpdateOne) SetNillableCreatedAt(t *time.Time) *UserUpdateOne {
 	return uuo
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (uuo *UserUpdateOne) SetDeletedAt(t time.Time) *UserUpdateOne {
+	uuo.mutation.SetDeletedAt(t)
+	return uuo
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableDeletedAt(t *time.Time) *UserUpdateOne {
+	if t != nil {
+		uuo.SetDeletedAt(*t)
+	}
+	return uuo
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (uuo *UserUpdateOne) ClearDeletedAt() *UserUpdateOne {
+	uuo.mutation.ClearDeletedAt()
+	return uuo
+}
+
+// SetPurgedAt sets the "purged_at" field.
+func (uuo *UserUpdateOne) SetPurgedAt(t time.Time) *UserUpdateOne {
+	uuo.mutation.SetPurgedAt(t)
+	return uuo
+}
+
+// SetNillablePurgedAt sets the "purged_at" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillablePurgedAt(t *time.Time) *UserUpdateOne {
+	if t != nil {
+		uuo.SetPurgedAt(*t)
+	}
+	return uuo
+}
+
+// ClearPurgedAt clears the value of the "purged_at" field.
+func (uuo *UserUpdateOne) ClearPurgedAt() *UserUpdateOne {
+	uuo.mutation.ClearPurgedAt()
+	return uuo
+}
+
+// SetRole sets the "role" field.
+func (uuo *UserUpdateOne) SetRole(u user.Role) *UserUpdateOne {
+	uuo.mutation.SetRole(u)
+	return uuo
+}
+
+// SetNillableRole sets the "role" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableRole(u *user.Role) *UserUpdateOne {
+	if u != nil {
+		uuo.SetRole(*u)
+	}
+	return uuo
+}
+
+// SetSuspendedAt sets the "suspended_at" field.
+func (uuo *UserUpdateOne) SetSuspendedAt(t time.Time) *UserUpdateOne {
+	uuo.mutation.SetSuspendedAt(t)
+	return uuo
+}
+
+// SetNillableSuspendedAt sets the "suspended_at" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableSuspendedAt(t *time.Time) *UserUpdateOne {
+	if t != nil {
+		uuo.SetSuspendedAt(*t)
+	}
+	return uuo
+}
+
+// ClearSuspendedAt clears the value of the "suspended_at" field.
+func (uuo *UserUpdateOne) ClearSuspendedAt() *UserUpdateOne {
+	uuo.mutation.ClearSuspendedAt()
+	return uuo
+}
+
+// SetSuspensionReason sets the "suspension_reason" field.
+func (uuo *UserUpdateOne) SetSuspensionReason(s string) *UserUpdateOne {
+	uuo.mutation.SetSuspensionReason(s)
+	return uuo
+}
+
+// SetNillableSuspensionReason sets the "suspension_reason" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableSuspensionReason(s *string) *UserUpdateOne {
+	if s != nil {
+		uuo.SetSuspensionReason(*s)
+	}
+	return uuo
+}
+
+// ClearSuspensionReason clears the value of the "suspension_reason" field.
+func (uuo *UserUpdateOne) ClearSuspensionReason() *UserUpdateOne {
+	uuo.mutation.ClearSuspensionReason()
+	return uuo
+}
+
+// SetIsPrivate sets the "is_private" field.
+func (uuo *UserUpdateOne) SetIsPrivate(b bool) *UserUpdateOne {
+	uuo.mutation.SetIsPrivate(b)
+	return uuo
+}
+
+// SetNillableIsPrivate sets the "is_private" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableIsPrivate(b *bool) *UserUpdateOne {
+	if b != nil {
+		uuo.SetIsPrivate(*b)
+	}
+	return uuo
+}
+
 // AddPostIDs adds the "posts" edge to the Post entity by IDs.
 func (uuo *UserUpdateOne) AddPostIDs(ids ...uuid.UUID) *UserUpdateOne {
 	uuo.mutation.AddPostIDs(ids...)
@@ -944,6 +1822,66 @@ func (uuo *UserUpdateOne) AddFollowers(f ...*FollowRelation) *UserUpdateOne {
 	return uuo.AddFollowerIDs(ids...)
 }
 
+// AddBlockingIDs adds the "blocking" edge to the Block entity by IDs.
+func (uuo *UserUpdateOne) AddBlockingIDs(ids ...uuid.UUID) *UserUpdateOne {
+	uuo.mutation.AddBlockingIDs(ids...)
+	return uuo
+}
+
+// AddBlocking adds the "blocking" edges to the Block entity.
+func (uuo *UserUpdateOne) AddBlocking(b ...*Block) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return uuo.AddBlockingIDs(ids...)
+}
+
+// AddBlockedByIDs adds the "blocked_by" edge to the Block entity by IDs.
+func (uuo *UserUpdateOne) AddBlockedByIDs(ids ...uuid.UUID) *UserUpdateOne {
+	uuo.mutation.AddBlockedByIDs(ids...)
+	return uuo
+}
+
+// AddBlockedBy adds the "blocked_by" edges to the Block entity.
+func (uuo *UserUpdateOne) AddBlockedBy(b ...*Block) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return uuo.AddBlockedByIDs(ids...)
+}
+
+// AddMutingIDs adds the "muting" edge to the Mute entity by IDs.
+func (uuo *UserUpdateOne) AddMutingIDs(ids ...uuid.UUID) *UserUpdateOne {
+	uuo.mutation.AddMutingIDs(ids...)
+	return uuo
+}
+
+// AddMuting adds the "muting" edges to the Mute entity.
+func (uuo *UserUpdateOne) AddMuting(m ...*Mute) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return uuo.AddMutingIDs(ids...)
+}
+
+// AddMutedByIDs adds the "muted_by" edge to the Mute entity by IDs.
+func (uuo *UserUpdateOne) AddMutedByIDs(ids ...uuid.UUID) *UserUpdateOne {
+	uuo.mutation.AddMutedByIDs(ids...)
+	return uuo
+}
+
+// AddMutedBy adds the "muted_by" edges to the Mute entity.
+func (uuo *UserUpdateOne) AddMutedBy(m ...*Mute) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return uuo.AddMutedByIDs(ids...)
+}
+
 // AddDailyTaskIDs adds the "daily_tasks" edge to the DailyTask entity by IDs.
 func (uuo *UserUpdateOne) AddDailyTaskIDs(ids ...uuid.UUID) *UserUpdateOne {
 	uuo.mutation.AddDailyTaskIDs(ids...)
@@ -959,6 +1897,51 @@ func (uuo *UserUpdateOne) AddDailyTasks(d ...*DailyTask) *UserUpdateOne {
 	return uuo.AddDailyTaskIDs(ids...)
 }
 
+// AddReportIDs adds the "reports" edge to the Report entity by IDs.
+func (uuo *UserUpdateOne) AddReportIDs(ids ...uuid.UUID) *UserUpdateOne {
+	uuo.mutation.AddReportIDs(ids...)
+	return uuo
+}
+
+// AddReports adds the "reports" edges to the Report entity.
+func (uuo *UserUpdateOne) AddReports(r ...*Report) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return uuo.AddReportIDs(ids...)
+}
+
+// AddAuditLogIDs adds the "audit_logs" edge to the AuditLog entity by IDs.
+func (uuo *UserUpdateOne) AddAuditLogIDs(ids ...uuid.UUID) *UserUpdateOne {
+	uuo.mutation.AddAuditLogIDs(ids...)
+	return uuo
+}
+
+// AddAuditLogs adds the "audit_logs" edges to the AuditLog entity.
+func (uuo *UserUpdateOne) AddAuditLogs(a ...*AuditLog) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return uuo.AddAuditLogIDs(ids...)
+}
+
+// AddPostRevisionIDs adds the "post_revisions" edge to the PostRevision entity by IDs.
+func (uuo *UserUpdateOne) AddPostRevisionIDs(ids ...uuid.UUID) *UserUpdateOne {
+	uuo.mutation.AddPostRevisionIDs(ids...)
+	return uuo
+}
+
+// AddPostRevisions adds the "post_revisions" edges to the PostRevision entity.
+func (uuo *UserUpdateOne) AddPostRevisions(p ...*PostRevision) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return uuo.AddPostRevisionIDs(ids...)
+}
+
 // Mutation returns the UserMutation object of the builder.
 func (uuo *UserUpdateOne) Mutation() *UserMutation {
 	return uuo.mutation
@@ -1090,6 +2073,90 @@ func (uuo *UserUpdateOne) RemoveFollowers(f ...*FollowRelation) *UserUpdateOne {
 	return uuo.RemoveFollowerIDs(ids...)
 }
 
+// ClearBlocking clears all "blocking" edges to the Block entity.
+func (uuo *UserUpdateOne) ClearBlocking() *UserUpdateOne {
+	uuo.mutation.ClearBlocking()
+	return uuo
+}
+
+// RemoveBlockingIDs removes the "blocking" edge to Block entities by IDs.
+func (uuo *UserUpdateOne) RemoveBlockingIDs(ids ...uuid.UUID) *UserUpdateOne {
+	uuo.mutation.RemoveBlockingIDs(ids...)
+	return uuo
+}
+
+// RemoveBlocking removes "blocking" edges to Block entities.
+func (uuo *UserUpdateOne) RemoveBlocking(b ...*Block) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return uuo.RemoveBlockingIDs(ids...)
+}
+
+// ClearBlockedBy clears all "blocked_by" edges to the Block entity.
+func (uuo *UserUpdateOne) ClearBlockedBy() *UserUpdateOne {
+	uuo.mutation.ClearBlockedBy()
+	return uuo
+}
+
+// RemoveBlockedByIDs removes the "blocked_by" edge to Block entities by IDs.
+func (uuo *UserUpdateOne) RemoveBlockedByIDs(ids ...uuid.UUID) *UserUpdateOne {
+	uuo.mutation.RemoveBlockedByIDs(ids...)
+	return uuo
+}
+
+// RemoveBlockedBy removes "blocked_by" edges to Block entities.
+func (uuo *UserUpdateOne) RemoveBlockedBy(b ...*Block) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return uuo.RemoveBlockedByIDs(ids...)
+}
+
+// ClearMuting clears all "muting" edges to the Mute entity.
+func (uuo *UserUpdateOne) ClearMuting() *UserUpdateOne {
+	uuo.mutation.ClearMuting()
+	return uuo
+}
+
+// RemoveMutingIDs removes the "muting" edge to Mute entities by IDs.
+func (uuo *UserUpdateOne) RemoveMutingIDs(ids ...uuid.UUID) *UserUpdateOne {
+	uuo.mutation.RemoveMutingIDs(ids...)
+	return uuo
+}
+
+// RemoveMuting removes "muting" edges to Mute entities.
+func (uuo *UserUpdateOne) RemoveMuting(m ...*Mute) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return uuo.RemoveMutingIDs(ids...)
+}
+
+// ClearMutedBy clears all "muted_by" edges to the Mute entity.
+func (uuo *UserUpdateOne) ClearMutedBy() *UserUpdateOne {
+	uuo.mutation.ClearMutedBy()
+	return uuo
+}
+
+// RemoveMutedByIDs removes the "muted_by" edge to Mute entities by IDs.
+func (uuo *UserUpdateOne) RemoveMutedByIDs(ids ...uuid.UUID) *UserUpdateOne {
+	uuo.mutation.RemoveMutedByIDs(ids...)
+	return uuo
+}
+
+// RemoveMutedBy removes "muted_by" edges to Mute entities.
+func (uuo *UserUpdateOne) RemoveMutedBy(m ...*Mute) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return uuo.RemoveMutedByIDs(ids...)
+}
+
 // ClearDailyTasks clears all "daily_tasks" edges to the DailyTask entity.
 func (uuo *UserUpdateOne) ClearDailyTasks() *UserUpdateOne {
 	uuo.mutation.ClearDailyTasks()
@@ -1108,7 +2175,70 @@ func (uuo *UserUpdateOne) RemoveDailyTasks(d ...*DailyTask) *UserUpdateOne {
 	for i := range d {
 		ids[i] = d[i].ID
 	}
-	return uuo.RemoveDailyTaskIDs(ids...)
+	return uuo.RemoveDailyTaskIDs(ids...)
+}
+
+// ClearReports clears all "reports" edges to the Report entity.
+func (uuo *UserUpdateOne) ClearReports() *UserUpdateOne {
+	uuo.mutation.ClearReports()
+	return uuo
+}
+
+// RemoveReportIDs removes the "reports" edge to Report entities by IDs.
+func (uuo *UserUpdateOne) RemoveReportIDs(ids ...uuid.UUID) *UserUpdateOne {
+	uuo.mutation.RemoveReportIDs(ids...)
+	return uuo
+}
+
+// RemoveReports removes "reports" edges to Report entities.
+func (uuo *UserUpdateOne) RemoveReports(r ...*Report) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return uuo.RemoveReportIDs(ids...)
+}
+
+// ClearAuditLogs clears all "audit_logs" edges to the AuditLog entity.
+func (uuo *UserUpdateOne) ClearAuditLogs() *UserUpdateOne {
+	uuo.mutation.ClearAuditLogs()
+	return uuo
+}
+
+// RemoveAuditLogIDs removes the "audit_logs" edge to AuditLog entities by IDs.
+func (uuo *UserUpdateOne) RemoveAuditLogIDs(ids ...uuid.UUID) *UserUpdateOne {
+	uuo.mutation.RemoveAuditLogIDs(ids...)
+	return uuo
+}
+
+// RemoveAuditLogs removes "audit_logs" edges to AuditLog entities.
+func (uuo *UserUpdateOne) RemoveAuditLogs(a ...*AuditLog) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return uuo.RemoveAuditLogIDs(ids...)
+}
+
+// ClearPostRevisions clears all "post_revisions" edges to the PostRevision entity.
+func (uuo *UserUpdateOne) ClearPostRevisions() *UserUpdateOne {
+	uuo.mutation.ClearPostRevisions()
+	return uuo
+}
+
+// RemovePostRevisionIDs removes the "post_revisions" edge to PostRevision entities by IDs.
+func (uuo *UserUpdateOne) RemovePostRevisionIDs(ids ...uuid.UUID) *UserUpdateOne {
+	uuo.mutation.RemovePostRevisionIDs(ids...)
+	return uuo
+}
+
+// RemovePostRevisions removes "post_revisions" edges to PostRevision entities.
+func (uuo *UserUpdateOne) RemovePostRevisions(p ...*PostRevision) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return uuo.RemovePostRevisionIDs(ids...)
 }
 
 // Where appends a list predicates to the UserUpdate builder.
@@ -1163,9 +2293,20 @@ func (uuo *UserUpdateOne) check() error {
 			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "User.name": %w`, err)}
 		}
 	}
+	if v, ok := uuo.mutation.Role(); ok {
+		if err := user.RoleValidator(v); err != nil {
+			return &ValidationError{Name: "role", err: fmt.Errorf(`ent: validator failed for field "User.role": %w`, err)}
+		}
+	}
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (uuo *UserUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *UserUpdateOne {
+	uuo.modifiers = append(uuo.modifiers, modifiers...)
+	return uuo
+}
+
 func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error) {
 	if err := uuo.check(); err != nil {
 		return _node, err
@@ -1201,6 +2342,12 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error)
 	if value, ok := uuo.mutation.Email(); ok {
 		_spec.SetField(user.FieldEmail, field.TypeString, value)
 	}
+	if value, ok := uuo.mutation.AuthSubject(); ok {
+		_spec.SetField(user.FieldAuthSubject, field.TypeString, value)
+	}
+	if uuo.mutation.AuthSubjectCleared() {
+		_spec.ClearField(user.FieldAuthSubject, field.TypeString)
+	}
 	if value, ok := uuo.mutation.Name(); ok {
 		_spec.SetField(user.FieldName, field.TypeString, value)
 	}
@@ -1216,6 +2363,36 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error)
 	if value, ok := uuo.mutation.CreatedAt(); ok {
 		_spec.SetField(user.FieldCreatedAt, field.TypeTime, value)
 	}
+	if value, ok := uuo.mutation.DeletedAt(); ok {
+		_spec.SetField(user.FieldDeletedAt, field.TypeTime, value)
+	}
+	if uuo.mutation.DeletedAtCleared() {
+		_spec.ClearField(user.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := uuo.mutation.PurgedAt(); ok {
+		_spec.SetField(user.FieldPurgedAt, field.TypeTime, value)
+	}
+	if uuo.mutation.PurgedAtCleared() {
+		_spec.ClearField(user.FieldPurgedAt, field.TypeTime)
+	}
+	if value, ok := uuo.mutation.Role(); ok {
+		_spec.SetField(user.FieldRole, field.TypeEnum, value)
+	}
+	if value, ok := uuo.mutation.SuspendedAt(); ok {
+		_spec.SetField(user.FieldSuspendedAt, field.TypeTime, value)
+	}
+	if uuo.mutation.SuspendedAtCleared() {
+		_spec.ClearField(user.FieldSuspendedAt, field.TypeTime)
+	}
+	if value, ok := uuo.mutation.SuspensionReason(); ok {
+		_spec.SetField(user.FieldSuspensionReason, field.TypeString, value)
+	}
+	if uuo.mutation.SuspensionReasonCleared() {
+		_spec.ClearField(user.FieldSuspensionReason, field.TypeString)
+	}
+	if value, ok := uuo.mutation.IsPrivate(); ok {
+		_spec.SetField(user.FieldIsPrivate, field.TypeBool, value)
+	}
 	if uuo.mutation.PostsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -1486,6 +2663,186 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if uuo.mutation.BlockingCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.BlockingTable,
+			Columns: []string{user.BlockingColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(block.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.RemovedBlockingIDs(); len(nodes) > 0 && !uuo.mutation.BlockingCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.BlockingTable,
+			Columns: []string{user.BlockingColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(block.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.BlockingIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.BlockingTable,
+			Columns: []string{user.BlockingColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(block.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uuo.mutation.BlockedByCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.BlockedByTable,
+			Columns: []string{user.BlockedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(block.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.RemovedBlockedByIDs(); len(nodes) > 0 && !uuo.mutation.BlockedByCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.BlockedByTable,
+			Columns: []string{user.BlockedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(block.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.BlockedByIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.BlockedByTable,
+			Columns: []string{user.BlockedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(block.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uuo.mutation.MutingCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.MutingTable,
+			Columns: []string{user.MutingColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(mute.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.RemovedMutingIDs(); len(nodes) > 0 && !uuo.mutation.MutingCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.MutingTable,
+			Columns: []string{user.MutingColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(mute.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.MutingIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.MutingTable,
+			Columns: []string{user.MutingColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(mute.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uuo.mutation.MutedByCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.MutedByTable,
+			Columns: []string{user.MutedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(mute.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.RemovedMutedByIDs(); len(nodes) > 0 && !uuo.mutation.MutedByCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.MutedByTable,
+			Columns: []string{user.MutedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(mute.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.MutedByIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.MutedByTable,
+			Columns: []string{user.MutedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(mute.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if uuo.mutation.DailyTasksCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -1531,6 +2888,142 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if uuo.mutation.ReportsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ReportsTable,
+			Columns: []string{user.ReportsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(report.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.RemovedReportsIDs(); len(nodes) > 0 && !uuo.mutation.ReportsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ReportsTable,
+			Columns: []string{user.ReportsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(report.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.ReportsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ReportsTable,
+			Columns: []string{user.ReportsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(report.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uuo.mutation.AuditLogsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.AuditLogsTable,
+			Columns: []string{user.AuditLogsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(auditlog.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.RemovedAuditLogsIDs(); len(nodes) > 0 && !uuo.mutation.AuditLogsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.AuditLogsTable,
+			Columns: []string{user.AuditLogsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(auditlog.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.AuditLogsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.AuditLogsTable,
+			Columns: []string{user.AuditLogsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(auditlog.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uuo.mutation.PostRevisionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.PostRevisionsTable,
+			Columns: []string{user.PostRevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(postrevision.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.RemovedPostRevisionsIDs(); len(nodes) > 0 && !uuo.mutation.PostRevisionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.PostRevisionsTable,
+			Columns: []string{user.PostRevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(postrevision.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.PostRevisionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.PostRevisionsTable,
+			Columns: []string{user.PostRevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(postrevision.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(uuo.modifiers...)
 	_node = &User{config: uuo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
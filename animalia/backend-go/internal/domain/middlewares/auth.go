@@ -1,14 +1,19 @@
 package middlewares
 
 import (
+	"errors"
 	"net/http"
 	"strings"
 
+	"github.com/aki-13627/animalia/backend-go/internal/domain/models"
 	"github.com/aki-13627/animalia/backend-go/internal/usecase"
 	"github.com/labstack/echo/v4"
 	"github.com/labstack/gommon/log"
 )
 
+// principalKey は認証済みの Principal を保持する echo.Context のキー
+const principalKey = "principal"
+
 type AuthMiddleware struct {
 	authUsecase usecase.AuthUsecase
 }
@@ -23,7 +28,7 @@ func (m *AuthMiddleware) Handler(next echo.HandlerFunc) echo.HandlerFunc {
 	return func(c echo.Context) error {
 		authHeader := c.Request().Header.Get("Authorization")
 		if authHeader == "" {
-			log.Error("Failed to get user email: token is empty")
+			log.Error("Failed to authenticate: token is empty")
 			return c.JSON(http.StatusUnauthorized, map[string]interface{}{
 				"error": "アクセストークンが必要です",
 			})
@@ -37,16 +42,28 @@ func (m *AuthMiddleware) Handler(next echo.HandlerFunc) echo.HandlerFunc {
 			})
 		}
 
-		email, err := m.authUsecase.GetUserEmail(tokenString)
+		principal, err := m.authUsecase.Authenticate(tokenString)
+		if errors.Is(err, usecase.ErrAccountSuspended) {
+			log.Errorf("Failed to authenticate token: %v", err)
+			return c.JSON(http.StatusForbidden, map[string]interface{}{
+				"error": "このアカウントは停止されています",
+			})
+		}
 		if err != nil {
-			log.Errorf("Failed to get user email from token: %v", err)
+			log.Errorf("Failed to authenticate token: %v", err)
 			return c.JSON(http.StatusUnauthorized, map[string]interface{}{
 				"error": "無効なアクセストークンです",
 			})
 		}
 
-		c.Set("email", email)
+		c.Set(principalKey, principal)
 
 		return next(c)
 	}
 }
+
+// CurrentPrincipal は AuthMiddleware が認証したリクエストの主体を返す
+func CurrentPrincipal(c echo.Context) (*models.Principal, bool) {
+	principal, ok := c.Get(principalKey).(*models.Principal)
+	return principal, ok && principal != nil
+}
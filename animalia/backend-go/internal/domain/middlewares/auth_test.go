@@ -1,56 +1,236 @@
 package middlewares
 
 import (
+	"crypto/rand"
+	"crypto/rsa"
 	"errors"
 	"net/http"
 	"net/http/httptest"
-	"strings"
 	"testing"
+	"time"
 
+	"github.com/aki-13627/animalia/backend-go/ent"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/models"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/repository/mock"
+	"github.com/aki-13627/animalia/backend-go/internal/infra"
+	"github.com/aki-13627/animalia/backend-go/internal/usecase"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
 	"github.com/labstack/echo/v4"
+	"github.com/lestrrat-go/jwx/jwk"
 	"github.com/stretchr/testify/assert"
 )
 
-// MockGetUserEmailFunc is a function type for mocking GetUserEmail
-type MockGetUserEmailFunc func(string) (string, error)
+const (
+	testIssuer   = "https://cognito-idp.ap-northeast-1.amazonaws.com/ap-northeast-1_test"
+	testClientId = "test-client-id"
+	testKeyId    = "test-key"
+)
+
+// newTestKeySet はテスト用の RSA 鍵と、その公開鍵だけを含む JWK セットを生成する
+func newTestKeySet(t *testing.T) (*rsa.PrivateKey, jwk.Set) {
+	t.Helper()
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("failed to generate key: %v", err)
+	}
+	key, err := jwk.New(privateKey.Public())
+	if err != nil {
+		t.Fatalf("failed to create JWK: %v", err)
+	}
+	if err := key.Set(jwk.KeyIDKey, testKeyId); err != nil {
+		t.Fatalf("failed to set kid: %v", err)
+	}
+	keySet := jwk.NewSet()
+	keySet.Add(key)
+	return privateKey, keySet
+}
+
+// signToken は claims に署名したトークンを返す
+func signToken(t *testing.T, privateKey *rsa.PrivateKey, kid string, claims jwt.MapClaims) string {
+	t.Helper()
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+	token.Header["kid"] = kid
+	signed, err := token.SignedString(privateKey)
+	if err != nil {
+		t.Fatalf("failed to sign token: %v", err)
+	}
+	return signed
+}
+
+// accessTokenClaims は Cognito のアクセストークンと同じ形のクレームを返す
+func accessTokenClaims(username string) jwt.MapClaims {
+	return jwt.MapClaims{
+		"sub":       "11111111-2222-3333-4444-555555555555",
+		"iss":       testIssuer,
+		"client_id": testClientId,
+		"token_use": "access",
+		"username":  username,
+		"exp":       time.Now().Add(time.Hour).Unix(),
+		"iat":       time.Now().Unix(),
+	}
+}
+
+// idTokenClaims は Cognito の ID トークンと同じ形のクレームを返す
+func idTokenClaims(email string) jwt.MapClaims {
+	return jwt.MapClaims{
+		"sub":       "11111111-2222-3333-4444-555555555555",
+		"iss":       testIssuer,
+		"aud":       testClientId,
+		"token_use": "id",
+		"email":     email,
+		"exp":       time.Now().Add(time.Hour).Unix(),
+		"iat":       time.Now().Unix(),
+	}
+}
+
+// withClaim は claims を複製して key を上書きする
+func withClaim(claims jwt.MapClaims, key string, value interface{}) jwt.MapClaims {
+	copied := jwt.MapClaims{}
+	for k, v := range claims {
+		copied[k] = v
+	}
+	copied[key] = value
+	return copied
+}
 
 // TestAuthMiddleware_Handler tests the auth middleware's Handler method
 func TestAuthMiddleware_Handler(t *testing.T) {
+	privateKey, keySet := newTestKeySet(t)
+	otherKey, _ := newTestKeySet(t)
+	userID := uuid.New()
+
 	// Test cases
 	testCases := []struct {
-		name           string
-		authHeader     string
-		mockToken      string
-		mockEmail      string
-		mockError      error
-		expectedStatus int
-		expectedEmail  string
+		name              string
+		authHeader        func() string
+		cognitoEmail      string
+		expectedStatus    int
+		expectedPrincipal *models.Principal
+		expectCognito     bool
 	}{
 		{
 			name:           "No Authorization header",
-			authHeader:     "",
+			authHeader:     func() string { return "" },
 			expectedStatus: http.StatusUnauthorized,
 		},
 		{
 			name:           "Empty token after trimming Bearer prefix",
-			authHeader:     "Bearer ",
+			authHeader:     func() string { return "Bearer " },
 			expectedStatus: http.StatusUnauthorized,
 		},
 		{
-			name:           "Invalid token",
-			authHeader:     "Bearer invalid-token",
-			mockToken:      "invalid-token",
-			mockError:      errors.New("invalid token"),
+			name:           "Malformed token",
+			authHeader:     func() string { return "Bearer invalid-token" },
 			expectedStatus: http.StatusUnauthorized,
 		},
 		{
-			name:           "Valid token",
-			authHeader:     "Bearer valid-token",
-			mockToken:      "valid-token",
-			mockEmail:      "test@example.com",
-			mockError:      nil,
-			expectedStatus: http.StatusOK,
-			expectedEmail:  "test@example.com",
+			name: "Valid access token",
+			authHeader: func() string {
+				return "Bearer " + signToken(t, privateKey, testKeyId, accessTokenClaims("test@example.com"))
+			},
+			expectedStatus:    http.StatusOK,
+			expectedPrincipal: &models.Principal{UserID: userID, Email: "test@example.com"},
+		},
+		{
+			name: "Valid ID token",
+			authHeader: func() string {
+				return "Bearer " + signToken(t, privateKey, testKeyId, idTokenClaims("test@example.com"))
+			},
+			expectedStatus:    http.StatusOK,
+			expectedPrincipal: &models.Principal{UserID: userID, Email: "test@example.com"},
+		},
+		{
+			name: "Access token with sub as username",
+			authHeader: func() string {
+				return "Bearer " + signToken(t, privateKey, testKeyId, accessTokenClaims("11111111-2222-3333-4444-555555555555"))
+			},
+			cognitoEmail:      "test@example.com",
+			expectedStatus:    http.StatusOK,
+			expectedPrincipal: &models.Principal{UserID: userID, Email: "test@example.com"},
+			expectCognito:     true,
+		},
+		{
+			name: "Expired token",
+			authHeader: func() string {
+				return "Bearer " + signToken(t, privateKey, testKeyId, withClaim(accessTokenClaims("test@example.com"), "exp", time.Now().Add(-time.Minute).Unix()))
+			},
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			name: "Missing exp",
+			authHeader: func() string {
+				claims := accessTokenClaims("test@example.com")
+				delete(claims, "exp")
+				return "Bearer " + signToken(t, privateKey, testKeyId, claims)
+			},
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			name: "Wrong issuer",
+			authHeader: func() string {
+				return "Bearer " + signToken(t, privateKey, testKeyId, withClaim(accessTokenClaims("test@example.com"), "iss", "https://example.com"))
+			},
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			name: "Wrong token_use",
+			authHeader: func() string {
+				return "Bearer " + signToken(t, privateKey, testKeyId, withClaim(accessTokenClaims("test@example.com"), "token_use", "refresh"))
+			},
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			name: "Access token for another client",
+			authHeader: func() string {
+				return "Bearer " + signToken(t, privateKey, testKeyId, withClaim(accessTokenClaims("test@example.com"), "client_id", "other-client"))
+			},
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			name: "ID token for another client",
+			authHeader: func() string {
+				return "Bearer " + signToken(t, privateKey, testKeyId, withClaim(idTokenClaims("test@example.com"), "aud", "other-client"))
+			},
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			name: "Unknown key ID",
+			authHeader: func() string {
+				return "Bearer " + signToken(t, privateKey, "unknown-key", accessTokenClaims("test@example.com"))
+			},
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			name: "Signed with another key",
+			authHeader: func() string {
+				return "Bearer " + signToken(t, otherKey, testKeyId, accessTokenClaims("test@example.com"))
+			},
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			name: "HMAC signed token",
+			authHeader: func() string {
+				token := jwt.NewWithClaims(jwt.SigningMethodHS256, accessTokenClaims("test@example.com"))
+				token.Header["kid"] = testKeyId
+				signed, _ := token.SignedString([]byte("secret"))
+				return "Bearer " + signed
+			},
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			name: "Unknown user",
+			authHeader: func() string {
+				return "Bearer " + signToken(t, privateKey, testKeyId, accessTokenClaims("unknown@example.com"))
+			},
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			name: "Suspended user",
+			authHeader: func() string {
+				return "Bearer " + signToken(t, privateKey, testKeyId, accessTokenClaims("suspended@example.com"))
+			},
+			expectedStatus: http.StatusForbidden,
 		},
 	}
 
@@ -63,77 +243,61 @@ func TestAuthMiddleware_Handler(t *testing.T) {
 			c := e.NewContext(req, rec)
 
 			// Set Authorization header if provided
-			if tc.authHeader != "" {
-				req.Header.Set("Authorization", tc.authHeader)
+			if header := tc.authHeader(); header != "" {
+				req.Header.Set("Authorization", header)
 			}
 
-			// Create a mock GetUserEmail function
-			getUserEmailCalled := false
-			var tokenPassed string
-			mockGetUserEmail := func(token string) (string, error) {
-				getUserEmailCalled = true
-				tokenPassed = token
-				if tc.mockToken != "" && token == tc.mockToken {
-					return tc.mockEmail, tc.mockError
-				}
-				return "", errors.New("unexpected token")
+			cognitoCalled := false
+			mockAuthRepo := &mock.MockAuthRepository{
+				GetUserEmailFunc: func(accessToken string) (string, error) {
+					cognitoCalled = true
+					return tc.cognitoEmail, nil
+				},
 			}
+			mockUserRepo := &mock.MockUserRepository{
+				// sub がまだ記録されていないユーザーとして、メールアドレスで探す
+				FindBySubjectFunc: func(subject string) (*ent.User, error) {
+					return nil, &ent.NotFoundError{}
+				},
+				LinkSubjectFunc: func(id uuid.UUID, subject string) error {
+					return nil
+				},
+				FindByEmailFunc: func(email string) (*ent.User, error) {
+					switch email {
+					case "test@example.com":
+						return &ent.User{ID: userID, Email: email}, nil
+					case "suspended@example.com":
+						return &ent.User{ID: userID, Email: email, SuspendedAt: time.Now()}, nil
+					}
+					return nil, errors.New("user not found")
+				},
+			}
+			verifier := infra.NewJWTVerifier(func() (jwk.Set, error) {
+				return keySet, nil
+			}, testIssuer, testClientId)
+			middleware := NewAuthMiddleware(*usecase.NewAuthUsecase(mockAuthRepo, mockUserRepo, verifier))
 
 			// Create a handler function that will be called if the middleware passes
 			handlerCalled := false
-			var emailFromContext string
+			var principal *models.Principal
 			handler := func(c echo.Context) error {
 				handlerCalled = true
-				emailFromContext = c.Get("email").(string)
+				principal, _ = CurrentPrincipal(c)
 				return c.NoContent(http.StatusOK)
 			}
 
-			// Create and execute the middleware directly
-			middlewareFunc := func(next echo.HandlerFunc) echo.HandlerFunc {
-				return func(c echo.Context) error {
-					authHeader := c.Request().Header.Get("Authorization")
-					if authHeader == "" {
-						return c.JSON(http.StatusUnauthorized, map[string]interface{}{
-							"error": "アクセストークンが必要です",
-						})
-					}
-
-					tokenString := strings.TrimPrefix(authHeader, "Bearer ")
-					if tokenString == "" {
-						return c.JSON(http.StatusUnauthorized, map[string]interface{}{
-							"error": "アクセストークンが必要です",
-						})
-					}
-
-					email, err := mockGetUserEmail(tokenString)
-					if err != nil {
-						return c.JSON(http.StatusUnauthorized, map[string]interface{}{
-							"error": "無効なアクセストークンです",
-						})
-					}
-
-					c.Set("email", email)
-
-					return next(c)
-				}
-			}
-
 			// Execute middleware
-			err := middlewareFunc(handler)(c)
+			err := middleware.Handler(handler)(c)
 
 			// Assertions
 			assert.NoError(t, err)
 			assert.Equal(t, tc.expectedStatus, rec.Code)
-
-			if tc.authHeader != "" && tc.authHeader != "Bearer " {
-				assert.True(t, getUserEmailCalled, "GetUserEmail should have been called")
-				assert.Equal(t, strings.TrimPrefix(tc.authHeader, "Bearer "), tokenPassed, "Unexpected token passed to GetUserEmail")
-			}
+			assert.Equal(t, tc.expectCognito, cognitoCalled, "Cognito should only be called when the token has no email")
 
 			if tc.expectedStatus == http.StatusOK {
 				// If we expect the middleware to pass, the handler should have been called
 				assert.True(t, handlerCalled, "Handler should have been called")
-				assert.Equal(t, tc.expectedEmail, emailFromContext, "Unexpected email in context")
+				assert.Equal(t, tc.expectedPrincipal, principal, "Unexpected principal in context")
 			} else {
 				// If we expect the middleware to fail, the handler should not have been called
 				assert.False(t, handlerCalled, "Handler should not have been called")
@@ -141,3 +305,105 @@ func TestAuthMiddleware_Handler(t *testing.T) {
 		})
 	}
 }
+
+// TestAuthMiddleware_PasswordProvider tests the auth middleware with tokens issued by the built-in password provider
+func TestAuthMiddleware_PasswordProvider(t *testing.T) {
+	secret := []byte("test-secret")
+	privateKey, _ := newTestKeySet(t)
+	userID := uuid.New()
+
+	passwordClaims := func(tokenUse string) jwt.MapClaims {
+		claims := jwt.MapClaims{
+			"sub":       uuid.NewString(),
+			"iss":       "animalia",
+			"token_use": tokenUse,
+			"exp":       time.Now().Add(time.Hour).Unix(),
+		}
+		if tokenUse == "id" {
+			claims["aud"] = infra.PasswordAuthClientId
+			claims["email"] = "test@example.com"
+		} else {
+			claims["client_id"] = infra.PasswordAuthClientId
+			claims["username"] = "test@example.com"
+		}
+		return claims
+	}
+	signHMAC := func(claims jwt.MapClaims, key []byte) string {
+		signed, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString(key)
+		if err != nil {
+			t.Fatalf("failed to sign token: %v", err)
+		}
+		return signed
+	}
+
+	// Test cases
+	testCases := []struct {
+		name           string
+		token          string
+		expectedStatus int
+	}{
+		{
+			name:           "Valid access token",
+			token:          signHMAC(passwordClaims("access"), secret),
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "Valid ID token",
+			token:          signHMAC(passwordClaims("id"), secret),
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "Refresh token used as access token",
+			token:          signHMAC(passwordClaims("refresh"), secret),
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			name:           "Signed with another secret",
+			token:          signHMAC(passwordClaims("access"), []byte("other-secret")),
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			name:           "RS256 token",
+			token:          signToken(t, privateKey, testKeyId, passwordClaims("access")),
+			expectedStatus: http.StatusUnauthorized,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			req.Header.Set("Authorization", "Bearer "+tc.token)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+
+			mockUserRepo := &mock.MockUserRepository{
+				// sub がまだ記録されていないユーザーとして、メールアドレスで探す
+				FindBySubjectFunc: func(subject string) (*ent.User, error) {
+					return nil, &ent.NotFoundError{}
+				},
+				LinkSubjectFunc: func(id uuid.UUID, subject string) error {
+					return nil
+				},
+				FindByEmailFunc: func(email string) (*ent.User, error) {
+					return &ent.User{ID: userID, Email: email}, nil
+				},
+			}
+			verifier := infra.NewHMACJWTVerifier(secret, "animalia", infra.PasswordAuthClientId)
+			middleware := NewAuthMiddleware(*usecase.NewAuthUsecase(&mock.MockAuthRepository{}, mockUserRepo, verifier))
+
+			// Execute middleware
+			err := middleware.Handler(func(c echo.Context) error {
+				principal, ok := CurrentPrincipal(c)
+				assert.True(t, ok)
+				assert.Equal(t, userID, principal.UserID)
+				return c.NoContent(http.StatusOK)
+			})(c)
+
+			// Assertions
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedStatus, rec.Code)
+		})
+	}
+}
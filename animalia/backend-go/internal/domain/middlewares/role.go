@@ -0,0 +1,31 @@
+package middlewares
+
+import (
+	"net/http"
+	"slices"
+
+	"github.com/aki-13627/animalia/backend-go/ent/user"
+	"github.com/labstack/echo/v4"
+	"github.com/labstack/gommon/log"
+)
+
+// RequireRole は認証済みユーザーのロールが roles のいずれかでなければ 403 を返す。AuthMiddleware の後に使う
+func RequireRole(roles ...user.Role) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			principal, ok := CurrentPrincipal(c)
+			if !ok {
+				return c.JSON(http.StatusUnauthorized, map[string]interface{}{
+					"error": "アクセストークンが必要です",
+				})
+			}
+			if !slices.Contains(roles, principal.Role) {
+				log.Errorf("Failed to authorize %s: role %q is not allowed", principal.UserID, principal.Role)
+				return c.JSON(http.StatusForbidden, map[string]interface{}{
+					"error": "この操作を行う権限がありません",
+				})
+			}
+			return next(c)
+		}
+	}
+}
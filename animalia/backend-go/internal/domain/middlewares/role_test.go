@@ -0,0 +1,67 @@
+package middlewares
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/aki-13627/animalia/backend-go/ent/user"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/models"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestRequireRole tests that RequireRole only lets allowed roles through
+func TestRequireRole(t *testing.T) {
+	// Test cases
+	testCases := []struct {
+		name           string
+		principal      *models.Principal
+		expectedStatus int
+	}{
+		{
+			name:           "No principal",
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			name:           "User",
+			principal:      &models.Principal{UserID: uuid.New(), Role: user.RoleUser},
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name:           "Moderator",
+			principal:      &models.Principal{UserID: uuid.New(), Role: user.RoleModerator},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "Admin",
+			principal:      &models.Principal{UserID: uuid.New(), Role: user.RoleAdmin},
+			expectedStatus: http.StatusOK,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			if tc.principal != nil {
+				c.Set(principalKey, tc.principal)
+			}
+
+			handlerCalled := false
+			err := RequireRole(user.RoleModerator, user.RoleAdmin)(func(c echo.Context) error {
+				handlerCalled = true
+				return c.NoContent(http.StatusOK)
+			})(c)
+
+			// Assertions
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedStatus, rec.Code)
+			assert.Equal(t, tc.expectedStatus == http.StatusOK, handlerCalled)
+		})
+	}
+}
@@ -0,0 +1,53 @@
+package middlewares
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+	"github.com/labstack/gommon/log"
+)
+
+// SignedURLVerifier は署名付き URL の検証を行う
+type SignedURLVerifier interface {
+	VerifySignedURL(method, fileKey, expires, signature string) error
+}
+
+type SignedURLMiddleware struct {
+	verifier SignedURLVerifier
+	prefix   string
+}
+
+func NewSignedURLMiddleware(verifier SignedURLVerifier, prefix string) *SignedURLMiddleware {
+	return &SignedURLMiddleware{
+		verifier: verifier,
+		prefix:   prefix,
+	}
+}
+
+func (m *SignedURLMiddleware) Handler(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		fileKey := strings.TrimPrefix(c.Request().URL.Path, m.prefix)
+		expires := c.QueryParam("expires")
+		signature := c.QueryParam("signature")
+		if fileKey == "" || expires == "" || signature == "" {
+			log.Error("Failed to verify signed url: missing parameters")
+			return c.JSON(http.StatusForbidden, map[string]interface{}{
+				"error": "署名付きURLが不正です",
+			})
+		}
+
+		method := c.Request().Method
+		if method == http.MethodHead {
+			method = http.MethodGet
+		}
+		if err := m.verifier.VerifySignedURL(method, fileKey, expires, signature); err != nil {
+			log.Errorf("Failed to verify signed url: %v", err)
+			return c.JSON(http.StatusForbidden, map[string]interface{}{
+				"error": "署名付きURLが不正です",
+			})
+		}
+
+		return next(c)
+	}
+}
@@ -0,0 +1,13 @@
+package models
+
+import "time"
+
+// AccountPurgeReport は退会したアカウントの削除の結果
+type AccountPurgeReport struct {
+	StartedAt      time.Time `json:"startedAt"`
+	GracePeriod    string    `json:"gracePeriod"`
+	PurgedAccounts int       `json:"purgedAccounts"`
+	FailedAccounts []string  `json:"failedAccounts"`
+	DeletedObjects int       `json:"deletedObjects"`
+	FailedObjects  []string  `json:"failedObjects"`
+}
@@ -0,0 +1,124 @@
+package models
+
+import (
+	"time"
+
+	"github.com/aki-13627/animalia/backend-go/ent"
+	"github.com/aki-13627/animalia/backend-go/ent/report"
+	"github.com/aki-13627/animalia/backend-go/ent/user"
+	"github.com/google/uuid"
+)
+
+// 監査ログの action
+const (
+	AuditActionSuspendUser   = "user.suspend"
+	AuditActionUnsuspendUser = "user.unsuspend"
+	AuditActionSetRole       = "user.set_role"
+	AuditActionDeletePost    = "post.delete"
+	AuditActionDeleteComment = "comment.delete"
+	AuditActionDismissReport = "report.dismiss"
+	AuditActionHideReported  = "report.hide"
+	AuditActionSuspendAuthor = "report.suspend_author"
+	AuditActionAutoHide      = "report.auto_hide"
+)
+
+// AdminUserResponse は管理画面で表示するユーザー
+type AdminUserResponse struct {
+	ID               uuid.UUID  `json:"id"`
+	Name             string     `json:"name"`
+	Email            string     `json:"email"`
+	Role             user.Role  `json:"role"`
+	SuspendedAt      *time.Time `json:"suspendedAt,omitempty"`
+	SuspensionReason string     `json:"suspensionReason,omitempty"`
+	DeletedAt        *time.Time `json:"deletedAt,omitempty"`
+	CreatedAt        time.Time  `json:"createdAt"`
+}
+
+func NewAdminUserResponse(u *ent.User) AdminUserResponse {
+	return AdminUserResponse{
+		ID:               u.ID,
+		Name:             u.Name,
+		Email:            u.Email,
+		Role:             u.Role,
+		SuspendedAt:      optionalTime(u.SuspendedAt),
+		SuspensionReason: u.SuspensionReason,
+		DeletedAt:        optionalTime(u.DeletedAt),
+		CreatedAt:        u.CreatedAt,
+	}
+}
+
+type ReportResponse struct {
+	ID         uuid.UUID         `json:"id"`
+	ReporterID *uuid.UUID        `json:"reporterId,omitempty"`
+	TargetType report.TargetType `json:"targetType"`
+	TargetID   uuid.UUID         `json:"targetId"`
+	Reason     report.Reason     `json:"reason"`
+	Detail     string            `json:"detail"`
+	Status     report.Status     `json:"status"`
+	CreatedAt  time.Time         `json:"createdAt"`
+	ReviewedAt *time.Time        `json:"reviewedAt,omitempty"`
+}
+
+func NewReportResponse(r *ent.Report) ReportResponse {
+	resp := ReportResponse{
+		ID:         r.ID,
+		TargetType: r.TargetType,
+		TargetID:   r.TargetID,
+		Reason:     r.Reason,
+		Detail:     r.Detail,
+		Status:     r.Status,
+		CreatedAt:  r.CreatedAt,
+		ReviewedAt: optionalTime(r.ReviewedAt),
+	}
+	if r.Edges.Reporter != nil {
+		resp.ReporterID = &r.Edges.Reporter.ID
+	}
+	return resp
+}
+
+// ReportDecision はモデレーターによる通報の処理内容
+type ReportDecision struct {
+	// Action は監査ログに記録する action
+	Action string
+	Status report.Status
+	// Hidden は対象の投稿・コメントを非表示にするか、非表示を解除するか。ユーザーへの通報では使わない
+	Hidden bool
+	// SuspendUserID が設定されていれば、そのユーザーのアカウントを停止する
+	SuspendUserID *uuid.UUID
+	Note          string
+}
+
+type AuditLogResponse struct {
+	ID         uuid.UUID  `json:"id"`
+	ActorID    *uuid.UUID `json:"actorId,omitempty"`
+	ActorName  string     `json:"actorName,omitempty"`
+	Action     string     `json:"action"`
+	TargetType string     `json:"targetType"`
+	TargetID   string     `json:"targetId"`
+	Detail     string     `json:"detail,omitempty"`
+	CreatedAt  time.Time  `json:"createdAt"`
+}
+
+func NewAuditLogResponse(l *ent.AuditLog) AuditLogResponse {
+	resp := AuditLogResponse{
+		ID:         l.ID,
+		Action:     l.Action,
+		TargetType: l.TargetType,
+		TargetID:   l.TargetID,
+		Detail:     l.Detail,
+		CreatedAt:  l.CreatedAt,
+	}
+	if l.Edges.Actor != nil {
+		resp.ActorID = &l.Edges.Actor.ID
+		resp.ActorName = l.Edges.Actor.Name
+	}
+	return resp
+}
+
+// optionalTime は未設定の日時を nil にする
+func optionalTime(t time.Time) *time.Time {
+	if t.IsZero() {
+		return nil
+	}
+	return &t
+}
@@ -2,9 +2,19 @@ package models
 
 import (
 	"github.com/aki-13627/animalia/backend-go/ent"
+	"github.com/aki-13627/animalia/backend-go/ent/followrelation"
 	"github.com/google/uuid"
 )
 
+// AuthTokens は認証プロバイダがサインイン・トークン更新で発行するトークン
+type AuthTokens struct {
+	AccessToken string
+	IdToken     string
+	// RefreshToken はトークン更新時には空のことがある
+	RefreshToken string
+	ExpiresIn    int
+}
+
 type RefreshTokenResponse struct {
 	AccessToken string
 	IdToken     string
@@ -24,6 +34,7 @@ type UserResponse struct {
 	Name           string             `json:"name"`
 	Bio            string             `json:"bio"`
 	IconImageUrl   string             `json:"iconImageUrl"`
+	IconImageUrls  *ImageURLs         `json:"iconImageUrls"`
 	Posts          []PostResponse     `json:"posts"`
 	Pets           []PetResponse      `json:"pets"`
 	Followers      []UserBaseResponse `json:"followers"`
@@ -31,22 +42,34 @@ type UserResponse struct {
 	FollowersCount int                `json:"followersCount"`
 	FollowsCount   int                `json:"followsCount"`
 	DailyTask      DailyTaskResponse  `json:"dailyTask"`
+	IsPrivate      bool               `json:"isPrivate"`
+	// FollowStatus は閲覧者から見たフォロー関係（pending / approved）。フォローしていなければ省略する
+	FollowStatus followrelation.Status `json:"followStatus,omitempty"`
+	// PostsNextCursor は Posts の続きを GET /users/:id/posts で取得するためのカーソル。続きがなければ省略する
+	PostsNextCursor string `json:"postsNextCursor,omitempty"`
+	// Restricted は非公開アカウントのため基本情報だけを返していることを示す
+	Restricted bool `json:"restricted,omitempty"`
 }
 
 // NewPetResponse converts a Pet to a PetResponse
 func NewUserResponse(
 	user *ent.User,
-	imageURL string,
+	imageURLs *ImageURLs,
 	posts []PostResponse,
 	pets []PetResponse,
 	followers []UserBaseResponse,
 	follows []UserBaseResponse,
 	dailyTask DailyTaskResponse) UserResponse {
+	var imageURL string
+	if imageURLs != nil {
+		imageURL = imageURLs.Full
+	}
 	return UserResponse{
 		ID:             user.ID,
 		Name:           user.Name,
 		Bio:            user.Bio,
 		IconImageUrl:   imageURL,
+		IconImageUrls:  imageURLs,
 		Posts:          posts,
 		Pets:           pets,
 		Followers:      followers,
@@ -54,6 +77,30 @@ func NewUserResponse(
 		FollowersCount: len(followers),
 		FollowsCount:   len(follows),
 		DailyTask:      dailyTask,
+		IsPrivate:      user.IsPrivate,
+	}
+}
+
+// NewRestrictedUserResponse は非公開アカウントを承認済みのフォロワー以外が見たときの基本情報だけのプロフィールを返す
+func NewRestrictedUserResponse(user *ent.User, imageURLs *ImageURLs, followersCount, followsCount int) UserResponse {
+	var imageURL string
+	if imageURLs != nil {
+		imageURL = imageURLs.Full
+	}
+	return UserResponse{
+		ID:             user.ID,
+		Name:           user.Name,
+		Bio:            user.Bio,
+		IconImageUrl:   imageURL,
+		IconImageUrls:  imageURLs,
+		Posts:          []PostResponse{},
+		Pets:           []PetResponse{},
+		Followers:      []UserBaseResponse{},
+		Follows:        []UserBaseResponse{},
+		FollowersCount: followersCount,
+		FollowsCount:   followsCount,
+		IsPrivate:      true,
+		Restricted:     true,
 	}
 }
 
@@ -22,7 +22,9 @@ type FastAPIPost struct {
 
 func NewPostResponseFromFastAPI(
 	post FastAPIPost,
-	imageURL string,
+	mediaItems []models.MediaItem,
+	imageURLs map[string]models.ImageURLs,
+	videoURLs map[string]string,
 	userIconURL *string,
 	commentResponses []models.CommentResponse,
 	likeResponses []models.LikeResponse,
@@ -41,10 +43,19 @@ func NewPostResponseFromFastAPI(
 		fmt.Printf("failed to parse UUID: %v\n", err)
 	}
 
+	if len(mediaItems) == 0 {
+		mediaItems = []models.MediaItem{{Type: models.MediaTypeImage, Key: post.ImageKey}}
+	}
+	media := models.NewPostMediaResponses(mediaItems, imageURLs, videoURLs)
+
 	return models.PostResponse{
-		ID:       uuid.MustParse(post.ID),
-		Caption:  post.Caption,
-		ImageURL: imageURL,
+		ID:        uuid.MustParse(post.ID),
+		Caption:   post.Caption,
+		ImageURL:  imageURLs[post.ImageKey].Full,
+		ImageURLs: imageURLs[post.ImageKey],
+		VideoURL:  media[0].VideoURL,
+		PosterURL: media[0].PosterURL,
+		Media:     media,
 		User: models.UserBaseResponse{
 			ID:           UserID,
 			Email:        post.User.Email,
@@ -52,9 +63,9 @@ func NewPostResponseFromFastAPI(
 			Bio:          post.User.Bio,
 			IconImageUrl: userIconURL,
 		},
-		Comments:      commentResponses,
+		Comments:      models.PreviewComments(commentResponses),
 		CommentsCount: len(commentResponses),
-		Likes:         likeResponses,
+		Likes:         models.PreviewLikes(likeResponses),
 		LikesCount:    len(likeResponses),
 		CreatedAt:     CreatedAt,
 		DailyTask:     dailyTaskResponse,
@@ -0,0 +1,31 @@
+package models
+
+import "strings"
+
+type ImageVariant string
+
+const (
+	ImageVariantThumb  ImageVariant = "thumb"
+	ImageVariantMedium ImageVariant = "medium"
+	ImageVariantFull   ImageVariant = "full"
+)
+
+// ImageVariants は生成するバリアントの一覧（小さい順）
+var ImageVariants = []ImageVariant{ImageVariantThumb, ImageVariantMedium, ImageVariantFull}
+
+// ImageURLs は画像バリアントごとの URL
+type ImageURLs struct {
+	Thumb  string `json:"thumb"`
+	Medium string `json:"medium"`
+	Full   string `json:"full"`
+}
+
+// ImageVariantKey は保存済みの画像キー（full バリアント）から指定したバリアントのキーを返す。
+// パイプライン導入前にアップロードされた画像はバリアントを持たないため、元のキーをそのまま返す。
+func ImageVariantKey(fileKey string, variant ImageVariant) string {
+	base, ok := strings.CutSuffix(fileKey, "/"+string(ImageVariantFull)+".jpg")
+	if !ok {
+		return fileKey
+	}
+	return base + "/" + string(variant) + ".jpg"
+}
@@ -0,0 +1,27 @@
+package models
+
+import "time"
+
+// OrphanedImage は DB から参照されていないストレージ上のオブジェクト
+type OrphanedImage struct {
+	Key          string    `json:"key"`
+	Size         int64     `json:"size"`
+	LastModified time.Time `json:"lastModified"`
+	Deleted      bool      `json:"deleted"`
+	Error        string    `json:"error,omitempty"`
+}
+
+// ImageGCReport は孤立画像のガベージコレクションの結果
+type ImageGCReport struct {
+	StartedAt     time.Time       `json:"startedAt"`
+	DryRun        bool            `json:"dryRun"`
+	GracePeriod   string          `json:"gracePeriod"`
+	Prefixes      []string        `json:"prefixes"`
+	Scanned       int             `json:"scanned"`
+	Referenced    int             `json:"referenced"`
+	SkippedRecent int             `json:"skippedRecent"`
+	Orphans       []OrphanedImage `json:"orphans"`
+	OrphanBytes   int64           `json:"orphanBytes"`
+	Deleted       int             `json:"deleted"`
+	Failed        int             `json:"failed"`
+}
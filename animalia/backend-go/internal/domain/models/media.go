@@ -0,0 +1,34 @@
+package models
+
+import "time"
+
+type MediaType string
+
+const (
+	MediaTypeImage MediaType = "image"
+	MediaTypeVideo MediaType = "video"
+)
+
+// MediaItem は投稿に添付された画像もしくは動画
+type MediaItem struct {
+	Type MediaType
+	// 画像の場合は full バリアントのキー、動画の場合は動画ファイルのキー
+	Key string
+	// 動画のポスターフレームのキー（画像の場合は空）
+	PosterKey string
+}
+
+// PreviewKey は表示に使う画像のキーを返す。動画の場合はポスターフレーム。
+func (m MediaItem) PreviewKey() string {
+	if m.Type == MediaTypeVideo {
+		return m.PosterKey
+	}
+	return m.Key
+}
+
+// VideoInfo は ffprobe で取得した動画の情報
+type VideoInfo struct {
+	Duration time.Duration
+	Width    int
+	Height   int
+}
@@ -16,20 +16,22 @@ type PetResponse struct {
 	Type      pet.Type    `json:"type"`
 	Species   pet.Species `json:"species"`
 	ImageURL  string      `json:"imageUrl"`
+	ImageURLs ImageURLs   `json:"imageUrls"`
 	OwnerID   uuid.UUID   `json:"ownerId"`
 	Owner     *ent.User   `json:"owner,omitempty"`
 	CreatedAt time.Time   `json:"createdAt"`
 }
 
 // NewPetResponse converts a Pet to a PetResponse
-func NewPetResponse(pet *ent.Pet, imageURL string) PetResponse {
+func NewPetResponse(pet *ent.Pet, imageURLs ImageURLs) PetResponse {
 	return PetResponse{
 		ID:        pet.ID,
 		Name:      pet.Name,
 		BirthDay:  pet.BirthDay,
 		Type:      pet.Type,
 		Species:   pet.Species,
-		ImageURL:  imageURL,
+		ImageURL:  imageURLs.Full,
+		ImageURLs: imageURLs,
 		CreatedAt: pet.CreatedAt,
 	}
 }
@@ -1,12 +1,24 @@
 package models
 
 import (
+	"slices"
 	"time"
 
 	"github.com/aki-13627/animalia/backend-go/ent"
+	"github.com/aki-13627/animalia/backend-go/ent/post"
 	"github.com/google/uuid"
 )
 
+// PostPreviewSize は投稿のレスポンスに含めるコメント・いいねの件数。
+// 全件は GET /posts/:id/comments と GET /posts/:id/likes で取得する
+const PostPreviewSize = 3
+
+// 投稿の一覧・詳細のクエリでコメント・いいねの件数を選択する列の名前
+const (
+	PostCommentsCountColumn = "comments_count"
+	PostLikesCountColumn    = "likes_count"
+)
+
 type PostBaseResponse struct {
 	ID uuid.UUID `json:"id"`
 }
@@ -16,12 +28,71 @@ type PostResponse struct {
 	Caption       string                 `json:"caption"`
 	User          UserBaseResponse       `json:"user"`
 	ImageURL      string                 `json:"imageUrl"`
+	ImageURLs     ImageURLs              `json:"imageUrls"`
+	VideoURL      string                 `json:"videoUrl,omitempty"`
+	PosterURL     string                 `json:"posterUrl,omitempty"`
+	Media         []PostMediaResponse    `json:"media"`
 	CreatedAt     time.Time              `json:"createdAt"`
 	Comments      []CommentResponse      `json:"comments"`
 	CommentsCount int                    `json:"commentsCount"`
 	Likes         []LikeResponse         `json:"likes"`
 	LikesCount    int                    `json:"likesCount"`
 	DailyTask     *DailyTaskBaseResponse `json:"dailyTask"`
+	// EditedAt はキャプションを編集した日時。編集されていなければ省略する
+	EditedAt *time.Time `json:"editedAt,omitempty"`
+	// Visibility は推薦 API 経由の投稿では空になる
+	Visibility post.Visibility `json:"visibility,omitempty"`
+}
+
+// PostMediaResponse は投稿に含まれる画像もしくは動画の1件分。Position の昇順に並ぶ。
+// 動画の場合、ImageURL/ImageURLs はポスターフレームを指す。
+type PostMediaResponse struct {
+	Type      MediaType `json:"type"`
+	Position  int       `json:"position"`
+	ImageURL  string    `json:"imageUrl"`
+	ImageURLs ImageURLs `json:"imageUrls"`
+	VideoURL  string    `json:"videoUrl,omitempty"`
+	PosterURL string    `json:"posterUrl,omitempty"`
+}
+
+// PostMediaItems は投稿の画像・動画を表示順に返す。
+// media エッジが読み込まれていない場合はカバー画像だけを返す。
+func PostMediaItems(post *ent.Post) []MediaItem {
+	if len(post.Edges.Media) == 0 {
+		return []MediaItem{{Type: MediaTypeImage, Key: post.ImageKey}}
+	}
+	media := slices.Clone(post.Edges.Media)
+	slices.SortFunc(media, func(a, b *ent.PostMedia) int {
+		return a.Position - b.Position
+	})
+	items := make([]MediaItem, len(media))
+	for i, m := range media {
+		items[i] = MediaItem{
+			Type:      MediaType(m.Type),
+			Key:       m.ImageKey,
+			PosterKey: m.PosterKey,
+		}
+	}
+	return items
+}
+
+// NewPostMediaResponses は画像・動画の並びから media のレスポンスを組み立てる
+func NewPostMediaResponses(items []MediaItem, imageURLs map[string]ImageURLs, videoURLs map[string]string) []PostMediaResponse {
+	media := make([]PostMediaResponse, len(items))
+	for i, item := range items {
+		preview := imageURLs[item.PreviewKey()]
+		media[i] = PostMediaResponse{
+			Type:      item.Type,
+			Position:  i,
+			ImageURL:  preview.Full,
+			ImageURLs: preview,
+		}
+		if item.Type == MediaTypeVideo {
+			media[i].VideoURL = videoURLs[item.Key]
+			media[i].PosterURL = preview.Full
+		}
+	}
+	return media
 }
 
 func NewPostBaseResponse(post *ent.Post) PostBaseResponse {
@@ -30,9 +101,13 @@ func NewPostBaseResponse(post *ent.Post) PostBaseResponse {
 	}
 }
 
+// NewPostResponse は投稿のレスポンスを組み立てる。
+// imageURLs には PostMediaItems の各 PreviewKey、videoURLs には各動画のキーの URL が含まれている必要がある。
+// comments・likes は読み込んだプレビューで、件数はクエリで選択した PostCommentsCountColumn・PostLikesCountColumn を使う。
 func NewPostResponse(
 	post *ent.Post,
-	postImageURL string,
+	imageURLs map[string]ImageURLs,
+	videoURLs map[string]string,
 	userImageURL string,
 	comments []CommentResponse,
 	likes []LikeResponse,
@@ -46,16 +121,79 @@ func NewPostResponse(
 		resp := NewDailyTaskBaseResponse(post.Edges.DailyTask)
 		dailyTaskResp = &resp
 	}
+	media := NewPostMediaResponses(PostMediaItems(post), imageURLs, videoURLs)
 	return PostResponse{
 		ID:            post.ID,
 		Caption:       post.Caption,
 		User:          NewUserBaseResponse(user, userImageURL),
-		ImageURL:      postImageURL,
+		ImageURL:      imageURLs[post.ImageKey].Full,
+		ImageURLs:     imageURLs[post.ImageKey],
+		VideoURL:      media[0].VideoURL,
+		PosterURL:     media[0].PosterURL,
+		Media:         media,
 		CreatedAt:     post.CreatedAt,
-		Comments:      comments,
-		CommentsCount: len(comments),
-		Likes:         likes,
-		LikesCount:    len(likes),
+		Comments:      PreviewComments(comments),
+		CommentsCount: postCount(post, PostCommentsCountColumn, len(comments)),
+		Likes:         PreviewLikes(likes),
+		LikesCount:    postCount(post, PostLikesCountColumn, len(likes)),
 		DailyTask:     dailyTaskResp,
+		EditedAt:      optionalTime(post.EditedAt),
+		Visibility:    post.Visibility,
+	}
+}
+
+// postCount はクエリで選択した column の件数を返す。選択していなければ loaded を返す
+func postCount(post *ent.Post, column string, loaded int) int {
+	value, err := post.Value(column)
+	if err != nil {
+		return loaded
+	}
+	count, ok := value.(int64)
+	if !ok {
+		return loaded
+	}
+	return int(count)
+}
+
+// PreviewComments は新しい PostPreviewSize 件のコメントを古い順に返す
+func PreviewComments(comments []CommentResponse) []CommentResponse {
+	return latest(comments, func(c CommentResponse) time.Time { return c.CreatedAt })
+}
+
+// PreviewLikes は新しい PostPreviewSize 件のいいねを古い順に返す
+func PreviewLikes(likes []LikeResponse) []LikeResponse {
+	return latest(likes, func(l LikeResponse) time.Time { return l.CreatedAt })
+}
+
+func latest[T any](items []T, createdAt func(T) time.Time) []T {
+	sorted := slices.Clone(items)
+	slices.SortStableFunc(sorted, func(a, b T) int {
+		return createdAt(a).Compare(createdAt(b))
+	})
+	if len(sorted) > PostPreviewSize {
+		sorted = sorted[len(sorted)-PostPreviewSize:]
+	}
+	return sorted
+}
+
+// PostRevisionResponse は投稿のキャプションの1つの版
+type PostRevisionResponse struct {
+	ID         uuid.UUID  `json:"id"`
+	Caption    string     `json:"caption"`
+	EditorID   *uuid.UUID `json:"editorId,omitempty"`
+	EditorName string     `json:"editorName,omitempty"`
+	CreatedAt  time.Time  `json:"createdAt"`
+}
+
+func NewPostRevisionResponse(r *ent.PostRevision) PostRevisionResponse {
+	resp := PostRevisionResponse{
+		ID:        r.ID,
+		Caption:   r.Caption,
+		CreatedAt: r.CreatedAt,
+	}
+	if r.Edges.Editor != nil {
+		resp.EditorID = &r.Edges.Editor.ID
+		resp.EditorName = r.Edges.Editor.Name
 	}
+	return resp
 }
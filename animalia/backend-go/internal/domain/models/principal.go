@@ -0,0 +1,23 @@
+package models
+
+import (
+	"github.com/aki-13627/animalia/backend-go/ent/user"
+	"github.com/google/uuid"
+)
+
+// TokenClaims は署名を検証したトークンから取り出した情報
+type TokenClaims struct {
+	Subject  string
+	TokenUse string
+	// Email は ID トークンにのみ含まれる
+	Email string
+	// Username はアクセストークンにのみ含まれる
+	Username string
+}
+
+// Principal は認証済みのリクエストの主体
+type Principal struct {
+	UserID uuid.UUID
+	Email  string
+	Role   user.Role
+}
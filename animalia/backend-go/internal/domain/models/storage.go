@@ -0,0 +1,20 @@
+package models
+
+import "time"
+
+// ObjectInfo はストレージ上のオブジェクトのメタデータ
+type ObjectInfo struct {
+	Key          string
+	Size         int64
+	ContentType  string
+	LastModified time.Time
+}
+
+// UploadTicket は署名付き URL による直接アップロードのための情報
+type UploadTicket struct {
+	UploadURL   string            `json:"uploadUrl"`
+	Method      string            `json:"method"`
+	Headers     map[string]string `json:"headers"`
+	UploadToken string            `json:"uploadToken"`
+	ExpiresAt   time.Time         `json:"expiresAt"`
+}
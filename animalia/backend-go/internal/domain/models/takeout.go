@@ -0,0 +1,76 @@
+package models
+
+import (
+	"time"
+
+	"github.com/aki-13627/animalia/backend-go/ent/enum"
+	"github.com/aki-13627/animalia/backend-go/ent/pet"
+	"github.com/google/uuid"
+)
+
+// TakeoutExport はデータエクスポートの ZIP のダウンロード用 URL
+type TakeoutExport struct {
+	URL       string    `json:"url"`
+	ExpiresAt time.Time `json:"expiresAt"`
+}
+
+// 以下はエクスポートの ZIP に含める JSON。File は ZIP 内のメディアファイルのパス
+
+type TakeoutProfile struct {
+	ID        uuid.UUID `json:"id"`
+	Name      string    `json:"name"`
+	Email     string    `json:"email"`
+	Bio       string    `json:"bio"`
+	IconFile  string    `json:"iconFile,omitempty"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+type TakeoutMedia struct {
+	Type       string `json:"type"`
+	File       string `json:"file"`
+	PosterFile string `json:"posterFile,omitempty"`
+}
+
+type TakeoutPost struct {
+	ID          uuid.UUID      `json:"id"`
+	Caption     string         `json:"caption"`
+	Media       []TakeoutMedia `json:"media"`
+	DailyTaskID *uuid.UUID     `json:"dailyTaskId,omitempty"`
+	CreatedAt   time.Time      `json:"createdAt"`
+}
+
+type TakeoutPet struct {
+	ID        uuid.UUID   `json:"id"`
+	Name      string      `json:"name"`
+	BirthDay  string      `json:"birthDay"`
+	Type      pet.Type    `json:"type"`
+	Species   pet.Species `json:"species"`
+	ImageFile string      `json:"imageFile"`
+	CreatedAt time.Time   `json:"createdAt"`
+}
+
+type TakeoutComment struct {
+	ID        uuid.UUID `json:"id"`
+	PostID    uuid.UUID `json:"postId"`
+	Content   string    `json:"content"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+type TakeoutLike struct {
+	PostID    uuid.UUID `json:"postId"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// TakeoutFollow はフォロー・フォロワーの相手のユーザー
+type TakeoutFollow struct {
+	UserID    uuid.UUID `json:"userId"`
+	Name      string    `json:"name"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+type TakeoutDailyTask struct {
+	ID        uuid.UUID     `json:"id"`
+	Type      enum.TaskType `json:"type"`
+	PostID    *uuid.UUID    `json:"postId,omitempty"`
+	CreatedAt time.Time     `json:"createdAt"`
+}
@@ -0,0 +1,40 @@
+package models
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	// ErrTimelineSessionNotFound はタイムラインのセッションが存在しない、もしくは削除済み
+	ErrTimelineSessionNotFound = errors.New("timeline session not found")
+	// ErrRecommendationUnavailable は推薦 API が続けて失敗しており、しばらく呼び出しを止めている
+	ErrRecommendationUnavailable = errors.New("recommendation service unavailable")
+)
+
+// TimelineSession は推薦 API が返した投稿の並び。ページごとに投稿を DB から読み直す
+type TimelineSession struct {
+	ID        uuid.UUID
+	UserID    uuid.UUID
+	PostIDs   []uuid.UUID
+	ExpiresAt time.Time
+}
+
+// Expired は now の時点でセッションの有効期限が切れているかを返す
+func (s *TimelineSession) Expired(now time.Time) bool {
+	return !now.Before(s.ExpiresAt)
+}
+
+// FeedCandidate はフォールバックのランキングで並べる投稿の候補と、スコアの計算に使う指標
+type FeedCandidate struct {
+	PostID    uuid.UUID
+	CreatedAt time.Time
+	// FromFollowing は閲覧者がフォローしているユーザーの投稿かどうか
+	FromFollowing bool
+	// Interactions は直近のいいねとコメントの数
+	Interactions int
+	// Similarity は閲覧者が最近いいねした投稿の画像と候補の画像のコサイン類似度。どちらかの特徴量がなければ 0
+	Similarity float64
+}
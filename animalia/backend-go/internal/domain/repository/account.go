@@ -0,0 +1,26 @@
+package repository
+
+import (
+	"time"
+
+	"github.com/aki-13627/animalia/backend-go/ent"
+	"github.com/google/uuid"
+)
+
+// AccountRepository はアカウントの退会・復元・削除を1つのトランザクションで行う
+type AccountRepository interface {
+	// Deactivate はユーザーを退会済みにし、投稿・ペット・コメントを deletedAt で論理削除する。
+	// いいね・フォロー関係は削除し、画像・動画のキーを deleteAfter 以降に削除するようキューに入れる
+	Deactivate(userID uuid.UUID, deletedAt, deleteAfter time.Time) error
+	// Restore は退会時に論理削除したデータを元に戻し、削除キューからユーザーのキーを外す
+	Restore(userID uuid.UUID) error
+	// FindDeactivatedByEmail は退会済みで、まだ削除されていないユーザーを取得する
+	FindDeactivatedByEmail(email string) (*ent.User, error)
+	// ListPurgeable は before より前に退会し、まだ削除されていないユーザーを返す
+	ListPurgeable(before time.Time) ([]*ent.User, error)
+	// Purge はユーザーのデータを削除してユーザーを匿名化する。コミット前に beforeCommit を呼び、失敗した場合はロールバックする
+	Purge(userID uuid.UUID, beforeCommit func() error) error
+	// DueStorageDeletions は now までに削除するキーを最大 limit 件返す
+	DueStorageDeletions(now time.Time, limit int) ([]*ent.StorageDeletion, error)
+	DeleteStorageDeletion(id uuid.UUID) error
+}
@@ -0,0 +1,23 @@
+package repository
+
+import (
+	"github.com/aki-13627/animalia/backend-go/ent"
+	"github.com/aki-13627/animalia/backend-go/ent/report"
+	"github.com/aki-13627/animalia/backend-go/ent/user"
+	"github.com/google/uuid"
+)
+
+// AdminRepository は管理者向けの操作。変更はすべて監査ログと同じトランザクションで記録する。
+// actorID が nil の操作はメンテナンスコマンドからの操作として記録する
+type AdminRepository interface {
+	// SearchUsers は名前かメールアドレスに query を含むユーザーを新しい順に返す。query が空ならすべてのユーザー
+	SearchUsers(query string, limit, offset int) ([]*ent.User, error)
+	GetUser(userID uuid.UUID) (*ent.User, error)
+	SetSuspended(actorID *uuid.UUID, userID uuid.UUID, suspended bool, reason string) (*ent.User, error)
+	SetRole(actorID *uuid.UUID, email string, role user.Role) (*ent.User, error)
+	ForceDeletePost(actorID *uuid.UUID, postID uuid.UUID, reason string) error
+	ForceDeleteComment(actorID *uuid.UUID, commentID uuid.UUID, reason string) error
+	// ListReports は status の通報を新しい順に返す。status が空ならすべての通報
+	ListReports(status report.Status, limit, offset int) ([]*ent.Report, error)
+	ListAuditLogs(limit, offset int) ([]*ent.AuditLog, error)
+}
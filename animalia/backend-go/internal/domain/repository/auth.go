@@ -1,16 +1,32 @@
 package repository
 
 import (
-	"github.com/aws/aws-sdk-go-v2/service/cognitoidentityprovider"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/models"
 )
 
+// AuthRepository は認証プロバイダ（Cognito もしくは組み込みのパスワード認証）を表す
 type AuthRepository interface {
-	GenerateHash(username string) string
-	VerifyToken(email, token string) error
 	CreateUser(name, email, password string) error
 	VerifyEmail(email, code string) error
-	SignIn(email, password string) (*cognitoidentityprovider.InitiateAuthOutput, error)
-	RefreshToken(refreshToken string) (*cognitoidentityprovider.InitiateAuthOutput, error)
+	SignIn(email, password string) (*models.AuthTokens, error)
+	RefreshToken(refreshToken string) (*models.AuthTokens, error)
 	GetUserEmail(accessToken string) (string, error)
-	SignOut(token string) error
+	SignOut(accessToken string) error
+	// ForgotPassword はパスワード再設定用の確認コードを送る
+	ForgotPassword(email string) error
+	ConfirmForgotPassword(email, code, newPassword string) error
+	ChangePassword(accessToken, oldPassword, newPassword string) error
+	// RequestEmailChange は新しいメールアドレスに確認コードを送る。確認されるまでは元のアドレスが有効
+	RequestEmailChange(accessToken, newEmail string) error
+	ConfirmEmailChange(accessToken, newEmail, code string) error
+	// RestoreEmail はメールアドレスの変更を取り消し、確認済みの email に戻す
+	RestoreEmail(accessToken, email string) error
+	// DeleteUser は退会の猶予期間が過ぎたユーザーを、トークンの sub である subject で認証プロバイダから削除する。
+	// subject のユーザーが既にいなければ削除済みとして扱う
+	DeleteUser(subject string) error
+}
+
+// TokenVerifier はアクセストークン・ID トークンをネットワークを介さずに検証する
+type TokenVerifier interface {
+	Verify(token string) (*models.TokenClaims, error)
 }
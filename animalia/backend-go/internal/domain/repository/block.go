@@ -0,0 +1,21 @@
+package repository
+
+import (
+	"github.com/aki-13627/animalia/backend-go/ent"
+	"github.com/google/uuid"
+)
+
+// BlockRepository はユーザー間のブロックとミュート
+type BlockRepository interface {
+	// Block は blockerID が blockedID をブロックし、2人の間のフォロー関係を両方向とも削除する
+	Block(blockerID, blockedID uuid.UUID) error
+	Unblock(blockerID, blockedID uuid.UUID) error
+	Mute(muterID, mutedID uuid.UUID) error
+	Unmute(muterID, mutedID uuid.UUID) error
+	// IsBlocked は2人のどちらかがもう一方をブロックしているかを返す
+	IsBlocked(userID, otherID uuid.UUID) (bool, error)
+	// BlockedUserIDs は userID がブロックしている、または userID をブロックしているユーザーの ID を返す
+	BlockedUserIDs(userID uuid.UUID) (map[uuid.UUID]bool, error)
+	ListBlocked(userID uuid.UUID) ([]*ent.User, error)
+	ListMuted(userID uuid.UUID) ([]*ent.User, error)
+}
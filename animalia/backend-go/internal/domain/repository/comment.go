@@ -2,10 +2,15 @@ package repository
 
 import (
 	"github.com/aki-13627/animalia/backend-go/ent"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/models"
 	"github.com/google/uuid"
 )
 
 type CommentRepository interface {
 	Create(userId uuid.UUID, postId uuid.UUID, content string) (*ent.Comment, error)
+	// GetById はコメントを投稿者と、コメント先の投稿（投稿者付き）とともに返す
+	GetById(commentId uuid.UUID) (*ent.Comment, error)
+	// ListByPost は投稿のコメントを投稿者とともに新しい順に1ページ分返す。viewerID とブロック関係にあるユーザーのコメントは含まない
+	ListByPost(postID, viewerID uuid.UUID, page models.PageRequest) ([]*ent.Comment, string, error)
 	Delete(commentId string) error
 }
@@ -0,0 +1,14 @@
+package repository
+
+import (
+	"time"
+
+	"github.com/aki-13627/animalia/backend-go/internal/domain/models"
+	"github.com/google/uuid"
+)
+
+type FeedRepository interface {
+	// FeedCandidates は since 以降の投稿のうち viewerID のフィードに表示できる他人の投稿を、最大 limit 件の新しい順の投稿と
+	// 最近いいねした投稿に画像が似ている投稿から集めて返す。Interactions は interactionsSince 以降のいいねとコメントを数える
+	FeedCandidates(viewerID uuid.UUID, since, interactionsSince time.Time, limit int) ([]models.FeedCandidate, error)
+}
@@ -1,10 +1,25 @@
 package repository
 
-import "github.com/aki-13627/animalia/backend-go/ent"
+import (
+	"github.com/aki-13627/animalia/backend-go/ent"
+	"github.com/aki-13627/animalia/backend-go/ent/followrelation"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/models"
+	"github.com/google/uuid"
+)
 
+// FollowRelationRepository のフォロー数・一覧は承認済みのフォロー関係だけを対象にする
 type FollowRelationRepository interface {
 	CountFollows(userId string) (int, error)
 	CountFollowers(userId string) (int, error)
-	Followings(userId string) ([]*ent.User, error)
-	Followers(userId string) ([]*ent.User, error)
+	// Followings・Followers はフォローした日時の新しい順に1ページ分返す。続きがあれば次のページのカーソルも返す
+	Followings(userId string, page models.PageRequest) ([]*ent.User, string, error)
+	Followers(userId string, page models.PageRequest) ([]*ent.User, string, error)
+	// Status は fromID から toID へのフォロー関係の状態を返す。フォローしていなければ空文字を返す
+	Status(fromID, toID uuid.UUID) (followrelation.Status, error)
+	// PendingRequests は userID への未承認のフォローリクエストを送ったユーザーを返す
+	PendingRequests(userID uuid.UUID) ([]*ent.User, error)
+	// Approve は fromID から toID への未承認のフォローリクエストを承認する。リクエストがなければ NotFound を返す
+	Approve(fromID, toID uuid.UUID) error
+	// Reject は fromID から toID への未承認のフォローリクエストを削除する。リクエストがなければ NotFound を返す
+	Reject(fromID, toID uuid.UUID) error
 }
@@ -0,0 +1,7 @@
+package repository
+
+type ImageReferenceRepository interface {
+	// ReferencedImageKeys は削除されていない投稿・ペット・ユーザーから参照されている画像キーと、
+	// 退会したユーザーの削除キューに入っている画像キーを返す
+	ReferencedImageKeys() ([]string, error)
+}
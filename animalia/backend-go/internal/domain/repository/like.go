@@ -1,7 +1,15 @@
 package repository
 
+import (
+	"github.com/aki-13627/animalia/backend-go/ent"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/models"
+	"github.com/google/uuid"
+)
+
 type LikeRepository interface {
 	Create(userId string, postId string) error
 	Delete(userId string, postId string) error
 	Count(petID string) (int, error)
+	// ListByPost は投稿のいいねをユーザーとともに新しい順に1ページ分返す。viewerID とブロック関係にあるユーザーのいいねは含まない
+	ListByPost(postID, viewerID uuid.UUID, page models.PageRequest) ([]*ent.Like, string, error)
 }
@@ -0,0 +1,6 @@
+package repository
+
+// Mailer は確認コードなどのメールを送信する
+type Mailer interface {
+	Send(to, subject, body string) error
+}
@@ -0,0 +1,51 @@
+package mock
+
+import (
+	"time"
+
+	"github.com/aki-13627/animalia/backend-go/ent"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/repository"
+	"github.com/google/uuid"
+)
+
+// MockAccountRepository is a mock implementation of the AccountRepository interface
+type MockAccountRepository struct {
+	DeactivateFunc             func(userID uuid.UUID, deletedAt, deleteAfter time.Time) error
+	RestoreFunc                func(userID uuid.UUID) error
+	FindDeactivatedByEmailFunc func(email string) (*ent.User, error)
+	ListPurgeableFunc          func(before time.Time) ([]*ent.User, error)
+	PurgeFunc                  func(userID uuid.UUID, beforeCommit func() error) error
+	DueStorageDeletionsFunc    func(now time.Time, limit int) ([]*ent.StorageDeletion, error)
+	DeleteStorageDeletionFunc  func(id uuid.UUID) error
+}
+
+// Ensure MockAccountRepository implements the AccountRepository interface
+var _ repository.AccountRepository = (*MockAccountRepository)(nil)
+
+func (m *MockAccountRepository) Deactivate(userID uuid.UUID, deletedAt, deleteAfter time.Time) error {
+	return m.DeactivateFunc(userID, deletedAt, deleteAfter)
+}
+
+func (m *MockAccountRepository) Restore(userID uuid.UUID) error {
+	return m.RestoreFunc(userID)
+}
+
+func (m *MockAccountRepository) FindDeactivatedByEmail(email string) (*ent.User, error) {
+	return m.FindDeactivatedByEmailFunc(email)
+}
+
+func (m *MockAccountRepository) ListPurgeable(before time.Time) ([]*ent.User, error) {
+	return m.ListPurgeableFunc(before)
+}
+
+func (m *MockAccountRepository) Purge(userID uuid.UUID, beforeCommit func() error) error {
+	return m.PurgeFunc(userID, beforeCommit)
+}
+
+func (m *MockAccountRepository) DueStorageDeletions(now time.Time, limit int) ([]*ent.StorageDeletion, error) {
+	return m.DueStorageDeletionsFunc(now, limit)
+}
+
+func (m *MockAccountRepository) DeleteStorageDeletion(id uuid.UUID) error {
+	return m.DeleteStorageDeletionFunc(id)
+}
@@ -0,0 +1,56 @@
+package mock
+
+import (
+	"github.com/aki-13627/animalia/backend-go/ent"
+	"github.com/aki-13627/animalia/backend-go/ent/report"
+	"github.com/aki-13627/animalia/backend-go/ent/user"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/repository"
+	"github.com/google/uuid"
+)
+
+// MockAdminRepository is a mock implementation of the AdminRepository interface
+type MockAdminRepository struct {
+	SearchUsersFunc        func(query string, limit, offset int) ([]*ent.User, error)
+	GetUserFunc            func(userID uuid.UUID) (*ent.User, error)
+	SetSuspendedFunc       func(actorID *uuid.UUID, userID uuid.UUID, suspended bool, reason string) (*ent.User, error)
+	SetRoleFunc            func(actorID *uuid.UUID, email string, role user.Role) (*ent.User, error)
+	ForceDeletePostFunc    func(actorID *uuid.UUID, postID uuid.UUID, reason string) error
+	ForceDeleteCommentFunc func(actorID *uuid.UUID, commentID uuid.UUID, reason string) error
+	ListReportsFunc        func(status report.Status, limit, offset int) ([]*ent.Report, error)
+	ListAuditLogsFunc      func(limit, offset int) ([]*ent.AuditLog, error)
+}
+
+// Ensure MockAdminRepository implements the AdminRepository interface
+var _ repository.AdminRepository = (*MockAdminRepository)(nil)
+
+func (m *MockAdminRepository) SearchUsers(query string, limit, offset int) ([]*ent.User, error) {
+	return m.SearchUsersFunc(query, limit, offset)
+}
+
+func (m *MockAdminRepository) GetUser(userID uuid.UUID) (*ent.User, error) {
+	return m.GetUserFunc(userID)
+}
+
+func (m *MockAdminRepository) SetSuspended(actorID *uuid.UUID, userID uuid.UUID, suspended bool, reason string) (*ent.User, error) {
+	return m.SetSuspendedFunc(actorID, userID, suspended, reason)
+}
+
+func (m *MockAdminRepository) SetRole(actorID *uuid.UUID, email string, role user.Role) (*ent.User, error) {
+	return m.SetRoleFunc(actorID, email, role)
+}
+
+func (m *MockAdminRepository) ForceDeletePost(actorID *uuid.UUID, postID uuid.UUID, reason string) error {
+	return m.ForceDeletePostFunc(actorID, postID, reason)
+}
+
+func (m *MockAdminRepository) ForceDeleteComment(actorID *uuid.UUID, commentID uuid.UUID, reason string) error {
+	return m.ForceDeleteCommentFunc(actorID, commentID, reason)
+}
+
+func (m *MockAdminRepository) ListReports(status report.Status, limit, offset int) ([]*ent.Report, error) {
+	return m.ListReportsFunc(status, limit, offset)
+}
+
+func (m *MockAdminRepository) ListAuditLogs(limit, offset int) ([]*ent.AuditLog, error) {
+	return m.ListAuditLogsFunc(limit, offset)
+}
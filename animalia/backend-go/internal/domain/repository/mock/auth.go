@@ -0,0 +1,91 @@
+package mock
+
+import (
+	"github.com/aki-13627/animalia/backend-go/internal/domain/models"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/repository"
+)
+
+// MockAuthRepository is a mock implementation of the AuthRepository interface
+type MockAuthRepository struct {
+	CreateUserFunc   func(name, email, password string) error
+	VerifyEmailFunc  func(email, code string) error
+	SignInFunc       func(email, password string) (*models.AuthTokens, error)
+	RefreshTokenFunc func(refreshToken string) (*models.AuthTokens, error)
+	GetUserEmailFunc func(accessToken string) (string, error)
+	SignOutFunc      func(token string) error
+
+	ForgotPasswordFunc        func(email string) error
+	ConfirmForgotPasswordFunc func(email, code, newPassword string) error
+	ChangePasswordFunc        func(accessToken, oldPassword, newPassword string) error
+	RequestEmailChangeFunc    func(accessToken, newEmail string) error
+	ConfirmEmailChangeFunc    func(accessToken, newEmail, code string) error
+	RestoreEmailFunc          func(accessToken, email string) error
+	DeleteUserFunc            func(subject string) error
+}
+
+// Ensure MockAuthRepository implements the AuthRepository interface
+var _ repository.AuthRepository = (*MockAuthRepository)(nil)
+
+func (m *MockAuthRepository) CreateUser(name, email, password string) error {
+	return m.CreateUserFunc(name, email, password)
+}
+
+func (m *MockAuthRepository) VerifyEmail(email, code string) error {
+	return m.VerifyEmailFunc(email, code)
+}
+
+func (m *MockAuthRepository) SignIn(email, password string) (*models.AuthTokens, error) {
+	return m.SignInFunc(email, password)
+}
+
+func (m *MockAuthRepository) RefreshToken(refreshToken string) (*models.AuthTokens, error) {
+	return m.RefreshTokenFunc(refreshToken)
+}
+
+func (m *MockAuthRepository) GetUserEmail(accessToken string) (string, error) {
+	return m.GetUserEmailFunc(accessToken)
+}
+
+func (m *MockAuthRepository) SignOut(token string) error {
+	return m.SignOutFunc(token)
+}
+
+func (m *MockAuthRepository) ForgotPassword(email string) error {
+	return m.ForgotPasswordFunc(email)
+}
+
+func (m *MockAuthRepository) ConfirmForgotPassword(email, code, newPassword string) error {
+	return m.ConfirmForgotPasswordFunc(email, code, newPassword)
+}
+
+func (m *MockAuthRepository) ChangePassword(accessToken, oldPassword, newPassword string) error {
+	return m.ChangePasswordFunc(accessToken, oldPassword, newPassword)
+}
+
+func (m *MockAuthRepository) RequestEmailChange(accessToken, newEmail string) error {
+	return m.RequestEmailChangeFunc(accessToken, newEmail)
+}
+
+func (m *MockAuthRepository) ConfirmEmailChange(accessToken, newEmail, code string) error {
+	return m.ConfirmEmailChangeFunc(accessToken, newEmail, code)
+}
+
+func (m *MockAuthRepository) RestoreEmail(accessToken, email string) error {
+	return m.RestoreEmailFunc(accessToken, email)
+}
+
+func (m *MockAuthRepository) DeleteUser(subject string) error {
+	return m.DeleteUserFunc(subject)
+}
+
+// MockTokenVerifier is a mock implementation of the TokenVerifier interface
+type MockTokenVerifier struct {
+	VerifyFunc func(token string) (*models.TokenClaims, error)
+}
+
+// Ensure MockTokenVerifier implements the TokenVerifier interface
+var _ repository.TokenVerifier = (*MockTokenVerifier)(nil)
+
+func (m *MockTokenVerifier) Verify(token string) (*models.TokenClaims, error) {
+	return m.VerifyFunc(token)
+}
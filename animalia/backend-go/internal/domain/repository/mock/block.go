@@ -0,0 +1,58 @@
+package mock
+
+import (
+	"github.com/aki-13627/animalia/backend-go/ent"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/repository"
+	"github.com/google/uuid"
+)
+
+// MockBlockRepository is a mock implementation of the BlockRepository interface
+type MockBlockRepository struct {
+	BlockFunc          func(blockerID, blockedID uuid.UUID) error
+	UnblockFunc        func(blockerID, blockedID uuid.UUID) error
+	MuteFunc           func(muterID, mutedID uuid.UUID) error
+	UnmuteFunc         func(muterID, mutedID uuid.UUID) error
+	IsBlockedFunc      func(userID, otherID uuid.UUID) (bool, error)
+	BlockedUserIDsFunc func(userID uuid.UUID) (map[uuid.UUID]bool, error)
+	ListBlockedFunc    func(userID uuid.UUID) ([]*ent.User, error)
+	ListMutedFunc      func(userID uuid.UUID) ([]*ent.User, error)
+}
+
+// Ensure MockBlockRepository implements the BlockRepository interface
+var _ repository.BlockRepository = (*MockBlockRepository)(nil)
+
+func (m *MockBlockRepository) Block(blockerID, blockedID uuid.UUID) error {
+	return m.BlockFunc(blockerID, blockedID)
+}
+
+func (m *MockBlockRepository) Unblock(blockerID, blockedID uuid.UUID) error {
+	return m.UnblockFunc(blockerID, blockedID)
+}
+
+func (m *MockBlockRepository) Mute(muterID, mutedID uuid.UUID) error {
+	return m.MuteFunc(muterID, mutedID)
+}
+
+func (m *MockBlockRepository) Unmute(muterID, mutedID uuid.UUID) error {
+	return m.UnmuteFunc(muterID, mutedID)
+}
+
+// IsBlocked はブロックがないものとして扱う
+func (m *MockBlockRepository) IsBlocked(userID, otherID uuid.UUID) (bool, error) {
+	if m.IsBlockedFunc != nil {
+		return m.IsBlockedFunc(userID, otherID)
+	}
+	return false, nil
+}
+
+func (m *MockBlockRepository) BlockedUserIDs(userID uuid.UUID) (map[uuid.UUID]bool, error) {
+	return m.BlockedUserIDsFunc(userID)
+}
+
+func (m *MockBlockRepository) ListBlocked(userID uuid.UUID) ([]*ent.User, error) {
+	return m.ListBlockedFunc(userID)
+}
+
+func (m *MockBlockRepository) ListMuted(userID uuid.UUID) ([]*ent.User, error) {
+	return m.ListMutedFunc(userID)
+}
@@ -2,14 +2,17 @@ package mock
 
 import (
 	"github.com/aki-13627/animalia/backend-go/ent"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/models"
 	"github.com/aki-13627/animalia/backend-go/internal/domain/repository"
 	"github.com/google/uuid"
 )
 
 // MockCommentRepository is a mock implementation of the CommentRepository interface
 type MockCommentRepository struct {
-	CreateFunc func(userId uuid.UUID, postId uuid.UUID, content string) (*ent.Comment, error)
-	DeleteFunc func(commentId string) error
+	CreateFunc     func(userId uuid.UUID, postId uuid.UUID, content string) (*ent.Comment, error)
+	GetByIdFunc    func(commentId uuid.UUID) (*ent.Comment, error)
+	ListByPostFunc func(postID, viewerID uuid.UUID, page models.PageRequest) ([]*ent.Comment, string, error)
+	DeleteFunc     func(commentId string) error
 }
 
 // Ensure MockCommentRepository implements CommentRepository interface
@@ -20,6 +23,16 @@ func (m *MockCommentRepository) Create(userId uuid.UUID, postId uuid.UUID, conte
 	return m.CreateFunc(userId, postId, content)
 }
 
+// GetById calls the mocked GetByIdFunc
+func (m *MockCommentRepository) GetById(commentId uuid.UUID) (*ent.Comment, error) {
+	return m.GetByIdFunc(commentId)
+}
+
+// ListByPost calls the mocked ListByPostFunc
+func (m *MockCommentRepository) ListByPost(postID, viewerID uuid.UUID, page models.PageRequest) ([]*ent.Comment, string, error) {
+	return m.ListByPostFunc(postID, viewerID, page)
+}
+
 // Delete calls the mocked DeleteFunc
 func (m *MockCommentRepository) Delete(commentId string) error {
 	return m.DeleteFunc(commentId)
@@ -0,0 +1,21 @@
+package mock
+
+import (
+	"time"
+
+	"github.com/aki-13627/animalia/backend-go/internal/domain/models"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/repository"
+	"github.com/google/uuid"
+)
+
+// MockFeedRepository is a mock implementation of the FeedRepository interface
+type MockFeedRepository struct {
+	FeedCandidatesFunc func(viewerID uuid.UUID, since, interactionsSince time.Time, limit int) ([]models.FeedCandidate, error)
+}
+
+// Ensure MockFeedRepository implements the FeedRepository interface
+var _ repository.FeedRepository = (*MockFeedRepository)(nil)
+
+func (m *MockFeedRepository) FeedCandidates(viewerID uuid.UUID, since, interactionsSince time.Time, limit int) ([]models.FeedCandidate, error) {
+	return m.FeedCandidatesFunc(viewerID, since, interactionsSince, limit)
+}
@@ -0,0 +1,56 @@
+package mock
+
+import (
+	"github.com/aki-13627/animalia/backend-go/ent"
+	"github.com/aki-13627/animalia/backend-go/ent/followrelation"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/models"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/repository"
+	"github.com/google/uuid"
+)
+
+// MockFollowRelationRepository is a mock implementation of the FollowRelationRepository interface
+type MockFollowRelationRepository struct {
+	CountFollowsFunc    func(userId string) (int, error)
+	CountFollowersFunc  func(userId string) (int, error)
+	FollowingsFunc      func(userId string, page models.PageRequest) ([]*ent.User, string, error)
+	FollowersFunc       func(userId string, page models.PageRequest) ([]*ent.User, string, error)
+	StatusFunc          func(fromID, toID uuid.UUID) (followrelation.Status, error)
+	PendingRequestsFunc func(userID uuid.UUID) ([]*ent.User, error)
+	ApproveFunc         func(fromID, toID uuid.UUID) error
+	RejectFunc          func(fromID, toID uuid.UUID) error
+}
+
+// Ensure MockFollowRelationRepository implements the FollowRelationRepository interface
+var _ repository.FollowRelationRepository = (*MockFollowRelationRepository)(nil)
+
+func (m *MockFollowRelationRepository) CountFollows(userId string) (int, error) {
+	return m.CountFollowsFunc(userId)
+}
+
+func (m *MockFollowRelationRepository) CountFollowers(userId string) (int, error) {
+	return m.CountFollowersFunc(userId)
+}
+
+func (m *MockFollowRelationRepository) Followings(userId string, page models.PageRequest) ([]*ent.User, string, error) {
+	return m.FollowingsFunc(userId, page)
+}
+
+func (m *MockFollowRelationRepository) Followers(userId string, page models.PageRequest) ([]*ent.User, string, error) {
+	return m.FollowersFunc(userId, page)
+}
+
+func (m *MockFollowRelationRepository) Status(fromID, toID uuid.UUID) (followrelation.Status, error) {
+	return m.StatusFunc(fromID, toID)
+}
+
+func (m *MockFollowRelationRepository) PendingRequests(userID uuid.UUID) ([]*ent.User, error) {
+	return m.PendingRequestsFunc(userID)
+}
+
+func (m *MockFollowRelationRepository) Approve(fromID, toID uuid.UUID) error {
+	return m.ApproveFunc(fromID, toID)
+}
+
+func (m *MockFollowRelationRepository) Reject(fromID, toID uuid.UUID) error {
+	return m.RejectFunc(fromID, toID)
+}
@@ -0,0 +1,16 @@
+package mock
+
+import "github.com/aki-13627/animalia/backend-go/internal/domain/repository"
+
+// MockImageReferenceRepository is a mock implementation of the ImageReferenceRepository interface
+type MockImageReferenceRepository struct {
+	ReferencedImageKeysFunc func() ([]string, error)
+}
+
+// Ensure MockImageReferenceRepository implements ImageReferenceRepository interface
+var _ repository.ImageReferenceRepository = (*MockImageReferenceRepository)(nil)
+
+// ReferencedImageKeys calls the mocked ReferencedImageKeysFunc
+func (m *MockImageReferenceRepository) ReferencedImageKeys() ([]string, error) {
+	return m.ReferencedImageKeysFunc()
+}
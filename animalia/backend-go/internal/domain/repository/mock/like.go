@@ -1,14 +1,18 @@
 package mock
 
 import (
+	"github.com/aki-13627/animalia/backend-go/ent"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/models"
 	"github.com/aki-13627/animalia/backend-go/internal/domain/repository"
+	"github.com/google/uuid"
 )
 
 // MockLikeRepository is a mock implementation of the LikeRepository interface
 type MockLikeRepository struct {
-	CreateFunc func(userId string, postId string) error
-	DeleteFunc func(userId string, postId string) error
-	CountFunc  func(postId string) (int, error)
+	CreateFunc     func(userId string, postId string) error
+	DeleteFunc     func(userId string, postId string) error
+	CountFunc      func(postId string) (int, error)
+	ListByPostFunc func(postID, viewerID uuid.UUID, page models.PageRequest) ([]*ent.Like, string, error)
 }
 
 // Ensure MockLikeRepository implements LikeRepository interface
@@ -28,3 +32,8 @@ func (m *MockLikeRepository) Delete(userId string, postId string) error {
 func (m *MockLikeRepository) Count(postId string) (int, error) {
 	return m.CountFunc(postId)
 }
+
+// ListByPost calls the mocked ListByPostFunc
+func (m *MockLikeRepository) ListByPost(postID, viewerID uuid.UUID, page models.PageRequest) ([]*ent.Like, string, error) {
+	return m.ListByPostFunc(postID, viewerID, page)
+}
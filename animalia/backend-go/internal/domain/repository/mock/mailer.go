@@ -0,0 +1,16 @@
+package mock
+
+import "github.com/aki-13627/animalia/backend-go/internal/domain/repository"
+
+// MockMailer is a mock implementation of the Mailer interface
+type MockMailer struct {
+	SendFunc func(to, subject, body string) error
+}
+
+// Ensure MockMailer implements Mailer interface
+var _ repository.Mailer = (*MockMailer)(nil)
+
+// Send calls the mocked SendFunc
+func (m *MockMailer) Send(to, subject, body string) error {
+	return m.SendFunc(to, subject, body)
+}
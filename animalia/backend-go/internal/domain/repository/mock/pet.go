@@ -3,11 +3,13 @@ package mock
 import (
 	"github.com/aki-13627/animalia/backend-go/ent"
 	"github.com/aki-13627/animalia/backend-go/internal/domain/repository"
+	"github.com/google/uuid"
 )
 
 // MockPetRepository is a mock implementation of the PetRepository interface
 type MockPetRepository struct {
 	GetByOwnerFunc func(ownerID string) ([]*ent.Pet, error)
+	GetByIdFunc    func(petID uuid.UUID) (*ent.Pet, error)
 	CreateFunc     func(name, petType, species, birthDay, fileKey, userID string) (*ent.Pet, error)
 	UpdateFunc     func(petID, name, petType, species, birthDay string) error
 	DeleteFunc     func(petID string) error
@@ -21,6 +23,11 @@ func (m *MockPetRepository) GetByOwner(ownerID string) ([]*ent.Pet, error) {
 	return m.GetByOwnerFunc(ownerID)
 }
 
+// GetById calls the mocked GetByIdFunc
+func (m *MockPetRepository) GetById(petID uuid.UUID) (*ent.Pet, error) {
+	return m.GetByIdFunc(petID)
+}
+
 // Create calls the mocked CreateFunc
 func (m *MockPetRepository) Create(name, petType, species, birthDay, fileKey, userID string) (*ent.Pet, error) {
 	return m.CreateFunc(name, petType, species, birthDay, fileKey, userID)
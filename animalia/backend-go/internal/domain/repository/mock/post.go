@@ -2,30 +2,38 @@ package mock
 
 import (
 	"github.com/aki-13627/animalia/backend-go/ent"
+	"github.com/aki-13627/animalia/backend-go/ent/post"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/models"
 	"github.com/aki-13627/animalia/backend-go/internal/domain/repository"
 	"github.com/google/uuid"
 )
 
 // MockPostRepository is a mock implementation of the PostRepository interface
 type MockPostRepository struct {
-	GetAllPostsFunc    func() ([]*ent.Post, error)
-	GetPostsByUserFunc func(userId uuid.UUID) ([]*ent.Post, error)
-	GetLikedPostsFunc  func(userId uuid.UUID) ([]*ent.Post, error)
-	CreatePostFunc     func(caption string, userId string, fileKey string, dailyTaskId *string) (*ent.Post, error)
-	UpdatePostFunc     func(postId, caption string) error
-	DeletePostFunc     func(postId string) error
-	GetByIdFunc        func(postId uuid.UUID) (*ent.Post, error)
+	GetAllPostsFunc      func(viewerID uuid.UUID, page models.PageRequest) ([]*ent.Post, string, error)
+	GetPostsByUserFunc   func(userId, viewerID uuid.UUID, page models.PageRequest) ([]*ent.Post, string, error)
+	GetLikedPostsFunc    func(userId uuid.UUID) ([]*ent.Post, error)
+	CreatePostFunc       func(caption string, userId string, visibility post.Visibility, media []models.MediaItem, dailyTaskId *string) (*ent.Post, error)
+	UpdatePostFunc       func(editorID uuid.UUID, postId, caption string) error
+	GetRevisionsFunc     func(postId uuid.UUID) ([]*ent.PostRevision, error)
+	UpdateVisibilityFunc func(postId uuid.UUID, visibility post.Visibility) error
+	DeletePostFunc       func(postId string) error
+	GetByIdFunc          func(postId uuid.UUID) (*ent.Post, error)
+	GetDetailFunc        func(postId, viewerID uuid.UUID) (*ent.Post, error)
+	IsVisibleFunc        func(postId, viewerID uuid.UUID) (bool, error)
+	GetByIDsFunc         func(postIds []uuid.UUID, viewerID uuid.UUID) ([]*ent.Post, error)
+	GetHiddenIDsFunc     func(viewerID uuid.UUID, postIds, commentIds []uuid.UUID) (map[uuid.UUID]bool, error)
 }
 
 // Ensure MockPostRepository implements the PostRepository interface
 var _ repository.PostRepository = (*MockPostRepository)(nil)
 
-func (m *MockPostRepository) GetAllPosts() ([]*ent.Post, error) {
-	return m.GetAllPostsFunc()
+func (m *MockPostRepository) GetAllPosts(viewerID uuid.UUID, page models.PageRequest) ([]*ent.Post, string, error) {
+	return m.GetAllPostsFunc(viewerID, page)
 }
 
-func (m *MockPostRepository) GetPostsByUser(userId uuid.UUID) ([]*ent.Post, error) {
-	return m.GetPostsByUserFunc(userId)
+func (m *MockPostRepository) GetPostsByUser(userId, viewerID uuid.UUID, page models.PageRequest) ([]*ent.Post, string, error) {
+	return m.GetPostsByUserFunc(userId, viewerID, page)
 }
 
 func (m *MockPostRepository) GetLikedPosts(userId uuid.UUID) ([]*ent.Post, error) {
@@ -35,12 +43,20 @@ func (m *MockPostRepository) GetLikedPosts(userId uuid.UUID) ([]*ent.Post, error
 	return nil, nil
 }
 
-func (m *MockPostRepository) CreatePost(caption string, userId string, fileKey string, dailyTaskId *string) (*ent.Post, error) {
-	return m.CreatePostFunc(caption, userId, fileKey, dailyTaskId)
+func (m *MockPostRepository) CreatePost(caption string, userId string, visibility post.Visibility, media []models.MediaItem, dailyTaskId *string) (*ent.Post, error) {
+	return m.CreatePostFunc(caption, userId, visibility, media, dailyTaskId)
 }
 
-func (m *MockPostRepository) UpdatePost(postId, caption string) error {
-	return m.UpdatePostFunc(postId, caption)
+func (m *MockPostRepository) UpdatePost(editorID uuid.UUID, postId, caption string) error {
+	return m.UpdatePostFunc(editorID, postId, caption)
+}
+
+func (m *MockPostRepository) GetRevisions(postId uuid.UUID) ([]*ent.PostRevision, error) {
+	return m.GetRevisionsFunc(postId)
+}
+
+func (m *MockPostRepository) UpdateVisibility(postId uuid.UUID, visibility post.Visibility) error {
+	return m.UpdateVisibilityFunc(postId, visibility)
 }
 
 func (m *MockPostRepository) DeletePost(postId string) error {
@@ -50,3 +66,23 @@ func (m *MockPostRepository) DeletePost(postId string) error {
 func (m *MockPostRepository) GetById(postId uuid.UUID) (*ent.Post, error) {
 	return m.GetByIdFunc(postId)
 }
+
+func (m *MockPostRepository) GetDetail(postId, viewerID uuid.UUID) (*ent.Post, error) {
+	return m.GetDetailFunc(postId, viewerID)
+}
+
+// IsVisible は IsVisibleFunc が未設定なら投稿が見えるものとして扱う
+func (m *MockPostRepository) IsVisible(postId, viewerID uuid.UUID) (bool, error) {
+	if m.IsVisibleFunc != nil {
+		return m.IsVisibleFunc(postId, viewerID)
+	}
+	return true, nil
+}
+
+func (m *MockPostRepository) GetByIDs(postIds []uuid.UUID, viewerID uuid.UUID) ([]*ent.Post, error) {
+	return m.GetByIDsFunc(postIds, viewerID)
+}
+
+func (m *MockPostRepository) GetHiddenIDs(viewerID uuid.UUID, postIds, commentIds []uuid.UUID) (map[uuid.UUID]bool, error) {
+	return m.GetHiddenIDsFunc(viewerID, postIds, commentIds)
+}
@@ -0,0 +1,122 @@
+package mock
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"time"
+
+	"github.com/aki-13627/animalia/backend-go/internal/domain/repository"
+	"github.com/google/uuid"
+)
+
+// MockRecommendationRepository is a mock implementation of the RecommendationRepository interface
+type MockRecommendationRepository struct {
+	TimelineFunc func(ctx context.Context, userID uuid.UUID) ([]uuid.UUID, error)
+}
+
+// Ensure MockRecommendationRepository implements the RecommendationRepository interface
+var _ repository.RecommendationRepository = (*MockRecommendationRepository)(nil)
+
+func (m *MockRecommendationRepository) Timeline(ctx context.Context, userID uuid.UUID) ([]uuid.UUID, error) {
+	return m.TimelineFunc(ctx, userID)
+}
+
+// FakeRecommendationServer は FastAPI の推薦 API の代わりに httptest で立てるサーバー。
+// POST /timeline に postIDs を推薦結果として返す。FailNext で失敗、SetDelay で遅延を再現できる
+type FakeRecommendationServer struct {
+	*httptest.Server
+
+	mu         sync.Mutex
+	postIDs    []uuid.UUID
+	failures   int
+	failStatus int
+	delay      time.Duration
+	requests   int
+	lastUserID string
+}
+
+func NewFakeRecommendationServer(postIDs ...uuid.UUID) *FakeRecommendationServer {
+	s := &FakeRecommendationServer{postIDs: postIDs}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.handle))
+	return s
+}
+
+// FailNext は次の n 回のリクエストに status を返す
+func (s *FakeRecommendationServer) FailNext(n, status int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.failures = n
+	s.failStatus = status
+}
+
+// SetDelay はレスポンスを返すまで d だけ待つ
+func (s *FakeRecommendationServer) SetDelay(d time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.delay = d
+}
+
+// Requests は受け取ったリクエストの数を返す
+func (s *FakeRecommendationServer) Requests() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.requests
+}
+
+// LastUserID は最後のリクエストの user_id を返す
+func (s *FakeRecommendationServer) LastUserID() string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.lastUserID
+}
+
+func (s *FakeRecommendationServer) handle(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost || r.URL.Path != "/timeline" {
+		http.NotFound(w, r)
+		return
+	}
+	var body struct {
+		UserID string `json:"user_id"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		http.Error(w, err.Error(), http.StatusUnprocessableEntity)
+		return
+	}
+
+	s.mu.Lock()
+	s.requests++
+	s.lastUserID = body.UserID
+	delay := s.delay
+	status := 0
+	if s.failures > 0 {
+		s.failures--
+		status = s.failStatus
+	}
+	postIDs := s.postIDs
+	s.mu.Unlock()
+
+	if delay > 0 {
+		select {
+		case <-time.After(delay):
+		case <-r.Context().Done():
+			return
+		}
+	}
+	if status != 0 {
+		http.Error(w, http.StatusText(status), status)
+		return
+	}
+
+	type post struct {
+		ID string `json:"id"`
+	}
+	posts := make([]post, len(postIDs))
+	for i, id := range postIDs {
+		posts[i] = post{ID: id.String()}
+	}
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]interface{}{"posts": posts})
+}
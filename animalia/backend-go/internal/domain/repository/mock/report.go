@@ -0,0 +1,46 @@
+package mock
+
+import (
+	"github.com/aki-13627/animalia/backend-go/ent"
+	"github.com/aki-13627/animalia/backend-go/ent/report"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/models"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/repository"
+	"github.com/google/uuid"
+)
+
+// MockReportRepository is a mock implementation of the ReportRepository interface
+type MockReportRepository struct {
+	CreateFunc          func(reporterID uuid.UUID, targetType report.TargetType, targetID uuid.UUID, reason report.Reason, detail string) (*ent.Report, int, error)
+	ExistsOpenFunc      func(reporterID uuid.UUID, targetType report.TargetType, targetID uuid.UUID) (bool, error)
+	GetByIdFunc         func(reportID uuid.UUID) (*ent.Report, error)
+	GetTargetAuthorFunc func(targetType report.TargetType, targetID uuid.UUID) (*ent.User, error)
+	AutoHideFunc        func(targetType report.TargetType, targetID uuid.UUID, reportCount int) error
+	ResolveFunc         func(actorID uuid.UUID, reportID uuid.UUID, decision models.ReportDecision) (int, error)
+}
+
+// Ensure MockReportRepository implements the ReportRepository interface
+var _ repository.ReportRepository = (*MockReportRepository)(nil)
+
+func (m *MockReportRepository) Create(reporterID uuid.UUID, targetType report.TargetType, targetID uuid.UUID, reason report.Reason, detail string) (*ent.Report, int, error) {
+	return m.CreateFunc(reporterID, targetType, targetID, reason, detail)
+}
+
+func (m *MockReportRepository) ExistsOpen(reporterID uuid.UUID, targetType report.TargetType, targetID uuid.UUID) (bool, error) {
+	return m.ExistsOpenFunc(reporterID, targetType, targetID)
+}
+
+func (m *MockReportRepository) GetById(reportID uuid.UUID) (*ent.Report, error) {
+	return m.GetByIdFunc(reportID)
+}
+
+func (m *MockReportRepository) GetTargetAuthor(targetType report.TargetType, targetID uuid.UUID) (*ent.User, error) {
+	return m.GetTargetAuthorFunc(targetType, targetID)
+}
+
+func (m *MockReportRepository) AutoHide(targetType report.TargetType, targetID uuid.UUID, reportCount int) error {
+	return m.AutoHideFunc(targetType, targetID, reportCount)
+}
+
+func (m *MockReportRepository) Resolve(actorID uuid.UUID, reportID uuid.UUID, decision models.ReportDecision) (int, error) {
+	return m.ResolveFunc(actorID, reportID, decision)
+}
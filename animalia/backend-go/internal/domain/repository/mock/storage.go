@@ -2,15 +2,26 @@ package mock
 
 import (
 	"mime/multipart"
+	"time"
 
+	"github.com/aki-13627/animalia/backend-go/internal/domain/models"
 	"github.com/aki-13627/animalia/backend-go/internal/domain/repository"
 )
 
 // MockStorageRepository is a mock implementation of the StorageRepository interface
 type MockStorageRepository struct {
-	UploadImageFunc func(file *multipart.FileHeader, directory string) (string, error)
-	GetUrlFunc      func(fileKey string) (string, error)
-	DeleteImageFunc func(fileKey string) error
+	UploadImageFunc  func(file *multipart.FileHeader, directory string) (string, error)
+	PutObjectFunc    func(fileKey string, body []byte, contentType string) error
+	GetObjectFunc    func(fileKey string) ([]byte, error)
+	StatObjectFunc   func(fileKey string) (*models.ObjectInfo, error)
+	ListObjectsFunc  func(prefix string) ([]models.ObjectInfo, error)
+	ImportObjectFunc func(srcKey string, directory string) (string, error)
+	GetUrlFunc       func(fileKey string) (string, error)
+	GetUrlsFunc      func(fileKeys []string) (map[string]string, error)
+	GetUploadUrlFunc func(fileKey string, contentType string, size int64) (string, error)
+	DeleteImageFunc  func(fileKey string) error
+
+	GetDownloadUrlFunc func(fileKey string, filename string, expiry time.Duration) (string, error)
 }
 
 // Ensure MockStorageRepository implements StorageRepository interface
@@ -21,12 +32,52 @@ func (m *MockStorageRepository) UploadImage(file *multipart.FileHeader, director
 	return m.UploadImageFunc(file, directory)
 }
 
+// PutObject calls the mocked PutObjectFunc
+func (m *MockStorageRepository) PutObject(fileKey string, body []byte, contentType string) error {
+	return m.PutObjectFunc(fileKey, body, contentType)
+}
+
+// GetObject calls the mocked GetObjectFunc
+func (m *MockStorageRepository) GetObject(fileKey string) ([]byte, error) {
+	return m.GetObjectFunc(fileKey)
+}
+
+// StatObject calls the mocked StatObjectFunc
+func (m *MockStorageRepository) StatObject(fileKey string) (*models.ObjectInfo, error) {
+	return m.StatObjectFunc(fileKey)
+}
+
+// ListObjects calls the mocked ListObjectsFunc
+func (m *MockStorageRepository) ListObjects(prefix string) ([]models.ObjectInfo, error) {
+	return m.ListObjectsFunc(prefix)
+}
+
+// ImportObject calls the mocked ImportObjectFunc
+func (m *MockStorageRepository) ImportObject(srcKey string, directory string) (string, error) {
+	return m.ImportObjectFunc(srcKey, directory)
+}
+
 // GetUrl calls the mocked GetUrlFunc
 func (m *MockStorageRepository) GetUrl(fileKey string) (string, error) {
 	return m.GetUrlFunc(fileKey)
 }
 
+// GetUrls calls the mocked GetUrlsFunc
+func (m *MockStorageRepository) GetUrls(fileKeys []string) (map[string]string, error) {
+	return m.GetUrlsFunc(fileKeys)
+}
+
+// GetUploadUrl calls the mocked GetUploadUrlFunc
+func (m *MockStorageRepository) GetUploadUrl(fileKey string, contentType string, size int64) (string, error) {
+	return m.GetUploadUrlFunc(fileKey, contentType, size)
+}
+
 // DeleteImage calls the mocked DeleteImageFunc
 func (m *MockStorageRepository) DeleteImage(fileKey string) error {
 	return m.DeleteImageFunc(fileKey)
 }
+
+// GetDownloadUrl calls the mocked GetDownloadUrlFunc
+func (m *MockStorageRepository) GetDownloadUrl(fileKey string, filename string, expiry time.Duration) (string, error) {
+	return m.GetDownloadUrlFunc(fileKey, filename, expiry)
+}
@@ -0,0 +1,19 @@
+package mock
+
+import (
+	"github.com/aki-13627/animalia/backend-go/ent"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/repository"
+	"github.com/google/uuid"
+)
+
+// MockTakeoutRepository is a mock implementation of the TakeoutRepository interface
+type MockTakeoutRepository struct {
+	GetUserDataFunc func(userID uuid.UUID) (*ent.User, error)
+}
+
+// Ensure MockTakeoutRepository implements the TakeoutRepository interface
+var _ repository.TakeoutRepository = (*MockTakeoutRepository)(nil)
+
+func (m *MockTakeoutRepository) GetUserData(userID uuid.UUID) (*ent.User, error) {
+	return m.GetUserDataFunc(userID)
+}
@@ -0,0 +1,31 @@
+package mock
+
+import (
+	"time"
+
+	"github.com/aki-13627/animalia/backend-go/internal/domain/models"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/repository"
+	"github.com/google/uuid"
+)
+
+// MockTimelineSessionRepository is a mock implementation of the TimelineSessionRepository interface
+type MockTimelineSessionRepository struct {
+	CreateFunc        func(session models.TimelineSession) error
+	GetFunc           func(id uuid.UUID) (*models.TimelineSession, error)
+	DeleteExpiredFunc func(now time.Time) (int, error)
+}
+
+// Ensure MockTimelineSessionRepository implements the TimelineSessionRepository interface
+var _ repository.TimelineSessionRepository = (*MockTimelineSessionRepository)(nil)
+
+func (m *MockTimelineSessionRepository) Create(session models.TimelineSession) error {
+	return m.CreateFunc(session)
+}
+
+func (m *MockTimelineSessionRepository) Get(id uuid.UUID) (*models.TimelineSession, error) {
+	return m.GetFunc(id)
+}
+
+func (m *MockTimelineSessionRepository) DeleteExpired(now time.Time) (int, error) {
+	return m.DeleteExpiredFunc(now)
+}
@@ -0,0 +1,70 @@
+package mock
+
+import (
+	"github.com/aki-13627/animalia/backend-go/ent"
+	"github.com/aki-13627/animalia/backend-go/ent/followrelation"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/repository"
+	"github.com/google/uuid"
+)
+
+// MockUserRepository is a mock implementation of the UserRepository interface
+type MockUserRepository struct {
+	CreateFunc        func(name, email string) (*ent.User, error)
+	ExistsEmailFunc   func(email string) (bool, error)
+	FindByEmailFunc   func(email string) (*ent.User, error)
+	FindBySubjectFunc func(subject string) (*ent.User, error)
+	LinkSubjectFunc   func(id uuid.UUID, subject string) error
+	GetByIdFunc       func(id string) (*ent.User, error)
+	UpdateFunc        func(id string, name string, description string, newImageKey string) error
+	UpdateEmailFunc   func(id uuid.UUID, email string, beforeCommit func() error) error
+	FollowFunc        func(toId string, fromId string) (followrelation.Status, error)
+	UnfollowFunc      func(toId string, fromId string) error
+	SetPrivateFunc    func(id uuid.UUID, isPrivate bool) error
+}
+
+// Ensure MockUserRepository implements the UserRepository interface
+var _ repository.UserRepository = (*MockUserRepository)(nil)
+
+func (m *MockUserRepository) Create(name, email string) (*ent.User, error) {
+	return m.CreateFunc(name, email)
+}
+
+func (m *MockUserRepository) ExistsEmail(email string) (bool, error) {
+	return m.ExistsEmailFunc(email)
+}
+
+func (m *MockUserRepository) FindByEmail(email string) (*ent.User, error) {
+	return m.FindByEmailFunc(email)
+}
+
+func (m *MockUserRepository) FindBySubject(subject string) (*ent.User, error) {
+	return m.FindBySubjectFunc(subject)
+}
+
+func (m *MockUserRepository) LinkSubject(id uuid.UUID, subject string) error {
+	return m.LinkSubjectFunc(id, subject)
+}
+
+func (m *MockUserRepository) GetById(id string) (*ent.User, error) {
+	return m.GetByIdFunc(id)
+}
+
+func (m *MockUserRepository) Update(id string, name string, description string, newImageKey string) error {
+	return m.UpdateFunc(id, name, description, newImageKey)
+}
+
+func (m *MockUserRepository) UpdateEmail(id uuid.UUID, email string, beforeCommit func() error) error {
+	return m.UpdateEmailFunc(id, email, beforeCommit)
+}
+
+func (m *MockUserRepository) Follow(toId string, fromId string) (followrelation.Status, error) {
+	return m.FollowFunc(toId, fromId)
+}
+
+func (m *MockUserRepository) Unfollow(toId string, fromId string) error {
+	return m.UnfollowFunc(toId, fromId)
+}
+
+func (m *MockUserRepository) SetPrivate(id uuid.UUID, isPrivate bool) error {
+	return m.SetPrivateFunc(id, isPrivate)
+}
@@ -0,0 +1,25 @@
+package mock
+
+import (
+	"github.com/aki-13627/animalia/backend-go/internal/domain/models"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/repository"
+)
+
+// MockVideoRepository is a mock implementation of the VideoRepository interface
+type MockVideoRepository struct {
+	ProbeFunc       func(data []byte) (*models.VideoInfo, error)
+	PosterFrameFunc func(data []byte) ([]byte, error)
+}
+
+// Ensure MockVideoRepository implements VideoRepository interface
+var _ repository.VideoRepository = (*MockVideoRepository)(nil)
+
+// Probe calls the mocked ProbeFunc
+func (m *MockVideoRepository) Probe(data []byte) (*models.VideoInfo, error) {
+	return m.ProbeFunc(data)
+}
+
+// PosterFrame calls the mocked PosterFrameFunc
+func (m *MockVideoRepository) PosterFrame(data []byte) ([]byte, error) {
+	return m.PosterFrameFunc(data)
+}
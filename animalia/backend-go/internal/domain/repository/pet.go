@@ -2,10 +2,13 @@ package repository
 
 import (
 	"github.com/aki-13627/animalia/backend-go/ent"
+	"github.com/google/uuid"
 )
 
 type PetRepository interface {
 	GetByOwner(ownerID string) ([]*ent.Pet, error)
+	// GetById はペットを飼い主付きで返す
+	GetById(petID uuid.UUID) (*ent.Pet, error)
 	Create(name, petType, species, birthDay, fileKey, userID string) (*ent.Pet, error)
 	Update(petID, name, petType, species, birthDay string) error
 	Delete(petID string) error
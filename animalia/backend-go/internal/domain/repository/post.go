@@ -2,14 +2,42 @@ package repository
 
 import (
 	"github.com/aki-13627/animalia/backend-go/ent"
+	"github.com/aki-13627/animalia/backend-go/ent/post"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/models"
 	"github.com/google/uuid"
 )
 
 type PostRepository interface {
-	GetAllPosts() ([]*ent.Post, error)
-	GetPostsByUser(userId uuid.UUID) ([]*ent.Post, error)
-	CreatePost(caption, userId, fileKey string, dailyTaskId *string) (*ent.Post, error)
-	UpdatePost(postId, caption string) error
+	// GetAllPosts は viewerID との間にブロックがあるユーザーと、viewerID がミュートしているユーザーの投稿、
+	// 公開範囲や非公開アカウントのため viewerID から見えない投稿を除いて、新しい順に1ページ分返す。
+	// コメント・いいねは新しい models.PostPreviewSize 件だけを読み込み、件数は別に選択する。
+	// 続きがあれば次のページのカーソルも返す
+	GetAllPosts(viewerID uuid.UUID, page models.PageRequest) ([]*ent.Post, string, error)
+	// GetPostsByUser は viewerID との間にブロックがあるユーザーのコメントと、公開範囲のため viewerID から見えない投稿を除いて、
+	// 新しい順に1ページ分返す。非公開アカウントの投稿を見せてよいかは呼び出し側で確認する
+	GetPostsByUser(userId, viewerID uuid.UUID, page models.PageRequest) ([]*ent.Post, string, error)
+	// CreatePost は media の順に画像・動画を登録し、先頭のプレビュー画像をカバー画像にする
+	CreatePost(caption, userId string, visibility post.Visibility, media []models.MediaItem, dailyTaskId *string) (*ent.Post, error)
+	// UpdatePost はキャプションを更新し、編集履歴に新しい版を追加する。
+	// 初めての編集では編集前のキャプションも最初の版として残す。キャプションが変わらなければ何もしない
+	UpdatePost(editorID uuid.UUID, postId, caption string) error
+	// GetRevisions は投稿の編集履歴を古い順に編集者付きで返す
+	GetRevisions(postId uuid.UUID) ([]*ent.PostRevision, error)
+	UpdateVisibility(postId uuid.UUID, visibility post.Visibility) error
 	DeletePost(postId string) error
+	// GetById は削除されていない投稿を投稿者付きで返す
 	GetById(postId uuid.UUID) (*ent.Post, error)
+	// GetDetail は投稿を投稿者・コメント・いいね・デイリータスク・画像付きで返す。コメント・いいねは GetAllPosts と同じくプレビューだけを読み込む。
+	// 削除済み・非表示の投稿と、ブロックや公開範囲のため viewerID から見えない投稿は NotFound にする。
+	// viewerID に uuid.Nil を渡すと、公開アカウントの public の投稿だけを返す
+	GetDetail(postId, viewerID uuid.UUID) (*ent.Post, error)
+	// IsVisible は投稿が viewerID から見えるかを GetDetail と同じ条件で返す。
+	// 削除済み・非表示の投稿、退会したユーザーやブロックの相手の投稿、公開範囲や非公開アカウントのため見えない投稿は false にする
+	IsVisible(postId, viewerID uuid.UUID) (bool, error)
+	// GetByIDs は postIds の投稿を GetAllPosts と同じ関連付きで返す。viewerID のフィードに表示しない投稿は含まない。
+	// 並び順は postIds の順とは限らない
+	GetByIDs(postIds []uuid.UUID, viewerID uuid.UUID) ([]*ent.Post, error)
+	// GetHiddenIDs は与えた投稿・コメントのうち、削除済みか通報で非表示になっているもの、
+	// viewerID のフィードから除くユーザーのもの、公開範囲や非公開アカウントのため viewerID から見えない投稿の ID を返す
+	GetHiddenIDs(viewerID uuid.UUID, postIds, commentIds []uuid.UUID) (map[uuid.UUID]bool, error)
 }
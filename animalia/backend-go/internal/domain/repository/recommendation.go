@@ -0,0 +1,14 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// RecommendationRepository は推薦 API からタイムラインの投稿を取得する
+type RecommendationRepository interface {
+	// Timeline は userID 向けの投稿 ID を推薦順に返す。
+	// 推薦 API が続けて失敗している間は呼び出さずに models.ErrRecommendationUnavailable を返す
+	Timeline(ctx context.Context, userID uuid.UUID) ([]uuid.UUID, error)
+}
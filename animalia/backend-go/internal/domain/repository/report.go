@@ -0,0 +1,22 @@
+package repository
+
+import (
+	"github.com/aki-13627/animalia/backend-go/ent"
+	"github.com/aki-13627/animalia/backend-go/ent/report"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/models"
+	"github.com/google/uuid"
+)
+
+type ReportRepository interface {
+	// Create は通報を登録し、同じ対象に対する未対応の通報をした人数を返す。対象が存在しなければ NotFound
+	Create(reporterID uuid.UUID, targetType report.TargetType, targetID uuid.UUID, reason report.Reason, detail string) (*ent.Report, int, error)
+	// ExistsOpen は reporterID が同じ対象にまだ対応されていない通報をしているかを返す
+	ExistsOpen(reporterID uuid.UUID, targetType report.TargetType, targetID uuid.UUID) (bool, error)
+	GetById(reportID uuid.UUID) (*ent.Report, error)
+	// GetTargetAuthor は通報された投稿・コメントの投稿者、またはユーザー自身を返す
+	GetTargetAuthor(targetType report.TargetType, targetID uuid.UUID) (*ent.User, error)
+	// AutoHide は通報数がしきい値に達した投稿・コメントを非表示にする。すでに非表示なら何もしない
+	AutoHide(targetType report.TargetType, targetID uuid.UUID, reportCount int) error
+	// Resolve は通報と同じ対象への未対応の通報をすべて decision の通りに処理し、処理した件数を返す
+	Resolve(actorID uuid.UUID, reportID uuid.UUID, decision models.ReportDecision) (int, error)
+}
@@ -1,9 +1,26 @@
 package repository
 
-import "mime/multipart"
+import (
+	"mime/multipart"
+	"time"
+
+	"github.com/aki-13627/animalia/backend-go/internal/domain/models"
+)
 
 type StorageRepository interface {
 	UploadImage(file *multipart.FileHeader, directory string) (string, error)
+	PutObject(fileKey string, body []byte, contentType string) error
+	GetObject(fileKey string) ([]byte, error)
+	StatObject(fileKey string) (*models.ObjectInfo, error)
+	// ListObjects は prefix 配下のすべてのオブジェクトを返す（ContentType は含まない）
+	ListObjects(prefix string) ([]models.ObjectInfo, error)
+	// ImportObject は直接アップロードされた srcKey のオブジェクトを directory 配下に取り込み、新しいキーを返す
+	ImportObject(srcKey string, directory string) (string, error)
 	GetUrl(fileKey string) (string, error)
+	// GetUrls は複数のキーの URL をまとめて取得し、キーから URL へのマップを返す
+	GetUrls(fileKeys []string) (map[string]string, error)
+	GetUploadUrl(fileKey string, contentType string, size int64) (string, error)
+	// GetDownloadUrl は filename で保存させる、expiry の間だけ有効なダウンロード用 URL を返す
+	GetDownloadUrl(fileKey string, filename string, expiry time.Duration) (string, error)
 	DeleteImage(fileKey string) error
 }
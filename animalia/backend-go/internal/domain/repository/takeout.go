@@ -0,0 +1,11 @@
+package repository
+
+import (
+	"github.com/aki-13627/animalia/backend-go/ent"
+	"github.com/google/uuid"
+)
+
+type TakeoutRepository interface {
+	// GetUserData はエクスポートに含めるユーザーのデータをエッジとあわせて取得する
+	GetUserData(userID uuid.UUID) (*ent.User, error)
+}
@@ -0,0 +1,19 @@
+package repository
+
+import (
+	"time"
+
+	"github.com/aki-13627/animalia/backend-go/internal/domain/models"
+	"github.com/google/uuid"
+)
+
+// TimelineSessionRepository はタイムラインのセッションの保存先。
+// Postgres とプロセス内のメモリの実装があり、TIMELINE_SESSION_BACKEND で切り替える
+type TimelineSessionRepository interface {
+	// Create はセッションを保存する。同じユーザーの期限切れのセッションは削除する
+	Create(session models.TimelineSession) error
+	// Get はセッションを返す。存在しない場合は models.ErrTimelineSessionNotFound を返す
+	Get(id uuid.UUID) (*models.TimelineSession, error)
+	// DeleteExpired は now の時点で期限切れのセッションを削除し、削除した件数を返す
+	DeleteExpired(now time.Time) (int, error)
+}
@@ -2,14 +2,26 @@ package repository
 
 import (
 	"github.com/aki-13627/animalia/backend-go/ent"
+	"github.com/aki-13627/animalia/backend-go/ent/followrelation"
+	"github.com/google/uuid"
 )
 
 type UserRepository interface {
 	Create(name, email string) (*ent.User, error)
 	ExistsEmail(email string) (bool, error)
 	FindByEmail(email string) (*ent.User, error)
+	// FindBySubject は認証プロバイダのユーザー ID (sub) に対応する、退会していないユーザーを返す
+	FindBySubject(subject string) (*ent.User, error)
+	// LinkSubject はユーザーに認証プロバイダのユーザー ID (sub) を記録する
+	LinkSubject(id uuid.UUID, subject string) error
 	GetById(id string) (*ent.User, error)
 	Update(id string, name string, description string, newImageKey string) error
-	Follow(toId string, fromId string) error
+	// UpdateEmail はトランザクション内でメールアドレスを更新し、コミット前に beforeCommit を呼ぶ。
+	// beforeCommit が失敗した場合は更新をロールバックする
+	UpdateEmail(id uuid.UUID, email string, beforeCommit func() error) error
+	// Follow は toId のユーザーが非公開アカウントなら未承認のフォローリクエストを作り、作成した関係の状態を返す
+	Follow(toId string, fromId string) (followrelation.Status, error)
 	Unfollow(toId string, fromId string) error
+	// SetPrivate はアカウントの公開設定を変更する。公開に戻した場合は未承認のフォローリクエストをすべて承認する
+	SetPrivate(id uuid.UUID, isPrivate bool) error
 }
@@ -0,0 +1,10 @@
+package repository
+
+import "github.com/aki-13627/animalia/backend-go/internal/domain/models"
+
+type VideoRepository interface {
+	// Probe は動画を解析して長さと解像度を返す
+	Probe(data []byte) (*models.VideoInfo, error)
+	// PosterFrame は動画の代表的なフレームを JPEG で返す
+	PosterFrame(data []byte) ([]byte, error)
+}
@@ -0,0 +1,150 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/aki-13627/animalia/backend-go/internal/domain/middlewares"
+	"github.com/aki-13627/animalia/backend-go/internal/usecase"
+	"github.com/labstack/echo/v4"
+	"github.com/labstack/gommon/log"
+)
+
+const (
+	defaultPageSize = 50
+	maxPageSize     = 100
+)
+
+type AdminHandler struct {
+	adminUsecase usecase.AdminUsecase
+}
+
+func NewAdminHandler(adminUsecase usecase.AdminUsecase) *AdminHandler {
+	return &AdminHandler{
+		adminUsecase: adminUsecase,
+	}
+}
+
+// moderationRequest は強制削除・アカウント停止の理由。監査ログに記録する
+type moderationRequest struct {
+	Reason string `json:"reason" query:"reason"`
+}
+
+func (h *AdminHandler) ListUsers(c echo.Context) error {
+	limit, offset := pagination(c)
+	users, err := h.adminUsecase.SearchUsers(c.QueryParam("q"), limit, offset)
+	if err != nil {
+		log.Errorf("Failed to search users: %v", err)
+		return errorResponse(c, err, "ユーザーの取得に失敗しました")
+	}
+	return c.JSON(http.StatusOK, users)
+}
+
+func (h *AdminHandler) SuspendUser(c echo.Context) error {
+	return h.setSuspended(c, true)
+}
+
+func (h *AdminHandler) UnsuspendUser(c echo.Context) error {
+	return h.setSuspended(c, false)
+}
+
+func (h *AdminHandler) setSuspended(c echo.Context, suspended bool) error {
+	principal, ok := middlewares.CurrentPrincipal(c)
+	if !ok {
+		return unauthorizedResponse(c)
+	}
+	var req moderationRequest
+	if err := c.Bind(&req); err != nil {
+		log.Errorf("Failed to parse request body: %v", err)
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error": "Invalid request body",
+		})
+	}
+
+	user, err := h.adminUsecase.SetSuspended(principal, c.Param("id"), suspended, req.Reason)
+	if err != nil {
+		log.Errorf("Failed to update suspension of user %s: %v", c.Param("id"), err)
+		return errorResponse(c, err, "アカウントの停止状態の変更に失敗しました")
+	}
+	return c.JSON(http.StatusOK, user)
+}
+
+func (h *AdminHandler) DeletePost(c echo.Context) error {
+	principal, ok := middlewares.CurrentPrincipal(c)
+	if !ok {
+		return unauthorizedResponse(c)
+	}
+	var req moderationRequest
+	if err := c.Bind(&req); err != nil {
+		log.Errorf("Failed to parse request: %v", err)
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error": "Invalid request body",
+		})
+	}
+
+	if err := h.adminUsecase.ForceDeletePost(principal, c.Param("id"), req.Reason); err != nil {
+		log.Errorf("Failed to force delete post %s: %v", c.Param("id"), err)
+		return errorResponse(c, err, "投稿の削除に失敗しました")
+	}
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"message": "投稿を削除しました",
+	})
+}
+
+func (h *AdminHandler) DeleteComment(c echo.Context) error {
+	principal, ok := middlewares.CurrentPrincipal(c)
+	if !ok {
+		return unauthorizedResponse(c)
+	}
+	var req moderationRequest
+	if err := c.Bind(&req); err != nil {
+		log.Errorf("Failed to parse request: %v", err)
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error": "Invalid request body",
+		})
+	}
+
+	if err := h.adminUsecase.ForceDeleteComment(principal, c.Param("id"), req.Reason); err != nil {
+		log.Errorf("Failed to force delete comment %s: %v", c.Param("id"), err)
+		return errorResponse(c, err, "コメントの削除に失敗しました")
+	}
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"message": "コメントを削除しました",
+	})
+}
+
+func (h *AdminHandler) ListReports(c echo.Context) error {
+	limit, offset := pagination(c)
+	reports, err := h.adminUsecase.ListReports(c.QueryParam("status"), limit, offset)
+	if err != nil {
+		log.Errorf("Failed to list reports: %v", err)
+		return errorResponse(c, err, "通報の取得に失敗しました")
+	}
+	return c.JSON(http.StatusOK, reports)
+}
+
+func (h *AdminHandler) ListAuditLogs(c echo.Context) error {
+	limit, offset := pagination(c)
+	logs, err := h.adminUsecase.ListAuditLogs(limit, offset)
+	if err != nil {
+		log.Errorf("Failed to list audit logs: %v", err)
+		return errorResponse(c, err, "監査ログの取得に失敗しました")
+	}
+	return c.JSON(http.StatusOK, logs)
+}
+
+// pagination はクエリパラメータの limit・offset を返す。不正な値は既定値にする
+func pagination(c echo.Context) (int, int) {
+	limit, err := strconv.Atoi(c.QueryParam("limit"))
+	if err != nil || limit <= 0 {
+		limit = defaultPageSize
+	}
+	if limit > maxPageSize {
+		limit = maxPageSize
+	}
+	offset, err := strconv.Atoi(c.QueryParam("offset"))
+	if err != nil || offset < 0 {
+		offset = 0
+	}
+	return limit, offset
+}
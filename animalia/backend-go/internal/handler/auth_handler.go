@@ -1,12 +1,14 @@
 package handler
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
-	"strings"
 
+	"github.com/aki-13627/animalia/backend-go/internal/domain/middlewares"
 	"github.com/aki-13627/animalia/backend-go/internal/domain/models"
 	"github.com/aki-13627/animalia/backend-go/internal/usecase"
+	"github.com/google/uuid"
 	"github.com/labstack/echo/v4"
 	"github.com/labstack/gommon/log"
 )
@@ -16,14 +18,16 @@ type AuthHandler struct {
 	userUsecase      usecase.UserUsecase
 	dailyTaskUsecase usecase.DailyTaskUsecase
 	storageUsecase   usecase.StorageUsecase
+	accountUsecase   usecase.AccountUsecase
 }
 
-func NewAuthHandler(authUsecase usecase.AuthUsecase, userUsecase usecase.UserUsecase, storageUsecase usecase.StorageUsecase, dailyTaskUsecase usecase.DailyTaskUsecase) *AuthHandler {
+func NewAuthHandler(authUsecase usecase.AuthUsecase, userUsecase usecase.UserUsecase, storageUsecase usecase.StorageUsecase, dailyTaskUsecase usecase.DailyTaskUsecase, accountUsecase usecase.AccountUsecase) *AuthHandler {
 	return &AuthHandler{
 		authUsecase:      authUsecase,
 		userUsecase:      userUsecase,
 		storageUsecase:   storageUsecase,
 		dailyTaskUsecase: dailyTaskUsecase,
+		accountUsecase:   accountUsecase,
 	}
 }
 
@@ -50,7 +54,7 @@ func (h *AuthHandler) SignIn(c echo.Context) error {
 	}
 
 	// ユーザー情報の取得
-	user, err := h.userUsecase.GetByEmail(req.Email)
+	user, err := h.userUsecase.GetByEmail(uuid.Nil, req.Email)
 
 	// IconImageKey が空の場合は URL を生成せずにレスポンスを返す
 	if err != nil {
@@ -63,9 +67,9 @@ func (h *AuthHandler) SignIn(c echo.Context) error {
 	return c.JSON(http.StatusOK, map[string]interface{}{
 		"message":      "ログイン成功",
 		"user":         user,
-		"accessToken":  *result.AuthenticationResult.AccessToken,
-		"idToken":      *result.AuthenticationResult.IdToken,
-		"refreshToken": *result.AuthenticationResult.RefreshToken,
+		"accessToken":  result.AccessToken,
+		"idToken":      result.IdToken,
+		"refreshToken": result.RefreshToken,
 	})
 }
 
@@ -98,8 +102,8 @@ func (h *AuthHandler) RefreshToken(c echo.Context) error {
 
 	// レスポンスの作成
 	resp := models.RefreshTokenResponse{
-		AccessToken: *result.AuthenticationResult.AccessToken,
-		IdToken:     *result.AuthenticationResult.IdToken,
+		AccessToken: result.AccessToken,
+		IdToken:     result.IdToken,
 	}
 
 	return c.JSON(http.StatusOK, resp)
@@ -183,26 +187,16 @@ func (h *AuthHandler) SignUp(c echo.Context) error {
 }
 
 func (h *AuthHandler) GetMe(c echo.Context) error {
-	// リクエストヘッダーから Authorization トークンを取得
-	authHeader := c.Request().Header.Get("Authorization")
-	if authHeader == "" {
-		log.Error("Failed to get user email: token is empty")
+	// AuthMiddleware が検証したトークンのユーザー
+	principal, ok := middlewares.CurrentPrincipal(c)
+	if !ok {
+		log.Error("Failed to get user email: principal is empty")
 		return c.JSON(http.StatusUnauthorized, map[string]interface{}{
 			"error": "アクセストークンが必要です",
 		})
 	}
 
-	tokenString := strings.TrimPrefix(authHeader, "Bearer ")
-
-	email, err := h.authUsecase.GetUserEmail(tokenString)
-	if err != nil {
-		log.Errorf("Failed to get user email: %v", err)
-		return c.JSON(http.StatusUnauthorized, map[string]interface{}{
-			"error": "無効なアクセストークンです",
-		})
-	}
-
-	userResponse, err := h.userUsecase.GetByEmail(email)
+	userResponse, err := h.userUsecase.GetByEmail(principal.UserID, principal.Email)
 	if err != nil {
 		log.Errorf("Failed to get user: %v", err)
 		return c.JSON(http.StatusInternalServerError, map[string]interface{}{
@@ -214,14 +208,13 @@ func (h *AuthHandler) GetMe(c echo.Context) error {
 
 func (h *AuthHandler) SignOut(c echo.Context) error {
 	// Authorization ヘッダーからトークンを取得
-	authHeader := c.Request().Header.Get("Authorization")
-	if authHeader == "" || len(authHeader) < 8 || authHeader[:7] != "Bearer " {
+	accessToken, ok := bearerToken(c)
+	if !ok {
 		log.Error("Failed to sign out: token is empty")
 		return c.JSON(http.StatusUnauthorized, map[string]interface{}{
 			"error": "トークンがありません",
 		})
 	}
-	accessToken := authHeader[7:]
 
 	if err := h.authUsecase.SignOut(accessToken); err != nil {
 		log.Errorf("Failed to sign out: %v", err)
@@ -237,16 +230,15 @@ func (h *AuthHandler) SignOut(c echo.Context) error {
 
 func (h *AuthHandler) GetSession(c echo.Context) error {
 	// Authorization ヘッダーからIDトークンを取得
-	authHeader := c.Request().Header.Get("Authorization")
-	if authHeader == "" || len(authHeader) < 8 || authHeader[:7] != "Bearer " {
+	idToken, ok := bearerToken(c)
+	if !ok {
 		log.Error("Failed to get session: token is empty")
 		return c.JSON(http.StatusUnauthorized, map[string]interface{}{
 			"error": "トークンがありません",
 		})
 	}
-	idToken := authHeader[7:]
 
-	user, err := h.authUsecase.GetUserEmail(idToken)
+	principal, err := h.authUsecase.Authenticate(idToken)
 	if err != nil {
 		log.Errorf("Failed to get session: %v", err)
 		return c.JSON(http.StatusBadRequest, map[string]interface{}{
@@ -254,5 +246,222 @@ func (h *AuthHandler) GetSession(c echo.Context) error {
 		})
 	}
 
-	return c.JSON(http.StatusOK, user)
+	return c.JSON(http.StatusOK, principal.Email)
+}
+
+func (h *AuthHandler) ForgotPassword(c echo.Context) error {
+	var req struct {
+		Email string `json:"email"`
+	}
+	if err := c.Bind(&req); err != nil || req.Email == "" {
+		log.Errorf("Failed to start password reset: invalid request body: %v", err)
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error": "メールアドレスが必要です",
+		})
+	}
+
+	if err := h.authUsecase.ForgotPassword(req.Email); err != nil {
+		log.Errorf("Failed to start password reset: %v", err)
+		return c.JSON(http.StatusInternalServerError, map[string]interface{}{
+			"error": "確認コードの送信に失敗しました",
+		})
+	}
+
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"message": "パスワード再設定の確認コードを送信しました",
+	})
+}
+
+func (h *AuthHandler) ConfirmForgotPassword(c echo.Context) error {
+	var req struct {
+		Email       string `json:"email"`
+		Code        string `json:"code"`
+		NewPassword string `json:"newPassword"`
+	}
+	if err := c.Bind(&req); err != nil {
+		log.Errorf("Failed to parse request body: %v", err)
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error": "Invalid request body",
+		})
+	}
+	if req.Email == "" || req.Code == "" || req.NewPassword == "" {
+		log.Error("Failed to reset password: information is missing")
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error": "情報が不足しています",
+		})
+	}
+
+	if err := h.authUsecase.ConfirmForgotPassword(req.Email, req.Code, req.NewPassword); err != nil {
+		log.Errorf("Failed to reset password: %v", err)
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error": "パスワードの再設定に失敗しました",
+		})
+	}
+
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"message": "パスワードを再設定しました",
+	})
+}
+
+func (h *AuthHandler) ChangePassword(c echo.Context) error {
+	var req struct {
+		OldPassword string `json:"oldPassword"`
+		NewPassword string `json:"newPassword"`
+	}
+	if err := c.Bind(&req); err != nil {
+		log.Errorf("Failed to parse request body: %v", err)
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error": "Invalid request body",
+		})
+	}
+	if req.OldPassword == "" || req.NewPassword == "" {
+		log.Error("Failed to change password: information is missing")
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error": "情報が不足しています",
+		})
+	}
+	accessToken, ok := bearerToken(c)
+	if !ok {
+		return unauthorizedResponse(c)
+	}
+
+	if err := h.authUsecase.ChangePassword(accessToken, req.OldPassword, req.NewPassword); err != nil {
+		log.Errorf("Failed to change password: %v", err)
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error": "パスワードの変更に失敗しました",
+		})
+	}
+
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"message": "パスワードを変更しました",
+	})
+}
+
+// RequestEmailChange は新しいメールアドレスに確認コードを送る。確認されるまでは元のアドレスのまま
+func (h *AuthHandler) RequestEmailChange(c echo.Context) error {
+	var req struct {
+		NewEmail string `json:"newEmail"`
+	}
+	if err := c.Bind(&req); err != nil || req.NewEmail == "" {
+		log.Errorf("Failed to request email change: invalid request body: %v", err)
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error": "新しいメールアドレスが必要です",
+		})
+	}
+	principal, ok := middlewares.CurrentPrincipal(c)
+	if !ok {
+		return unauthorizedResponse(c)
+	}
+	accessToken, ok := bearerToken(c)
+	if !ok {
+		return unauthorizedResponse(c)
+	}
+
+	if err := h.authUsecase.RequestEmailChange(principal, accessToken, req.NewEmail); err != nil {
+		log.Errorf("Failed to request email change: %v", err)
+		if errors.Is(err, usecase.ErrEmailAlreadyUsed) {
+			return c.JSON(http.StatusConflict, map[string]interface{}{
+				"error": "このメールアドレスは既に登録されています",
+			})
+		}
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error": "メールアドレスの変更に失敗しました",
+		})
+	}
+
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"message": "新しいメールアドレスに確認コードを送信しました",
+	})
+}
+
+func (h *AuthHandler) ConfirmEmailChange(c echo.Context) error {
+	var req struct {
+		NewEmail string `json:"newEmail"`
+		Code     string `json:"code"`
+	}
+	if err := c.Bind(&req); err != nil {
+		log.Errorf("Failed to parse request body: %v", err)
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error": "Invalid request body",
+		})
+	}
+	if req.NewEmail == "" || req.Code == "" {
+		log.Error("Failed to confirm email change: information is missing")
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error": "情報が不足しています",
+		})
+	}
+	principal, ok := middlewares.CurrentPrincipal(c)
+	if !ok {
+		return unauthorizedResponse(c)
+	}
+	accessToken, ok := bearerToken(c)
+	if !ok {
+		return unauthorizedResponse(c)
+	}
+
+	if err := h.authUsecase.ConfirmEmailChange(principal, accessToken, req.NewEmail, req.Code); err != nil {
+		log.Errorf("Failed to confirm email change: %v", err)
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error": "メールアドレスの変更に失敗しました",
+		})
+	}
+
+	// 発行済みのトークンには元のメールアドレスが入っているため、クライアントはトークンを更新する
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"message": "メールアドレスを変更しました",
+		"email":   req.NewEmail,
+	})
+}
+
+// RestoreAccount は退会の猶予期間中のアカウントを復元し、サインインと同じトークンを返す
+func (h *AuthHandler) RestoreAccount(c echo.Context) error {
+	var req struct {
+		Email    string `json:"email"`
+		Password string `json:"password"`
+	}
+	if err := c.Bind(&req); err != nil {
+		log.Errorf("Failed to parse request body: %v", err)
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error": "リクエストのパースに失敗しました",
+		})
+	}
+
+	result, err := h.accountUsecase.Restore(req.Email, req.Password)
+	if err != nil {
+		log.Errorf("Failed to restore account: %v", err)
+		if errors.Is(err, usecase.ErrAccountNotRestorable) {
+			return c.JSON(http.StatusNotFound, map[string]interface{}{
+				"error": "復元できるアカウントがありません",
+			})
+		}
+		return c.JSON(http.StatusUnauthorized, map[string]interface{}{
+			"error": "アカウントの復元に失敗しました",
+		})
+	}
+
+	user, err := h.userUsecase.GetByEmail(uuid.Nil, req.Email)
+	if err != nil {
+		log.Errorf("Failed to get user by email: %v", err)
+		return c.JSON(http.StatusInternalServerError, map[string]interface{}{
+			"error": "ユーザー情報の取得に失敗しました",
+		})
+	}
+
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"message":      "アカウントを復元しました",
+		"user":         user,
+		"accessToken":  result.AccessToken,
+		"idToken":      result.IdToken,
+		"refreshToken": result.RefreshToken,
+	})
+}
+
+// bearerToken は Authorization ヘッダーのトークンを返す
+func bearerToken(c echo.Context) (string, bool) {
+	authHeader := c.Request().Header.Get("Authorization")
+	if len(authHeader) < 8 || authHeader[:7] != "Bearer " {
+		return "", false
+	}
+	return authHeader[7:], true
 }
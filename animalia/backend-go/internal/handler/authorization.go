@@ -0,0 +1,51 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/aki-13627/animalia/backend-go/internal/domain/middlewares"
+	"github.com/aki-13627/animalia/backend-go/internal/usecase"
+	"github.com/labstack/echo/v4"
+)
+
+// unauthorizedResponse は AuthMiddleware を通っておらず認証済みユーザーがいない場合に 401 を返す
+func unauthorizedResponse(c echo.Context) error {
+	return c.JSON(http.StatusUnauthorized, map[string]interface{}{
+		"error": "アクセストークンが必要です",
+	})
+}
+
+// currentEmail は認証済みユーザーのメールアドレスを返す。未認証の場合は空文字になり、アップロードトークンの検証で弾かれる
+func currentEmail(c echo.Context) string {
+	if principal, ok := middlewares.CurrentPrincipal(c); ok {
+		return principal.Email
+	}
+	return ""
+}
+
+// errorResponse は認可エラーを 403、存在しないリソースを 404、不正なパラメータを 400、期限切れのタイムラインを 410 に変換する。それ以外は 500 で message を返す
+func errorResponse(c echo.Context, err error, message string) error {
+	switch {
+	case errors.Is(err, usecase.ErrForbidden):
+		return c.JSON(http.StatusForbidden, map[string]interface{}{
+			"error": "この操作を行う権限がありません",
+		})
+	case errors.Is(err, usecase.ErrNotFound):
+		return c.JSON(http.StatusNotFound, map[string]interface{}{
+			"error": "対象が見つかりません",
+		})
+	case errors.Is(err, usecase.ErrInvalidArgument):
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error": "リクエストが不正です",
+		})
+	case errors.Is(err, usecase.ErrSessionExpired):
+		return c.JSON(http.StatusGone, map[string]interface{}{
+			"error": "タイムラインの有効期限が切れました。最初から読み込み直してください",
+		})
+	default:
+		return c.JSON(http.StatusInternalServerError, map[string]interface{}{
+			"error": message,
+		})
+	}
+}
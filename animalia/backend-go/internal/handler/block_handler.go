@@ -0,0 +1,78 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/aki-13627/animalia/backend-go/internal/domain/middlewares"
+	"github.com/aki-13627/animalia/backend-go/internal/usecase"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/labstack/gommon/log"
+)
+
+type BlockHandler struct {
+	blockUsecase usecase.BlockUsecase
+}
+
+func NewBlockHandler(blockUsecase usecase.BlockUsecase) *BlockHandler {
+	return &BlockHandler{
+		blockUsecase: blockUsecase,
+	}
+}
+
+func (h *BlockHandler) Block(c echo.Context) error {
+	return h.update(c, h.blockUsecase.Block, "ブロックしました", "ブロックに失敗しました")
+}
+
+func (h *BlockHandler) Unblock(c echo.Context) error {
+	return h.update(c, h.blockUsecase.Unblock, "ブロックを解除しました", "ブロックの解除に失敗しました")
+}
+
+func (h *BlockHandler) Mute(c echo.Context) error {
+	return h.update(c, h.blockUsecase.Mute, "ミュートしました", "ミュートに失敗しました")
+}
+
+func (h *BlockHandler) Unmute(c echo.Context) error {
+	return h.update(c, h.blockUsecase.Unmute, "ミュートを解除しました", "ミュートの解除に失敗しました")
+}
+
+func (h *BlockHandler) ListBlocked(c echo.Context) error {
+	principal, ok := middlewares.CurrentPrincipal(c)
+	if !ok {
+		return unauthorizedResponse(c)
+	}
+	users, err := h.blockUsecase.ListBlocked(principal.UserID)
+	if err != nil {
+		log.Errorf("Failed to list blocked users: %v", err)
+		return errorResponse(c, err, "ブロック中のユーザーの取得に失敗しました")
+	}
+	return c.JSON(http.StatusOK, map[string]interface{}{"blocked_users": users})
+}
+
+func (h *BlockHandler) ListMuted(c echo.Context) error {
+	principal, ok := middlewares.CurrentPrincipal(c)
+	if !ok {
+		return unauthorizedResponse(c)
+	}
+	users, err := h.blockUsecase.ListMuted(principal.UserID)
+	if err != nil {
+		log.Errorf("Failed to list muted users: %v", err)
+		return errorResponse(c, err, "ミュート中のユーザーの取得に失敗しました")
+	}
+	return c.JSON(http.StatusOK, map[string]interface{}{"muted_users": users})
+}
+
+// update はパスの :id のユーザーに対してブロック・ミュートの操作を行う
+func (h *BlockHandler) update(c echo.Context, action func(uuid.UUID, string) error, message, failure string) error {
+	principal, ok := middlewares.CurrentPrincipal(c)
+	if !ok {
+		return unauthorizedResponse(c)
+	}
+	if err := action(principal.UserID, c.Param("id")); err != nil {
+		log.Errorf("Failed to update block or mute of user %s: %v", c.Param("id"), err)
+		return errorResponse(c, err, failure)
+	}
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"message": message,
+	})
+}
@@ -3,6 +3,7 @@ package handler
 import (
 	"net/http"
 
+	"github.com/aki-13627/animalia/backend-go/internal/domain/middlewares"
 	"github.com/aki-13627/animalia/backend-go/internal/usecase"
 	"github.com/google/uuid"
 	"github.com/labstack/echo/v4"
@@ -11,25 +12,20 @@ import (
 
 type CommentHandler struct {
 	commentUsecase usecase.CommentUsecase
-	userUsecase    usecase.UserUsecase
 }
 
-func NewCommentHandler(commentUsecase usecase.CommentUsecase, userUsecase usecase.UserUsecase) *CommentHandler {
+func NewCommentHandler(commentUsecase usecase.CommentUsecase) *CommentHandler {
 	return &CommentHandler{
 		commentUsecase: commentUsecase,
-		userUsecase:    userUsecase,
 	}
 }
 
 func (h *CommentHandler) Create(c echo.Context) error {
 	postId := c.FormValue("postId")
 	content := c.FormValue("content")
-	user, err := h.userUsecase.FindByEmail(c.Get("email").(string))
-	if err != nil {
-		log.Errorf("Failed to find current user: %v", err)
-		return c.JSON(http.StatusInternalServerError, map[string]interface{}{
-			"error": "Failed to find current user",
-		})
+	principal, ok := middlewares.CurrentPrincipal(c)
+	if !ok {
+		return unauthorizedResponse(c)
 	}
 	parsedPostId, err := uuid.Parse(postId)
 	if err != nil {
@@ -38,12 +34,10 @@ func (h *CommentHandler) Create(c echo.Context) error {
 			"error": "Failed to parse postId",
 		})
 	}
-	comment, err := h.commentUsecase.Create(user.ID, parsedPostId, content)
+	comment, err := h.commentUsecase.Create(principal.UserID, parsedPostId, content)
 	if err != nil {
 		log.Errorf("Failed to create comment: %v", err)
-		return c.JSON(http.StatusInternalServerError, map[string]interface{}{
-			"error": "Failed to create comment",
-		})
+		return errorResponse(c, err, "Failed to create comment")
 	}
 	return c.JSON(http.StatusOK, map[string]interface{}{
 		"message": "Comment created successfully",
@@ -51,14 +45,37 @@ func (h *CommentHandler) Create(c echo.Context) error {
 	})
 }
 
+// ListByPost はパスの :id の投稿のコメントを新しい順に1ページ分返す
+func (h *CommentHandler) ListByPost(c echo.Context) error {
+	principal, ok := middlewares.CurrentPrincipal(c)
+	if !ok {
+		return unauthorizedResponse(c)
+	}
+	page, err := cursorPage(c)
+	if err != nil {
+		return errorResponse(c, err, "Failed to get comments")
+	}
+	comments, nextCursor, err := h.commentUsecase.ListByPost(principal.UserID, c.Param("id"), page)
+	if err != nil {
+		log.Errorf("Failed to get comments: %v", err)
+		return errorResponse(c, err, "Failed to get comments")
+	}
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"comments":   comments,
+		"nextCursor": nextCursor,
+	})
+}
+
 func (h *CommentHandler) Delete(c echo.Context) error {
+	principal, ok := middlewares.CurrentPrincipal(c)
+	if !ok {
+		return unauthorizedResponse(c)
+	}
 	commentId := c.QueryParam("commentId")
-	err := h.commentUsecase.Delete(commentId)
+	err := h.commentUsecase.Delete(principal.UserID, commentId)
 	if err != nil {
 		log.Errorf("Failed to delete comment: %v", err)
-		return c.JSON(http.StatusInternalServerError, map[string]interface{}{
-			"error": "Failed to delete comment",
-		})
+		return errorResponse(c, err, "Failed to delete comment")
 	}
 	return c.JSON(http.StatusOK, map[string]interface{}{
 		"message": "Comment deleted successfully",
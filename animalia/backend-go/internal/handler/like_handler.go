@@ -3,6 +3,7 @@ package handler
 import (
 	"net/http"
 
+	"github.com/aki-13627/animalia/backend-go/internal/domain/middlewares"
 	"github.com/aki-13627/animalia/backend-go/internal/usecase"
 	"github.com/labstack/echo/v4"
 	"github.com/labstack/gommon/log"
@@ -19,6 +20,10 @@ func NewLikeHandler(likeUsecase usecase.LikeUsecase) *LikeHandler {
 }
 
 func (h *LikeHandler) Create(c echo.Context) error {
+	principal, ok := middlewares.CurrentPrincipal(c)
+	if !ok {
+		return unauthorizedResponse(c)
+	}
 	userId := c.QueryParam("userId")
 	postId := c.QueryParam("postId")
 	if userId == "" || postId == "" {
@@ -27,29 +32,50 @@ func (h *LikeHandler) Create(c echo.Context) error {
 			"error": "userId または postId が指定されていません",
 		})
 	}
-	err := h.likeUsecase.Create(userId, postId)
+	err := h.likeUsecase.Create(principal.UserID, userId, postId)
 	if err != nil {
 		log.Errorf("Failed to create like: %v", err)
-		return c.JSON(http.StatusInternalServerError, map[string]interface{}{
-			"error": "Failed to create like",
-		})
+		return errorResponse(c, err, "Failed to create like")
 	}
 	return c.NoContent(http.StatusOK)
 }
 
 func (h *LikeHandler) Delete(c echo.Context) error {
+	principal, ok := middlewares.CurrentPrincipal(c)
+	if !ok {
+		return unauthorizedResponse(c)
+	}
 	userId := c.QueryParam("userId")
 	postId := c.QueryParam("postId")
-	err := h.likeUsecase.Delete(userId, postId)
+	err := h.likeUsecase.Delete(principal.UserID, userId, postId)
 	if err != nil {
 		log.Errorf("Failed to delete like: %v", err)
-		return c.JSON(http.StatusInternalServerError, map[string]interface{}{
-			"error": "Failed to delete like",
-		})
+		return errorResponse(c, err, "Failed to delete like")
 	}
 	return c.NoContent(http.StatusOK)
 }
 
+// ListByPost はパスの :id の投稿にいいねしたユーザーを新しい順に1ページ分返す
+func (h *LikeHandler) ListByPost(c echo.Context) error {
+	principal, ok := middlewares.CurrentPrincipal(c)
+	if !ok {
+		return unauthorizedResponse(c)
+	}
+	page, err := cursorPage(c)
+	if err != nil {
+		return errorResponse(c, err, "Failed to get likes")
+	}
+	likes, nextCursor, err := h.likeUsecase.ListByPost(principal.UserID, c.Param("id"), page)
+	if err != nil {
+		log.Errorf("Failed to get likes: %v", err)
+		return errorResponse(c, err, "Failed to get likes")
+	}
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"likes":      likes,
+		"nextCursor": nextCursor,
+	})
+}
+
 func (h *LikeHandler) Count(c echo.Context) error {
 	postId := c.Param("postId")
 	count, err := h.likeUsecase.Count(postId)
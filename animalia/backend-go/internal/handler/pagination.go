@@ -0,0 +1,15 @@
+package handler
+
+import (
+	"strconv"
+
+	"github.com/aki-13627/animalia/backend-go/internal/domain/models"
+	"github.com/aki-13627/animalia/backend-go/internal/usecase"
+	"github.com/labstack/echo/v4"
+)
+
+// cursorPage はクエリの cursor と limit から一覧の取得範囲を作る。レスポンスの nextCursor を cursor に渡すと続きを取得できる
+func cursorPage(c echo.Context) (models.PageRequest, error) {
+	limit, _ := strconv.Atoi(c.QueryParam("limit"))
+	return usecase.NewPageRequest(c.QueryParam("cursor"), limit)
+}
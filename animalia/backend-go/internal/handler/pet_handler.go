@@ -3,6 +3,7 @@ package handler
 import (
 	"net/http"
 
+	"github.com/aki-13627/animalia/backend-go/internal/domain/middlewares"
 	"github.com/aki-13627/animalia/backend-go/internal/domain/models"
 	"github.com/aki-13627/animalia/backend-go/internal/usecase"
 	"github.com/labstack/echo/v4"
@@ -37,16 +38,20 @@ func (h *PetHandler) GetByOwner(c echo.Context) error {
 			"error": "Failed to get pets",
 		})
 	}
+	imageKeys := make([]string, len(pets))
+	for i, pet := range pets {
+		imageKeys[i] = pet.ImageKey
+	}
+	imageURLs, err := h.storageUsecase.GetImageUrlsBatch(imageKeys)
+	if err != nil {
+		log.Errorf("Failed to get pet image URL: %v", err)
+		return c.JSON(http.StatusInternalServerError, map[string]interface{}{
+			"error": "Failed to get pet image URL",
+		})
+	}
 	petResponses := make([]models.PetResponse, len(pets))
 	for i, pet := range pets {
-		url, err := h.storageUsecase.GetUrl(pet.ImageKey)
-		if err != nil {
-			log.Errorf("Failed to get pet image URL: %v", err)
-			return c.JSON(http.StatusInternalServerError, map[string]interface{}{
-				"error": "Failed to get pet image URL",
-			})
-		}
-		petResponses[i] = models.NewPetResponse(pet, url)
+		petResponses[i] = models.NewPetResponse(pet, imageURLs[pet.ImageKey])
 	}
 
 	return c.JSON(http.StatusOK, map[string]interface{}{
@@ -55,6 +60,10 @@ func (h *PetHandler) GetByOwner(c echo.Context) error {
 }
 
 func (h *PetHandler) Create(c echo.Context) error {
+	principal, ok := middlewares.CurrentPrincipal(c)
+	if !ok {
+		return unauthorizedResponse(c)
+	}
 	form, err := c.MultipartForm()
 	if err != nil {
 		log.Errorf("Failed to create pet: invalid form data: %v", err)
@@ -70,15 +79,6 @@ func (h *PetHandler) Create(c echo.Context) error {
 	birthDay := form.Value["birthDay"][0]
 	userID := form.Value["userId"][0]
 
-	// Get the image file
-	file, err := c.FormFile("image")
-	if err != nil {
-		log.Errorf("Failed to create pet: image file is required: %v", err)
-		return c.JSON(http.StatusBadRequest, map[string]interface{}{
-			"error": "Image file is required",
-		})
-	}
-
 	// Validate form values
 	if name == "" || petType == "" || birthDay == "" || userID == "" {
 		log.Error("Failed to create pet: missing required fields")
@@ -87,21 +87,23 @@ func (h *PetHandler) Create(c echo.Context) error {
 		})
 	}
 
-	// Upload the image
-	fileKey, err := h.storageUsecase.UploadImage(file, "pets")
+	// 他人のペットとして登録しようとした場合は画像を取り込む前に弾く
+	if err := usecase.AuthorizeUser(principal.UserID, userID); err != nil {
+		log.Errorf("Failed to create pet: %v", err)
+		return errorResponse(c, err, "Failed to create pet")
+	}
+
+	// Upload the image (multipart file or a direct upload token)
+	fileKey, err := resolveImageKey(c, h.storageUsecase, "pets")
 	if err != nil {
 		log.Errorf("Failed to create pet: failed to upload image: %v", err)
-		return c.JSON(http.StatusInternalServerError, map[string]interface{}{
-			"error": "Failed to upload image",
-		})
+		return uploadErrorResponse(c, err, "Failed to upload image")
 	}
 
-	_, err = h.petUsecase.Create(name, petType, species, birthDay, fileKey, userID)
+	_, err = h.petUsecase.Create(principal.UserID, name, petType, species, birthDay, fileKey, userID)
 	if err != nil {
 		log.Errorf("Failed to create pet: failed to create pet: %v", err)
-		return c.JSON(http.StatusInternalServerError, map[string]interface{}{
-			"error": "Failed to create pet",
-		})
+		return errorResponse(c, err, "Failed to create pet")
 	}
 
 	return c.JSON(http.StatusOK, map[string]interface{}{
@@ -110,6 +112,10 @@ func (h *PetHandler) Create(c echo.Context) error {
 }
 
 func (h *PetHandler) Update(c echo.Context) error {
+	principal, ok := middlewares.CurrentPrincipal(c)
+	if !ok {
+		return unauthorizedResponse(c)
+	}
 	petId := c.QueryParam("petId")
 	if petId == "" {
 		log.Error("Failed to update pet: petId is empty")
@@ -131,11 +137,9 @@ func (h *PetHandler) Update(c echo.Context) error {
 	species := form.Value["species"][0]
 	birthDay := form.Value["birthDay"][0]
 
-	if err := h.petUsecase.Update(petId, name, petType, species, birthDay); err != nil {
+	if err := h.petUsecase.Update(principal.UserID, petId, name, petType, species, birthDay); err != nil {
 		log.Errorf("Failed to update pet: failed to update pet: %v", err)
-		return c.JSON(http.StatusInternalServerError, map[string]interface{}{
-			"error": "Failed to update pet",
-		})
+		return errorResponse(c, err, "Failed to update pet")
 	}
 
 	return c.JSON(http.StatusOK, map[string]interface{}{
@@ -144,6 +148,10 @@ func (h *PetHandler) Update(c echo.Context) error {
 }
 
 func (h *PetHandler) Delete(c echo.Context) error {
+	principal, ok := middlewares.CurrentPrincipal(c)
+	if !ok {
+		return unauthorizedResponse(c)
+	}
 	petId := c.QueryParam("petId")
 	if petId == "" {
 		log.Error("Failed to delete pet: petId is empty")
@@ -152,11 +160,9 @@ func (h *PetHandler) Delete(c echo.Context) error {
 		})
 	}
 
-	if err := h.petUsecase.Delete(petId); err != nil {
+	if err := h.petUsecase.Delete(principal.UserID, petId); err != nil {
 		log.Errorf("Failed to delete pet: failed to delete pet: %v", err)
-		return c.JSON(http.StatusInternalServerError, map[string]interface{}{
-			"error": "Failed to delete pet",
-		})
+		return errorResponse(c, err, "Failed to delete pet")
 	}
 
 	return c.JSON(http.StatusOK, map[string]interface{}{
@@ -1,274 +1,207 @@
 package handler
 
 import (
-	"bytes"
-	"encoding/json"
 	"fmt"
-	"io"
 	"net/http"
-	"strings"
 
+	"github.com/aki-13627/animalia/backend-go/ent"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/middlewares"
 	"github.com/aki-13627/animalia/backend-go/internal/domain/models"
-	"github.com/aki-13627/animalia/backend-go/internal/domain/models/fastapi"
 	"github.com/aki-13627/animalia/backend-go/internal/usecase"
-	"github.com/google/uuid"
 	"github.com/labstack/echo/v4"
 	"github.com/labstack/gommon/log"
 )
 
 type PostHandler struct {
-	postUsecase    usecase.PostUsecase
-	storageUsecase usecase.StorageUsecase
-	cacheUsecase   usecase.CacheUsecase
+	postUsecase     usecase.PostUsecase
+	storageUsecase  usecase.StorageUsecase
+	timelineUsecase usecase.TimelineUsecase
+	// publicBaseURL は共有ページの URL に使う。空の場合はリクエストのホストを使う
+	publicBaseURL string
 }
 type TimelineRequest struct {
-	UserID uuid.UUID `json:"user_id"`
-	Cursor *string   `json:"cursor,omitempty"`
-	Limit  int       `json:"limit"`
+	// SessionID は最初のページで返した sessionId。省略すると推薦 API から新しいセッションを作る
+	SessionID string  `json:"sessionId,omitempty"`
+	Cursor    *string `json:"cursor,omitempty"`
+	Limit     int     `json:"limit"`
 }
 
-func NewPostHandler(postUsecase usecase.PostUsecase, storageUsecase usecase.StorageUsecase, cacheUsecase usecase.CacheUsecase) *PostHandler {
+func NewPostHandler(postUsecase usecase.PostUsecase, storageUsecase usecase.StorageUsecase, timelineUsecase usecase.TimelineUsecase, publicBaseURL string) *PostHandler {
 	return &PostHandler{
-		postUsecase:    postUsecase,
-		storageUsecase: storageUsecase,
-		cacheUsecase:   cacheUsecase,
+		postUsecase:     postUsecase,
+		storageUsecase:  storageUsecase,
+		timelineUsecase: timelineUsecase,
+		publicBaseURL:   publicBaseURL,
 	}
 }
 
+// GetRecommended はタイムラインを1ページ返す。sessionId がなければ推薦 API の結果から新しいセッションを作り、先頭のページを返す。
+// 2ページ目以降は sessionId と前のページの nextCursor を渡す。セッションの期限が切れていれば 410 を返すので、クライアントは最初から読み直す
 func (h *PostHandler) GetRecommended(c echo.Context) error {
+	principal, ok := middlewares.CurrentPrincipal(c)
+	if !ok {
+		return unauthorizedResponse(c)
+	}
 	var reqBody TimelineRequest
 	if err := c.Bind(&reqBody); err != nil {
 		return c.JSON(http.StatusBadRequest, map[string]string{
 			"error": "invalid request body",
 		})
 	}
-	// reqBody は TimelineRequest 構造体
-	if reqBody.Cursor != nil {
-		all := h.cacheUsecase.GetPostResponses(reqBody.UserID)
-		log.Infof("Retrieved %d posts from cache for user %s", len(all), reqBody.UserID)
-
-		var filtered []models.PostResponse
-		limit := reqBody.Limit
 
-		started := reqBody.Cursor == nil
-		for _, p := range all {
-			if !started {
-				if p.ID.String() == *reqBody.Cursor {
-					started = true
-				}
-				continue
-			}
-			if len(filtered) >= limit {
-				break
-			}
-			filtered = append(filtered, p)
+	sessionID := reqBody.SessionID
+	var cursor string
+	if reqBody.Cursor != nil {
+		cursor = *reqBody.Cursor
+	}
+	if sessionID == "" {
+		// セッションなしの続きの要求は、以前のプロセス内キャッシュを前提にしたクライアントなので読み直させる
+		if cursor != "" {
+			return errorResponse(c, usecase.ErrSessionExpired, "")
 		}
-
-		return c.JSON(http.StatusOK, map[string]interface{}{
-			"posts": filtered,
-		})
+		session, err := h.timelineUsecase.StartSession(c.Request().Context(), principal.UserID)
+		if err != nil {
+			log.Errorf("Failed to create timeline session: %v", err)
+			return c.JSON(http.StatusInternalServerError, map[string]string{
+				"error": "failed to get recommended posts",
+			})
+		}
+		sessionID = session.ID.String()
 	}
 
-	// FastAPI に送る body は user_id のみ
-	fastapiReqBody := struct {
-		UserID string `json:"user_id"`
-	}{
-		UserID: reqBody.UserID.String(),
+	posts, nextCursor, err := h.timelineUsecase.Page(principal.UserID, sessionID, cursor, reqBody.Limit)
+	if err != nil {
+		log.Errorf("Failed to get timeline page: %v", err)
+		return errorResponse(c, err, "failed to get timeline")
 	}
-
-	jsonBodyForFastAPI, err := json.Marshal(fastapiReqBody)
+	postResponses, err := newPostResponses(h.storageUsecase, posts)
 	if err != nil {
+		log.Errorf("Failed to get URLs of posts: %v", err)
 		return c.JSON(http.StatusInternalServerError, map[string]string{
-			"error": "failed to marshal request body",
+			"error": "failed to get image URL",
 		})
 	}
 
-	// POSTリクエストを作成
-	req, err := http.NewRequest(
-		"POST",
-		"https://animalia-lnzk.onrender.com/timeline",
-		bytes.NewBuffer(jsonBodyForFastAPI),
-	)
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"posts":      postResponses,
+		"sessionId":  sessionID,
+		"nextCursor": nextCursor,
+	})
+}
+
+func (h *PostHandler) GetAllPosts(c echo.Context) error {
+	principal, ok := middlewares.CurrentPrincipal(c)
+	if !ok {
+		return unauthorizedResponse(c)
+	}
+	log.Debug("GetAllPosts")
+	fmt.Println("GetAllPosts")
+	page, err := cursorPage(c)
 	if err != nil {
-		return c.JSON(http.StatusInternalServerError, map[string]string{
-			"error": "failed to create HTTP request",
+		return errorResponse(c, err, "投稿の取得に失敗しました")
+	}
+	posts, nextCursor, err := h.postUsecase.GetAllPosts(principal.UserID, page)
+	if err != nil {
+		log.Errorf("Failed to get all posts: %v", err)
+		return c.JSON(http.StatusInternalServerError, map[string]interface{}{
+			"error": err.Error(),
 		})
 	}
-
-	// ヘッダー設定
-	req.Header.Set("Content-Type", "application/json")
-
-	client := &http.Client{}
-	resp, err := client.Do(req)
+	log.Debug("GetAllPosts: posts", posts)
+	postResponses, err := newPostResponses(h.storageUsecase, posts)
 	if err != nil {
-		return c.JSON(http.StatusInternalServerError, map[string]string{
-			"error": "failed to send request",
+		log.Errorf("Failed to get URLs of posts: %v", err)
+		return c.JSON(http.StatusInternalServerError, map[string]interface{}{
+			"error": err.Error(),
 		})
 	}
-	defer resp.Body.Close()
 
-	// 以降の処理（デコード・変換）はこれまでと同様でOK
-	respBody, _ := io.ReadAll(resp.Body)
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"posts":      postResponses,
+		"nextCursor": nextCursor,
+	})
+}
 
-	var result struct {
-		Posts []fastapi.FastAPIPost `json:"posts"`
+// GetPost はパスの :id の投稿をコメント・いいね付きで返す。permalink は共有ページの URL
+func (h *PostHandler) GetPost(c echo.Context) error {
+	principal, ok := middlewares.CurrentPrincipal(c)
+	if !ok {
+		return unauthorizedResponse(c)
 	}
-
-	if err := json.Unmarshal(respBody, &result); err != nil {
-		return c.JSON(http.StatusInternalServerError, map[string]string{
-			"error": "invalid response from FastAPI",
+	post, err := h.postUsecase.GetPost(principal.UserID, c.Param("id"))
+	if err != nil {
+		log.Errorf("Failed to get post: %v", err)
+		return errorResponse(c, err, "投稿の取得に失敗しました")
+	}
+	postResponses, err := newPostResponses(h.storageUsecase, []*ent.Post{post})
+	if err != nil {
+		log.Errorf("Failed to get URLs of post: %v", err)
+		return c.JSON(http.StatusInternalServerError, map[string]interface{}{
+			"error": "投稿の取得に失敗しました",
 		})
 	}
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"post":      postResponses[0],
+		"permalink": sharePostURL(c, h.publicBaseURL, post.ID),
+	})
+}
 
-	postResponses := make([]models.PostResponse, len(result.Posts))
-	for i, post := range result.Posts {
-		imageURL, err := h.storageUsecase.GetUrl(post.ImageKey)
-		if err != nil {
-			log.Errorf("Failed to get image URL: %v", err)
-			return c.JSON(http.StatusInternalServerError, map[string]string{
-				"error": "failed to get image URL",
-			})
-		}
-
-		var userIconURL *string
-		if post.User.IconImageKey != "" {
-			iconURL, err := h.storageUsecase.GetUrl(post.User.IconImageKey)
-			if err != nil {
-				log.Errorf("Failed to get user icon URL: %v", err)
-				return c.JSON(http.StatusInternalServerError, map[string]string{
-					"error": "failed to get user icon URL",
-				})
+// newPostResponses は投稿者・コメント・いいね・画像を読み込んだ投稿のレスポンスを組み立てる
+func newPostResponses(storageUsecase usecase.StorageUsecase, posts []*ent.Post) ([]models.PostResponse, error) {
+	// 画像（バリアント付き）と、アイコン・動画の URL をそれぞれ一括で取得する
+	imageKeys := make([]string, 0, len(posts))
+	fileKeys := []string{}
+	for _, post := range posts {
+		imageKeys = append(imageKeys, post.ImageKey)
+		for _, item := range models.PostMediaItems(post) {
+			imageKeys = append(imageKeys, item.PreviewKey())
+			if item.Type == models.MediaTypeVideo {
+				fileKeys = append(fileKeys, item.Key)
 			}
-			userIconURL = &iconURL
 		}
-
-		// コメント
-		commentResponses := make([]models.CommentResponse, len(post.Comments))
-		for j, comment := range post.Comments {
-			var commentUserIconURL string
-			if comment.User.IconImageKey != "" {
-				url, err := h.storageUsecase.GetUrl(comment.User.IconImageKey)
-				if err != nil {
-					log.Errorf("Failed to get comment user icon URL: %v", err)
-					return c.JSON(http.StatusInternalServerError, map[string]string{
-						"error": "failed to get comment user icon URL",
-					})
-				}
-				commentUserIconURL = url
-			}
-
-			commentResponses[j] = fastapi.NewCommentResponseFromFastAPI(comment, commentUserIconURL)
+		fileKeys = append(fileKeys, post.Edges.User.IconImageKey)
+		for _, comment := range post.Edges.Comments {
+			fileKeys = append(fileKeys, comment.Edges.User.IconImageKey)
 		}
-
-		// いいね
-		likeResponses := make([]models.LikeResponse, len(post.Likes))
-		for j, like := range post.Likes {
-			var likeUserIconURL string
-			if like.User.IconImageKey != "" {
-				url, err := h.storageUsecase.GetUrl(like.User.IconImageKey)
-				if err != nil {
-					log.Errorf("Failed to get like user icon URL: %v", err)
-					return c.JSON(http.StatusInternalServerError, map[string]string{
-						"error": "failed to get like user icon URL",
-					})
-				}
-				likeUserIconURL = url
-			}
-			likeResponses[j] = fastapi.NewLikeResponseFromFastAPI(like, likeUserIconURL)
+		for _, like := range post.Edges.Likes {
+			fileKeys = append(fileKeys, like.Edges.User.IconImageKey)
 		}
-
-		postResponses[i] = fastapi.NewPostResponseFromFastAPI(post, imageURL, userIconURL, commentResponses, likeResponses)
 	}
-
-	h.cacheUsecase.ClearPostResponses(reqBody.UserID)
-	h.cacheUsecase.StorePostResponses(reqBody.UserID, postResponses)
-
-	// limit件だけ返すようにスライス
-	var firstPage []models.PostResponse
-	if reqBody.Limit < len(postResponses) {
-		firstPage = postResponses[:reqBody.Limit]
-	} else {
-		firstPage = postResponses
+	imageURLs, err := storageUsecase.GetImageUrlsBatch(imageKeys)
+	if err != nil {
+		return nil, err
 	}
-
-	return c.JSON(http.StatusOK, map[string]interface{}{
-		"posts": firstPage,
-	})
-}
-
-func (h *PostHandler) GetAllPosts(c echo.Context) error {
-	log.Debug("GetAllPosts")
-	fmt.Println("GetAllPosts")
-	posts, err := h.postUsecase.GetAllPosts()
+	fileURLs, err := storageUsecase.GetUrls(fileKeys)
 	if err != nil {
-		log.Errorf("Failed to get all posts: %v", err)
-		return c.JSON(http.StatusInternalServerError, map[string]interface{}{
-			"error": err.Error(),
-		})
+		return nil, err
 	}
-	log.Debug("GetAllPosts: posts", posts)
+
 	postResponses := make([]models.PostResponse, len(posts))
 	for i, post := range posts {
-		imageURL, err := h.storageUsecase.GetUrl(post.ImageKey)
-		if err != nil {
-			log.Errorf("Failed to get image URL: %v", err)
-			return c.JSON(http.StatusInternalServerError, map[string]interface{}{
-				"error": err.Error(),
-			})
-		}
-		var userImageURL string
-		if post.Edges.User.IconImageKey != "" {
-			var err error
-			userImageURL, err = h.storageUsecase.GetUrl(post.Edges.User.IconImageKey)
-			if err != nil {
-				log.Errorf("Failed to get user image URL: %v", err)
-				return c.JSON(http.StatusInternalServerError, map[string]interface{}{
-					"error": err.Error(),
-				})
-			}
-		}
-
 		commentResponses := make([]models.CommentResponse, len(post.Edges.Comments))
 		for j, comment := range post.Edges.Comments {
-			var commentUserImageURL string
-			if comment.Edges.User.IconImageKey != "" {
-				commentUserImageURL, err = h.storageUsecase.GetUrl(comment.Edges.User.IconImageKey)
-				if err != nil {
-					log.Errorf("Failed to get comment user image URL: %v", err)
-					return c.JSON(http.StatusInternalServerError, map[string]interface{}{
-						"error": err.Error(),
-					})
-				}
-			}
-			commentResponses[j] = models.NewCommentResponse(comment, comment.Edges.User, commentUserImageURL)
+			commentResponses[j] = models.NewCommentResponse(comment, comment.Edges.User, fileURLs[comment.Edges.User.IconImageKey])
 		}
 		likeResponses := make([]models.LikeResponse, len(post.Edges.Likes))
 		for j, like := range post.Edges.Likes {
-			var likeUserImageURL string
-			if like.Edges.User.IconImageKey != "" {
-				likeUserImageURL, err = h.storageUsecase.GetUrl(like.Edges.User.IconImageKey)
-				if err != nil {
-					log.Errorf("Failed to get like user image URL: %v", err)
-					return c.JSON(http.StatusInternalServerError, map[string]interface{}{
-						"error": err.Error(),
-					})
-				}
-			}
-			likeResponses[j] = models.NewLikeResponse(like, likeUserImageURL)
+			likeResponses[j] = models.NewLikeResponse(like, fileURLs[like.Edges.User.IconImageKey])
 		}
-		postResponses[i] = models.NewPostResponse(post, imageURL, userImageURL, commentResponses, likeResponses)
+		postResponses[i] = models.NewPostResponse(post, imageURLs, fileURLs, fileURLs[post.Edges.User.IconImageKey], commentResponses, likeResponses)
 	}
-
-	return c.JSON(http.StatusOK, map[string]interface{}{
-		"posts": postResponses,
-	})
+	return postResponses, nil
 }
 
 func (h *PostHandler) CreatePost(c echo.Context) error {
+	principal, ok := middlewares.CurrentPrincipal(c)
+	if !ok {
+		return unauthorizedResponse(c)
+	}
 	var req struct {
 		Caption     string  `json:"caption,omitempty" form:"caption"`
 		UserId      string  `json:"userId,omitempty" form:"userId"`
 		DailyTaskId *string `json:"dailyTaskId,omitempty" form:"dailyTaskId"`
+		// Visibility は public（既定）・followers・only_me のいずれか
+		Visibility string `json:"visibility,omitempty" form:"visibility"`
 	}
 	if err := c.Bind(&req); err != nil {
 		log.Error("Failed to create post: invalid request body")
@@ -285,61 +218,113 @@ func (h *PostHandler) CreatePost(c echo.Context) error {
 		})
 	}
 
-	file, err := c.FormFile("image")
+	// userId を省略した場合は認証済みユーザーの投稿にする
+	if req.UserId == "" {
+		req.UserId = principal.UserID.String()
+	}
+	// 他人として投稿しようとした場合は画像を取り込む前に弾く
+	if err := usecase.AuthorizeUser(principal.UserID, req.UserId); err != nil {
+		log.Errorf("Failed to create post: %v", err)
+		return errorResponse(c, err, "投稿の作成に失敗しました")
+	}
+
+	// Upload the images and videos (multipart files or direct upload tokens) in order
+	media, err := resolveMedia(c, h.storageUsecase, "posts", usecase.MaxPostMedia)
 	if err != nil {
-		log.Errorf("Failed to create post: image file is required: %v", err)
-		return c.JSON(http.StatusBadRequest, map[string]interface{}{
-			"error": "画像ファイルが必要です",
-		})
+		log.Errorf("Failed to create post: failed to upload image: %v", err)
+		return uploadErrorResponse(c, err, "画像のアップロードに失敗しました")
+	}
+
+	post, err := h.postUsecase.CreatePost(principal.UserID, req.Caption, req.UserId, req.Visibility, media, req.DailyTaskId)
+	if err != nil {
+		log.Errorf("Failed to create post: failed to create post: %v", err)
+		return errorResponse(c, err, "投稿の作成に失敗しました")
 	}
 
-	// ファイル情報のデバッグログ
-	log.Printf("Received file: name=%s, size=%d, content-type=%s",
-		file.Filename,
-		file.Size,
-		file.Header.Get("Content-Type"))
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"message": "投稿が作成されました",
+		"post":    post,
+	})
+}
 
-	// Content-Typeの検証
-	contentType := file.Header.Get("Content-Type")
-	if !strings.HasPrefix(contentType, "image/") {
-		log.Errorf("Invalid content type: %s", contentType)
+// UpdatePost はパスの :id の投稿のキャプションを編集する
+func (h *PostHandler) UpdatePost(c echo.Context) error {
+	principal, ok := middlewares.CurrentPrincipal(c)
+	if !ok {
+		return unauthorizedResponse(c)
+	}
+	var req struct {
+		Caption string `json:"caption"`
+	}
+	if err := c.Bind(&req); err != nil {
 		return c.JSON(http.StatusBadRequest, map[string]interface{}{
-			"error": "画像ファイルのみアップロード可能です",
+			"error": "Invalid request body",
 		})
 	}
 
-	// Upload the image
-	fileKey, err := h.storageUsecase.UploadImage(file, "posts")
-	if err != nil {
-		log.Errorf("Failed to create post: failed to upload image: %v", err)
-		return c.JSON(http.StatusInternalServerError, map[string]interface{}{
-			"error": "画像のアップロードに失敗しました",
-		})
+	if err := h.postUsecase.UpdatePost(principal.UserID, c.Param("id"), req.Caption); err != nil {
+		log.Errorf("Failed to update post: %v", err)
+		return errorResponse(c, err, "投稿の編集に失敗しました")
 	}
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"message": "投稿を編集しました",
+	})
+}
 
-	post, err := h.postUsecase.CreatePost(req.Caption, req.UserId, fileKey, req.DailyTaskId)
+func (h *PostHandler) GetRevisions(c echo.Context) error {
+	principal, ok := middlewares.CurrentPrincipal(c)
+	if !ok {
+		return unauthorizedResponse(c)
+	}
+	revisions, err := h.postUsecase.GetRevisions(principal.UserID, c.Param("id"))
 	if err != nil {
-		log.Errorf("Failed to create post: failed to create post: %v", err)
-		return c.JSON(http.StatusInternalServerError, map[string]interface{}{
-			"error": "投稿の作成に失敗しました",
+		log.Errorf("Failed to get post revisions: %v", err)
+		return errorResponse(c, err, "編集履歴の取得に失敗しました")
+	}
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"revisions": revisions,
+	})
+}
+
+// UpdateVisibility はパスの :id の投稿の公開範囲を変更する
+func (h *PostHandler) UpdateVisibility(c echo.Context) error {
+	principal, ok := middlewares.CurrentPrincipal(c)
+	if !ok {
+		return unauthorizedResponse(c)
+	}
+	var req struct {
+		Visibility string `json:"visibility"`
+	}
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error": "Invalid request body",
 		})
 	}
 
+	if err := h.postUsecase.UpdateVisibility(principal.UserID, c.Param("id"), req.Visibility); err != nil {
+		log.Errorf("Failed to update post visibility: %v", err)
+		return errorResponse(c, err, "公開範囲の変更に失敗しました")
+	}
 	return c.JSON(http.StatusOK, map[string]interface{}{
-		"message": "投稿が作成されました",
-		"post":    post,
+		"message":    "公開範囲を変更しました",
+		"visibility": req.Visibility,
 	})
 }
 
 func (h *PostHandler) DeletePost(c echo.Context) error {
+	principal, ok := middlewares.CurrentPrincipal(c)
+	if !ok {
+		return unauthorizedResponse(c)
+	}
 	postID := c.QueryParam("id")
 	if postID == "" {
 		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Post ID is required"})
 	}
 
-	err := h.postUsecase.DeletePost(postID)
+	err := h.postUsecase.DeletePost(principal.UserID, postID)
 	if err != nil {
-		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+		log.Errorf("Failed to delete post: %v", err)
+		return errorResponse(c, err, "投稿の削除に失敗しました")
 	}
 
 	return c.JSON(http.StatusOK, map[string]string{"message": "Post deleted successfully"})
@@ -0,0 +1,88 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/aki-13627/animalia/backend-go/internal/domain/middlewares"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/models"
+	"github.com/aki-13627/animalia/backend-go/internal/usecase"
+	"github.com/labstack/echo/v4"
+	"github.com/labstack/gommon/log"
+)
+
+type ReportHandler struct {
+	reportUsecase usecase.ReportUsecase
+}
+
+func NewReportHandler(reportUsecase usecase.ReportUsecase) *ReportHandler {
+	return &ReportHandler{
+		reportUsecase: reportUsecase,
+	}
+}
+
+func (h *ReportHandler) CreateReport(c echo.Context) error {
+	principal, ok := middlewares.CurrentPrincipal(c)
+	if !ok {
+		return unauthorizedResponse(c)
+	}
+	var req struct {
+		TargetType string `json:"targetType"`
+		TargetID   string `json:"targetId"`
+		Reason     string `json:"reason"`
+		Detail     string `json:"detail"`
+	}
+	if err := c.Bind(&req); err != nil {
+		log.Errorf("Failed to parse request body: %v", err)
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error": "Invalid request body",
+		})
+	}
+
+	report, err := h.reportUsecase.File(principal, req.TargetType, req.TargetID, req.Reason, req.Detail)
+	if err != nil {
+		log.Errorf("Failed to create report: %v", err)
+		if errors.Is(err, usecase.ErrAlreadyReported) {
+			return c.JSON(http.StatusConflict, map[string]interface{}{
+				"error": "既に通報済みです",
+			})
+		}
+		return errorResponse(c, err, "通報に失敗しました")
+	}
+	return c.JSON(http.StatusCreated, report)
+}
+
+func (h *ReportHandler) DismissReport(c echo.Context) error {
+	return h.resolve(c, h.reportUsecase.Dismiss)
+}
+
+func (h *ReportHandler) HideReported(c echo.Context) error {
+	return h.resolve(c, h.reportUsecase.Hide)
+}
+
+func (h *ReportHandler) SuspendAuthor(c echo.Context) error {
+	return h.resolve(c, h.reportUsecase.SuspendAuthor)
+}
+
+func (h *ReportHandler) resolve(c echo.Context, action func(*models.Principal, string, string) (int, error)) error {
+	principal, ok := middlewares.CurrentPrincipal(c)
+	if !ok {
+		return unauthorizedResponse(c)
+	}
+	var req moderationRequest
+	if err := c.Bind(&req); err != nil {
+		log.Errorf("Failed to parse request body: %v", err)
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error": "Invalid request body",
+		})
+	}
+
+	resolved, err := action(principal, c.Param("id"), req.Reason)
+	if err != nil {
+		log.Errorf("Failed to resolve report %s: %v", c.Param("id"), err)
+		return errorResponse(c, err, "通報の処理に失敗しました")
+	}
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"resolved": resolved,
+	})
+}
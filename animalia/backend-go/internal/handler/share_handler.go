@@ -0,0 +1,161 @@
+package handler
+
+import (
+	"errors"
+	"fmt"
+	"html/template"
+	"net/http"
+	"strings"
+
+	"github.com/aki-13627/animalia/backend-go/internal/usecase"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/labstack/gommon/log"
+)
+
+// shareDescriptionLength は共有ページの description に使うキャプションの最大文字数
+const shareDescriptionLength = 200
+
+var sharePostTemplate = template.Must(template.New("share").Parse(`<!DOCTYPE html>
+<html lang="ja">
+<head>
+<meta charset="utf-8">
+<meta name="viewport" content="width=device-width, initial-scale=1">
+<title>{{.Title}}</title>
+<meta name="description" content="{{.Description}}">
+<meta property="og:type" content="article">
+<meta property="og:site_name" content="Animalia">
+<meta property="og:title" content="{{.Title}}">
+<meta property="og:description" content="{{.Description}}">
+<meta property="og:url" content="{{.URL}}">
+{{- if .ImageURL}}
+<meta property="og:image" content="{{.ImageURL}}">
+{{- end}}
+<meta name="twitter:card" content="{{if .ImageURL}}summary_large_image{{else}}summary{{end}}">
+<meta name="twitter:title" content="{{.Title}}">
+<meta name="twitter:description" content="{{.Description}}">
+{{- if .ImageURL}}
+<meta name="twitter:image" content="{{.ImageURL}}">
+{{- end}}
+<link rel="canonical" href="{{.URL}}">
+</head>
+<body>
+<main>
+<h1>{{.Author}}</h1>
+{{- if .ImageURL}}
+<img src="{{.ImageURL}}" alt="{{.Description}}">
+{{- end}}
+<p>{{.Caption}}</p>
+</main>
+</body>
+</html>
+`))
+
+type sharePostPage struct {
+	Title       string
+	Description string
+	Caption     string
+	Author      string
+	ImageURL    string
+	URL         string
+}
+
+// ShareHandler はリンクのプレビュー用に、認証なしで投稿の Open Graph / Twitter カードを返す
+type ShareHandler struct {
+	postUsecase    usecase.PostUsecase
+	storageUsecase usecase.StorageUsecase
+	publicBaseURL  string
+}
+
+func NewShareHandler(postUsecase usecase.PostUsecase, storageUsecase usecase.StorageUsecase, publicBaseURL string) *ShareHandler {
+	return &ShareHandler{
+		postUsecase:    postUsecase,
+		storageUsecase: storageUsecase,
+		publicBaseURL:  publicBaseURL,
+	}
+}
+
+// sharePostImageMaxAge は共有ページの画像のリダイレクトをキャッシュさせる秒数。
+// リダイレクト先の署名付き URL はキャッシュから返しても10分以上有効なため、それより短くする
+const sharePostImageMaxAge = 5 * 60
+
+// SharePost はパスの :id の投稿の共有ページを返す。公開アカウントの public の投稿以外は 404 にする
+func (h *ShareHandler) SharePost(c echo.Context) error {
+	post, err := h.postUsecase.GetSharedPost(c.Param("id"))
+	if err != nil {
+		return sharedPostError(c, err)
+	}
+
+	// 署名付き URL は1時間で切れるため、リンクのプレビューがキャッシュしても使える固定の URL を載せる
+	pageURL := sharePostURL(c, h.publicBaseURL, post.ID)
+	imageURL := ""
+	if post.ImageKey != "" {
+		imageURL = pageURL + "/image"
+	}
+
+	author := ""
+	if post.Edges.User != nil {
+		author = post.Edges.User.Name
+	}
+	page := sharePostPage{
+		Title:       fmt.Sprintf("%sさんの投稿 | Animalia", author),
+		Description: truncateRunes(post.Caption, shareDescriptionLength),
+		Caption:     post.Caption,
+		Author:      author,
+		ImageURL:    imageURL,
+		URL:         pageURL,
+	}
+	var body strings.Builder
+	if err := sharePostTemplate.Execute(&body, page); err != nil {
+		log.Errorf("Failed to render share page: %v", err)
+		return c.HTML(http.StatusInternalServerError, http.StatusText(http.StatusInternalServerError))
+	}
+	return c.HTML(http.StatusOK, body.String())
+}
+
+// SharePostImage は共有ページの投稿の画像へ、その時点で有効な署名付き URL でリダイレクトする
+func (h *ShareHandler) SharePostImage(c echo.Context) error {
+	post, err := h.postUsecase.GetSharedPost(c.Param("id"))
+	if err != nil {
+		return sharedPostError(c, err)
+	}
+	if post.ImageKey == "" {
+		return c.HTML(http.StatusNotFound, http.StatusText(http.StatusNotFound))
+	}
+
+	imageURLs, err := h.storageUsecase.GetImageUrlsBatch([]string{post.ImageKey})
+	if err != nil {
+		log.Errorf("Failed to get image URL of shared post: %v", err)
+		return c.HTML(http.StatusInternalServerError, http.StatusText(http.StatusInternalServerError))
+	}
+	c.Response().Header().Set(echo.HeaderCacheControl, fmt.Sprintf("public, max-age=%d", sharePostImageMaxAge))
+	return c.Redirect(http.StatusFound, imageURLs[post.ImageKey].Full)
+}
+
+// sharedPostError は共有する投稿を取得できなかった場合のレスポンスを返す
+func sharedPostError(c echo.Context, err error) error {
+	log.Errorf("Failed to get shared post: %v", err)
+	status := http.StatusInternalServerError
+	if errors.Is(err, usecase.ErrNotFound) {
+		status = http.StatusNotFound
+	}
+	return c.HTML(status, http.StatusText(status))
+}
+
+// sharePostURL は投稿の共有ページの URL を返す。publicBaseURL が空の場合はリクエストのスキームとホストを使う
+func sharePostURL(c echo.Context, publicBaseURL string, postID uuid.UUID) string {
+	base := strings.TrimSuffix(publicBaseURL, "/")
+	if base == "" {
+		base = c.Scheme() + "://" + c.Request().Host
+	}
+	return fmt.Sprintf("%s/share/posts/%s", base, postID)
+}
+
+// truncateRunes は s を最大 n 文字に切り詰める。切り詰めた場合は末尾に … を付ける
+func truncateRunes(s string, n int) string {
+	runes := []rune(s)
+	if len(runes) <= n {
+		return s
+	}
+	return string(runes[:n]) + "…"
+}
@@ -0,0 +1,80 @@
+package handler
+
+import (
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/aki-13627/animalia/backend-go/internal/domain/middlewares"
+	"github.com/aki-13627/animalia/backend-go/internal/usecase"
+	"github.com/labstack/echo/v4"
+	"github.com/labstack/gommon/log"
+)
+
+type StorageHandler struct {
+	storageUsecase usecase.StorageUsecase
+}
+
+func NewStorageHandler(storageUsecase usecase.StorageUsecase) *StorageHandler {
+	return &StorageHandler{
+		storageUsecase: storageUsecase,
+	}
+}
+
+func (h *StorageHandler) IssueUpload(c echo.Context) error {
+	var req struct {
+		Directory   string `json:"directory"`
+		ContentType string `json:"contentType"`
+		Size        int64  `json:"size"`
+	}
+	if err := c.Bind(&req); err != nil {
+		log.Errorf("Failed to issue upload: invalid request body: %v", err)
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error": "Invalid request body",
+		})
+	}
+
+	principal, ok := middlewares.CurrentPrincipal(c)
+	if !ok {
+		return unauthorizedResponse(c)
+	}
+
+	ticket, err := h.storageUsecase.IssueUpload(principal.Email, req.Directory, req.ContentType, req.Size)
+	if err != nil {
+		log.Errorf("Failed to issue upload: %v", err)
+		return uploadErrorResponse(c, err, "アップロードURLの発行に失敗しました")
+	}
+
+	return c.JSON(http.StatusOK, ticket)
+}
+
+// PutObject はローカルストレージ利用時に署名付き URL へのアップロードを受け付ける
+func (h *StorageHandler) PutObject(c echo.Context) error {
+	fileKey := strings.TrimPrefix(c.Request().URL.Path, "/storage/")
+	if !strings.HasPrefix(fileKey, "uploads/") {
+		return c.JSON(http.StatusForbidden, map[string]interface{}{
+			"error": "アップロード先が不正です",
+		})
+	}
+
+	body, err := io.ReadAll(io.LimitReader(c.Request().Body, usecase.MaxUploadBytes+1))
+	if err != nil {
+		log.Errorf("Failed to read upload body: %v", err)
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error": "Invalid request body",
+		})
+	}
+	if len(body) > usecase.MaxUploadBytes {
+		return c.JSON(http.StatusRequestEntityTooLarge, map[string]interface{}{
+			"error": "ファイルサイズが大きすぎます",
+		})
+	}
+
+	if err := h.storageUsecase.PutObject(fileKey, body, c.Request().Header.Get("Content-Type")); err != nil {
+		log.Errorf("Failed to store uploaded object: %v", err)
+		return c.JSON(http.StatusInternalServerError, map[string]interface{}{
+			"error": "アップロードに失敗しました",
+		})
+	}
+	return c.NoContent(http.StatusOK)
+}
@@ -0,0 +1,101 @@
+package handler
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/aki-13627/animalia/backend-go/internal/domain/models"
+	"github.com/aki-13627/animalia/backend-go/internal/usecase"
+	"github.com/labstack/echo/v4"
+	"github.com/labstack/gommon/log"
+)
+
+var (
+	errImageNotProvided = errors.New("image is not provided")
+	errTooManyImages    = errors.New("too many images")
+)
+
+// resolveImageKey はリクエストの uploadToken（直接アップロード済み）もしくは
+// multipart の image ファイルから、保存済みの画像キーを取得する
+func resolveImageKey(c echo.Context, storageUsecase usecase.StorageUsecase, directory string) (string, error) {
+	if token := c.FormValue("uploadToken"); token != "" {
+		return storageUsecase.ConsumeUpload(currentEmail(c), directory, token)
+	}
+
+	file, err := c.FormFile("image")
+	if err != nil {
+		return "", errImageNotProvided
+	}
+	return storageUsecase.UploadImage(file, directory)
+}
+
+// resolveMedia は複数の uploadToken もしくは image ファイルから、保存済みの画像・動画を送信順に取得する。
+// image ファイルには動画を含めてもよい（中身で判定する）。途中で失敗した場合は取り込み済みのものを削除する。
+func resolveMedia(c echo.Context, storageUsecase usecase.StorageUsecase, directory string, limit int) ([]models.MediaItem, error) {
+	form, err := c.MultipartForm()
+	if err != nil {
+		return nil, errImageNotProvided
+	}
+	tokens := form.Value["uploadToken"]
+	files := form.File["image"]
+	count := len(tokens)
+	if count == 0 {
+		count = len(files)
+	}
+	if count == 0 {
+		return nil, errImageNotProvided
+	}
+	if count > limit {
+		return nil, fmt.Errorf("%w: %d > %d", errTooManyImages, count, limit)
+	}
+
+	email := currentEmail(c)
+	media := make([]models.MediaItem, 0, count)
+	for i := 0; i < count; i++ {
+		var item models.MediaItem
+		if len(tokens) > 0 {
+			item, err = storageUsecase.ConsumeMediaUpload(email, directory, tokens[i])
+		} else {
+			item, err = storageUsecase.UploadMedia(files[i], directory)
+		}
+		if err != nil {
+			for _, stored := range media {
+				if err := storageUsecase.DeleteMedia(stored); err != nil {
+					log.Errorf("Failed to clean up media %s: %v", stored.Key, err)
+				}
+			}
+			return nil, err
+		}
+		media = append(media, item)
+	}
+	return media, nil
+}
+
+// uploadErrorResponse はアップロード時のエラーをステータスコードとメッセージに対応付けて返す。
+// 利用者側の問題でない場合は message を返す。
+func uploadErrorResponse(c echo.Context, err error, message string) error {
+	status := http.StatusInternalServerError
+	switch {
+	case errors.Is(err, usecase.ErrFileTooLarge):
+		status, message = http.StatusRequestEntityTooLarge, "ファイルサイズ・画像の解像度・動画の長さのいずれかが上限を超えています"
+	case errors.Is(err, usecase.ErrUnsupportedMediaType):
+		status, message = http.StatusUnsupportedMediaType, "対応していないファイル形式です（JPEG/PNG/WebP、投稿のみ MP4/MOV）"
+	case errors.Is(err, usecase.ErrInvalidImage):
+		status, message = http.StatusBadRequest, "画像ファイルが不正です"
+	case errors.Is(err, usecase.ErrInvalidVideo):
+		status, message = http.StatusBadRequest, "動画ファイルが不正です"
+	case errors.Is(err, errImageNotProvided):
+		status, message = http.StatusBadRequest, "画像ファイルが必要です"
+	case errors.Is(err, errTooManyImages):
+		status, message = http.StatusBadRequest, "画像の枚数が多すぎます"
+	case errors.Is(err, usecase.ErrInvalidUploadRequest):
+		status, message = http.StatusBadRequest, "アップロード情報が不正です"
+	case errors.Is(err, usecase.ErrInvalidUploadToken),
+		errors.Is(err, usecase.ErrUploadMismatch):
+		status, message = http.StatusBadRequest, "アップロードトークンが不正です"
+	}
+	return c.JSON(status, map[string]interface{}{
+		"error": message,
+	})
+}
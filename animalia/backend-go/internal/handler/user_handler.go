@@ -1,8 +1,12 @@
 package handler
 
 import (
+	"errors"
 	"net/http"
+	"time"
 
+	"github.com/aki-13627/animalia/backend-go/ent/followrelation"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/middlewares"
 	"github.com/aki-13627/animalia/backend-go/internal/usecase"
 	"github.com/labstack/echo/v4"
 	"github.com/labstack/gommon/log"
@@ -11,16 +15,24 @@ import (
 type UserHandler struct {
 	userUsecase    usecase.UserUsecase
 	storageUsecase usecase.StorageUsecase
+	accountUsecase usecase.AccountUsecase
+	takeoutUsecase usecase.TakeoutUsecase
 }
 
-func NewUserHandler(userUsecase usecase.UserUsecase, storageUsecase usecase.StorageUsecase) *UserHandler {
+func NewUserHandler(userUsecase usecase.UserUsecase, storageUsecase usecase.StorageUsecase, accountUsecase usecase.AccountUsecase, takeoutUsecase usecase.TakeoutUsecase) *UserHandler {
 	return &UserHandler{
 		userUsecase:    userUsecase,
 		storageUsecase: storageUsecase,
+		accountUsecase: accountUsecase,
+		takeoutUsecase: takeoutUsecase,
 	}
 }
 
 func (h *UserHandler) UpdateUser(c echo.Context) error {
+	principal, ok := middlewares.CurrentPrincipal(c)
+	if !ok {
+		return unauthorizedResponse(c)
+	}
 	// クエリからユーザーIDを取得
 	id := c.QueryParam("id")
 	if id == "" {
@@ -29,6 +41,12 @@ func (h *UserHandler) UpdateUser(c echo.Context) error {
 		})
 	}
 
+	// 他人のプロフィールの場合は画像を取り込む前に弾く
+	if err := usecase.AuthorizeUser(principal.UserID, id); err != nil {
+		log.Errorf("Failed to update user: %v", err)
+		return errorResponse(c, err, "プロフィール更新に失敗しました")
+	}
+
 	form, err := c.MultipartForm()
 	if err != nil {
 		return c.JSON(http.StatusBadRequest, map[string]interface{}{
@@ -49,38 +67,25 @@ func (h *UserHandler) UpdateUser(c echo.Context) error {
 		})
 	}
 
-	// 画像ファイルが存在するか確認
-	file, fileErr := c.FormFile("image")
-	var newImageKey string
-	if fileErr == nil {
-		// 画像ファイルが送られてきた場合、古い画像があれば削除して新しい画像をアップロードする
-		if user.IconImageKey != "" {
-			if err := h.storageUsecase.DeleteImage(user.IconImageKey); err != nil {
-				log.Errorf("Failed to delete image: %v", err)
-				return c.JSON(http.StatusInternalServerError, map[string]interface{}{
-					"error": "既存画像の削除に失敗しました",
-				})
-			}
-		}
-
-		newImageKey, err = h.storageUsecase.UploadImage(file, "profile")
-		if err != nil {
-			log.Errorf("Failed to upload image: %v", err)
-			return c.JSON(http.StatusInternalServerError, map[string]interface{}{
-				"error": "新しい画像のアップロードに失敗しました",
-			})
-		}
-	} else {
-		// 画像ファイルが送られてこなかった場合は既存の画像キーを維持する
+	// 画像ファイルもしくはアップロードトークンが送られてきた場合は新しい画像を取り込む
+	newImageKey, err := resolveImageKey(c, h.storageUsecase, "profile")
+	if errors.Is(err, errImageNotProvided) {
+		// 画像が送られてこなかった場合は既存の画像キーを維持する
 		newImageKey = user.IconImageKey
+	} else if err != nil {
+		log.Errorf("Failed to upload image: %v", err)
+		return uploadErrorResponse(c, err, "新しい画像のアップロードに失敗しました")
+	} else if user.IconImageKey != "" {
+		// 新しい画像を保存できたら古い画像を削除する
+		if err := h.storageUsecase.DeleteImage(user.IconImageKey); err != nil {
+			log.Errorf("Failed to delete image: %v", err)
+		}
 	}
 
 	// ユーザー情報を更新（画像キーは新しい画像があればその値、なければ既存のもの）
-	if err := h.userUsecase.Update(id, name, bio, newImageKey); err != nil {
+	if err := h.userUsecase.Update(principal.UserID, id, name, bio, newImageKey); err != nil {
 		log.Errorf("Failed to update user: %v", err)
-		return c.JSON(http.StatusInternalServerError, map[string]interface{}{
-			"error": "プロフィール更新に失敗しました",
-		})
+		return errorResponse(c, err, "プロフィール更新に失敗しました")
 	}
 
 	return c.JSON(http.StatusOK, map[string]interface{}{
@@ -89,36 +94,82 @@ func (h *UserHandler) UpdateUser(c echo.Context) error {
 	})
 }
 
+// DeleteMe は認証済みユーザーを退会させる。猶予期間中は POST /auth/restore で復元できる
+func (h *UserHandler) DeleteMe(c echo.Context) error {
+	principal, ok := middlewares.CurrentPrincipal(c)
+	if !ok {
+		return unauthorizedResponse(c)
+	}
+	accessToken, ok := bearerToken(c)
+	if !ok {
+		return unauthorizedResponse(c)
+	}
+
+	if err := h.accountUsecase.Delete(principal, accessToken); err != nil {
+		log.Errorf("Failed to delete account: %v", err)
+		return errorResponse(c, err, "退会に失敗しました")
+	}
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"message":      "退会しました",
+		"restoreUntil": time.Now().Add(usecase.AccountDeletionGracePeriod),
+	})
+}
+
+// ExportMe は認証済みユーザーのデータを ZIP にまとめ、期限付きのダウンロード URL を返す
+func (h *UserHandler) ExportMe(c echo.Context) error {
+	principal, ok := middlewares.CurrentPrincipal(c)
+	if !ok {
+		return unauthorizedResponse(c)
+	}
+
+	export, err := h.takeoutUsecase.Export(principal.UserID)
+	if err != nil {
+		log.Errorf("Failed to export user data: %v", err)
+		return errorResponse(c, err, "データのエクスポートに失敗しました")
+	}
+	return c.JSON(http.StatusOK, export)
+}
+
 func (h *UserHandler) Follow(c echo.Context) error {
+	principal, ok := middlewares.CurrentPrincipal(c)
+	if !ok {
+		return unauthorizedResponse(c)
+	}
 	toId, fromId := c.QueryParam("toId"), c.QueryParam("fromId")
 
 	if toId == "" || fromId == "" {
 		log.Error("Failed to follow: followerId or followedId is empty")
 		return c.JSON(http.StatusBadRequest, map[string]interface{}{"error": "情報が不足しています"})
 	}
-	if err := h.userUsecase.Follow(toId, fromId); err != nil {
+	status, err := h.userUsecase.Follow(principal.UserID, toId, fromId)
+	if err != nil {
 		log.Errorf("Failed to follow: %v", err)
-		return c.JSON(http.StatusInternalServerError, map[string]interface{}{
-			"error": "フォローに失敗しました",
-		})
+		return errorResponse(c, err, "フォローに失敗しました")
+	}
+	message := "フォローしました"
+	if status == followrelation.StatusPending {
+		message = "フォローリクエストを送信しました"
 	}
 	return c.JSON(http.StatusOK, map[string]interface{}{
-		"message": "フォローしました",
+		"message": message,
+		"status":  status,
 	})
 }
 
 func (h *UserHandler) Unfollow(c echo.Context) error {
+	principal, ok := middlewares.CurrentPrincipal(c)
+	if !ok {
+		return unauthorizedResponse(c)
+	}
 	toId, fromId := c.QueryParam("toId"), c.QueryParam("fromId")
 
 	if toId == "" || fromId == "" {
 		log.Error("Failed to unfollow: followerId or followedId is empty")
 		return c.JSON(http.StatusBadRequest, map[string]interface{}{"error": "情報が不足しています"})
 	}
-	if err := h.userUsecase.Unfollow(toId, fromId); err != nil {
+	if err := h.userUsecase.Unfollow(principal.UserID, toId, fromId); err != nil {
 		log.Errorf("Failed to unfollow: %v", err)
-		return c.JSON(http.StatusInternalServerError, map[string]interface{}{
-			"error": "フォロー解除に失敗しました",
-		})
+		return errorResponse(c, err, "フォロー解除に失敗しました")
 	}
 
 	return c.JSON(http.StatusOK, map[string]interface{}{
@@ -155,45 +206,155 @@ func (h *UserHandler) GetFollowerCount(c echo.Context) error {
 }
 
 func (h *UserHandler) GetFollowsUsers(c echo.Context) error {
+	principal, ok := middlewares.CurrentPrincipal(c)
+	if !ok {
+		return unauthorizedResponse(c)
+	}
 	id := c.QueryParam("id")
 	if id == "" {
 		log.Error("Failed to get follows users: id is empty")
 		return c.JSON(http.StatusBadRequest, map[string]interface{}{"error": "ユーザーIDが必要です"})
 	}
-	users, err := h.userUsecase.FollowingUsers(id)
+	page, err := cursorPage(c)
+	if err != nil {
+		return errorResponse(c, err, "フォロー中のユーザー一覧取得に失敗しました")
+	}
+	users, nextCursor, err := h.userUsecase.FollowingUsers(principal.UserID, id, page)
 	if err != nil {
 		log.Errorf("Failed to get follows users: %v", err)
-		return c.JSON(http.StatusInternalServerError, map[string]interface{}{"error": "フォロー中のユーザー一覧取得に失敗しました"})
+		return errorResponse(c, err, "フォロー中のユーザー一覧取得に失敗しました")
 	}
-	return c.JSON(http.StatusOK, map[string]interface{}{"followed_users": users})
+	return c.JSON(http.StatusOK, map[string]interface{}{"followed_users": users, "nextCursor": nextCursor})
 }
 
 func (h *UserHandler) GetFollowerUsers(c echo.Context) error {
+	principal, ok := middlewares.CurrentPrincipal(c)
+	if !ok {
+		return unauthorizedResponse(c)
+	}
 	id := c.QueryParam("id")
 	if id == "" {
 		log.Error("Failed to get follower users: id is empty")
 		return c.JSON(http.StatusBadRequest, map[string]interface{}{"error": "ユーザーIDが必要です"})
 	}
-	users, err := h.userUsecase.Followers(id)
+	page, err := cursorPage(c)
+	if err != nil {
+		return errorResponse(c, err, "フォロワーの取得に失敗しました")
+	}
+	users, nextCursor, err := h.userUsecase.Followers(principal.UserID, id, page)
 	if err != nil {
 		log.Errorf("Failed to get follower users: %v", err)
-		return c.JSON(http.StatusInternalServerError, map[string]interface{}{"error": "フォロワーの取得に失敗しました"})
+		return errorResponse(c, err, "フォロワーの取得に失敗しました")
+	}
+	return c.JSON(http.StatusOK, map[string]interface{}{"follower_users": users, "nextCursor": nextCursor})
+}
+
+// GetUserPosts はパスの :id のユーザーの投稿を新しい順に1ページ分返す
+func (h *UserHandler) GetUserPosts(c echo.Context) error {
+	principal, ok := middlewares.CurrentPrincipal(c)
+	if !ok {
+		return unauthorizedResponse(c)
+	}
+	page, err := cursorPage(c)
+	if err != nil {
+		return errorResponse(c, err, "投稿の取得に失敗しました")
+	}
+	posts, nextCursor, err := h.userUsecase.GetPostsByUser(principal.UserID, c.Param("id"), page)
+	if err != nil {
+		log.Errorf("Failed to get posts of user %s: %v", c.Param("id"), err)
+		return errorResponse(c, err, "投稿の取得に失敗しました")
+	}
+	postResponses, err := newPostResponses(h.storageUsecase, posts)
+	if err != nil {
+		log.Errorf("Failed to get URLs of posts: %v", err)
+		return c.JSON(http.StatusInternalServerError, map[string]interface{}{"error": "投稿の取得に失敗しました"})
 	}
-	return c.JSON(http.StatusOK, map[string]interface{}{"follower_users": users})
+	return c.JSON(http.StatusOK, map[string]interface{}{"posts": postResponses, "nextCursor": nextCursor})
 }
 
 func (h *UserHandler) GetUser(c echo.Context) error {
+	principal, ok := middlewares.CurrentPrincipal(c)
+	if !ok {
+		return unauthorizedResponse(c)
+	}
 	email := c.QueryParam("email")
 	if email == "" {
 		log.Error("Failed to get user: id is empty")
 		return c.JSON(http.StatusBadRequest, map[string]interface{}{"error": "ユーザーIDが必要です"})
 	}
-	user, err := h.userUsecase.GetByEmail(email)
+	user, err := h.userUsecase.GetByEmail(principal.UserID, email)
 	if err != nil {
 		log.Errorf("Failed to get user: %v", err)
-		return c.JSON(http.StatusInternalServerError, map[string]interface{}{"error": "ユーザー情報の取得に失敗しました"})
+		return errorResponse(c, err, "ユーザー情報の取得に失敗しました")
 	}
 	return c.JSON(http.StatusOK, map[string]interface{}{
 		"user": user,
 	})
 }
+
+type privacyRequest struct {
+	IsPrivate *bool `json:"isPrivate"`
+}
+
+// UpdatePrivacy は認証済みユーザーのアカウントの公開設定を変更する
+func (h *UserHandler) UpdatePrivacy(c echo.Context) error {
+	principal, ok := middlewares.CurrentPrincipal(c)
+	if !ok {
+		return unauthorizedResponse(c)
+	}
+	var req privacyRequest
+	if err := c.Bind(&req); err != nil || req.IsPrivate == nil {
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{"error": "isPrivate が必要です"})
+	}
+	if err := h.userUsecase.SetPrivate(principal.UserID, *req.IsPrivate); err != nil {
+		log.Errorf("Failed to update privacy: %v", err)
+		return errorResponse(c, err, "公開設定の変更に失敗しました")
+	}
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"message":   "公開設定を変更しました",
+		"isPrivate": *req.IsPrivate,
+	})
+}
+
+func (h *UserHandler) ListFollowRequests(c echo.Context) error {
+	principal, ok := middlewares.CurrentPrincipal(c)
+	if !ok {
+		return unauthorizedResponse(c)
+	}
+	users, err := h.userUsecase.ListFollowRequests(principal.UserID)
+	if err != nil {
+		log.Errorf("Failed to list follow requests: %v", err)
+		return errorResponse(c, err, "フォローリクエストの取得に失敗しました")
+	}
+	return c.JSON(http.StatusOK, map[string]interface{}{"requested_users": users})
+}
+
+// ApproveFollowRequest はパスの :id のユーザーからのフォローリクエストを承認する
+func (h *UserHandler) ApproveFollowRequest(c echo.Context) error {
+	principal, ok := middlewares.CurrentPrincipal(c)
+	if !ok {
+		return unauthorizedResponse(c)
+	}
+	if err := h.userUsecase.ApproveFollowRequest(principal.UserID, c.Param("id")); err != nil {
+		log.Errorf("Failed to approve follow request from %s: %v", c.Param("id"), err)
+		return errorResponse(c, err, "フォローリクエストの承認に失敗しました")
+	}
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"message": "フォローリクエストを承認しました",
+	})
+}
+
+// RejectFollowRequest はパスの :id のユーザーからのフォローリクエストを拒否する
+func (h *UserHandler) RejectFollowRequest(c echo.Context) error {
+	principal, ok := middlewares.CurrentPrincipal(c)
+	if !ok {
+		return unauthorizedResponse(c)
+	}
+	if err := h.userUsecase.RejectFollowRequest(principal.UserID, c.Param("id")); err != nil {
+		log.Errorf("Failed to reject follow request from %s: %v", c.Param("id"), err)
+		return errorResponse(c, err, "フォローリクエストの拒否に失敗しました")
+	}
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"message": "フォローリクエストを拒否しました",
+	})
+}
@@ -0,0 +1,344 @@
+package infra
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aki-13627/animalia/backend-go/ent"
+	"github.com/aki-13627/animalia/backend-go/ent/block"
+	"github.com/aki-13627/animalia/backend-go/ent/comment"
+	"github.com/aki-13627/animalia/backend-go/ent/dailytask"
+	"github.com/aki-13627/animalia/backend-go/ent/followrelation"
+	"github.com/aki-13627/animalia/backend-go/ent/like"
+	"github.com/aki-13627/animalia/backend-go/ent/mute"
+	"github.com/aki-13627/animalia/backend-go/ent/pet"
+	"github.com/aki-13627/animalia/backend-go/ent/post"
+	"github.com/aki-13627/animalia/backend-go/ent/postmedia"
+	"github.com/aki-13627/animalia/backend-go/ent/postrevision"
+	"github.com/aki-13627/animalia/backend-go/ent/storagedeletion"
+	"github.com/aki-13627/animalia/backend-go/ent/user"
+	"github.com/google/uuid"
+)
+
+// deletedUserName は削除後に匿名化したユーザーの名前
+const deletedUserName = "退会済みユーザー"
+
+type AccountRepository struct {
+	db *ent.Client
+}
+
+func NewAccountRepository(db *ent.Client) *AccountRepository {
+	return &AccountRepository{
+		db: db,
+	}
+}
+
+func (r *AccountRepository) Deactivate(userID uuid.UUID, deletedAt, deleteAfter time.Time) error {
+	ctx := context.Background()
+	tx, err := r.db.Tx(ctx)
+	if err != nil {
+		return err
+	}
+
+	u, err := tx.User.Get(ctx, userID)
+	if err != nil {
+		return rollback(tx, err)
+	}
+	if !u.DeletedAt.IsZero() {
+		return rollback(tx, errors.New("user is already deactivated"))
+	}
+
+	// 復元時に退会で削除したものだけを戻せるよう、すべて同じ日時で論理削除する
+	if err := tx.User.UpdateOneID(userID).SetDeletedAt(deletedAt).Exec(ctx); err != nil {
+		return rollback(tx, fmt.Errorf("failed to deactivate user: %w", err))
+	}
+	_, err = tx.Post.Update().
+		Where(post.HasUserWith(user.ID(userID)), post.DeletedAtIsNil()).
+		SetDeletedAt(deletedAt).
+		Save(ctx)
+	if err != nil {
+		return rollback(tx, fmt.Errorf("failed to delete posts: %w", err))
+	}
+	_, err = tx.Pet.Update().
+		Where(pet.HasOwnerWith(user.ID(userID)), pet.DeletedAtIsNil()).
+		SetDeletedAt(deletedAt).
+		Save(ctx)
+	if err != nil {
+		return rollback(tx, fmt.Errorf("failed to delete pets: %w", err))
+	}
+	_, err = tx.Comment.Update().
+		Where(comment.HasUserWith(user.ID(userID)), comment.DeletedAtIsNil()).
+		SetDeletedAt(deletedAt).
+		Save(ctx)
+	if err != nil {
+		return rollback(tx, fmt.Errorf("failed to delete comments: %w", err))
+	}
+
+	// いいね数・フォロワー数にすぐ反映されるよう、いいねとフォロー関係は削除する（復元しない）
+	if _, err := tx.Like.Delete().Where(like.HasUserWith(user.ID(userID))).Exec(ctx); err != nil {
+		return rollback(tx, fmt.Errorf("failed to delete likes: %w", err))
+	}
+	_, err = tx.FollowRelation.Delete().
+		Where(followrelation.Or(
+			followrelation.HasFromWith(user.ID(userID)),
+			followrelation.HasToWith(user.ID(userID)),
+		)).
+		Exec(ctx)
+	if err != nil {
+		return rollback(tx, fmt.Errorf("failed to delete follow relations: %w", err))
+	}
+
+	keys, err := storageKeysOf(ctx, tx, u)
+	if err != nil {
+		return rollback(tx, err)
+	}
+	creates := make([]*ent.StorageDeletionCreate, 0, len(keys))
+	for _, key := range keys {
+		creates = append(creates, tx.StorageDeletion.Create().
+			SetKey(key).
+			SetUserID(userID).
+			SetDeleteAfter(deleteAfter))
+	}
+	if err := tx.StorageDeletion.CreateBulk(creates...).Exec(ctx); err != nil {
+		return rollback(tx, fmt.Errorf("failed to queue storage deletions: %w", err))
+	}
+
+	return tx.Commit()
+}
+
+func (r *AccountRepository) Restore(userID uuid.UUID) error {
+	ctx := context.Background()
+	tx, err := r.db.Tx(ctx)
+	if err != nil {
+		return err
+	}
+
+	u, err := tx.User.Get(ctx, userID)
+	if err != nil {
+		return rollback(tx, err)
+	}
+	if u.DeletedAt.IsZero() || !u.PurgedAt.IsZero() {
+		return rollback(tx, errors.New("user is not restorable"))
+	}
+
+	// 退会より前に削除していた投稿などは deleted_at が異なるため戻さない
+	_, err = tx.Post.Update().
+		Where(post.HasUserWith(user.ID(userID)), post.DeletedAtEQ(u.DeletedAt)).
+		ClearDeletedAt().
+		Save(ctx)
+	if err != nil {
+		return rollback(tx, fmt.Errorf("failed to restore posts: %w", err))
+	}
+	_, err = tx.Pet.Update().
+		Where(pet.HasOwnerWith(user.ID(userID)), pet.DeletedAtEQ(u.DeletedAt)).
+		ClearDeletedAt().
+		Save(ctx)
+	if err != nil {
+		return rollback(tx, fmt.Errorf("failed to restore pets: %w", err))
+	}
+	_, err = tx.Comment.Update().
+		Where(comment.HasUserWith(user.ID(userID)), comment.DeletedAtEQ(u.DeletedAt)).
+		ClearDeletedAt().
+		Save(ctx)
+	if err != nil {
+		return rollback(tx, fmt.Errorf("failed to restore comments: %w", err))
+	}
+	if _, err := tx.StorageDeletion.Delete().Where(storagedeletion.UserID(userID)).Exec(ctx); err != nil {
+		return rollback(tx, fmt.Errorf("failed to dequeue storage deletions: %w", err))
+	}
+	if err := tx.User.UpdateOneID(userID).ClearDeletedAt().Exec(ctx); err != nil {
+		return rollback(tx, fmt.Errorf("failed to restore user: %w", err))
+	}
+
+	return tx.Commit()
+}
+
+func (r *AccountRepository) FindDeactivatedByEmail(email string) (*ent.User, error) {
+	return r.db.User.Query().
+		Where(
+			user.Email(email),
+			user.DeletedAtNotNil(),
+			user.PurgedAtIsNil(),
+		).
+		Only(context.Background())
+}
+
+func (r *AccountRepository) ListPurgeable(before time.Time) ([]*ent.User, error) {
+	return r.db.User.Query().
+		Where(
+			user.DeletedAtLT(before),
+			user.PurgedAtIsNil(),
+		).
+		All(context.Background())
+}
+
+func (r *AccountRepository) Purge(userID uuid.UUID, beforeCommit func() error) error {
+	ctx := context.Background()
+	tx, err := r.db.Tx(ctx)
+	if err != nil {
+		return err
+	}
+
+	u, err := tx.User.Get(ctx, userID)
+	if err != nil {
+		return rollback(tx, err)
+	}
+	if u.DeletedAt.IsZero() || !u.PurgedAt.IsZero() {
+		return rollback(tx, errors.New("user is not purgeable"))
+	}
+
+	// 猶予期間中に投稿されることはないが、退会時にキューに入っていないキーがあれば追加する
+	keys, err := storageKeysOf(ctx, tx, u)
+	if err != nil {
+		return rollback(tx, err)
+	}
+	queued, err := tx.StorageDeletion.Query().
+		Where(storagedeletion.UserID(userID)).
+		Select(storagedeletion.FieldKey).
+		Strings(ctx)
+	if err != nil {
+		return rollback(tx, err)
+	}
+	queuedSet := make(map[string]struct{}, len(queued))
+	for _, key := range queued {
+		queuedSet[key] = struct{}{}
+	}
+	creates := []*ent.StorageDeletionCreate{}
+	now := time.Now()
+	for _, key := range keys {
+		if _, ok := queuedSet[key]; ok {
+			continue
+		}
+		creates = append(creates, tx.StorageDeletion.Create().
+			SetKey(key).
+			SetUserID(userID).
+			SetDeleteAfter(now))
+	}
+	if err := tx.StorageDeletion.CreateBulk(creates...).Exec(ctx); err != nil {
+		return rollback(tx, fmt.Errorf("failed to queue storage deletions: %w", err))
+	}
+
+	// 外部キーの参照元から順に削除する
+	ownPosts := post.HasUserWith(user.ID(userID))
+	steps := []struct {
+		name string
+		exec func() (int, error)
+	}{
+		{"likes", func() (int, error) {
+			return tx.Like.Delete().Where(like.Or(like.HasUserWith(user.ID(userID)), like.HasPostWith(ownPosts))).Exec(ctx)
+		}},
+		{"comments", func() (int, error) {
+			return tx.Comment.Delete().Where(comment.Or(comment.HasUserWith(user.ID(userID)), comment.HasPostWith(ownPosts))).Exec(ctx)
+		}},
+		{"follow relations", func() (int, error) {
+			return tx.FollowRelation.Delete().
+				Where(followrelation.Or(
+					followrelation.HasFromWith(user.ID(userID)),
+					followrelation.HasToWith(user.ID(userID)),
+				)).
+				Exec(ctx)
+		}},
+		{"blocks", func() (int, error) {
+			return tx.Block.Delete().Where(block.Or(block.HasFromWith(user.ID(userID)), block.HasToWith(user.ID(userID)))).Exec(ctx)
+		}},
+		{"mutes", func() (int, error) {
+			return tx.Mute.Delete().Where(mute.Or(mute.HasFromWith(user.ID(userID)), mute.HasToWith(user.ID(userID)))).Exec(ctx)
+		}},
+		{"daily tasks", func() (int, error) {
+			return tx.DailyTask.Delete().Where(dailytask.Or(dailytask.HasUserWith(user.ID(userID)), dailytask.HasPostWith(ownPosts))).Exec(ctx)
+		}},
+		{"post revisions", func() (int, error) {
+			return tx.PostRevision.Delete().Where(postrevision.Or(postrevision.HasPostWith(ownPosts), postrevision.HasEditorWith(user.ID(userID)))).Exec(ctx)
+		}},
+		{"post media", func() (int, error) {
+			return tx.PostMedia.Delete().Where(postmedia.HasPostWith(ownPosts)).Exec(ctx)
+		}},
+		{"posts", func() (int, error) {
+			return tx.Post.Delete().Where(ownPosts).Exec(ctx)
+		}},
+		{"pets", func() (int, error) {
+			return tx.Pet.Delete().Where(pet.HasOwnerWith(user.ID(userID))).Exec(ctx)
+		}},
+	}
+	for _, step := range steps {
+		if _, err := step.exec(); err != nil {
+			return rollback(tx, fmt.Errorf("failed to delete %s: %w", step.name, err))
+		}
+	}
+
+	// メールアドレスは一意のため、ユーザー ID から匿名のアドレスを作る
+	err = tx.User.UpdateOneID(userID).
+		SetEmail(fmt.Sprintf("deleted-%s@animalia.invalid", userID)).
+		SetName(deletedUserName).
+		SetBio("").
+		ClearIconImageKey().
+		ClearAuthSubject().
+		SetPurgedAt(now).
+		Exec(ctx)
+	if err != nil {
+		return rollback(tx, fmt.Errorf("failed to anonymize user: %w", err))
+	}
+
+	if err := beforeCommit(); err != nil {
+		return rollback(tx, err)
+	}
+	return tx.Commit()
+}
+
+func (r *AccountRepository) DueStorageDeletions(now time.Time, limit int) ([]*ent.StorageDeletion, error) {
+	return r.db.StorageDeletion.Query().
+		Where(storagedeletion.DeleteAfterLTE(now)).
+		Order(ent.Asc(storagedeletion.FieldDeleteAfter)).
+		Limit(limit).
+		All(context.Background())
+}
+
+func (r *AccountRepository) DeleteStorageDeletion(id uuid.UUID) error {
+	return r.db.StorageDeletion.DeleteOneID(id).Exec(context.Background())
+}
+
+// storageKeysOf はユーザーの投稿・ペット・アイコンが参照している画像・動画のキーを重複なく返す
+func storageKeysOf(ctx context.Context, tx *ent.Tx, u *ent.User) ([]string, error) {
+	ownPosts := post.HasUserWith(user.ID(u.ID))
+
+	postKeys, err := tx.Post.Query().Where(ownPosts).Select(post.FieldImageKey).Strings(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to collect post keys: %w", err)
+	}
+	media, err := tx.PostMedia.Query().
+		Where(postmedia.HasPostWith(ownPosts)).
+		Select(postmedia.FieldImageKey, postmedia.FieldPosterKey).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to collect media keys: %w", err)
+	}
+	petKeys, err := tx.Pet.Query().
+		Where(pet.HasOwnerWith(user.ID(u.ID))).
+		Select(pet.FieldImageKey).
+		Strings(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to collect pet keys: %w", err)
+	}
+
+	candidates := append(postKeys, petKeys...)
+	for _, m := range media {
+		candidates = append(candidates, m.ImageKey, m.PosterKey)
+	}
+	candidates = append(candidates, u.IconImageKey)
+
+	seen := make(map[string]struct{}, len(candidates))
+	keys := make([]string, 0, len(candidates))
+	for _, key := range candidates {
+		if key == "" {
+			continue
+		}
+		if _, ok := seen[key]; ok {
+			continue
+		}
+		seen[key] = struct{}{}
+		keys = append(keys, key)
+	}
+	return keys, nil
+}
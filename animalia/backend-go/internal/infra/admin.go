@@ -0,0 +1,166 @@
+package infra
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aki-13627/animalia/backend-go/ent"
+	"github.com/aki-13627/animalia/backend-go/ent/auditlog"
+	"github.com/aki-13627/animalia/backend-go/ent/comment"
+	"github.com/aki-13627/animalia/backend-go/ent/post"
+	"github.com/aki-13627/animalia/backend-go/ent/report"
+	"github.com/aki-13627/animalia/backend-go/ent/user"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/models"
+	"github.com/google/uuid"
+)
+
+type AdminRepository struct {
+	db *ent.Client
+}
+
+func NewAdminRepository(db *ent.Client) *AdminRepository {
+	return &AdminRepository{
+		db: db,
+	}
+}
+
+func (r *AdminRepository) SearchUsers(query string, limit, offset int) ([]*ent.User, error) {
+	q := r.db.User.Query().Where(user.PurgedAtIsNil())
+	if query != "" {
+		q = q.Where(user.Or(user.NameContainsFold(query), user.EmailContainsFold(query)))
+	}
+	return q.
+		Order(ent.Desc(user.FieldCreatedAt)).
+		Limit(limit).
+		Offset(offset).
+		All(context.Background())
+}
+
+func (r *AdminRepository) GetUser(userID uuid.UUID) (*ent.User, error) {
+	return r.db.User.Get(context.Background(), userID)
+}
+
+func (r *AdminRepository) SetSuspended(actorID *uuid.UUID, userID uuid.UUID, suspended bool, reason string) (*ent.User, error) {
+	ctx := context.Background()
+	tx, err := r.db.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	update := tx.User.UpdateOneID(userID)
+	action := models.AuditActionUnsuspendUser
+	if suspended {
+		update.SetSuspendedAt(time.Now()).SetSuspensionReason(reason)
+		action = models.AuditActionSuspendUser
+	} else {
+		update.ClearSuspendedAt().ClearSuspensionReason()
+	}
+	updated, err := update.Save(ctx)
+	if err != nil {
+		return nil, rollback(tx, err)
+	}
+	if err := recordAudit(ctx, tx, actorID, action, "user", userID.String(), reason); err != nil {
+		return nil, rollback(tx, err)
+	}
+	return updated, tx.Commit()
+}
+
+func (r *AdminRepository) SetRole(actorID *uuid.UUID, email string, role user.Role) (*ent.User, error) {
+	ctx := context.Background()
+	tx, err := r.db.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	target, err := tx.User.Query().Where(user.Email(email)).Only(ctx)
+	if err != nil {
+		return nil, rollback(tx, err)
+	}
+	updated, err := target.Update().SetRole(role).Save(ctx)
+	if err != nil {
+		return nil, rollback(tx, err)
+	}
+	detail := fmt.Sprintf("%s -> %s", target.Role, role)
+	if err := recordAudit(ctx, tx, actorID, models.AuditActionSetRole, "user", target.ID.String(), detail); err != nil {
+		return nil, rollback(tx, err)
+	}
+	return updated, tx.Commit()
+}
+
+func (r *AdminRepository) ForceDeletePost(actorID *uuid.UUID, postID uuid.UUID, reason string) error {
+	ctx := context.Background()
+	tx, err := r.db.Tx(ctx)
+	if err != nil {
+		return err
+	}
+
+	err = tx.Post.UpdateOneID(postID).
+		Where(post.DeletedAtIsNil()).
+		SetDeletedAt(time.Now()).
+		Exec(ctx)
+	if err != nil {
+		return rollback(tx, err)
+	}
+	if err := recordAudit(ctx, tx, actorID, models.AuditActionDeletePost, "post", postID.String(), reason); err != nil {
+		return rollback(tx, err)
+	}
+	return tx.Commit()
+}
+
+func (r *AdminRepository) ForceDeleteComment(actorID *uuid.UUID, commentID uuid.UUID, reason string) error {
+	ctx := context.Background()
+	tx, err := r.db.Tx(ctx)
+	if err != nil {
+		return err
+	}
+
+	// 監査ログから内容を確認できるよう、コメントは論理削除にする
+	err = tx.Comment.UpdateOneID(commentID).
+		Where(comment.DeletedAtIsNil()).
+		SetDeletedAt(time.Now()).
+		Exec(ctx)
+	if err != nil {
+		return rollback(tx, err)
+	}
+	if err := recordAudit(ctx, tx, actorID, models.AuditActionDeleteComment, "comment", commentID.String(), reason); err != nil {
+		return rollback(tx, err)
+	}
+	return tx.Commit()
+}
+
+func (r *AdminRepository) ListReports(status report.Status, limit, offset int) ([]*ent.Report, error) {
+	q := r.db.Report.Query().WithReporter()
+	if status != "" {
+		q = q.Where(report.StatusEQ(status))
+	}
+	return q.
+		Order(ent.Desc(report.FieldCreatedAt)).
+		Limit(limit).
+		Offset(offset).
+		All(context.Background())
+}
+
+func (r *AdminRepository) ListAuditLogs(limit, offset int) ([]*ent.AuditLog, error) {
+	return r.db.AuditLog.Query().
+		WithActor().
+		Order(ent.Desc(auditlog.FieldCreatedAt)).
+		Limit(limit).
+		Offset(offset).
+		All(context.Background())
+}
+
+// recordAudit は tx の中で監査ログを追加する
+func recordAudit(ctx context.Context, tx *ent.Tx, actorID *uuid.UUID, action, targetType, targetID, detail string) error {
+	err := tx.AuditLog.Create().
+		SetNillableActorID(actorID).
+		SetAction(action).
+		SetTargetType(targetType).
+		SetTargetID(targetID).
+		SetDetail(detail).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to record audit log: %w", err)
+	}
+	return nil
+}
@@ -9,23 +9,19 @@ import (
 	"fmt"
 	"log"
 	"os"
-	"time"
 
+	"github.com/aki-13627/animalia/backend-go/internal/domain/models"
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/config"
 	"github.com/aws/aws-sdk-go-v2/service/cognitoidentityprovider"
 	"github.com/aws/aws-sdk-go-v2/service/cognitoidentityprovider/types"
-	"github.com/golang-jwt/jwt/v5"
-	"github.com/lestrrat-go/jwx/jwk"
 )
 
 type CognitoRepository struct {
 	region        string
 	userPoolId    string
-	jwksURL       string
 	secret        string
 	clientId      string
-	jwksClient    *jwk.AutoRefresh
 	cognitoClient *cognitoidentityprovider.Client
 }
 
@@ -33,7 +29,6 @@ func NewCognitoRepository() *CognitoRepository {
 	region := os.Getenv("AWS_REGION")
 	fmt.Println("region", region)
 	userPoolId := os.Getenv("AWS_COGNITO_POOL_ID")
-	jwksURL := fmt.Sprintf("https://cognito-idp.%s.amazonaws.com/%s/.well-known/jwks.json", region, userPoolId)
 	secret := os.Getenv("AWS_COGNITO_CLIENT_SECRET")
 	clientId := os.Getenv("AWS_COGNITO_CLIENT_ID")
 
@@ -44,24 +39,16 @@ func NewCognitoRepository() *CognitoRepository {
 	}
 	cognitoClient := cognitoidentityprovider.NewFromConfig(cfg)
 
-	// Initialize JWK client for token verification
-	jwksClient := jwk.NewAutoRefresh(context.Background())
-	jwksClient.Configure(jwksURL, jwk.WithMinRefreshInterval(15*time.Minute))
-	if _, err := jwksClient.Refresh(context.Background(), jwksURL); err != nil {
-		log.Fatalf("Failed to refresh JWK endpoint: %v", err)
-	}
-
 	return &CognitoRepository{
 		region:        region,
 		userPoolId:    userPoolId,
-		jwksURL:       jwksURL,
 		secret:        secret,
 		clientId:      clientId,
-		jwksClient:    jwksClient,
 		cognitoClient: cognitoClient,
 	}
 }
 
+// GenerateHash はアプリクライアントのシークレットから SECRET_HASH を計算する
 func (r *CognitoRepository) GenerateHash(username string) string {
 	message := username + r.clientId
 	key := []byte(r.secret)
@@ -70,70 +57,6 @@ func (r *CognitoRepository) GenerateHash(username string) string {
 	return base64.StdEncoding.EncodeToString(h.Sum(nil))
 }
 
-func (r *CognitoRepository) VerifyToken(email, token string) error {
-	parsed, _, err := new(jwt.Parser).ParseUnverified(token, jwt.MapClaims{})
-	if err != nil {
-		return fmt.Errorf("failed to parse token: %w", err)
-	}
-
-	if parsed.Header == nil {
-		return errors.New("token header is nil")
-	}
-
-	kid, ok := parsed.Header["kid"].(string)
-	if !ok {
-		return errors.New("token header does not contain kid")
-	}
-
-	keySet, err := r.jwksClient.Fetch(context.Background(), r.jwksURL)
-	if err != nil {
-		return fmt.Errorf("failed to fetch JWK set: %w", err)
-	}
-
-	// Get the key with the matching key ID
-	key, found := keySet.LookupKeyID(kid)
-	if !found {
-		return errors.New("matching key not found in JWK set")
-	}
-
-	// Convert the JWK to a public key
-	var publicKey interface{}
-	if err := key.Raw(&publicKey); err != nil {
-		return fmt.Errorf("failed to get public key: %w", err)
-	}
-
-	// Parse and verify the token
-	parsedToken, err := jwt.Parse(token, func(token *jwt.Token) (interface{}, error) {
-		// Validate the algorithm
-		if _, ok := token.Method.(*jwt.SigningMethodRSA); !ok {
-			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
-		}
-		return publicKey, nil
-	})
-
-	if err != nil {
-		return fmt.Errorf("failed to parse and verify token: %w", err)
-	}
-
-	// Get the claims from the token
-	claims, ok := parsedToken.Claims.(jwt.MapClaims)
-	if !ok || !parsedToken.Valid {
-		return errors.New("invalid token claims")
-	}
-
-	// Get the email from the claims
-	tokenEmail, ok := claims["email"].(string)
-	if !ok {
-		return errors.New("email not found in token claims")
-	}
-
-	if tokenEmail != email {
-		return errors.New("email mismatch")
-	}
-
-	return nil
-}
-
 func (r *CognitoRepository) CreateUser(name, email, password string) error {
 	// TODO: これを呼ぶ前に既存のユーザーをチェック
 	// Generate the secret hash
@@ -178,7 +101,7 @@ func (r *CognitoRepository) VerifyEmail(email, code string) error {
 	return nil
 }
 
-func (r *CognitoRepository) SignIn(email, password string) (*cognitoidentityprovider.InitiateAuthOutput, error) {
+func (r *CognitoRepository) SignIn(email, password string) (*models.AuthTokens, error) {
 	// Generate the secret hash
 	secretHash := r.GenerateHash(email)
 
@@ -197,10 +120,10 @@ func (r *CognitoRepository) SignIn(email, password string) (*cognitoidentityprov
 		return nil, fmt.Errorf("failed to authenticate user: %w", err)
 	}
 
-	return result, nil
+	return newAuthTokens(result)
 }
 
-func (r *CognitoRepository) RefreshToken(refreshToken string) (*cognitoidentityprovider.InitiateAuthOutput, error) {
+func (r *CognitoRepository) RefreshToken(refreshToken string) (*models.AuthTokens, error) {
 	// Refresh the user's tokens with Cognito
 	result, err := r.cognitoClient.InitiateAuth(context.TODO(), &cognitoidentityprovider.InitiateAuthInput{
 		AuthFlow: types.AuthFlowTypeRefreshTokenAuth,
@@ -214,7 +137,22 @@ func (r *CognitoRepository) RefreshToken(refreshToken string) (*cognitoidentityp
 		return nil, fmt.Errorf("failed to refresh tokens: %w", err)
 	}
 
-	return result, nil
+	return newAuthTokens(result)
+}
+
+// newAuthTokens は InitiateAuth の結果をプロバイダに依存しないトークンに変換する
+func newAuthTokens(result *cognitoidentityprovider.InitiateAuthOutput) (*models.AuthTokens, error) {
+	if result.AuthenticationResult == nil {
+		// MFA などのチャレンジには対応していない
+		return nil, fmt.Errorf("unsupported auth challenge: %s", result.ChallengeName)
+	}
+	auth := result.AuthenticationResult
+	return &models.AuthTokens{
+		AccessToken:  aws.ToString(auth.AccessToken),
+		IdToken:      aws.ToString(auth.IdToken),
+		RefreshToken: aws.ToString(auth.RefreshToken),
+		ExpiresIn:    int(auth.ExpiresIn),
+	}, nil
 }
 
 func (r *CognitoRepository) GetUserEmail(accessToken string) (string, error) {
@@ -254,3 +192,132 @@ func (r *CognitoRepository) SignOut(accessToken string) error {
 
 	return nil
 }
+
+func (r *CognitoRepository) ForgotPassword(email string) error {
+	_, err := r.cognitoClient.ForgotPassword(context.TODO(), &cognitoidentityprovider.ForgotPasswordInput{
+		ClientId:   aws.String(r.clientId),
+		Username:   aws.String(email),
+		SecretHash: aws.String(r.GenerateHash(email)),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to start password reset: %w", err)
+	}
+	return nil
+}
+
+func (r *CognitoRepository) ConfirmForgotPassword(email, code, newPassword string) error {
+	_, err := r.cognitoClient.ConfirmForgotPassword(context.TODO(), &cognitoidentityprovider.ConfirmForgotPasswordInput{
+		ClientId:         aws.String(r.clientId),
+		Username:         aws.String(email),
+		ConfirmationCode: aws.String(code),
+		Password:         aws.String(newPassword),
+		SecretHash:       aws.String(r.GenerateHash(email)),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to reset password: %w", err)
+	}
+	return nil
+}
+
+func (r *CognitoRepository) ChangePassword(accessToken, oldPassword, newPassword string) error {
+	_, err := r.cognitoClient.ChangePassword(context.TODO(), &cognitoidentityprovider.ChangePasswordInput{
+		AccessToken:      aws.String(accessToken),
+		PreviousPassword: aws.String(oldPassword),
+		ProposedPassword: aws.String(newPassword),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to change password: %w", err)
+	}
+	return nil
+}
+
+// RequestEmailChange はユーザープールで「更新の確認中は元の属性値を有効にしておく」を有効にしている前提で、
+// 確認コードを新しいアドレスに送る
+func (r *CognitoRepository) RequestEmailChange(accessToken, newEmail string) error {
+	_, err := r.cognitoClient.UpdateUserAttributes(context.TODO(), &cognitoidentityprovider.UpdateUserAttributesInput{
+		AccessToken: aws.String(accessToken),
+		UserAttributes: []types.AttributeType{
+			{
+				Name:  aws.String("email"),
+				Value: aws.String(newEmail),
+			},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to request email change: %w", err)
+	}
+	return nil
+}
+
+// ConfirmEmailChange は確認コードを検証して新しいアドレスを有効にする。
+// 確認待ちのアドレスは Cognito が保持しているため newEmail は使わない
+func (r *CognitoRepository) ConfirmEmailChange(accessToken, newEmail, code string) error {
+	_, err := r.cognitoClient.VerifyUserAttribute(context.TODO(), &cognitoidentityprovider.VerifyUserAttributeInput{
+		AccessToken:   aws.String(accessToken),
+		AttributeName: aws.String("email"),
+		Code:          aws.String(code),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to confirm email change: %w", err)
+	}
+	return nil
+}
+
+func (r *CognitoRepository) RestoreEmail(accessToken, email string) error {
+	result, err := r.cognitoClient.GetUser(context.TODO(), &cognitoidentityprovider.GetUserInput{
+		AccessToken: aws.String(accessToken),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to get user information: %w", err)
+	}
+
+	// 元のアドレスは確認済みのため、管理者 API で確認コードを送らずに戻す
+	_, err = r.cognitoClient.AdminUpdateUserAttributes(context.TODO(), &cognitoidentityprovider.AdminUpdateUserAttributesInput{
+		UserPoolId: aws.String(r.userPoolId),
+		Username:   result.Username,
+		UserAttributes: []types.AttributeType{
+			{
+				Name:  aws.String("email"),
+				Value: aws.String(email),
+			},
+			{
+				Name:  aws.String("email_verified"),
+				Value: aws.String("true"),
+			},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to restore email: %w", err)
+	}
+	return nil
+}
+
+// DeleteUser は sub が subject のユーザーを削除する。
+// メールアドレスは変更の取り消しに失敗すると DB とずれることがあるため、変わらない sub でユーザー名を引く
+func (r *CognitoRepository) DeleteUser(subject string) error {
+	result, err := r.cognitoClient.ListUsers(context.TODO(), &cognitoidentityprovider.ListUsersInput{
+		UserPoolId: aws.String(r.userPoolId),
+		Filter:     aws.String(fmt.Sprintf("sub = %q", subject)),
+		Limit:      aws.Int32(1),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to find user in Cognito: %w", err)
+	}
+	if len(result.Users) == 0 {
+		// 前回の削除でプロバイダ側だけ削除済みの場合
+		return nil
+	}
+
+	_, err = r.cognitoClient.AdminDeleteUser(context.TODO(), &cognitoidentityprovider.AdminDeleteUserInput{
+		UserPoolId: aws.String(r.userPoolId),
+		Username:   result.Users[0].Username,
+	})
+	if err != nil {
+		var notFound *types.UserNotFoundException
+		if errors.As(err, &notFound) {
+			return nil
+		}
+		return fmt.Errorf("failed to delete user from Cognito: %w", err)
+	}
+	return nil
+}
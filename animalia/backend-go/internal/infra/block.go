@@ -0,0 +1,136 @@
+package infra
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aki-13627/animalia/backend-go/ent"
+	"github.com/aki-13627/animalia/backend-go/ent/block"
+	"github.com/aki-13627/animalia/backend-go/ent/followrelation"
+	"github.com/aki-13627/animalia/backend-go/ent/mute"
+	"github.com/aki-13627/animalia/backend-go/ent/predicate"
+	"github.com/aki-13627/animalia/backend-go/ent/user"
+	"github.com/google/uuid"
+)
+
+type BlockRepository struct {
+	db *ent.Client
+}
+
+func NewBlockRepository(db *ent.Client) *BlockRepository {
+	return &BlockRepository{
+		db: db,
+	}
+}
+
+func (r *BlockRepository) Block(blockerID, blockedID uuid.UUID) error {
+	ctx := context.Background()
+	tx, err := r.db.Tx(ctx)
+	if err != nil {
+		return err
+	}
+
+	if _, err := tx.User.Query().Where(user.ID(blockedID), user.DeletedAtIsNil()).OnlyID(ctx); err != nil {
+		return rollback(tx, err)
+	}
+	exists, err := tx.Block.Query().
+		Where(block.HasFromWith(user.ID(blockerID)), block.HasToWith(user.ID(blockedID))).
+		Exist(ctx)
+	if err != nil {
+		return rollback(tx, err)
+	}
+	if !exists {
+		if err := tx.Block.Create().SetFromID(blockerID).SetToID(blockedID).Exec(ctx); err != nil {
+			return rollback(tx, fmt.Errorf("failed to create block: %w", err))
+		}
+	}
+
+	_, err = tx.FollowRelation.Delete().
+		Where(followrelation.Or(
+			followrelation.And(followrelation.HasFromWith(user.ID(blockerID)), followrelation.HasToWith(user.ID(blockedID))),
+			followrelation.And(followrelation.HasFromWith(user.ID(blockedID)), followrelation.HasToWith(user.ID(blockerID))),
+		)).
+		Exec(ctx)
+	if err != nil {
+		return rollback(tx, fmt.Errorf("failed to delete follow relations: %w", err))
+	}
+	return tx.Commit()
+}
+
+func (r *BlockRepository) Unblock(blockerID, blockedID uuid.UUID) error {
+	_, err := r.db.Block.Delete().
+		Where(block.HasFromWith(user.ID(blockerID)), block.HasToWith(user.ID(blockedID))).
+		Exec(context.Background())
+	return err
+}
+
+func (r *BlockRepository) Mute(muterID, mutedID uuid.UUID) error {
+	ctx := context.Background()
+	if _, err := r.db.User.Query().Where(user.ID(mutedID), user.DeletedAtIsNil()).OnlyID(ctx); err != nil {
+		return err
+	}
+	exists, err := r.db.Mute.Query().
+		Where(mute.HasFromWith(user.ID(muterID)), mute.HasToWith(user.ID(mutedID))).
+		Exist(ctx)
+	if err != nil || exists {
+		return err
+	}
+	return r.db.Mute.Create().SetFromID(muterID).SetToID(mutedID).Exec(ctx)
+}
+
+func (r *BlockRepository) Unmute(muterID, mutedID uuid.UUID) error {
+	_, err := r.db.Mute.Delete().
+		Where(mute.HasFromWith(user.ID(muterID)), mute.HasToWith(user.ID(mutedID))).
+		Exec(context.Background())
+	return err
+}
+
+func (r *BlockRepository) IsBlocked(userID, otherID uuid.UUID) (bool, error) {
+	return r.db.User.Query().
+		Where(user.ID(otherID), blockedWith(userID)).
+		Exist(context.Background())
+}
+
+func (r *BlockRepository) BlockedUserIDs(userID uuid.UUID) (map[uuid.UUID]bool, error) {
+	ids, err := r.db.User.Query().
+		Where(blockedWith(userID)).
+		IDs(context.Background())
+	if err != nil {
+		return nil, err
+	}
+	blocked := make(map[uuid.UUID]bool, len(ids))
+	for _, id := range ids {
+		blocked[id] = true
+	}
+	return blocked, nil
+}
+
+func (r *BlockRepository) ListBlocked(userID uuid.UUID) ([]*ent.User, error) {
+	return r.db.User.Query().
+		Where(user.HasBlockedByWith(block.HasFromWith(user.ID(userID))), user.DeletedAtIsNil()).
+		Order(ent.Asc(user.FieldName)).
+		All(context.Background())
+}
+
+func (r *BlockRepository) ListMuted(userID uuid.UUID) ([]*ent.User, error) {
+	return r.db.User.Query().
+		Where(user.HasMutedByWith(mute.HasFromWith(user.ID(userID))), user.DeletedAtIsNil()).
+		Order(ent.Asc(user.FieldName)).
+		All(context.Background())
+}
+
+// blockedWith は viewerID がブロックしている、または viewerID をブロックしているユーザーの条件
+func blockedWith(viewerID uuid.UUID) predicate.User {
+	return user.Or(
+		user.HasBlockedByWith(block.HasFromWith(user.ID(viewerID))),
+		user.HasBlockingWith(block.HasToWith(user.ID(viewerID))),
+	)
+}
+
+// hiddenFromFeed は viewerID のフィードに表示しないユーザーの条件。ブロックに加えて viewerID がミュートしているユーザーを含む
+func hiddenFromFeed(viewerID uuid.UUID) predicate.User {
+	return user.Or(
+		blockedWith(viewerID),
+		user.HasMutedByWith(mute.HasFromWith(user.ID(viewerID))),
+	)
+}
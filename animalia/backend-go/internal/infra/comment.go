@@ -5,6 +5,9 @@ import (
 
 	"github.com/aki-13627/animalia/backend-go/ent"
 	"github.com/aki-13627/animalia/backend-go/ent/comment"
+	"github.com/aki-13627/animalia/backend-go/ent/post"
+	"github.com/aki-13627/animalia/backend-go/ent/predicate"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/models"
 	"github.com/google/uuid"
 )
 
@@ -41,6 +44,33 @@ func (r *CommentRepository) Create(userId uuid.UUID, postId uuid.UUID, content s
 	return commentWithUser, nil
 }
 
+func (r *CommentRepository) GetById(commentId uuid.UUID) (*ent.Comment, error) {
+	return r.db.Comment.Query().
+		Where(comment.ID(commentId), comment.DeletedAtIsNil()).
+		WithUser().
+		WithPost(func(q *ent.PostQuery) {
+			q.WithUser().Select(post.FieldID)
+		}).
+		Only(context.Background())
+}
+
+func (r *CommentRepository) ListByPost(postID, viewerID uuid.UUID, page models.PageRequest) ([]*ent.Comment, string, error) {
+	comments, err := r.db.Comment.Query().
+		Where(comment.HasPostWith(post.ID(postID)), visibleComments(viewerID)).
+		Where(before[predicate.Comment](page.Cursor)).
+		Order(ent.Desc(comment.FieldCreatedAt), ent.Desc(comment.FieldID)).
+		Limit(page.Limit + 1).
+		WithUser().
+		All(context.Background())
+	if err != nil {
+		return nil, "", err
+	}
+	comments, next := nextPage(comments, page, func(c *ent.Comment) models.Cursor {
+		return models.Cursor{CreatedAt: c.CreatedAt, ID: c.ID}
+	})
+	return comments, next, nil
+}
+
 func (r *CommentRepository) Delete(commentId string) error {
 	parsedCommentId, err := uuid.Parse(commentId)
 	if err != nil {
@@ -54,3 +84,8 @@ func (r *CommentRepository) Delete(commentId string) error {
 
 	return nil
 }
+
+// visibleComments は viewerID に見せるコメントの条件。削除・非表示のコメントと、viewerID との間にブロックがあるユーザーのコメントを除く
+func visibleComments(viewerID uuid.UUID) predicate.Comment {
+	return comment.And(comment.DeletedAtIsNil(), comment.HiddenAtIsNil(), comment.Not(comment.HasUserWith(blockedWith(viewerID))))
+}
@@ -0,0 +1,256 @@
+package infra
+
+import (
+	"context"
+	"math"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/aki-13627/animalia/backend-go/ent"
+	"github.com/aki-13627/animalia/backend-go/ent/comment"
+	"github.com/aki-13627/animalia/backend-go/ent/followrelation"
+	"github.com/aki-13627/animalia/backend-go/ent/like"
+	"github.com/aki-13627/animalia/backend-go/ent/post"
+	"github.com/aki-13627/animalia/backend-go/ent/predicate"
+	"github.com/aki-13627/animalia/backend-go/ent/user"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/models"
+	"github.com/google/uuid"
+	"github.com/pgvector/pgvector-go"
+	entvec "github.com/pgvector/pgvector-go/ent"
+)
+
+// profileLikes は閲覧者の好みの画像の特徴量を求めるのに使う、直近のいいねの数
+const profileLikes = 20
+
+type FeedRepository struct {
+	db *ent.Client
+}
+
+func NewFeedRepository(db *ent.Client) *FeedRepository {
+	return &FeedRepository{
+		db: db,
+	}
+}
+
+func (r *FeedRepository) FeedCandidates(viewerID uuid.UUID, since, interactionsSince time.Time, limit int) ([]models.FeedCandidate, error) {
+	ctx := context.Background()
+	profile, err := r.profileVector(ctx, viewerID)
+	if err != nil {
+		return nil, err
+	}
+
+	feed := []predicate.Post{
+		post.CreatedAtGTE(since),
+		post.DeletedAtIsNil(),
+		post.HiddenAtIsNil(),
+		post.Not(post.HasUserWith(user.Or(user.ID(viewerID), user.DeletedAtNotNil(), hiddenFromFeed(viewerID)))),
+		visibleTo(viewerID),
+	}
+	posts, err := r.db.Post.Query().
+		Where(feed...).
+		Order(ent.Desc(post.FieldCreatedAt), ent.Desc(post.FieldID)).
+		Limit(limit).
+		Select(post.FieldID, post.FieldCreatedAt).
+		WithUser(func(q *ent.UserQuery) {
+			q.Select(user.FieldID)
+		}).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if profile != nil {
+		// 新しい投稿だけでは好みに合う投稿が漏れるため、画像が近い投稿も pgvector で探して候補に加える
+		similar, err := r.db.Post.Query().
+			Where(feed...).
+			Where(post.ImageFeatureNotNil()).
+			Order(func(s *sql.Selector) {
+				s.OrderExpr(entvec.CosineDistance(post.FieldImageFeature, *profile))
+			}).
+			Limit(limit/4+1).
+			Select(post.FieldID, post.FieldCreatedAt).
+			WithUser(func(q *ent.UserQuery) {
+				q.Select(user.FieldID)
+			}).
+			All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		posts = append(posts, similar...)
+	}
+
+	seen := make(map[uuid.UUID]bool, len(posts))
+	postIDs := make([]uuid.UUID, 0, len(posts))
+	unique := posts[:0]
+	for _, p := range posts {
+		if seen[p.ID] {
+			continue
+		}
+		seen[p.ID] = true
+		postIDs = append(postIDs, p.ID)
+		unique = append(unique, p)
+	}
+	if len(unique) == 0 {
+		return []models.FeedCandidate{}, nil
+	}
+
+	following, err := r.db.User.Query().
+		Where(user.HasFollowersWith(followrelation.HasFromWith(user.ID(viewerID)), approved())).
+		IDs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	followed := make(map[uuid.UUID]bool, len(following))
+	for _, id := range following {
+		followed[id] = true
+	}
+	interactions, err := r.interactions(ctx, postIDs, interactionsSince)
+	if err != nil {
+		return nil, err
+	}
+	var similarity map[uuid.UUID]float64
+	if profile != nil {
+		// image_feature が NULL の行は読み込めないため、特徴量のある投稿だけを取得する
+		withFeature, err := r.db.Post.Query().
+			Where(post.IDIn(postIDs...), post.ImageFeatureNotNil()).
+			Select(post.FieldID, post.FieldImageFeature).
+			All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		similarity = similarities(*profile, withFeature)
+	}
+
+	candidates := make([]models.FeedCandidate, len(unique))
+	for i, p := range unique {
+		candidates[i] = models.FeedCandidate{
+			PostID:        p.ID,
+			CreatedAt:     p.CreatedAt,
+			FromFollowing: p.Edges.User != nil && followed[p.Edges.User.ID],
+			Interactions:  interactions[p.ID],
+			Similarity:    similarity[p.ID],
+		}
+	}
+	return candidates, nil
+}
+
+// profileVector は viewerID が最近いいねした投稿の画像の特徴量を平均したものを返す。特徴量のある投稿にいいねしていなければ nil
+func (r *FeedRepository) profileVector(ctx context.Context, viewerID uuid.UUID) (*pgvector.Vector, error) {
+	likes, err := r.db.Like.Query().
+		Where(like.HasUserWith(user.ID(viewerID)), like.HasPostWith(post.ImageFeatureNotNil(), post.DeletedAtIsNil())).
+		Order(ent.Desc(like.FieldCreatedAt)).
+		Limit(profileLikes).
+		WithPost(func(q *ent.PostQuery) {
+			q.Where(post.ImageFeatureNotNil()).Select(post.FieldID, post.FieldImageFeature)
+		}).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var sum []float32
+	n := 0
+	for _, l := range likes {
+		if l.Edges.Post == nil {
+			continue
+		}
+		v := normalize(l.Edges.Post.ImageFeature.Slice())
+		if v == nil || (sum != nil && len(v) != len(sum)) {
+			continue
+		}
+		if sum == nil {
+			sum = make([]float32, len(v))
+		}
+		for i := range v {
+			sum[i] += v[i]
+		}
+		n++
+	}
+	if n == 0 {
+		return nil, nil
+	}
+	for i := range sum {
+		sum[i] /= float32(n)
+	}
+	profile := pgvector.NewVector(sum)
+	return &profile, nil
+}
+
+// interactions は postIDs の投稿ごとに since 以降のいいねと、削除・非表示になっていないコメントの数を返す
+func (r *FeedRepository) interactions(ctx context.Context, postIDs []uuid.UUID, since time.Time) (map[uuid.UUID]int, error) {
+	counts := make(map[uuid.UUID]int, len(postIDs))
+	likes, err := r.db.Like.Query().
+		Where(like.CreatedAtGTE(since), like.HasPostWith(post.IDIn(postIDs...))).
+		WithPost(func(q *ent.PostQuery) {
+			q.Select(post.FieldID)
+		}).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, l := range likes {
+		if l.Edges.Post != nil {
+			counts[l.Edges.Post.ID]++
+		}
+	}
+	comments, err := r.db.Comment.Query().
+		Where(comment.CreatedAtGTE(since), comment.DeletedAtIsNil(), comment.HiddenAtIsNil(), comment.HasPostWith(post.IDIn(postIDs...))).
+		WithPost(func(q *ent.PostQuery) {
+			q.Select(post.FieldID)
+		}).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, c := range comments {
+		if c.Edges.Post != nil {
+			counts[c.Edges.Post.ID]++
+		}
+	}
+	return counts, nil
+}
+
+// similarities は posts の投稿ごとに画像の特徴量と profile のコサイン類似度を返す。特徴量のない投稿は含めない
+func similarities(profile pgvector.Vector, posts []*ent.Post) map[uuid.UUID]float64 {
+	similarity := make(map[uuid.UUID]float64, len(posts))
+	for _, p := range posts {
+		if len(p.ImageFeature.Slice()) == 0 {
+			continue
+		}
+		similarity[p.ID] = cosineSimilarity(profile.Slice(), p.ImageFeature.Slice())
+	}
+	return similarity
+}
+
+// normalize は v を長さ 1 にしたものを返す。空かゼロベクトルなら nil
+func normalize(v []float32) []float32 {
+	var norm float64
+	for _, x := range v {
+		norm += float64(x) * float64(x)
+	}
+	if norm == 0 {
+		return nil
+	}
+	norm = math.Sqrt(norm)
+	normalized := make([]float32, len(v))
+	for i, x := range v {
+		normalized[i] = float32(float64(x) / norm)
+	}
+	return normalized
+}
+
+// cosineSimilarity は a と b のコサイン類似度を返す。次元が違うか、どちらかがゼロベクトルなら 0
+func cosineSimilarity(a, b []float32) float64 {
+	if len(a) == 0 || len(a) != len(b) {
+		return 0
+	}
+	var dot, normA, normB float64
+	for i := range a {
+		dot += float64(a[i]) * float64(b[i])
+		normA += float64(a[i]) * float64(a[i])
+		normB += float64(b[i]) * float64(b[i])
+	}
+	if normA == 0 || normB == 0 {
+		return 0
+	}
+	return dot / (math.Sqrt(normA) * math.Sqrt(normB))
+}
@@ -0,0 +1,52 @@
+package infra
+
+import (
+	"testing"
+
+	"github.com/aki-13627/animalia/backend-go/ent"
+	"github.com/google/uuid"
+	"github.com/pgvector/pgvector-go"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSimilarities(t *testing.T) {
+	profile := pgvector.NewVector([]float32{1, 0})
+	same := &ent.Post{ID: uuid.New(), ImageFeature: pgvector.NewVector([]float32{2, 0})}
+	orthogonal := &ent.Post{ID: uuid.New(), ImageFeature: pgvector.NewVector([]float32{0, 3})}
+	opposite := &ent.Post{ID: uuid.New(), ImageFeature: pgvector.NewVector([]float32{-1, 0})}
+	// image_feature が NULL の投稿
+	noFeature := &ent.Post{ID: uuid.New()}
+	otherDimension := &ent.Post{ID: uuid.New(), ImageFeature: pgvector.NewVector([]float32{1, 0, 0})}
+
+	similarity := similarities(profile, []*ent.Post{same, orthogonal, opposite, noFeature, otherDimension})
+
+	assert.InDelta(t, 1, similarity[same.ID], 1e-6)
+	assert.InDelta(t, 0, similarity[orthogonal.ID], 1e-6)
+	assert.InDelta(t, -1, similarity[opposite.ID], 1e-6)
+	assert.NotContains(t, similarity, noFeature.ID)
+	assert.Equal(t, 0.0, similarity[otherDimension.ID])
+	assert.Len(t, similarity, 4)
+}
+
+func TestNormalize(t *testing.T) {
+	testCases := []struct {
+		name     string
+		v        []float32
+		expected []float32
+	}{
+		{name: "Unit length", v: []float32{3, 4}, expected: []float32{0.6, 0.8}},
+		{name: "Zero vector", v: []float32{0, 0}, expected: nil},
+		{name: "Empty", v: nil, expected: nil},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			actual := normalize(tc.v)
+			if tc.expected == nil {
+				assert.Nil(t, actual)
+				return
+			}
+			assert.InDeltaSlice(t, tc.expected, actual, 1e-6)
+		})
+	}
+}
@@ -0,0 +1,136 @@
+package infra
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"strconv"
+	"time"
+
+	"github.com/aki-13627/animalia/backend-go/internal/domain/models"
+)
+
+// ffmpegTimeout は ffmpeg/ffprobe の1回の実行にかける時間の上限
+const ffmpegTimeout = 30 * time.Second
+
+// FFmpegRepository はローカルの ffmpeg/ffprobe を使って動画を解析する VideoRepository 実装
+type FFmpegRepository struct {
+	ffmpegPath  string
+	ffprobePath string
+}
+
+func NewFFmpegRepository(ffmpegPath, ffprobePath string) *FFmpegRepository {
+	return &FFmpegRepository{
+		ffmpegPath:  ffmpegPath,
+		ffprobePath: ffprobePath,
+	}
+}
+
+func (r *FFmpegRepository) Probe(data []byte) (*models.VideoInfo, error) {
+	path, cleanup, err := writeTempVideo(data)
+	if err != nil {
+		return nil, err
+	}
+	defer cleanup()
+
+	out, err := r.run(r.ffprobePath,
+		"-v", "error",
+		"-print_format", "json",
+		"-show_format",
+		"-show_streams",
+		path,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to probe video: %w", err)
+	}
+
+	var result struct {
+		Streams []struct {
+			CodecType string `json:"codec_type"`
+			Width     int    `json:"width"`
+			Height    int    `json:"height"`
+		} `json:"streams"`
+		Format struct {
+			Duration string `json:"duration"`
+		} `json:"format"`
+	}
+	if err := json.Unmarshal(out, &result); err != nil {
+		return nil, fmt.Errorf("failed to parse ffprobe output: %w", err)
+	}
+
+	info := &models.VideoInfo{}
+	if seconds, err := strconv.ParseFloat(result.Format.Duration, 64); err == nil {
+		info.Duration = time.Duration(seconds * float64(time.Second))
+	}
+	for _, stream := range result.Streams {
+		if stream.CodecType == "video" {
+			info.Width = stream.Width
+			info.Height = stream.Height
+			break
+		}
+	}
+	return info, nil
+}
+
+func (r *FFmpegRepository) PosterFrame(data []byte) ([]byte, error) {
+	path, cleanup, err := writeTempVideo(data)
+	if err != nil {
+		return nil, err
+	}
+	defer cleanup()
+
+	// thumbnail フィルタで先頭付近から暗転していない代表的なフレームを選ぶ
+	out, err := r.run(r.ffmpegPath,
+		"-v", "error",
+		"-i", path,
+		"-vf", "thumbnail",
+		"-frames:v", "1",
+		"-f", "image2",
+		"-c:v", "mjpeg",
+		"-q:v", "2",
+		"pipe:1",
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to extract poster frame: %w", err)
+	}
+	if len(out) == 0 {
+		return nil, fmt.Errorf("failed to extract poster frame: empty output")
+	}
+	return out, nil
+}
+
+func (r *FFmpegRepository) run(name string, args ...string) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), ffmpegTimeout)
+	defer cancel()
+
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, name, args...)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		return nil, fmt.Errorf("%s: %w: %s", name, err, bytes.TrimSpace(stderr.Bytes()))
+	}
+	return stdout.Bytes(), nil
+}
+
+// writeTempVideo は ffmpeg に渡すために動画を一時ファイルへ書き出す
+func writeTempVideo(data []byte) (string, func(), error) {
+	f, err := os.CreateTemp("", "animalia-video-*")
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to create temp file: %w", err)
+	}
+	cleanup := func() { os.Remove(f.Name()) }
+	if _, err := f.Write(data); err != nil {
+		f.Close()
+		cleanup()
+		return "", nil, fmt.Errorf("failed to write temp file: %w", err)
+	}
+	if err := f.Close(); err != nil {
+		cleanup()
+		return "", nil, fmt.Errorf("failed to write temp file: %w", err)
+	}
+	return f.Name(), cleanup, nil
+}
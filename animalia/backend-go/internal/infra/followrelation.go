@@ -5,7 +5,9 @@ import (
 
 	"github.com/aki-13627/animalia/backend-go/ent"
 	"github.com/aki-13627/animalia/backend-go/ent/followrelation"
+	"github.com/aki-13627/animalia/backend-go/ent/predicate"
 	"github.com/aki-13627/animalia/backend-go/ent/user"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/models"
 	"github.com/google/uuid"
 )
 
@@ -25,7 +27,7 @@ func (r *FollowRelationRepository) CountFollows(userId string) (int, error) {
 		return 0, err
 	}
 	count, err := r.db.FollowRelation.Query().
-		Where(followrelation.HasFromWith(user.ID(userUUID))).
+		Where(followrelation.HasFromWith(user.ID(userUUID)), approved()).
 		Count(context.Background())
 	if err != nil {
 		return 0, err
@@ -39,7 +41,7 @@ func (r *FollowRelationRepository) CountFollowers(userId string) (int, error) {
 		return 0, err
 	}
 	count, err := r.db.FollowRelation.Query().
-		Where(followrelation.HasToWith(user.ID(userUUID))).
+		Where(followrelation.HasToWith(user.ID(userUUID)), approved()).
 		Count(context.Background())
 	if err != nil {
 		return 0, err
@@ -47,38 +49,119 @@ func (r *FollowRelationRepository) CountFollowers(userId string) (int, error) {
 	return int(count), nil
 }
 
-func (r *FollowRelationRepository) Followings(userId string) ([]*ent.User, error) {
+func (r *FollowRelationRepository) Followings(userId string, page models.PageRequest) ([]*ent.User, string, error) {
 	userUUID, err := uuid.Parse(userId)
 	if err != nil {
-		return nil, err
+		return nil, "", err
 	}
 	followings, err := r.db.FollowRelation.Query().
-		Where(followrelation.HasFromWith(user.ID(userUUID))).
+		Where(followrelation.HasFromWith(user.ID(userUUID)), approved()).
+		Where(before[predicate.FollowRelation](page.Cursor)).
+		Order(ent.Desc(followrelation.FieldCreatedAt), ent.Desc(followrelation.FieldID)).
+		Limit(page.Limit + 1).
+		WithTo().
 		All(context.Background())
 	if err != nil {
-		return nil, err
+		return nil, "", err
 	}
+	followings, next := nextPage(followings, page, followCursor)
 	users := make([]*ent.User, len(followings))
 	for i, following := range followings {
 		users[i] = following.Edges.To
 	}
-	return users, nil
+	return users, next, nil
 }
 
-func (r *FollowRelationRepository) Followers(userId string) ([]*ent.User, error) {
+func (r *FollowRelationRepository) Followers(userId string, page models.PageRequest) ([]*ent.User, string, error) {
 	userUUID, err := uuid.Parse(userId)
 	if err != nil {
-		return nil, err
+		return nil, "", err
 	}
 	followers, err := r.db.FollowRelation.Query().
-		Where(followrelation.HasToWith(user.ID(userUUID))).
+		Where(followrelation.HasToWith(user.ID(userUUID)), approved()).
+		Where(before[predicate.FollowRelation](page.Cursor)).
+		Order(ent.Desc(followrelation.FieldCreatedAt), ent.Desc(followrelation.FieldID)).
+		Limit(page.Limit + 1).
+		WithFrom().
 		All(context.Background())
 	if err != nil {
-		return nil, err
+		return nil, "", err
 	}
+	followers, next := nextPage(followers, page, followCursor)
 	users := make([]*ent.User, len(followers))
 	for i, follower := range followers {
 		users[i] = follower.Edges.From
 	}
-	return users, nil
+	return users, next, nil
+}
+
+func (r *FollowRelationRepository) Status(fromID, toID uuid.UUID) (followrelation.Status, error) {
+	relation, err := r.db.FollowRelation.Query().
+		Where(followrelation.HasFromWith(user.ID(fromID)), followrelation.HasToWith(user.ID(toID))).
+		First(context.Background())
+	if ent.IsNotFound(err) {
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+	return relation.Status, nil
+}
+
+func (r *FollowRelationRepository) PendingRequests(userID uuid.UUID) ([]*ent.User, error) {
+	return r.db.User.Query().
+		Where(
+			user.HasFollowingWith(
+				followrelation.HasToWith(user.ID(userID)),
+				followrelation.StatusEQ(followrelation.StatusPending),
+			),
+			user.DeletedAtIsNil(),
+		).
+		Order(ent.Asc(user.FieldName)).
+		All(context.Background())
+}
+
+func (r *FollowRelationRepository) Approve(fromID, toID uuid.UUID) error {
+	ctx := context.Background()
+	relationID, err := r.db.FollowRelation.Query().Where(pendingRequest(fromID, toID)).OnlyID(ctx)
+	if err != nil {
+		return err
+	}
+	return r.db.FollowRelation.UpdateOneID(relationID).SetStatus(followrelation.StatusApproved).Exec(ctx)
+}
+
+func (r *FollowRelationRepository) Reject(fromID, toID uuid.UUID) error {
+	ctx := context.Background()
+	relationID, err := r.db.FollowRelation.Query().Where(pendingRequest(fromID, toID)).OnlyID(ctx)
+	if err != nil {
+		return err
+	}
+	return r.db.FollowRelation.DeleteOneID(relationID).Exec(ctx)
+}
+
+func followCursor(f *ent.FollowRelation) models.Cursor {
+	return models.Cursor{CreatedAt: f.CreatedAt, ID: f.ID}
+}
+
+// approved は承認済みのフォロー関係の条件
+func approved() predicate.FollowRelation {
+	return followrelation.StatusEQ(followrelation.StatusApproved)
+}
+
+// pendingRequest は fromID から toID への未承認のフォローリクエストの条件
+func pendingRequest(fromID, toID uuid.UUID) predicate.FollowRelation {
+	return followrelation.And(
+		followrelation.HasFromWith(user.ID(fromID)),
+		followrelation.HasToWith(user.ID(toID)),
+		followrelation.StatusEQ(followrelation.StatusPending),
+	)
+}
+
+// privateTo は viewerID から投稿が見えない非公開アカウントの条件。本人と承認済みのフォロワーには見える
+func privateTo(viewerID uuid.UUID) predicate.User {
+	return user.And(
+		user.IsPrivate(true),
+		user.IDNEQ(viewerID),
+		user.Not(user.HasFollowersWith(followrelation.HasFromWith(user.ID(viewerID)), approved())),
+	)
 }
@@ -0,0 +1,288 @@
+package infra
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+	"image/jpeg"
+	_ "image/png"
+	"io"
+	"log"
+	"mime/multipart"
+
+	"github.com/aki-13627/animalia/backend-go/internal/domain/models"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/repository"
+	"github.com/google/uuid"
+	xdraw "golang.org/x/image/draw"
+	_ "golang.org/x/image/webp"
+)
+
+var ErrUnsupportedImageFormat = errors.New("unsupported image format")
+
+// maxImageUploadBytes はパイプラインが読み込む画像の上限サイズ
+const maxImageUploadBytes = 20 << 20
+
+type imageVariantSpec struct {
+	maxEdge int
+	quality int
+}
+
+var imageVariantSpecs = map[models.ImageVariant]imageVariantSpec{
+	models.ImageVariantThumb:  {maxEdge: 320, quality: 80},
+	models.ImageVariantMedium: {maxEdge: 1080, quality: 85},
+	models.ImageVariantFull:   {maxEdge: 2048, quality: 85},
+}
+
+// ImagePipelineRepository はアップロードされた画像を正規化してから保存する StorageRepository。
+// JPEG/PNG/WebP をデコードし、EXIF の向きを反映したうえで JPEG として再エンコードするため、
+// GPS を含むメタデータはすべて取り除かれる。thumb/medium/full の3バリアントを生成し、
+// full バリアントのキーを返す。HEIC は端末側で JPEG に変換されたものだけを受け付ける
+// （拡張子や Content-Type ではなく中身で判定する）。
+type ImagePipelineRepository struct {
+	repository.StorageRepository
+}
+
+func NewImagePipelineRepository(inner repository.StorageRepository) *ImagePipelineRepository {
+	return &ImagePipelineRepository{StorageRepository: inner}
+}
+
+func (r *ImagePipelineRepository) UploadImage(file *multipart.FileHeader, directory string) (string, error) {
+	src, err := file.Open()
+	if err != nil {
+		return "", fmt.Errorf("failed to open file: %w", err)
+	}
+	defer src.Close()
+
+	data, err := io.ReadAll(io.LimitReader(src, maxImageUploadBytes+1))
+	if err != nil {
+		return "", fmt.Errorf("failed to read file: %w", err)
+	}
+	if len(data) == 0 {
+		return "", fmt.Errorf("file is empty")
+	}
+	if len(data) > maxImageUploadBytes {
+		return "", fmt.Errorf("file is too large: %d bytes", len(data))
+	}
+
+	return r.storeVariants(data, directory)
+}
+
+// ImportObject は直接アップロードされた画像を取得し、バリアントを生成して取り込む
+func (r *ImagePipelineRepository) ImportObject(srcKey string, directory string) (string, error) {
+	data, err := r.StorageRepository.GetObject(srcKey)
+	if err != nil {
+		return "", err
+	}
+
+	fileKey, err := r.storeVariants(data, directory)
+	if err != nil {
+		return "", err
+	}
+
+	if err := r.StorageRepository.DeleteImage(srcKey); err != nil {
+		log.Printf("Failed to delete imported object %s: %v", srcKey, err)
+	}
+	return fileKey, nil
+}
+
+func (r *ImagePipelineRepository) DeleteImage(fileKey string) error {
+	if models.ImageVariantKey(fileKey, models.ImageVariantThumb) == fileKey {
+		return r.StorageRepository.DeleteImage(fileKey)
+	}
+	for _, variant := range models.ImageVariants {
+		if err := r.StorageRepository.DeleteImage(models.ImageVariantKey(fileKey, variant)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *ImagePipelineRepository) storeVariants(data []byte, directory string) (string, error) {
+	img, err := decodeImage(data)
+	if err != nil {
+		return "", err
+	}
+
+	fullKey := fmt.Sprintf("%s/%s/%s.jpg", directory, uuid.New().String(), models.ImageVariantFull)
+	stored := make([]string, 0, len(models.ImageVariants))
+	for _, variant := range models.ImageVariants {
+		spec := imageVariantSpecs[variant]
+		var buf bytes.Buffer
+		if err := jpeg.Encode(&buf, fitImage(img, spec.maxEdge), &jpeg.Options{Quality: spec.quality}); err != nil {
+			r.deleteKeys(stored)
+			return "", fmt.Errorf("failed to encode %s variant: %w", variant, err)
+		}
+
+		key := models.ImageVariantKey(fullKey, variant)
+		if err := r.StorageRepository.PutObject(key, buf.Bytes(), "image/jpeg"); err != nil {
+			r.deleteKeys(stored)
+			return "", err
+		}
+		stored = append(stored, key)
+	}
+
+	log.Printf("Successfully processed image %s (%dx%d)", fullKey, img.Bounds().Dx(), img.Bounds().Dy())
+	return fullKey, nil
+}
+
+func (r *ImagePipelineRepository) deleteKeys(keys []string) {
+	for _, key := range keys {
+		if err := r.StorageRepository.DeleteImage(key); err != nil {
+			log.Printf("Failed to clean up image variant %s: %v", key, err)
+		}
+	}
+}
+
+// decodeImage は中身から形式を判定してデコードし、向きの補正と透過の除去を行う
+func decodeImage(data []byte) (image.Image, error) {
+	if isHEIF(data) {
+		return nil, fmt.Errorf("%w: HEIC images must be converted to JPEG before upload", ErrUnsupportedImageFormat)
+	}
+
+	img, format, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrUnsupportedImageFormat, err)
+	}
+
+	if format == "jpeg" {
+		img = applyOrientation(img, jpegOrientation(data))
+	}
+	return flatten(img), nil
+}
+
+func isHEIF(data []byte) bool {
+	if len(data) < 12 || string(data[4:8]) != "ftyp" {
+		return false
+	}
+	switch string(data[8:12]) {
+	case "heic", "heix", "hevc", "heim", "heis", "mif1", "msf1":
+		return true
+	}
+	return false
+}
+
+// flatten は透過を白背景に合成して RGBA に変換する
+func flatten(img image.Image) *image.RGBA {
+	bounds := img.Bounds()
+	dst := image.NewRGBA(image.Rect(0, 0, bounds.Dx(), bounds.Dy()))
+	draw.Draw(dst, dst.Bounds(), image.NewUniform(color.White), image.Point{}, draw.Src)
+	draw.Draw(dst, dst.Bounds(), img, bounds.Min, draw.Over)
+	return dst
+}
+
+// fitImage は長辺が maxEdge に収まるよう縮小する（拡大はしない）
+func fitImage(img image.Image, maxEdge int) image.Image {
+	w, h := img.Bounds().Dx(), img.Bounds().Dy()
+	if w <= maxEdge && h <= maxEdge {
+		return img
+	}
+	if w >= h {
+		h = max(1, h*maxEdge/w)
+		w = maxEdge
+	} else {
+		w = max(1, w*maxEdge/h)
+		h = maxEdge
+	}
+	dst := image.NewRGBA(image.Rect(0, 0, w, h))
+	xdraw.CatmullRom.Scale(dst, dst.Bounds(), img, img.Bounds(), xdraw.Src, nil)
+	return dst
+}
+
+// applyOrientation は EXIF Orientation (1-8) に従って画像を回転・反転する
+func applyOrientation(img image.Image, orientation int) image.Image {
+	if orientation < 2 || orientation > 8 {
+		return img
+	}
+	bounds := img.Bounds()
+	w, h := bounds.Dx(), bounds.Dy()
+	dw, dh := w, h
+	if orientation >= 5 {
+		dw, dh = h, w
+	}
+
+	dst := image.NewRGBA(image.Rect(0, 0, dw, dh))
+	for y := 0; y < dh; y++ {
+		for x := 0; x < dw; x++ {
+			var sx, sy int
+			switch orientation {
+			case 2:
+				sx, sy = w-1-x, y
+			case 3:
+				sx, sy = w-1-x, h-1-y
+			case 4:
+				sx, sy = x, h-1-y
+			case 5:
+				sx, sy = y, x
+			case 6:
+				sx, sy = y, h-1-x
+			case 7:
+				sx, sy = w-1-y, h-1-x
+			case 8:
+				sx, sy = w-1-y, x
+			}
+			dst.Set(x, y, img.At(bounds.Min.X+sx, bounds.Min.Y+sy))
+		}
+	}
+	return dst
+}
+
+// jpegOrientation は JPEG の APP1(Exif) から Orientation タグを読み取る。見つからなければ 1 を返す。
+func jpegOrientation(data []byte) int {
+	if len(data) < 4 || data[0] != 0xFF || data[1] != 0xD8 {
+		return 1
+	}
+	pos := 2
+	for pos+4 <= len(data) {
+		if data[pos] != 0xFF {
+			return 1
+		}
+		marker := data[pos+1]
+		if marker == 0xDA || marker == 0xD9 {
+			return 1
+		}
+		length := int(binary.BigEndian.Uint16(data[pos+2 : pos+4]))
+		if length < 2 || pos+2+length > len(data) {
+			return 1
+		}
+		segment := data[pos+4 : pos+2+length]
+		if marker == 0xE1 && len(segment) > 6 && string(segment[:6]) == "Exif\x00\x00" {
+			return tiffOrientation(segment[6:])
+		}
+		pos += 2 + length
+	}
+	return 1
+}
+
+func tiffOrientation(tiff []byte) int {
+	if len(tiff) < 8 {
+		return 1
+	}
+	var order binary.ByteOrder
+	switch string(tiff[:2]) {
+	case "II":
+		order = binary.LittleEndian
+	case "MM":
+		order = binary.BigEndian
+	default:
+		return 1
+	}
+	ifd := int(order.Uint32(tiff[4:8]))
+	if ifd+2 > len(tiff) {
+		return 1
+	}
+	entries := int(order.Uint16(tiff[ifd : ifd+2]))
+	for i := 0; i < entries; i++ {
+		entry := ifd + 2 + i*12
+		if entry+12 > len(tiff) {
+			return 1
+		}
+		if order.Uint16(tiff[entry:entry+2]) == 0x0112 {
+			return int(order.Uint16(tiff[entry+8 : entry+10]))
+		}
+	}
+	return 1
+}
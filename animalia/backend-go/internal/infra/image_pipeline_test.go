@@ -0,0 +1,221 @@
+package infra
+
+import (
+	"bytes"
+	"encoding/binary"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"testing"
+
+	"github.com/aki-13627/animalia/backend-go/internal/domain/models"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/repository/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+// newTestJPEG は左上の四分の一が赤、残りが青の JPEG を返す
+func newTestJPEG(t *testing.T, w, h int) []byte {
+	t.Helper()
+	img := image.NewRGBA(image.Rect(0, 0, w, h))
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			c := color.RGBA{B: 255, A: 255}
+			if x < w/2 && y < h/2 {
+				c = color.RGBA{R: 255, A: 255}
+			}
+			img.Set(x, y, c)
+		}
+	}
+	var buf bytes.Buffer
+	if err := jpeg.Encode(&buf, img, &jpeg.Options{Quality: 95}); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+// withExif は SOI の直後に Orientation タグと GPS 情報を模したデータを持つ APP1(Exif) を挿入する
+func withExif(data []byte, order binary.ByteOrder, orientation uint16) []byte {
+	tiff := make([]byte, 8+2+12+4)
+	if order == binary.LittleEndian {
+		copy(tiff, "II")
+	} else {
+		copy(tiff, "MM")
+	}
+	order.PutUint16(tiff[2:4], 42)
+	order.PutUint32(tiff[4:8], 8)
+	order.PutUint16(tiff[8:10], 1)
+	entry := tiff[10:22]
+	order.PutUint16(entry[0:2], 0x0112)
+	order.PutUint16(entry[2:4], 3)
+	order.PutUint32(entry[4:8], 1)
+	order.PutUint16(entry[8:10], orientation)
+
+	segment := append([]byte("Exif\x00\x00"), tiff...)
+	segment = append(segment, []byte("GPS 35.6812N 139.7671E")...)
+	app1 := []byte{0xFF, 0xE1, 0, 0}
+	binary.BigEndian.PutUint16(app1[2:4], uint16(len(segment)+2))
+	app1 = append(app1, segment...)
+
+	out := append([]byte{}, data[:2]...)
+	out = append(out, app1...)
+	return append(out, data[2:]...)
+}
+
+func isRed(c color.Color) bool {
+	r, g, b, _ := c.RGBA()
+	return r > 0xC000 && g < 0x4000 && b < 0x4000
+}
+
+func TestJpegOrientation(t *testing.T) {
+	plain := newTestJPEG(t, 16, 16)
+
+	testCases := []struct {
+		name     string
+		data     []byte
+		expected int
+	}{
+		{name: "No Exif", data: plain, expected: 1},
+		{name: "Big endian", data: withExif(plain, binary.BigEndian, 6), expected: 6},
+		{name: "Little endian", data: withExif(plain, binary.LittleEndian, 8), expected: 8},
+		{name: "Not a JPEG", data: []byte("\x89PNG\r\n\x1a\n"), expected: 1},
+		{name: "Truncated segment", data: withExif(plain, binary.BigEndian, 3)[:10], expected: 1},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, jpegOrientation(tc.data))
+		})
+	}
+}
+
+func TestApplyOrientation(t *testing.T) {
+	// 3x2 の画像の各画素に 1 から 6 の番号を振る
+	//   1 2 3
+	//   4 5 6
+	src := image.NewGray(image.Rect(0, 0, 3, 2))
+	for i := range 6 {
+		src.SetGray(i%3, i/3, color.Gray{Y: uint8(i + 1)})
+	}
+
+	testCases := []struct {
+		orientation int
+		expected    [][]uint8
+	}{
+		{orientation: 1, expected: [][]uint8{{1, 2, 3}, {4, 5, 6}}},
+		{orientation: 2, expected: [][]uint8{{3, 2, 1}, {6, 5, 4}}},
+		{orientation: 3, expected: [][]uint8{{6, 5, 4}, {3, 2, 1}}},
+		{orientation: 4, expected: [][]uint8{{4, 5, 6}, {1, 2, 3}}},
+		{orientation: 5, expected: [][]uint8{{1, 4}, {2, 5}, {3, 6}}},
+		{orientation: 6, expected: [][]uint8{{4, 1}, {5, 2}, {6, 3}}},
+		{orientation: 7, expected: [][]uint8{{6, 3}, {5, 2}, {4, 1}}},
+		{orientation: 8, expected: [][]uint8{{3, 6}, {2, 5}, {1, 4}}},
+	}
+
+	for _, tc := range testCases {
+		dst := applyOrientation(src, tc.orientation)
+		actual := make([][]uint8, dst.Bounds().Dy())
+		for y := range actual {
+			actual[y] = make([]uint8, dst.Bounds().Dx())
+			for x := range actual[y] {
+				actual[y][x] = color.GrayModel.Convert(dst.At(x, y)).(color.Gray).Y
+			}
+		}
+		assert.Equal(t, tc.expected, actual, "orientation %d", tc.orientation)
+	}
+}
+
+func TestImagePipelineRepository_ImportObject(t *testing.T) {
+	testCases := []struct {
+		name          string
+		data          []byte
+		expectedSizes map[models.ImageVariant]image.Point
+		redCorner     func(bounds image.Rectangle) image.Point
+	}{
+		{
+			name: "Variants of a large image",
+			data: newTestJPEG(t, 2400, 1200),
+			expectedSizes: map[models.ImageVariant]image.Point{
+				models.ImageVariantThumb:  {X: 320, Y: 160},
+				models.ImageVariantMedium: {X: 1080, Y: 540},
+				models.ImageVariantFull:   {X: 2048, Y: 1024},
+			},
+			redCorner: func(b image.Rectangle) image.Point { return image.Pt(b.Dx()/8, b.Dy()/8) },
+		},
+		{
+			name: "Small image is not enlarged",
+			data: newTestJPEG(t, 200, 100),
+			expectedSizes: map[models.ImageVariant]image.Point{
+				models.ImageVariantThumb:  {X: 200, Y: 100},
+				models.ImageVariantMedium: {X: 200, Y: 100},
+				models.ImageVariantFull:   {X: 200, Y: 100},
+			},
+			redCorner: func(b image.Rectangle) image.Point { return image.Pt(b.Dx()/8, b.Dy()/8) },
+		},
+		{
+			name: "Rotated by Exif orientation",
+			data: withExif(newTestJPEG(t, 400, 200), binary.BigEndian, 6),
+			expectedSizes: map[models.ImageVariant]image.Point{
+				models.ImageVariantThumb:  {X: 160, Y: 320},
+				models.ImageVariantMedium: {X: 200, Y: 400},
+				models.ImageVariantFull:   {X: 200, Y: 400},
+			},
+			// 90度右に回すと左上が右上に来る
+			redCorner: func(b image.Rectangle) image.Point { return image.Pt(b.Dx()*7/8, b.Dy()/8) },
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			stored := map[string][]byte{}
+			var deleted []string
+			storage := &mock.MockStorageRepository{
+				GetObjectFunc: func(fileKey string) ([]byte, error) {
+					assert.Equal(t, "uploads/photo.jpg", fileKey)
+					return tc.data, nil
+				},
+				PutObjectFunc: func(fileKey string, body []byte, contentType string) error {
+					assert.Equal(t, "image/jpeg", contentType)
+					stored[fileKey] = body
+					return nil
+				},
+				DeleteImageFunc: func(fileKey string) error {
+					deleted = append(deleted, fileKey)
+					return nil
+				},
+			}
+
+			fullKey, err := NewImagePipelineRepository(storage).ImportObject("uploads/photo.jpg", "posts")
+
+			assert.NoError(t, err)
+			assert.Equal(t, []string{"uploads/photo.jpg"}, deleted)
+			assert.Len(t, stored, len(models.ImageVariants))
+			for variant, size := range tc.expectedSizes {
+				body, ok := stored[models.ImageVariantKey(fullKey, variant)]
+				if !assert.True(t, ok, "variant %s", variant) {
+					continue
+				}
+				// 再エンコードで EXIF は取り除かれる
+				assert.NotContains(t, string(body), "Exif")
+				assert.NotContains(t, string(body), "GPS")
+				img, err := jpeg.Decode(bytes.NewReader(body))
+				if !assert.NoError(t, err) {
+					continue
+				}
+				assert.Equal(t, size, img.Bounds().Size(), "variant %s", variant)
+				assert.True(t, isRed(img.At(tc.redCorner(img.Bounds()).X, tc.redCorner(img.Bounds()).Y)), "variant %s", variant)
+			}
+		})
+	}
+}
+
+func TestImagePipelineRepository_RejectsHEIC(t *testing.T) {
+	storage := &mock.MockStorageRepository{
+		GetObjectFunc: func(fileKey string) ([]byte, error) {
+			return []byte("\x00\x00\x00\x18ftypheic\x00\x00\x00\x00mif1heic"), nil
+		},
+	}
+
+	_, err := NewImagePipelineRepository(storage).ImportObject("uploads/photo.heic", "posts")
+
+	assert.ErrorIs(t, err, ErrUnsupportedImageFormat)
+}
@@ -0,0 +1,86 @@
+package infra
+
+import (
+	"context"
+
+	"github.com/aki-13627/animalia/backend-go/ent"
+	"github.com/aki-13627/animalia/backend-go/ent/pet"
+	"github.com/aki-13627/animalia/backend-go/ent/post"
+	"github.com/aki-13627/animalia/backend-go/ent/postmedia"
+	"github.com/aki-13627/animalia/backend-go/ent/storagedeletion"
+	"github.com/aki-13627/animalia/backend-go/ent/user"
+)
+
+type ImageReferenceRepository struct {
+	db *ent.Client
+}
+
+func NewImageReferenceRepository(db *ent.Client) *ImageReferenceRepository {
+	return &ImageReferenceRepository{
+		db: db,
+	}
+}
+
+func (r *ImageReferenceRepository) ReferencedImageKeys() ([]string, error) {
+	ctx := context.Background()
+
+	postKeys, err := r.db.Post.Query().
+		Where(post.DeletedAtIsNil()).
+		Select(post.FieldImageKey).
+		Strings(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// 複数画像の投稿では2枚目以降が post.image_key に含まれない
+	mediaKeys, err := r.db.PostMedia.Query().
+		Where(postmedia.HasPostWith(post.DeletedAtIsNil())).
+		Select(postmedia.FieldImageKey).
+		Strings(ctx)
+	if err != nil {
+		return nil, err
+	}
+	posterKeys, err := r.db.PostMedia.Query().
+		Where(
+			postmedia.HasPostWith(post.DeletedAtIsNil()),
+			postmedia.PosterKeyNEQ(""),
+		).
+		Select(postmedia.FieldPosterKey).
+		Strings(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	petKeys, err := r.db.Pet.Query().
+		Where(pet.DeletedAtIsNil()).
+		Select(pet.FieldImageKey).
+		Strings(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	iconKeys, err := r.db.User.Query().
+		Where(user.IconImageKeyNEQ("")).
+		Select(user.FieldIconImageKey).
+		Strings(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// 削除キューにある退会したユーザーの画像は、猶予期間中に復元できるよう参照済みとして扱う。削除はパージで行う
+	queuedKeys, err := r.db.StorageDeletion.Query().
+		Select(storagedeletion.FieldKey).
+		Strings(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	keys := make([]string, 0, len(postKeys)+len(mediaKeys)+len(posterKeys)+len(petKeys)+len(iconKeys)+len(queuedKeys))
+	keys = append(keys, postKeys...)
+	keys = append(keys, mediaKeys...)
+	keys = append(keys, posterKeys...)
+	keys = append(keys, petKeys...)
+	keys = append(keys, iconKeys...)
+	keys = append(keys, queuedKeys...)
+	return keys, nil
+}
@@ -0,0 +1,147 @@
+package infra
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"slices"
+	"time"
+
+	"github.com/aki-13627/animalia/backend-go/internal/domain/models"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/lestrrat-go/jwx/jwk"
+)
+
+// tokenClaims は Cognito が発行するアクセストークン・ID トークンのクレーム。
+// 組み込みのパスワード認証も同じ形のトークンを発行する
+type tokenClaims struct {
+	jwt.RegisteredClaims
+	TokenUse string `json:"token_use"`
+	ClientId string `json:"client_id,omitempty"`
+	Username string `json:"username,omitempty"`
+	Email    string `json:"email,omitempty"`
+	// Version はパスワード認証のリフレッシュトークンの世代
+	Version int `json:"ver,omitempty"`
+}
+
+// JWTVerifier はトークンの署名とクレームを検証する
+type JWTVerifier struct {
+	keyFunc  jwt.Keyfunc
+	method   string
+	issuer   string
+	clientId string
+}
+
+// NewJWTVerifier はキャッシュした JWK セットで RS256 の署名を検証する JWTVerifier を作成する
+func NewJWTVerifier(keySet func() (jwk.Set, error), issuer, clientId string) *JWTVerifier {
+	return &JWTVerifier{
+		keyFunc:  jwkKeyFunc(keySet),
+		method:   jwt.SigningMethodRS256.Alg(),
+		issuer:   issuer,
+		clientId: clientId,
+	}
+}
+
+// NewHMACJWTVerifier は共通鍵で HS256 の署名を検証する JWTVerifier を作成する
+func NewHMACJWTVerifier(secret []byte, issuer, clientId string) *JWTVerifier {
+	return &JWTVerifier{
+		keyFunc: func(token *jwt.Token) (interface{}, error) {
+			return secret, nil
+		},
+		method:   jwt.SigningMethodHS256.Alg(),
+		issuer:   issuer,
+		clientId: clientId,
+	}
+}
+
+// NewCognitoJWTVerifier はユーザープールの JWKS エンドポイントを定期的に取得する JWTVerifier を作成する
+func NewCognitoJWTVerifier() *JWTVerifier {
+	issuer := fmt.Sprintf("https://cognito-idp.%s.amazonaws.com/%s", os.Getenv("AWS_REGION"), os.Getenv("AWS_COGNITO_POOL_ID"))
+	jwksURL := issuer + "/.well-known/jwks.json"
+
+	// Initialize JWK client for token verification
+	jwksClient := jwk.NewAutoRefresh(context.Background())
+	jwksClient.Configure(jwksURL, jwk.WithMinRefreshInterval(15*time.Minute))
+	if _, err := jwksClient.Refresh(context.Background(), jwksURL); err != nil {
+		log.Fatalf("Failed to refresh JWK endpoint: %v", err)
+	}
+
+	return NewJWTVerifier(func() (jwk.Set, error) {
+		return jwksClient.Fetch(context.Background(), jwksURL)
+	}, issuer, os.Getenv("AWS_COGNITO_CLIENT_ID"))
+}
+
+func (v *JWTVerifier) Verify(token string) (*models.TokenClaims, error) {
+	claims, err := v.parse(token)
+	if err != nil {
+		return nil, err
+	}
+
+	// アクセストークンは client_id、ID トークンは aud にアプリクライアント ID が入る
+	switch claims.TokenUse {
+	case "access":
+		if claims.ClientId != v.clientId {
+			return nil, errors.New("client_id mismatch")
+		}
+	case "id":
+		if !slices.Contains(claims.Audience, v.clientId) {
+			return nil, errors.New("audience mismatch")
+		}
+	default:
+		return nil, fmt.Errorf("unexpected token_use: %q", claims.TokenUse)
+	}
+	if claims.Subject == "" {
+		return nil, errors.New("sub not found in token claims")
+	}
+
+	return &models.TokenClaims{
+		Subject:  claims.Subject,
+		TokenUse: claims.TokenUse,
+		Email:    claims.Email,
+		Username: claims.Username,
+	}, nil
+}
+
+// parse は署名と iss・exp を検証してクレームを取り出す。token_use は検証しない
+func (v *JWTVerifier) parse(token string) (*tokenClaims, error) {
+	var claims tokenClaims
+	_, err := jwt.ParseWithClaims(token, &claims, v.keyFunc,
+		jwt.WithValidMethods([]string{v.method}),
+		jwt.WithIssuer(v.issuer),
+		jwt.WithExpirationRequired(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse and verify token: %w", err)
+	}
+	return &claims, nil
+}
+
+// jwkKeyFunc はトークンの kid に一致する公開鍵を JWK セットから取得する
+func jwkKeyFunc(keySet func() (jwk.Set, error)) jwt.Keyfunc {
+	return func(token *jwt.Token) (interface{}, error) {
+		kid, ok := token.Header["kid"].(string)
+		if !ok {
+			return nil, errors.New("token header does not contain kid")
+		}
+
+		set, err := keySet()
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch JWK set: %w", err)
+		}
+
+		// Get the key with the matching key ID
+		key, found := set.LookupKeyID(kid)
+		if !found {
+			return nil, errors.New("matching key not found in JWK set")
+		}
+
+		// Convert the JWK to a public key
+		var publicKey interface{}
+		if err := key.Raw(&publicKey); err != nil {
+			return nil, fmt.Errorf("failed to get public key: %w", err)
+		}
+		return publicKey, nil
+	}
+}
@@ -6,7 +6,9 @@ import (
 	"github.com/aki-13627/animalia/backend-go/ent"
 	"github.com/aki-13627/animalia/backend-go/ent/like"
 	"github.com/aki-13627/animalia/backend-go/ent/post"
+	"github.com/aki-13627/animalia/backend-go/ent/predicate"
 	"github.com/aki-13627/animalia/backend-go/ent/user"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/models"
 	"github.com/google/uuid"
 )
 
@@ -77,3 +79,26 @@ func (r *LikeRepository) Count(postId string) (int, error) {
 
 	return count, nil
 }
+
+func (r *LikeRepository) ListByPost(postID, viewerID uuid.UUID, page models.PageRequest) ([]*ent.Like, string, error) {
+	likes, err := r.db.Like.Query().
+		Where(like.HasPostWith(post.ID(postID))).
+		Where(visibleLikes(viewerID)).
+		Where(before[predicate.Like](page.Cursor)).
+		Order(ent.Desc(like.FieldCreatedAt), ent.Desc(like.FieldID)).
+		Limit(page.Limit + 1).
+		WithUser().
+		All(context.Background())
+	if err != nil {
+		return nil, "", err
+	}
+	likes, next := nextPage(likes, page, func(l *ent.Like) models.Cursor {
+		return models.Cursor{CreatedAt: l.CreatedAt, ID: l.ID}
+	})
+	return likes, next, nil
+}
+
+// visibleLikes は viewerID に見せるいいねの条件。退会したユーザーと、viewerID との間にブロックがあるユーザーのいいねを除く
+func visibleLikes(viewerID uuid.UUID) predicate.Like {
+	return like.And(like.HasUserWith(user.DeletedAtIsNil()), like.Not(like.HasUserWith(blockedWith(viewerID))))
+}
@@ -0,0 +1,246 @@
+package infra
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"log"
+	"mime/multipart"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aki-13627/animalia/backend-go/internal/domain/models"
+	"github.com/google/uuid"
+)
+
+// LocalStorageRepository はローカルファイルシステムに画像を保存する StorageRepository 実装。
+// S3 の認証情報がない開発環境向けで、署名付き URL は HMAC で生成する。
+type LocalStorageRepository struct {
+	baseDir string
+	baseURL string
+	secret  []byte
+	expires time.Duration
+}
+
+// LocalStorageRoutePrefix は保存したファイルを配信するルートのプレフィックス
+const LocalStorageRoutePrefix = "/storage/"
+
+func NewLocalStorageRepository(baseDir, baseURL string, secret []byte) *LocalStorageRepository {
+	if err := os.MkdirAll(baseDir, 0o755); err != nil {
+		log.Fatalf("Failed to create local storage directory: %v", err)
+	}
+	log.Printf("Using local storage at %s", baseDir)
+
+	return &LocalStorageRepository{
+		baseDir: baseDir,
+		baseURL: baseURL,
+		secret:  secret,
+		expires: PresignedURLExpiry,
+	}
+}
+
+// BaseDir は静的配信に使うルートディレクトリを返す
+func (r *LocalStorageRepository) BaseDir() string {
+	return r.baseDir
+}
+
+func (r *LocalStorageRepository) UploadImage(file *multipart.FileHeader, directory string) (string, error) {
+	src, err := file.Open()
+	if err != nil {
+		return "", fmt.Errorf("failed to open file: %w", err)
+	}
+	defer src.Close()
+
+	fileKey := fmt.Sprintf("%s/%s-%s", directory, uuid.New().String(), filepath.Base(file.Filename))
+	path := r.pathFor(fileKey)
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return "", fmt.Errorf("failed to create directory: %w", err)
+	}
+
+	dst, err := os.Create(path)
+	if err != nil {
+		return "", fmt.Errorf("failed to create file: %w", err)
+	}
+	defer dst.Close()
+
+	written, err := io.Copy(dst, src)
+	if err != nil {
+		return "", fmt.Errorf("failed to write file: %w", err)
+	}
+	if written == 0 {
+		os.Remove(path)
+		return "", fmt.Errorf("file is empty")
+	}
+
+	log.Printf("Successfully stored file %s locally (%d bytes)", fileKey, written)
+	return fileKey, nil
+}
+
+func (r *LocalStorageRepository) PutObject(fileKey string, body []byte, contentType string) error {
+	path := r.pathFor(fileKey)
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return fmt.Errorf("failed to create directory: %w", err)
+	}
+	if err := os.WriteFile(path, body, 0o644); err != nil {
+		return fmt.Errorf("failed to write file: %w", err)
+	}
+	return nil
+}
+
+func (r *LocalStorageRepository) GetObject(fileKey string) ([]byte, error) {
+	body, err := os.ReadFile(r.pathFor(fileKey))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read file: %w", err)
+	}
+	return body, nil
+}
+
+// StatObject はファイル情報を返す。ローカルでは Content-Type を保存しないため中身から判定する。
+func (r *LocalStorageRepository) StatObject(fileKey string) (*models.ObjectInfo, error) {
+	path := r.pathFor(fileKey)
+	stat, err := os.Stat(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to stat file: %w", err)
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open file: %w", err)
+	}
+	defer f.Close()
+	head := make([]byte, 512)
+	n, _ := io.ReadFull(f, head)
+
+	return &models.ObjectInfo{
+		Key:          fileKey,
+		Size:         stat.Size(),
+		ContentType:  http.DetectContentType(head[:n]),
+		LastModified: stat.ModTime(),
+	}, nil
+}
+
+func (r *LocalStorageRepository) ListObjects(prefix string) ([]models.ObjectInfo, error) {
+	var objects []models.ObjectInfo
+	err := filepath.WalkDir(r.baseDir, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			if errors.Is(err, fs.ErrNotExist) {
+				return nil
+			}
+			return err
+		}
+		if d.IsDir() {
+			return nil
+		}
+		rel, err := filepath.Rel(r.baseDir, p)
+		if err != nil {
+			return err
+		}
+		key := filepath.ToSlash(rel)
+		if !strings.HasPrefix(key, prefix) {
+			return nil
+		}
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		objects = append(objects, models.ObjectInfo{
+			Key:          key,
+			Size:         info.Size(),
+			LastModified: info.ModTime(),
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list files: %w", err)
+	}
+	return objects, nil
+}
+
+func (r *LocalStorageRepository) ImportObject(srcKey string, directory string) (string, error) {
+	fileKey := fmt.Sprintf("%s/%s-%s", directory, uuid.New().String(), path.Base(srcKey))
+	dst := r.pathFor(fileKey)
+	if err := os.MkdirAll(filepath.Dir(dst), 0o755); err != nil {
+		return "", fmt.Errorf("failed to create directory: %w", err)
+	}
+	if err := os.Rename(r.pathFor(srcKey), dst); err != nil {
+		return "", fmt.Errorf("failed to move file: %w", err)
+	}
+	return fileKey, nil
+}
+
+func (r *LocalStorageRepository) GetUrl(fileKey string) (string, error) {
+	return r.signedURL(http.MethodGet, fileKey, r.expires), nil
+}
+
+func (r *LocalStorageRepository) GetUrls(fileKeys []string) (map[string]string, error) {
+	return getUrls(r, fileKeys)
+}
+
+// GetDownloadUrl はローカルでは Content-Disposition を付けられないため、filename は使わない
+func (r *LocalStorageRepository) GetDownloadUrl(fileKey string, filename string, expiry time.Duration) (string, error) {
+	return r.signedURL(http.MethodGet, fileKey, expiry), nil
+}
+
+func (r *LocalStorageRepository) GetUploadUrl(fileKey string, contentType string, size int64) (string, error) {
+	return r.signedURL(http.MethodPut, fileKey, 15*time.Minute), nil
+}
+
+func (r *LocalStorageRepository) DeleteImage(fileKey string) error {
+	if err := os.Remove(r.pathFor(fileKey)); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("画像の削除に失敗しました: %w", err)
+	}
+	return nil
+}
+
+// VerifySignedURL は GetUrl / GetUploadUrl で発行した URL の署名と有効期限を検証する
+func (r *LocalStorageRepository) VerifySignedURL(method, fileKey, expires, signature string) error {
+	expiresAt, err := strconv.ParseInt(expires, 10, 64)
+	if err != nil {
+		return errors.New("invalid expires parameter")
+	}
+	if time.Now().Unix() > expiresAt {
+		return errors.New("signed url has expired")
+	}
+
+	expected, err := hex.DecodeString(r.sign(method, fileKey, expires))
+	if err != nil {
+		return err
+	}
+	given, err := hex.DecodeString(signature)
+	if err != nil || !hmac.Equal(expected, given) {
+		return errors.New("invalid signature")
+	}
+	return nil
+}
+
+func (r *LocalStorageRepository) signedURL(method, fileKey string, expiresIn time.Duration) string {
+	expires := strconv.FormatInt(time.Now().Add(expiresIn).Unix(), 10)
+	return fmt.Sprintf("%s%s?%s",
+		r.baseURL,
+		(&url.URL{Path: LocalStorageRoutePrefix + fileKey}).EscapedPath(),
+		url.Values{
+			"expires":   {expires},
+			"signature": {r.sign(method, fileKey, expires)},
+		}.Encode(),
+	)
+}
+
+func (r *LocalStorageRepository) sign(method, fileKey, expires string) string {
+	h := hmac.New(sha256.New, r.secret)
+	h.Write([]byte(method + "\n" + fileKey + "\n" + expires))
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+func (r *LocalStorageRepository) pathFor(fileKey string) string {
+	return filepath.Join(r.baseDir, filepath.FromSlash(filepath.Clean("/"+fileKey)))
+}
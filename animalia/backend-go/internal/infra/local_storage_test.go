@@ -0,0 +1,168 @@
+package infra
+
+import (
+	"net/http"
+	"net/url"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLocalStorageRepository_VerifySignedURL(t *testing.T) {
+	repo := NewLocalStorageRepository(t.TempDir(), "http://localhost:3000", []byte("secret"))
+	fileKey := "posts/photo.jpg"
+	future := strconv.FormatInt(time.Now().Add(time.Hour).Unix(), 10)
+	past := strconv.FormatInt(time.Now().Add(-time.Minute).Unix(), 10)
+
+	testCases := []struct {
+		name        string
+		method      string
+		fileKey     string
+		expires     string
+		signature   string
+		expectError bool
+	}{
+		{
+			name:      "Valid signature",
+			method:    http.MethodGet,
+			fileKey:   fileKey,
+			expires:   future,
+			signature: repo.sign(http.MethodGet, fileKey, future),
+		},
+		{
+			name:        "Tampered signature",
+			method:      http.MethodGet,
+			fileKey:     fileKey,
+			expires:     future,
+			signature:   strings.Repeat("0", 64),
+			expectError: true,
+		},
+		{
+			name:        "Signature that is not hex",
+			method:      http.MethodGet,
+			fileKey:     fileKey,
+			expires:     future,
+			signature:   "not-hex",
+			expectError: true,
+		},
+		{
+			name:        "Signature for another key",
+			method:      http.MethodGet,
+			fileKey:     "posts/other.jpg",
+			expires:     future,
+			signature:   repo.sign(http.MethodGet, fileKey, future),
+			expectError: true,
+		},
+		{
+			name:        "Extended expiry",
+			method:      http.MethodGet,
+			fileKey:     fileKey,
+			expires:     strconv.FormatInt(time.Now().Add(2*time.Hour).Unix(), 10),
+			signature:   repo.sign(http.MethodGet, fileKey, future),
+			expectError: true,
+		},
+		{
+			name:        "Expired link",
+			method:      http.MethodGet,
+			fileKey:     fileKey,
+			expires:     past,
+			signature:   repo.sign(http.MethodGet, fileKey, past),
+			expectError: true,
+		},
+		{
+			name:        "Invalid expires",
+			method:      http.MethodGet,
+			fileKey:     fileKey,
+			expires:     "tomorrow",
+			signature:   repo.sign(http.MethodGet, fileKey, "tomorrow"),
+			expectError: true,
+		},
+		{
+			name:        "Method mismatch",
+			method:      http.MethodPut,
+			fileKey:     fileKey,
+			expires:     future,
+			signature:   repo.sign(http.MethodGet, fileKey, future),
+			expectError: true,
+		},
+		{
+			name:        "Signed with another secret",
+			method:      http.MethodGet,
+			fileKey:     fileKey,
+			expires:     future,
+			signature:   (&LocalStorageRepository{secret: []byte("other")}).sign(http.MethodGet, fileKey, future),
+			expectError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := repo.VerifySignedURL(tc.method, tc.fileKey, tc.expires, tc.signature)
+
+			if tc.expectError {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestLocalStorageRepository_GetUrl(t *testing.T) {
+	repo := NewLocalStorageRepository(t.TempDir(), "http://localhost:3000", []byte("secret"))
+
+	signed, err := repo.GetUrl("posts/my photo.jpg")
+	assert.NoError(t, err)
+
+	u, err := url.Parse(signed)
+	assert.NoError(t, err)
+	assert.Equal(t, "/storage/posts/my photo.jpg", u.Path)
+	query := u.Query()
+	assert.NoError(t, repo.VerifySignedURL(http.MethodGet, "posts/my photo.jpg", query.Get("expires"), query.Get("signature")))
+	assert.Error(t, repo.VerifySignedURL(http.MethodPut, "posts/my photo.jpg", query.Get("expires"), query.Get("signature")))
+}
+
+func TestLocalStorageRepository_PathFor(t *testing.T) {
+	baseDir := t.TempDir()
+	repo := NewLocalStorageRepository(baseDir, "http://localhost:3000", []byte("secret"))
+
+	testCases := []struct {
+		name     string
+		fileKey  string
+		expected string
+	}{
+		{
+			name:     "Plain key",
+			fileKey:  "posts/photo.jpg",
+			expected: filepath.Join(baseDir, "posts", "photo.jpg"),
+		},
+		{
+			name:     "Parent directory",
+			fileKey:  "../secret.txt",
+			expected: filepath.Join(baseDir, "secret.txt"),
+		},
+		{
+			name:     "Parent directory in the middle",
+			fileKey:  "posts/../../../etc/passwd",
+			expected: filepath.Join(baseDir, "etc", "passwd"),
+		},
+		{
+			name:     "Absolute key",
+			fileKey:  "/etc/passwd",
+			expected: filepath.Join(baseDir, "etc", "passwd"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			path := repo.pathFor(tc.fileKey)
+
+			assert.Equal(t, tc.expected, path)
+			assert.True(t, strings.HasPrefix(path, baseDir+string(filepath.Separator)))
+		})
+	}
+}
@@ -0,0 +1,49 @@
+package infra
+
+import (
+	"context"
+	"log"
+
+	"github.com/aki-13627/animalia/backend-go/ent"
+	"github.com/aki-13627/animalia/backend-go/ent/post"
+)
+
+// backfillBatchSize は1回の一括登録で作成する行数
+const backfillBatchSize = 500
+
+// BackfillPostMedia は画像が1枚だった頃の投稿に、image_key を position 0 とする media を作成する。
+// 作成済みの投稿は対象外になるため、起動のたびに実行しても問題ない。
+func BackfillPostMedia(client *ent.Client) error {
+	ctx := context.Background()
+	total := 0
+	for {
+		posts, err := client.Post.Query().
+			Where(post.Not(post.HasMedia())).
+			Select(post.FieldID, post.FieldImageKey).
+			Limit(backfillBatchSize).
+			All(ctx)
+		if err != nil {
+			return err
+		}
+		if len(posts) == 0 {
+			break
+		}
+
+		creates := make([]*ent.PostMediaCreate, len(posts))
+		for i, p := range posts {
+			creates[i] = client.PostMedia.Create().
+				SetPosition(0).
+				SetImageKey(p.ImageKey).
+				SetPostID(p.ID)
+		}
+		if err := client.PostMedia.CreateBulk(creates...).Exec(ctx); err != nil {
+			return err
+		}
+		total += len(posts)
+	}
+
+	if total > 0 {
+		log.Printf("Backfilled media for %d posts", total)
+	}
+	return nil
+}
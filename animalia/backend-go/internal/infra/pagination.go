@@ -0,0 +1,29 @@
+package infra
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/models"
+)
+
+// before は created_at・id の降順で cursor より後ろにある行の条件。
+// created_at と id を持つエンティティの predicate（predicate.Post など）として使う
+func before[P ~func(*sql.Selector)](cursor *models.Cursor) P {
+	return func(s *sql.Selector) {
+		if cursor == nil {
+			return
+		}
+		s.Where(sql.Or(
+			sql.LT(s.C("created_at"), cursor.CreatedAt),
+			sql.And(sql.EQ(s.C("created_at"), cursor.CreatedAt), sql.LT(s.C("id"), cursor.ID)),
+		))
+	}
+}
+
+// nextPage は limit+1 件取得した結果を limit 件に切り詰め、続きがあれば次のページのカーソルを返す
+func nextPage[T any](items []T, page models.PageRequest, key func(T) models.Cursor) ([]T, string) {
+	if len(items) <= page.Limit {
+		return items, ""
+	}
+	items = items[:page.Limit]
+	return items, key(items[len(items)-1]).Encode()
+}
@@ -0,0 +1,492 @@
+package infra
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/aki-13627/animalia/backend-go/ent"
+	"github.com/aki-13627/animalia/backend-go/ent/credential"
+	"github.com/aki-13627/animalia/backend-go/ent/user"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/models"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/repository"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"golang.org/x/crypto/bcrypt"
+)
+
+const (
+	// PasswordAuthClientId はパスワード認証が発行するトークンの client_id・aud
+	PasswordAuthClientId = "animalia"
+
+	passwordAccessTokenExpiry  = time.Hour
+	passwordRefreshTokenExpiry = 30 * 24 * time.Hour
+	verificationCodeExpiry     = 24 * time.Hour
+	resetCodeExpiry            = time.Hour
+	// maxVerificationAttempts 回間違えると確認コードを作り直して再送する
+	maxVerificationAttempts = 5
+	minPasswordLength       = 8
+)
+
+var (
+	errCodeExpired     = errors.New("verification code has expired")
+	errTooManyAttempts = errors.New("too many verification attempts")
+	errCodeMismatch    = errors.New("verification code mismatch")
+)
+
+var dummyPasswordHash = sync.OnceValue(func() []byte {
+	hash, _ := bcrypt.GenerateFromPassword([]byte("dummy-password"), bcrypt.DefaultCost)
+	return hash
+})
+
+// PasswordAuthRepository は Postgres にパスワードのハッシュを保存し、自前で署名したトークンを発行する認証プロバイダ
+type PasswordAuthRepository struct {
+	*JWTVerifier
+	client *ent.Client
+	mailer repository.Mailer
+	secret []byte
+	issuer string
+}
+
+func NewPasswordAuthRepository(client *ent.Client, mailer repository.Mailer, secret []byte, issuer string) *PasswordAuthRepository {
+	return &PasswordAuthRepository{
+		JWTVerifier: NewHMACJWTVerifier(secret, issuer, PasswordAuthClientId),
+		client:      client,
+		mailer:      mailer,
+		secret:      secret,
+		issuer:      issuer,
+	}
+}
+
+func (r *PasswordAuthRepository) CreateUser(name, email, password string) error {
+	if len(password) < minPasswordLength {
+		return fmt.Errorf("password must be at least %d characters", minPasswordLength)
+	}
+	exists, err := r.client.Credential.Query().Where(credential.EmailEQ(email)).Exist(context.Background())
+	if err != nil {
+		return fmt.Errorf("failed to check credential: %w", err)
+	}
+	if exists {
+		return errors.New("user already exists")
+	}
+
+	passwordHash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return fmt.Errorf("failed to hash password: %w", err)
+	}
+	cred, err := r.client.Credential.Create().
+		SetEmail(email).
+		SetPasswordHash(string(passwordHash)).
+		Save(context.Background())
+	if err != nil {
+		return fmt.Errorf("failed to create credential: %w", err)
+	}
+	return r.sendVerificationCode(cred, cred.Email)
+}
+
+func (r *PasswordAuthRepository) VerifyEmail(email, code string) error {
+	cred, err := r.client.Credential.Query().Where(credential.EmailEQ(email)).Only(context.Background())
+	if err != nil {
+		return fmt.Errorf("failed to find credential: %w", err)
+	}
+	if cred.EmailVerified {
+		return nil
+	}
+	switch err := checkCode(cred.VerificationCodeHash, cred.VerificationExpiresAt, cred.VerificationAttempts, code); {
+	case errors.Is(err, errCodeExpired), errors.Is(err, errTooManyAttempts):
+		if err := r.sendVerificationCode(cred, cred.Email); err != nil {
+			return err
+		}
+		return fmt.Errorf("%w, sent a new code", err)
+	case errors.Is(err, errCodeMismatch):
+		if err := cred.Update().AddVerificationAttempts(1).Exec(context.Background()); err != nil {
+			return fmt.Errorf("failed to record verification attempt: %w", err)
+		}
+		return err
+	}
+
+	err = cred.Update().
+		SetEmailVerified(true).
+		ClearVerificationCodeHash().
+		ClearVerificationExpiresAt().
+		Exec(context.Background())
+	if err != nil {
+		return fmt.Errorf("failed to verify email: %w", err)
+	}
+	return nil
+}
+
+func (r *PasswordAuthRepository) SignIn(email, password string) (*models.AuthTokens, error) {
+	cred, err := r.client.Credential.Query().Where(credential.EmailEQ(email)).Only(context.Background())
+	if err != nil {
+		if ent.IsNotFound(err) {
+			// 存在しないユーザーでも同じだけ時間をかけ、応答時間から登録の有無がわからないようにする
+			bcrypt.CompareHashAndPassword(dummyPasswordHash(), []byte(password))
+			return nil, errors.New("invalid email or password")
+		}
+		return nil, fmt.Errorf("failed to find credential: %w", err)
+	}
+	if err := bcrypt.CompareHashAndPassword([]byte(cred.PasswordHash), []byte(password)); err != nil {
+		return nil, errors.New("invalid email or password")
+	}
+	if !cred.EmailVerified {
+		return nil, errors.New("email is not verified")
+	}
+
+	tokens, err := r.issueTokens(cred)
+	if err != nil {
+		return nil, err
+	}
+	tokens.RefreshToken, err = r.sign(cred, "refresh", passwordRefreshTokenExpiry)
+	if err != nil {
+		return nil, err
+	}
+	return tokens, nil
+}
+
+func (r *PasswordAuthRepository) RefreshToken(refreshToken string) (*models.AuthTokens, error) {
+	claims, err := r.parse(refreshToken)
+	if err != nil {
+		return nil, err
+	}
+	if claims.TokenUse != "refresh" || claims.ClientId != PasswordAuthClientId {
+		return nil, fmt.Errorf("unexpected token_use: %q", claims.TokenUse)
+	}
+	id, err := uuid.Parse(claims.Subject)
+	if err != nil {
+		return nil, fmt.Errorf("invalid subject: %w", err)
+	}
+
+	cred, err := r.client.Credential.Get(context.Background(), id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find credential: %w", err)
+	}
+	// サインアウト後は以前に発行したリフレッシュトークンを受け付けない
+	if claims.Version != cred.TokenVersion {
+		return nil, errors.New("refresh token has been revoked")
+	}
+	// 退会・停止したアカウントにはアクセストークンを発行し直さない
+	u, err := r.client.User.Query().Where(user.Email(cred.Email)).Only(context.Background())
+	if err != nil {
+		return nil, fmt.Errorf("failed to find user: %w", err)
+	}
+	if !u.DeletedAt.IsZero() {
+		return nil, errors.New("account has been deleted")
+	}
+	if !u.SuspendedAt.IsZero() {
+		return nil, errors.New("account is suspended")
+	}
+	return r.issueTokens(cred)
+}
+
+func (r *PasswordAuthRepository) GetUserEmail(accessToken string) (string, error) {
+	claims, err := r.Verify(accessToken)
+	if err != nil {
+		return "", err
+	}
+	if claims.Email != "" {
+		return claims.Email, nil
+	}
+	return claims.Username, nil
+}
+
+// SignOut はすべての端末のリフレッシュトークンを無効にする。発行済みのアクセストークンは期限まで有効
+func (r *PasswordAuthRepository) SignOut(accessToken string) error {
+	claims, err := r.Verify(accessToken)
+	if err != nil {
+		return err
+	}
+	id, err := uuid.Parse(claims.Subject)
+	if err != nil {
+		return fmt.Errorf("invalid subject: %w", err)
+	}
+	if err := r.client.Credential.UpdateOneID(id).AddTokenVersion(1).Exec(context.Background()); err != nil {
+		return fmt.Errorf("failed to sign user out: %w", err)
+	}
+	return nil
+}
+
+func (r *PasswordAuthRepository) ForgotPassword(email string) error {
+	cred, err := r.client.Credential.Query().Where(credential.EmailEQ(email)).Only(context.Background())
+	if err != nil {
+		if ent.IsNotFound(err) {
+			// 登録の有無がわからないよう、存在しないアドレスでも成功として扱う
+			return nil
+		}
+		return fmt.Errorf("failed to find credential: %w", err)
+	}
+
+	code, err := generateVerificationCode()
+	if err != nil {
+		return err
+	}
+	err = cred.Update().
+		SetResetCodeHash(hashVerificationCode(code)).
+		SetResetExpiresAt(time.Now().Add(resetCodeExpiry)).
+		SetResetAttempts(0).
+		Exec(context.Background())
+	if err != nil {
+		return fmt.Errorf("failed to save reset code: %w", err)
+	}
+
+	body := fmt.Sprintf("Animalia のパスワード再設定コードは %s です。\nこのコードの有効期限は1時間です。", code)
+	if err := r.mailer.Send(cred.Email, "Animalia パスワード再設定", body); err != nil {
+		return fmt.Errorf("failed to send reset code: %w", err)
+	}
+	return nil
+}
+
+// ConfirmForgotPassword はパスワードを再設定し、発行済みのリフレッシュトークンを無効にする
+func (r *PasswordAuthRepository) ConfirmForgotPassword(email, code, newPassword string) error {
+	if len(newPassword) < minPasswordLength {
+		return fmt.Errorf("password must be at least %d characters", minPasswordLength)
+	}
+	cred, err := r.client.Credential.Query().Where(credential.EmailEQ(email)).Only(context.Background())
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return errCodeMismatch
+		}
+		return fmt.Errorf("failed to find credential: %w", err)
+	}
+	if err := checkCode(cred.ResetCodeHash, cred.ResetExpiresAt, cred.ResetAttempts, code); err != nil {
+		if errors.Is(err, errCodeMismatch) {
+			if err := cred.Update().AddResetAttempts(1).Exec(context.Background()); err != nil {
+				return fmt.Errorf("failed to record reset attempt: %w", err)
+			}
+		}
+		return err
+	}
+
+	passwordHash, err := bcrypt.GenerateFromPassword([]byte(newPassword), bcrypt.DefaultCost)
+	if err != nil {
+		return fmt.Errorf("failed to hash password: %w", err)
+	}
+	err = cred.Update().
+		SetPasswordHash(string(passwordHash)).
+		ClearResetCodeHash().
+		ClearResetExpiresAt().
+		AddTokenVersion(1).
+		Exec(context.Background())
+	if err != nil {
+		return fmt.Errorf("failed to reset password: %w", err)
+	}
+	return nil
+}
+
+func (r *PasswordAuthRepository) ChangePassword(accessToken, oldPassword, newPassword string) error {
+	if len(newPassword) < minPasswordLength {
+		return fmt.Errorf("password must be at least %d characters", minPasswordLength)
+	}
+	cred, err := r.credentialFromToken(accessToken)
+	if err != nil {
+		return err
+	}
+	if err := bcrypt.CompareHashAndPassword([]byte(cred.PasswordHash), []byte(oldPassword)); err != nil {
+		return errors.New("invalid password")
+	}
+
+	passwordHash, err := bcrypt.GenerateFromPassword([]byte(newPassword), bcrypt.DefaultCost)
+	if err != nil {
+		return fmt.Errorf("failed to hash password: %w", err)
+	}
+	// 他の端末のリフレッシュトークンも無効にする
+	if err := cred.Update().SetPasswordHash(string(passwordHash)).AddTokenVersion(1).Exec(context.Background()); err != nil {
+		return fmt.Errorf("failed to change password: %w", err)
+	}
+	return nil
+}
+
+func (r *PasswordAuthRepository) RequestEmailChange(accessToken, newEmail string) error {
+	cred, err := r.credentialFromToken(accessToken)
+	if err != nil {
+		return err
+	}
+	exists, err := r.client.Credential.Query().Where(credential.EmailEQ(newEmail)).Exist(context.Background())
+	if err != nil {
+		return fmt.Errorf("failed to check credential: %w", err)
+	}
+	if exists {
+		return errors.New("email already exists")
+	}
+
+	if err := cred.Update().SetPendingEmail(newEmail).Exec(context.Background()); err != nil {
+		return fmt.Errorf("failed to save pending email: %w", err)
+	}
+	return r.sendVerificationCode(cred, newEmail)
+}
+
+func (r *PasswordAuthRepository) ConfirmEmailChange(accessToken, newEmail, code string) error {
+	cred, err := r.credentialFromToken(accessToken)
+	if err != nil {
+		return err
+	}
+	if cred.PendingEmail == "" || cred.PendingEmail != newEmail {
+		return errors.New("email change has not been requested")
+	}
+	if err := checkCode(cred.VerificationCodeHash, cred.VerificationExpiresAt, cred.VerificationAttempts, code); err != nil {
+		if errors.Is(err, errCodeMismatch) {
+			if err := cred.Update().AddVerificationAttempts(1).Exec(context.Background()); err != nil {
+				return fmt.Errorf("failed to record verification attempt: %w", err)
+			}
+		}
+		return err
+	}
+
+	err = cred.Update().
+		SetEmail(newEmail).
+		ClearPendingEmail().
+		ClearVerificationCodeHash().
+		ClearVerificationExpiresAt().
+		Exec(context.Background())
+	if err != nil {
+		return fmt.Errorf("failed to change email: %w", err)
+	}
+	return nil
+}
+
+func (r *PasswordAuthRepository) RestoreEmail(accessToken, email string) error {
+	cred, err := r.credentialFromToken(accessToken)
+	if err != nil {
+		return err
+	}
+	if err := cred.Update().SetEmail(email).Exec(context.Background()); err != nil {
+		return fmt.Errorf("failed to restore email: %w", err)
+	}
+	return nil
+}
+
+// DeleteUser は ID が subject の認証情報を削除する。既に削除されていれば何もしない
+func (r *PasswordAuthRepository) DeleteUser(subject string) error {
+	id, err := uuid.Parse(subject)
+	if err != nil {
+		return fmt.Errorf("invalid subject: %w", err)
+	}
+	_, err = r.client.Credential.Delete().Where(credential.ID(id)).Exec(context.Background())
+	if err != nil {
+		return fmt.Errorf("failed to delete credential: %w", err)
+	}
+	return nil
+}
+
+// credentialFromToken はアクセストークンの sub に対応する認証情報を取得する
+func (r *PasswordAuthRepository) credentialFromToken(accessToken string) (*ent.Credential, error) {
+	claims, err := r.Verify(accessToken)
+	if err != nil {
+		return nil, err
+	}
+	id, err := uuid.Parse(claims.Subject)
+	if err != nil {
+		return nil, fmt.Errorf("invalid subject: %w", err)
+	}
+	cred, err := r.client.Credential.Get(context.Background(), id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find credential: %w", err)
+	}
+	return cred, nil
+}
+
+// sendVerificationCode は新しい確認コードを保存して to にメールで送る
+func (r *PasswordAuthRepository) sendVerificationCode(cred *ent.Credential, to string) error {
+	code, err := generateVerificationCode()
+	if err != nil {
+		return err
+	}
+	err = cred.Update().
+		SetVerificationCodeHash(hashVerificationCode(code)).
+		SetVerificationExpiresAt(time.Now().Add(verificationCodeExpiry)).
+		SetVerificationAttempts(0).
+		Exec(context.Background())
+	if err != nil {
+		return fmt.Errorf("failed to save verification code: %w", err)
+	}
+
+	body := fmt.Sprintf("Animalia の確認コードは %s です。\nこのコードの有効期限は24時間です。", code)
+	if err := r.mailer.Send(to, "Animalia 確認コード", body); err != nil {
+		return fmt.Errorf("failed to send verification code: %w", err)
+	}
+	return nil
+}
+
+// issueTokens はアクセストークンと ID トークンを発行する
+func (r *PasswordAuthRepository) issueTokens(cred *ent.Credential) (*models.AuthTokens, error) {
+	accessToken, err := r.sign(cred, "access", passwordAccessTokenExpiry)
+	if err != nil {
+		return nil, err
+	}
+	idToken, err := r.sign(cred, "id", passwordAccessTokenExpiry)
+	if err != nil {
+		return nil, err
+	}
+	return &models.AuthTokens{
+		AccessToken: accessToken,
+		IdToken:     idToken,
+		ExpiresIn:   int(passwordAccessTokenExpiry.Seconds()),
+	}, nil
+}
+
+// sign は Cognito と同じ形のクレームで tokenUse のトークンに署名する
+func (r *PasswordAuthRepository) sign(cred *ent.Credential, tokenUse string, expiry time.Duration) (string, error) {
+	now := time.Now()
+	claims := tokenClaims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			Subject:   cred.ID.String(),
+			Issuer:    r.issuer,
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(now.Add(expiry)),
+			ID:        uuid.NewString(),
+		},
+		TokenUse: tokenUse,
+	}
+	switch tokenUse {
+	case "id":
+		claims.Audience = jwt.ClaimStrings{PasswordAuthClientId}
+		claims.Email = cred.Email
+	case "access":
+		claims.ClientId = PasswordAuthClientId
+		claims.Username = cred.Email
+	case "refresh":
+		claims.ClientId = PasswordAuthClientId
+		claims.Version = cred.TokenVersion
+	}
+
+	signed, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString(r.secret)
+	if err != nil {
+		return "", fmt.Errorf("failed to sign %s token: %w", tokenUse, err)
+	}
+	return signed, nil
+}
+
+// checkCode は保存された確認コードのハッシュ・有効期限・試行回数と code を照合する
+func checkCode(codeHash string, expiresAt *time.Time, attempts int, code string) error {
+	if codeHash == "" || expiresAt == nil || time.Now().After(*expiresAt) {
+		return errCodeExpired
+	}
+	if attempts >= maxVerificationAttempts {
+		return errTooManyAttempts
+	}
+	if subtle.ConstantTimeCompare([]byte(codeHash), []byte(hashVerificationCode(code))) != 1 {
+		return errCodeMismatch
+	}
+	return nil
+}
+
+// generateVerificationCode は6桁の確認コードを生成する
+func generateVerificationCode() (string, error) {
+	n, err := rand.Int(rand.Reader, big.NewInt(1_000_000))
+	if err != nil {
+		return "", fmt.Errorf("failed to generate verification code: %w", err)
+	}
+	return fmt.Sprintf("%06d", n.Int64()), nil
+}
+
+func hashVerificationCode(code string) string {
+	sum := sha256.Sum256([]byte(code))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,261 @@
+package infra
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql/schema"
+	"github.com/aki-13627/animalia/backend-go/ent"
+	"github.com/aki-13627/animalia/backend-go/ent/enttest"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/repository/mock"
+	"github.com/google/uuid"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/assert"
+)
+
+// newTestClient は tables だけを作成したインメモリの SQLite に接続する。
+// PostgreSQL の型しか指定していない列（pgvector）は SQLite では blob として作る
+func newTestClient(t *testing.T, tables ...string) *ent.Client {
+	t.Helper()
+	dsn := fmt.Sprintf("file:%s?mode=memory&cache=shared&_fk=1", uuid.NewString())
+	only := schema.WithHooks(func(next schema.Creator) schema.Creator {
+		return schema.CreateFunc(func(ctx context.Context, all ...*schema.Table) error {
+			var selected []*schema.Table
+			for _, table := range all {
+				for _, name := range tables {
+					if table.Name == name {
+						selected = append(selected, table)
+					}
+				}
+				for _, column := range table.Columns {
+					if column.SchemaType[dialect.Postgres] != "" && column.SchemaType[dialect.SQLite] == "" {
+						column.SchemaType[dialect.SQLite] = "blob"
+					}
+				}
+			}
+			return next.Create(ctx, selected...)
+		})
+	})
+	client := enttest.Open(t, dialect.SQLite, dsn, enttest.WithMigrateOptions(only))
+	t.Cleanup(func() { client.Close() })
+	return client
+}
+
+var verificationCodePattern = regexp.MustCompile(`\d{6}`)
+
+type passwordAuthFixture struct {
+	repo   *PasswordAuthRepository
+	client *ent.Client
+	// codes は宛先ごとに最後に送った確認コード
+	codes map[string]string
+}
+
+func newPasswordAuthFixture(t *testing.T) *passwordAuthFixture {
+	t.Helper()
+	f := &passwordAuthFixture{
+		client: newTestClient(t, "credentials", "users"),
+		codes:  map[string]string{},
+	}
+	mailer := &mock.MockMailer{
+		SendFunc: func(to, subject, body string) error {
+			f.codes[to] = verificationCodePattern.FindString(body)
+			return nil
+		},
+	}
+	f.repo = NewPasswordAuthRepository(f.client, mailer, []byte("secret"), "animalia")
+	return f
+}
+
+// signUp は確認済みのアカウントとユーザーを作成する
+func (f *passwordAuthFixture) signUp(t *testing.T, email, password string) *ent.User {
+	t.Helper()
+	assert.NoError(t, f.repo.CreateUser("test", email, password))
+	assert.NoError(t, f.repo.VerifyEmail(email, f.codes[email]))
+	return f.client.User.Create().SetEmail(email).SetName("test").SaveX(context.Background())
+}
+
+func TestPasswordAuthRepository_SignIn(t *testing.T) {
+	f := newPasswordAuthFixture(t)
+	f.signUp(t, "user@example.com", "password123")
+	assert.NoError(t, f.repo.CreateUser("test", "unverified@example.com", "password123"))
+
+	testCases := []struct {
+		name        string
+		email       string
+		password    string
+		expectError bool
+	}{
+		{name: "Success", email: "user@example.com", password: "password123"},
+		{name: "Wrong password", email: "user@example.com", password: "password124", expectError: true},
+		{name: "Unknown email", email: "nobody@example.com", password: "password123", expectError: true},
+		{name: "Email not verified", email: "unverified@example.com", password: "password123", expectError: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tokens, err := f.repo.SignIn(tc.email, tc.password)
+
+			if tc.expectError {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			claims, err := f.repo.Verify(tokens.AccessToken)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.email, claims.Username)
+			email, err := f.repo.GetUserEmail(tokens.AccessToken)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.email, email)
+		})
+	}
+}
+
+func TestPasswordAuthRepository_CreateUser(t *testing.T) {
+	f := newPasswordAuthFixture(t)
+
+	assert.Error(t, f.repo.CreateUser("test", "user@example.com", "short"))
+	assert.NoError(t, f.repo.CreateUser("test", "user@example.com", "password123"))
+	assert.Error(t, f.repo.CreateUser("test", "user@example.com", "password123"))
+}
+
+func TestPasswordAuthRepository_VerifyEmail(t *testing.T) {
+	f := newPasswordAuthFixture(t)
+	assert.NoError(t, f.repo.CreateUser("test", "user@example.com", "password123"))
+	first := f.codes["user@example.com"]
+
+	for range maxVerificationAttempts {
+		assert.ErrorIs(t, f.repo.VerifyEmail("user@example.com", "000000x"), errCodeMismatch)
+	}
+	// 上限まで間違えると新しいコードを送り直す
+	assert.ErrorIs(t, f.repo.VerifyEmail("user@example.com", first), errTooManyAttempts)
+	assert.NoError(t, f.repo.VerifyEmail("user@example.com", f.codes["user@example.com"]))
+	_, err := f.repo.SignIn("user@example.com", "password123")
+	assert.NoError(t, err)
+}
+
+func TestPasswordAuthRepository_RefreshToken(t *testing.T) {
+	testCases := []struct {
+		name        string
+		prepare     func(t *testing.T, f *passwordAuthFixture, u *ent.User, accessToken string)
+		expectError bool
+	}{
+		{
+			name:    "Success",
+			prepare: func(t *testing.T, f *passwordAuthFixture, u *ent.User, accessToken string) {},
+		},
+		{
+			name: "Signed out",
+			prepare: func(t *testing.T, f *passwordAuthFixture, u *ent.User, accessToken string) {
+				assert.NoError(t, f.repo.SignOut(accessToken))
+			},
+			expectError: true,
+		},
+		{
+			name: "Password changed",
+			prepare: func(t *testing.T, f *passwordAuthFixture, u *ent.User, accessToken string) {
+				assert.NoError(t, f.repo.ChangePassword(accessToken, "password123", "password456"))
+			},
+			expectError: true,
+		},
+		{
+			name: "Password reset",
+			prepare: func(t *testing.T, f *passwordAuthFixture, u *ent.User, accessToken string) {
+				assert.NoError(t, f.repo.ForgotPassword(u.Email))
+				assert.NoError(t, f.repo.ConfirmForgotPassword(u.Email, f.codes[u.Email], "password456"))
+			},
+			expectError: true,
+		},
+		{
+			name: "Suspended user",
+			prepare: func(t *testing.T, f *passwordAuthFixture, u *ent.User, accessToken string) {
+				u.Update().SetSuspendedAt(time.Now()).ExecX(context.Background())
+			},
+			expectError: true,
+		},
+		{
+			name: "Deleted user",
+			prepare: func(t *testing.T, f *passwordAuthFixture, u *ent.User, accessToken string) {
+				u.Update().SetDeletedAt(time.Now()).ExecX(context.Background())
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			f := newPasswordAuthFixture(t)
+			u := f.signUp(t, "user@example.com", "password123")
+			tokens, err := f.repo.SignIn("user@example.com", "password123")
+			assert.NoError(t, err)
+
+			tc.prepare(t, f, u, tokens.AccessToken)
+			refreshed, err := f.repo.RefreshToken(tokens.RefreshToken)
+
+			if tc.expectError {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			_, err = f.repo.Verify(refreshed.AccessToken)
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestPasswordAuthRepository_RefreshToken_InvalidToken(t *testing.T) {
+	f := newPasswordAuthFixture(t)
+	f.signUp(t, "user@example.com", "password123")
+	tokens, err := f.repo.SignIn("user@example.com", "password123")
+	assert.NoError(t, err)
+	other := NewPasswordAuthRepository(f.client, nil, []byte("other-secret"), "animalia")
+	forged, err := other.SignIn("user@example.com", "password123")
+	assert.NoError(t, err)
+
+	// アクセストークンはリフレッシュに使えない
+	_, err = f.repo.RefreshToken(tokens.AccessToken)
+	assert.Error(t, err)
+	// 別の鍵で署名したトークンは受け付けない
+	_, err = f.repo.RefreshToken(forged.RefreshToken)
+	assert.Error(t, err)
+	_, err = f.repo.Verify(forged.AccessToken)
+	assert.Error(t, err)
+}
+
+func TestPasswordAuthRepository_ChangeEmail(t *testing.T) {
+	f := newPasswordAuthFixture(t)
+	f.signUp(t, "user@example.com", "password123")
+	f.signUp(t, "taken@example.com", "password123")
+	tokens, err := f.repo.SignIn("user@example.com", "password123")
+	assert.NoError(t, err)
+
+	assert.Error(t, f.repo.RequestEmailChange(tokens.AccessToken, "taken@example.com"))
+	assert.Error(t, f.repo.ConfirmEmailChange(tokens.AccessToken, "new@example.com", "000000"))
+	assert.NoError(t, f.repo.RequestEmailChange(tokens.AccessToken, "new@example.com"))
+	assert.ErrorIs(t, f.repo.ConfirmEmailChange(tokens.AccessToken, "new@example.com", "000000x"), errCodeMismatch)
+	assert.NoError(t, f.repo.ConfirmEmailChange(tokens.AccessToken, "new@example.com", f.codes["new@example.com"]))
+
+	_, err = f.repo.SignIn("user@example.com", "password123")
+	assert.Error(t, err)
+	_, err = f.repo.SignIn("new@example.com", "password123")
+	assert.NoError(t, err)
+}
+
+func TestPasswordAuthRepository_DeleteUser(t *testing.T) {
+	f := newPasswordAuthFixture(t)
+	f.signUp(t, "user@example.com", "password123")
+	tokens, err := f.repo.SignIn("user@example.com", "password123")
+	assert.NoError(t, err)
+	claims, err := f.repo.Verify(tokens.AccessToken)
+	assert.NoError(t, err)
+
+	assert.NoError(t, f.repo.DeleteUser(claims.Subject))
+	_, err = f.repo.SignIn("user@example.com", "password123")
+	assert.Error(t, err)
+	// 削除済みの subject は削除済みとして扱う
+	assert.NoError(t, f.repo.DeleteUser(claims.Subject))
+	assert.Error(t, f.repo.DeleteUser("invalid"))
+}
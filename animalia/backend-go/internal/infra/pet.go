@@ -32,6 +32,13 @@ func (r *PetRepository) GetByOwner(ownerID string) ([]*ent.Pet, error) {
 	return pets, nil
 }
 
+func (r *PetRepository) GetById(petID uuid.UUID) (*ent.Pet, error) {
+	return r.db.Pet.Query().
+		Where(pet.ID(petID)).
+		WithOwner().
+		Only(context.Background())
+}
+
 func (r *PetRepository) Create(name, petType, species, birthDay, fileKey, userID string) (*ent.Pet, error) {
 	ownerID, err := uuid.Parse(userID)
 	if err != nil {
@@ -2,13 +2,21 @@ package infra
 
 import (
 	"context"
+	"fmt"
 	"time"
 
+	"entgo.io/ent/dialect/sql"
 	"github.com/aki-13627/animalia/backend-go/ent"
+	"github.com/aki-13627/animalia/backend-go/ent/comment"
 	"github.com/aki-13627/animalia/backend-go/ent/dailytask"
+	"github.com/aki-13627/animalia/backend-go/ent/followrelation"
 	"github.com/aki-13627/animalia/backend-go/ent/like"
 	"github.com/aki-13627/animalia/backend-go/ent/post"
+	"github.com/aki-13627/animalia/backend-go/ent/postmedia"
+	"github.com/aki-13627/animalia/backend-go/ent/postrevision"
+	"github.com/aki-13627/animalia/backend-go/ent/predicate"
 	"github.com/aki-13627/animalia/backend-go/ent/user"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/models"
 	"github.com/google/uuid"
 	"github.com/labstack/gommon/log"
 )
@@ -23,62 +31,63 @@ func NewPostRepository(db *ent.Client) *PostRepository {
 	}
 }
 
-func (r *PostRepository) GetAllPosts() ([]*ent.Post, error) {
-	posts, err := r.db.Post.Query().
+func (r *PostRepository) GetAllPosts(viewerID uuid.UUID, page models.PageRequest) ([]*ent.Post, string, error) {
+	posts, err := withPreview(r.db.Post.Query(), viewerID).
 		WithUser().
-		WithComments(func(q *ent.CommentQuery) {
-			q.WithUser()
-		}).
-		WithLikes(func(q *ent.LikeQuery) {
-			q.WithUser()
-		}).
 		WithDailyTask().
-		Where(post.DeletedAtIsNil()).
-		Select(post.FieldID, post.FieldCaption, post.FieldImageKey, post.FieldCreatedAt).
+		WithMedia(func(q *ent.PostMediaQuery) {
+			q.Order(ent.Asc(postmedia.FieldPosition))
+		}).
+		Where(post.DeletedAtIsNil(), post.HiddenAtIsNil()).
+		Where(post.Not(post.HasUserWith(hiddenFromFeed(viewerID))), visibleTo(viewerID)).
+		Where(before[predicate.Post](page.Cursor)).
+		Order(ent.Desc(post.FieldCreatedAt), ent.Desc(post.FieldID)).
+		Limit(page.Limit+1).
+		Select(post.FieldID, post.FieldCaption, post.FieldImageKey, post.FieldCreatedAt, post.FieldEditedAt, post.FieldVisibility).
 		All(context.Background())
 	if err != nil {
 		log.Errorf("Failed to get all posts: %v", err)
-		return nil, err
+		return nil, "", err
 	}
-	return posts, nil
+	posts, next := nextPage(posts, page, postCursor)
+	return posts, next, nil
 }
 
-func (r *PostRepository) GetPostsByUser(userID uuid.UUID) ([]*ent.Post, error) {
-	posts, err := r.db.Post.Query().
+func (r *PostRepository) GetPostsByUser(userID, viewerID uuid.UUID, page models.PageRequest) ([]*ent.Post, string, error) {
+	posts, err := withPreview(r.db.Post.Query(), viewerID).
 		WithUser().
-		WithComments(func(q *ent.CommentQuery) {
-			q.WithUser()
-		}).
-		WithLikes(func(q *ent.LikeQuery) {
-			q.WithUser()
-		}).
 		WithDailyTask().
+		WithMedia(func(q *ent.PostMediaQuery) {
+			q.Order(ent.Asc(postmedia.FieldPosition))
+		}).
 		Where(post.HasUserWith(user.ID(userID))).
-		Where(post.DeletedAtIsNil()).
-		Order(ent.Desc(post.FieldCreatedAt)).
-		Select(post.FieldID, post.FieldCaption, post.FieldImageKey, post.FieldCreatedAt).
+		Where(post.DeletedAtIsNil(), post.HiddenAtIsNil()).
+		Where(post.Not(post.HasUserWith(blockedWith(viewerID))), visibleTo(viewerID)).
+		Where(before[predicate.Post](page.Cursor)).
+		Order(ent.Desc(post.FieldCreatedAt), ent.Desc(post.FieldID)).
+		Limit(page.Limit+1).
+		Select(post.FieldID, post.FieldCaption, post.FieldImageKey, post.FieldCreatedAt, post.FieldEditedAt, post.FieldVisibility).
 		All(context.Background())
 	if err != nil {
 		log.Errorf("Failed to get posts by user: %v", err)
-		return nil, err
+		return nil, "", err
 	}
-	return posts, nil
+	posts, next := nextPage(posts, page, postCursor)
+	return posts, next, nil
 }
 
 func (r *PostRepository) GetLikedPosts(userID uuid.UUID) ([]*ent.Post, error) {
-	posts, err := r.db.Post.Query().
+	posts, err := withPreview(r.db.Post.Query(), userID).
 		WithUser().
-		WithComments(func(q *ent.CommentQuery) {
-			q.WithUser()
-		}).
-		WithLikes(func(q *ent.LikeQuery) {
-			q.WithUser()
-		}).
 		WithDailyTask().
+		WithMedia(func(q *ent.PostMediaQuery) {
+			q.Order(ent.Asc(postmedia.FieldPosition))
+		}).
 		Where(post.HasLikesWith(like.HasUserWith(user.ID(userID)))).
-		Where(post.DeletedAtIsNil()).
+		Where(post.DeletedAtIsNil(), post.HiddenAtIsNil()).
+		Where(post.Not(post.HasUserWith(blockedWith(userID))), visibleTo(userID)).
 		Order(ent.Desc(post.FieldCreatedAt)).
-		Select(post.FieldID, post.FieldCaption, post.FieldImageKey, post.FieldCreatedAt).
+		Select(post.FieldID, post.FieldCaption, post.FieldImageKey, post.FieldCreatedAt, post.FieldEditedAt, post.FieldVisibility).
 		All(context.Background())
 	if err != nil {
 		log.Errorf("Failed to get posts by user: %v", err)
@@ -87,61 +96,146 @@ func (r *PostRepository) GetLikedPosts(userID uuid.UUID) ([]*ent.Post, error) {
 	return posts, nil
 }
 
-func (r *PostRepository) CreatePost(caption, userID, fileKey string, dailyTaskId *string) (*ent.Post, error) {
+func (r *PostRepository) CreatePost(caption, userID string, visibility post.Visibility, media []models.MediaItem, dailyTaskId *string) (*ent.Post, error) {
 	userUUID, err := uuid.Parse(userID)
 	if err != nil {
 		return nil, err
 	}
+	ctx := context.Background()
 
-	postCount, err := r.db.Post.Query().Count(context.Background())
+	tx, err := r.db.Tx(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	postCreate := r.db.Post.Create().
+	postCount, err := tx.Post.Query().Count(ctx)
+	if err != nil {
+		return nil, rollback(tx, err)
+	}
+
+	postCreate := tx.Post.Create().
 		SetCaption(caption).
-		SetImageKey(fileKey).
+		SetImageKey(media[0].PreviewKey()).
 		SetUserID(userUUID).
+		SetVisibility(visibility).
 		SetIndex(postCount)
 
 	if dailyTaskId != nil {
 		dailyTaskUUID, err := uuid.Parse(*dailyTaskId)
 		if err != nil {
-			return nil, err
+			return nil, rollback(tx, err)
 		}
-		err = r.db.Post.
+		err = tx.Post.
 			Update().
 			Where(
 				post.HasDailyTaskWith(dailytask.ID(dailyTaskUUID)),
 				post.DeletedAtNotNil(), // 論理削除済みのみ対象
 			).
 			ClearDailyTask().
-			Exec(context.Background())
+			Exec(ctx)
 		if err != nil {
-			return nil, err
+			return nil, rollback(tx, err)
 		}
 
 		postCreate = postCreate.SetDailyTaskID(dailyTaskUUID)
 	}
 
-	post, err := postCreate.Save(context.Background())
+	post, err := postCreate.Save(ctx)
 	if err != nil {
-		return nil, err
+		return nil, rollback(tx, err)
 	}
 
+	mediaCreates := make([]*ent.PostMediaCreate, len(media))
+	for i, item := range media {
+		mediaCreates[i] = tx.PostMedia.Create().
+			SetPosition(i).
+			SetType(postmedia.Type(item.Type)).
+			SetImageKey(item.Key).
+			SetPosterKey(item.PosterKey).
+			SetPost(post)
+	}
+	created, err := tx.PostMedia.CreateBulk(mediaCreates...).Save(ctx)
+	if err != nil {
+		return nil, rollback(tx, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	post.Edges.Media = created
 	return post, nil
 }
 
-func (r *PostRepository) UpdatePost(postID, caption string) error {
+func (r *PostRepository) UpdatePost(editorID uuid.UUID, postID, caption string) error {
 	postUUID, err := uuid.Parse(postID)
 	if err != nil {
 		return err
 	}
+	ctx := context.Background()
+	tx, err := r.db.Tx(ctx)
+	if err != nil {
+		return err
+	}
+
+	// 初めての編集では編集前の版の日時に投稿日時を使うため、created_at も読み込む
+	current, err := tx.Post.Query().
+		Where(post.ID(postUUID), post.DeletedAtIsNil()).
+		WithUser().
+		Select(post.FieldID, post.FieldCaption, post.FieldCreatedAt).
+		Only(ctx)
+	if err != nil {
+		return rollback(tx, err)
+	}
+	if current.Caption == caption {
+		return tx.Rollback()
+	}
+
+	// 初めての編集では、編集前のキャプションを最初の版として残す
+	hasRevisions, err := tx.PostRevision.Query().Where(postrevision.HasPostWith(post.ID(postUUID))).Exist(ctx)
+	if err != nil {
+		return rollback(tx, err)
+	}
+	if !hasRevisions {
+		err = tx.PostRevision.Create().
+			SetPostID(postUUID).
+			SetEditorID(current.Edges.User.ID).
+			SetCaption(current.Caption).
+			SetCreatedAt(current.CreatedAt).
+			Exec(ctx)
+		if err != nil {
+			return rollback(tx, fmt.Errorf("failed to record original caption: %w", err))
+		}
+	}
 
-	_, err = r.db.Post.UpdateOneID(postUUID).
+	now := time.Now()
+	err = tx.PostRevision.Create().
+		SetPostID(postUUID).
+		SetEditorID(editorID).
 		SetCaption(caption).
-		Save(context.Background())
-	return err
+		SetCreatedAt(now).
+		Exec(ctx)
+	if err != nil {
+		return rollback(tx, fmt.Errorf("failed to record post revision: %w", err))
+	}
+	if err := tx.Post.UpdateOneID(postUUID).SetCaption(caption).SetEditedAt(now).Exec(ctx); err != nil {
+		return rollback(tx, err)
+	}
+	return tx.Commit()
+}
+
+func (r *PostRepository) GetRevisions(postID uuid.UUID) ([]*ent.PostRevision, error) {
+	return r.db.PostRevision.Query().
+		Where(postrevision.HasPostWith(post.ID(postID))).
+		WithEditor().
+		Order(ent.Asc(postrevision.FieldCreatedAt)).
+		All(context.Background())
+}
+
+func (r *PostRepository) UpdateVisibility(postID uuid.UUID, visibility post.Visibility) error {
+	return r.db.Post.UpdateOneID(postID).
+		Where(post.DeletedAtIsNil()).
+		SetVisibility(visibility).
+		Exec(context.Background())
 }
 
 func (r *PostRepository) DeletePost(postID string) error {
@@ -156,10 +250,172 @@ func (r *PostRepository) DeletePost(postID string) error {
 }
 
 func (r *PostRepository) GetById(postId uuid.UUID) (*ent.Post, error) {
-	post, err := r.db.Post.Get(context.Background(), postId)
+	post, err := r.db.Post.Query().
+		Where(post.ID(postId), post.DeletedAtIsNil()).
+		WithUser().
+		Select(post.FieldID, post.FieldCaption, post.FieldImageKey, post.FieldCreatedAt, post.FieldEditedAt, post.FieldVisibility).
+		Only(context.Background())
 	if err != nil {
 		log.Errorf("Failed to get post with id %s: %v", postId, err)
 		return nil, err
 	}
 	return post, nil
 }
+
+func (r *PostRepository) GetDetail(postID, viewerID uuid.UUID) (*ent.Post, error) {
+	return withPreview(r.db.Post.Query(), viewerID).
+		WithUser().
+		WithDailyTask().
+		WithMedia(func(q *ent.PostMediaQuery) {
+			q.Order(ent.Asc(postmedia.FieldPosition))
+		}).
+		Where(post.ID(postID), viewableBy(viewerID)).
+		Select(post.FieldID, post.FieldCaption, post.FieldImageKey, post.FieldCreatedAt, post.FieldEditedAt, post.FieldVisibility).
+		Only(context.Background())
+}
+
+func (r *PostRepository) IsVisible(postID, viewerID uuid.UUID) (bool, error) {
+	return r.db.Post.Query().
+		Where(post.ID(postID), viewableBy(viewerID)).
+		Exist(context.Background())
+}
+
+func (r *PostRepository) GetByIDs(postIDs []uuid.UUID, viewerID uuid.UUID) ([]*ent.Post, error) {
+	posts, err := withPreview(r.db.Post.Query(), viewerID).
+		WithUser().
+		WithDailyTask().
+		WithMedia(func(q *ent.PostMediaQuery) {
+			q.Order(ent.Asc(postmedia.FieldPosition))
+		}).
+		Where(post.IDIn(postIDs...), post.DeletedAtIsNil(), post.HiddenAtIsNil()).
+		Where(post.Not(post.HasUserWith(hiddenFromFeed(viewerID))), visibleTo(viewerID)).
+		Select(post.FieldID, post.FieldCaption, post.FieldImageKey, post.FieldCreatedAt, post.FieldEditedAt, post.FieldVisibility).
+		All(context.Background())
+	if err != nil {
+		log.Errorf("Failed to get posts by IDs: %v", err)
+		return nil, err
+	}
+	return posts, nil
+}
+
+// GetHiddenIDs は postIds・commentIds のうち、削除済みか通報で非表示になっているもの、
+// viewerID との間にブロックがあるか viewerID がミュートしているユーザーのもの、公開範囲や非公開アカウントのため viewerID から見えない投稿を返す
+func (r *PostRepository) GetHiddenIDs(viewerID uuid.UUID, postIds, commentIds []uuid.UUID) (map[uuid.UUID]bool, error) {
+	ctx := context.Background()
+	hidden := map[uuid.UUID]bool{}
+	if len(postIds) > 0 {
+		ids, err := r.db.Post.Query().
+			Where(post.IDIn(postIds...), post.Or(
+				post.DeletedAtNotNil(),
+				post.HiddenAtNotNil(),
+				post.HasUserWith(hiddenFromFeed(viewerID)),
+				post.Not(visibleTo(viewerID)),
+			)).
+			IDs(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, id := range ids {
+			hidden[id] = true
+		}
+	}
+	if len(commentIds) > 0 {
+		ids, err := r.db.Comment.Query().
+			Where(comment.IDIn(commentIds...), comment.Or(
+				comment.DeletedAtNotNil(),
+				comment.HiddenAtNotNil(),
+				comment.HasUserWith(hiddenFromFeed(viewerID)),
+			)).
+			IDs(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, id := range ids {
+			hidden[id] = true
+		}
+	}
+	return hidden, nil
+}
+
+// withPreview は q の投稿に新しい PostPreviewSize 件のコメント・いいねを投稿者付きで読み込み、
+// GET /posts/:id/comments・GET /posts/:id/likes と同じ条件で数えた件数を選択する
+func withPreview(q *ent.PostQuery, viewerID uuid.UUID) *ent.PostQuery {
+	comments := visibleComments(viewerID)
+	likes := visibleLikes(viewerID)
+	q.Modify(func(s *sql.Selector) {
+		s.AppendSelectExprAs(countPerPost(s, comment.Table, comment.PostColumn, comments), models.PostCommentsCountColumn).
+			AppendSelectExprAs(countPerPost(s, like.Table, like.PostColumn, likes), models.PostLikesCountColumn)
+	})
+	return q.
+		WithComments(func(q *ent.CommentQuery) {
+			q.Where(comments, latestPerPost(comment.PostColumn, comments)).WithUser()
+		}).
+		WithLikes(func(q *ent.LikeQuery) {
+			q.Where(likes, latestPerPost(like.PostColumn, likes)).WithUser()
+		})
+}
+
+// countPerPost は s の投稿ごとに、table の行のうち ps を満たすものを数える副問い合わせを返す
+func countPerPost[P ~func(*sql.Selector)](s *sql.Selector, table, postColumn string, ps ...P) *sql.Selector {
+	t := sql.Table(table)
+	count := sql.Dialect(s.Dialect()).
+		Select(sql.Count("*")).
+		From(t).
+		Where(sql.ColumnsEQ(t.C(postColumn), s.C(post.FieldID)))
+	for _, p := range ps {
+		p(count)
+	}
+	return count
+}
+
+// latestPerPost は同じ投稿の行のうち ps を満たすものの中で、新しい PostPreviewSize 件に入る行の条件
+func latestPerPost[P ~func(*sql.Selector)](postColumn string, ps ...P) P {
+	return func(s *sql.Selector) {
+		t := sql.Table(s.TableName()).As("latest")
+		latest := sql.Dialect(s.Dialect()).
+			Select(t.C("id")).
+			From(t).
+			Where(sql.ColumnsEQ(t.C(postColumn), s.C(postColumn))).
+			OrderBy(sql.Desc(t.C("created_at")), sql.Desc(t.C("id"))).
+			Limit(models.PostPreviewSize)
+		for _, p := range ps {
+			p(latest)
+		}
+		s.Where(sql.In(s.C("id"), latest))
+	}
+}
+
+func postCursor(p *ent.Post) models.Cursor {
+	return models.Cursor{CreatedAt: p.CreatedAt, ID: p.ID}
+}
+
+// visibleTo は viewerID が見られる投稿の条件。本人の投稿はすべて見える。
+// 他人の投稿は公開範囲に加えて、非公開アカウントの場合は承認済みのフォロワーであることが必要
+func visibleTo(viewerID uuid.UUID) predicate.Post {
+	followedByViewer := user.HasFollowersWith(followrelation.HasFromWith(user.ID(viewerID)), approved())
+	return post.Or(
+		post.HasUserWith(user.ID(viewerID)),
+		post.And(post.VisibilityEQ(post.VisibilityPublic), post.Not(post.HasUserWith(privateTo(viewerID)))),
+		post.And(post.VisibilityEQ(post.VisibilityFollowers), post.HasUserWith(followedByViewer)),
+	)
+}
+
+// viewableBy は viewerID に投稿の詳細を見せる条件。削除済み・非表示の投稿、退会したユーザーの投稿、
+// viewerID との間にブロックがあるユーザーの投稿と、公開範囲や非公開アカウントのため見えない投稿を除く
+func viewableBy(viewerID uuid.UUID) predicate.Post {
+	return post.And(
+		post.DeletedAtIsNil(),
+		post.HiddenAtIsNil(),
+		post.HasUserWith(user.DeletedAtIsNil()),
+		post.Not(post.HasUserWith(blockedWith(viewerID))),
+		visibleTo(viewerID),
+	)
+}
+
+// rollback はトランザクションを取り消し、元のエラーを返す
+func rollback(tx *ent.Tx, err error) error {
+	if rerr := tx.Rollback(); rerr != nil {
+		return fmt.Errorf("%w: rolling back transaction: %v", err, rerr)
+	}
+	return err
+}
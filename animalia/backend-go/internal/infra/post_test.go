@@ -0,0 +1,172 @@
+package infra
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aki-13627/animalia/backend-go/ent"
+	"github.com/aki-13627/animalia/backend-go/ent/post"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/models"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+// newPostTestClient は投稿の一覧・詳細のクエリに必要なテーブルを作成したクライアントを返す
+func newPostTestClient(t *testing.T) *ent.Client {
+	t.Helper()
+	return newTestClient(t, "users", "posts", "comments", "likes", "blocks", "mutes", "follow_relations", "post_media", "daily_tasks")
+}
+
+func createTestUser(t *testing.T, client *ent.Client, name string) *ent.User {
+	t.Helper()
+	return client.User.Create().SetEmail(name + "@example.com").SetName(name).SaveX(context.Background())
+}
+
+func TestPostRepository_Preview(t *testing.T) {
+	ctx := context.Background()
+	client := newPostTestClient(t)
+	repo := NewPostRepository(client)
+
+	viewer := createTestUser(t, client, "viewer")
+	author := createTestUser(t, client, "author")
+	blocked := createTestUser(t, client, "blocked")
+	deleted := createTestUser(t, client, "deleted")
+	muted := createTestUser(t, client, "muted")
+	client.Block.Create().SetFromID(viewer.ID).SetToID(blocked.ID).ExecX(ctx)
+	client.Mute.Create().SetFromID(viewer.ID).SetToID(muted.ID).ExecX(ctx)
+	p := client.Post.Create().SetCaption("caption").SetImageKey("posts/a/full.jpg").SetUser(author).SaveX(ctx)
+
+	base := time.Now().Add(-time.Hour)
+	var latestComments []uuid.UUID
+	for i := range 5 {
+		c := client.Comment.Create().SetContent("comment").SetPost(p).SetUser(muted).SetCreatedAt(base.Add(time.Duration(i) * time.Minute)).SaveX(ctx)
+		if i >= 5-models.PostPreviewSize {
+			latestComments = append(latestComments, c.ID)
+		}
+	}
+	// 見せないコメントは件数にもプレビューにも含めない
+	client.Comment.Create().SetContent("blocked").SetPost(p).SetUser(blocked).SetCreatedAt(base.Add(time.Hour)).ExecX(ctx)
+	client.Comment.Create().SetContent("hidden").SetPost(p).SetUser(author).SetCreatedAt(base.Add(time.Hour)).SetHiddenAt(time.Now()).ExecX(ctx)
+
+	var latestLikes []uuid.UUID
+	for i := range 4 {
+		liker := createTestUser(t, client, uuid.NewString())
+		l := client.Like.Create().SetPost(p).SetUser(liker).SetCreatedAt(base.Add(time.Duration(i) * time.Minute)).SaveX(ctx)
+		if i >= 4-models.PostPreviewSize {
+			latestLikes = append(latestLikes, l.ID)
+		}
+	}
+	client.Like.Create().SetPost(p).SetUser(blocked).SetCreatedAt(base.Add(time.Hour)).ExecX(ctx)
+	client.Like.Create().SetPost(p).SetUser(deleted).SetCreatedAt(base.Add(time.Hour)).ExecX(ctx)
+	deleted.Update().SetDeletedAt(time.Now()).ExecX(ctx)
+
+	// 別の投稿のコメント・いいねは数えない
+	other := client.Post.Create().SetCaption("other").SetImageKey("posts/b/full.jpg").SetUser(author).SaveX(ctx)
+	client.Comment.Create().SetContent("other").SetPost(other).SetUser(author).ExecX(ctx)
+
+	detail, err := repo.GetDetail(p.ID, viewer.ID)
+	assert.NoError(t, err)
+	all, _, err := repo.GetAllPosts(viewer.ID, models.PageRequest{Limit: 10})
+	assert.NoError(t, err)
+	byUser, _, err := repo.GetPostsByUser(author.ID, viewer.ID, models.PageRequest{Limit: 10})
+	assert.NoError(t, err)
+	byIDs, err := repo.GetByIDs([]uuid.UUID{p.ID, other.ID}, viewer.ID)
+	assert.NoError(t, err)
+	assert.Len(t, all, 2)
+	assert.Len(t, byUser, 2)
+	assert.Len(t, byIDs, 2)
+
+	for name, posts := range map[string][]*ent.Post{
+		"GetDetail":      {detail},
+		"GetAllPosts":    all,
+		"GetPostsByUser": byUser,
+		"GetByIDs":       byIDs,
+	} {
+		t.Run(name, func(t *testing.T) {
+			for _, got := range posts {
+				resp := models.NewPostResponse(got, nil, nil, "", nil, nil)
+				if got.ID == other.ID {
+					assert.Equal(t, 1, resp.CommentsCount)
+					assert.Equal(t, 0, resp.LikesCount)
+					continue
+				}
+				assert.Equal(t, 5, resp.CommentsCount)
+				assert.Equal(t, 4, resp.LikesCount)
+
+				commentIDs := []uuid.UUID{}
+				for _, c := range got.Edges.Comments {
+					assert.NotNil(t, c.Edges.User)
+					commentIDs = append(commentIDs, c.ID)
+				}
+				assert.ElementsMatch(t, latestComments, commentIDs)
+				likeIDs := []uuid.UUID{}
+				for _, l := range got.Edges.Likes {
+					assert.NotNil(t, l.Edges.User)
+					likeIDs = append(likeIDs, l.ID)
+				}
+				assert.ElementsMatch(t, latestLikes, likeIDs)
+			}
+		})
+	}
+}
+
+func TestPostRepository_IsVisible(t *testing.T) {
+	// image_feature が NULL の行は UpdateOne で読み込み直せないため、投稿は Update で更新する
+	testCases := []struct {
+		name     string
+		prepare  func(t *testing.T, client *ent.Client, viewer, author *ent.User, p *ent.Post)
+		expected bool
+	}{
+		{
+			name:     "Visible",
+			prepare:  func(t *testing.T, client *ent.Client, viewer, author *ent.User, p *ent.Post) {},
+			expected: true,
+		},
+		{
+			name: "Hidden post",
+			prepare: func(t *testing.T, client *ent.Client, viewer, author *ent.User, p *ent.Post) {
+				client.Post.Update().Where(post.ID(p.ID)).SetHiddenAt(time.Now()).ExecX(context.Background())
+			},
+		},
+		{
+			name: "Deleted post",
+			prepare: func(t *testing.T, client *ent.Client, viewer, author *ent.User, p *ent.Post) {
+				client.Post.Update().Where(post.ID(p.ID)).SetDeletedAt(time.Now()).ExecX(context.Background())
+			},
+		},
+		{
+			name: "Deleted author",
+			prepare: func(t *testing.T, client *ent.Client, viewer, author *ent.User, p *ent.Post) {
+				author.Update().SetDeletedAt(time.Now()).ExecX(context.Background())
+			},
+		},
+		{
+			name: "Blocked author",
+			prepare: func(t *testing.T, client *ent.Client, viewer, author *ent.User, p *ent.Post) {
+				client.Block.Create().SetFromID(author.ID).SetToID(viewer.ID).ExecX(context.Background())
+			},
+		},
+		{
+			name: "Only me",
+			prepare: func(t *testing.T, client *ent.Client, viewer, author *ent.User, p *ent.Post) {
+				client.Post.Update().Where(post.ID(p.ID)).SetVisibility(post.VisibilityOnlyMe).ExecX(context.Background())
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			client := newPostTestClient(t)
+			viewer := createTestUser(t, client, "viewer")
+			author := createTestUser(t, client, "author")
+			p := client.Post.Create().SetCaption("caption").SetImageKey("posts/a/full.jpg").SetUser(author).SaveX(context.Background())
+			tc.prepare(t, client, viewer, author, p)
+
+			visible, err := NewPostRepository(client).IsVisible(p.ID, viewer.ID)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, visible)
+		})
+	}
+}
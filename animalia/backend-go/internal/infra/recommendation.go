@@ -0,0 +1,234 @@
+package infra
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand/v2"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aki-13627/animalia/backend-go/internal/domain/models"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/models/fastapi"
+	"github.com/google/uuid"
+	"github.com/labstack/gommon/log"
+)
+
+// RecommendationConfig は推薦 API のクライアントの設定
+type RecommendationConfig struct {
+	BaseURL string
+	// Timeout は1回のリクエストのタイムアウト
+	Timeout time.Duration
+	// MaxRetries は失敗したリクエストを再試行する回数。ネットワークエラー・5xx・429 のみ再試行する
+	MaxRetries int
+	// RetryBaseDelay は再試行までの待ち時間の基準。n 回目は 0 から RetryBaseDelay*2^n の間でランダムに待つ
+	RetryBaseDelay time.Duration
+	// MaxResponseBytes を超えるレスポンスはエラーにする
+	MaxResponseBytes int64
+	// BreakerThreshold 回続けて失敗すると BreakerCooldown の間は呼び出しを止める
+	BreakerThreshold int
+	BreakerCooldown  time.Duration
+}
+
+// DefaultRecommendationConfig は baseURL 以外を既定値にした設定を返す
+func DefaultRecommendationConfig(baseURL string) RecommendationConfig {
+	return RecommendationConfig{
+		BaseURL:          baseURL,
+		Timeout:          10 * time.Second,
+		MaxRetries:       2,
+		RetryBaseDelay:   200 * time.Millisecond,
+		MaxResponseBytes: 5 << 20,
+		BreakerThreshold: 5,
+		BreakerCooldown:  30 * time.Second,
+	}
+}
+
+// HTTPRecommendationRepository は FastAPI の推薦 API を HTTP で呼び出す。
+// サーキットブレーカーの状態を共有するため、プロセスで1つだけ生成する
+type HTTPRecommendationRepository struct {
+	config  RecommendationConfig
+	client  *http.Client
+	breaker *circuitBreaker
+}
+
+func NewHTTPRecommendationRepository(config RecommendationConfig) *HTTPRecommendationRepository {
+	return &HTTPRecommendationRepository{
+		config:  config,
+		client:  &http.Client{},
+		breaker: newCircuitBreaker(config.BreakerThreshold, config.BreakerCooldown),
+	}
+}
+
+// retryableError は再試行すれば成功する可能性があるエラー
+type retryableError struct {
+	err error
+}
+
+func (e *retryableError) Error() string { return e.err.Error() }
+func (e *retryableError) Unwrap() error { return e.err }
+
+func (r *HTTPRecommendationRepository) Timeline(ctx context.Context, userID uuid.UUID) ([]uuid.UUID, error) {
+	if !r.breaker.allow() {
+		return nil, models.ErrRecommendationUnavailable
+	}
+
+	// FastAPI に送る body は user_id のみ
+	body, err := json.Marshal(struct {
+		UserID string `json:"user_id"`
+	}{
+		UserID: userID.String(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal request body: %w", err)
+	}
+
+	var posts []fastapi.FastAPIPost
+	for attempt := 0; ; attempt++ {
+		posts, err = r.post(ctx, "/timeline", body)
+		var retryable *retryableError
+		if err == nil || !errors.As(err, &retryable) || attempt >= r.config.MaxRetries {
+			break
+		}
+		log.Warnf("Recommendation request failed (attempt %d): %v", attempt+1, err)
+		if err := sleepWithJitter(ctx, r.config.RetryBaseDelay, attempt); err != nil {
+			break
+		}
+	}
+	if err != nil {
+		// 呼び出し元のキャンセルは推薦 API の障害として数えない
+		if ctx.Err() != nil {
+			r.breaker.release()
+		} else {
+			r.breaker.failure()
+		}
+		return nil, err
+	}
+	r.breaker.success()
+
+	postIDs := make([]uuid.UUID, 0, len(posts))
+	for _, post := range posts {
+		if id, err := uuid.Parse(post.ID); err == nil {
+			postIDs = append(postIDs, id)
+		}
+	}
+	return postIDs, nil
+}
+
+// post は path に body を POST し、レスポンスの投稿を返す
+func (r *HTTPRecommendationRepository) post(ctx context.Context, path string, body []byte) ([]fastapi.FastAPIPost, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.config.Timeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, strings.TrimSuffix(r.config.BaseURL, "/")+path, bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create HTTP request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := r.client.Do(req)
+	if err != nil {
+		return nil, &retryableError{fmt.Errorf("failed to send request: %w", err)}
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		io.Copy(io.Discard, io.LimitReader(resp.Body, r.config.MaxResponseBytes))
+		err := fmt.Errorf("recommendation service returned status %d", resp.StatusCode)
+		if resp.StatusCode >= 500 || resp.StatusCode == http.StatusTooManyRequests {
+			return nil, &retryableError{err}
+		}
+		return nil, err
+	}
+
+	respBody, err := io.ReadAll(io.LimitReader(resp.Body, r.config.MaxResponseBytes+1))
+	if err != nil {
+		return nil, &retryableError{fmt.Errorf("failed to read response: %w", err)}
+	}
+	if int64(len(respBody)) > r.config.MaxResponseBytes {
+		return nil, fmt.Errorf("response from recommendation service exceeds %d bytes", r.config.MaxResponseBytes)
+	}
+
+	var result struct {
+		Posts []fastapi.FastAPIPost `json:"posts"`
+	}
+	if err := json.Unmarshal(respBody, &result); err != nil {
+		return nil, fmt.Errorf("invalid response from recommendation service: %w", err)
+	}
+	return result.Posts, nil
+}
+
+// sleepWithJitter は attempt 回目の再試行まで 0 から base*2^attempt の間でランダムに待つ。ctx が終わればすぐに戻る
+func sleepWithJitter(ctx context.Context, base time.Duration, attempt int) error {
+	if base <= 0 {
+		return ctx.Err()
+	}
+	delay := time.Duration(rand.Int64N(int64(base << attempt)))
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// circuitBreaker は threshold 回続けて失敗すると cooldown の間は呼び出しを止める。
+// cooldown が過ぎたら1回だけ試し、成功すれば元に戻り、失敗すればまた cooldown の間止める
+type circuitBreaker struct {
+	mu        sync.Mutex
+	threshold int
+	cooldown  time.Duration
+	failures  int
+	openUntil time.Time
+	probing   bool
+}
+
+func newCircuitBreaker(threshold int, cooldown time.Duration) *circuitBreaker {
+	return &circuitBreaker{
+		threshold: threshold,
+		cooldown:  cooldown,
+	}
+}
+
+func (b *circuitBreaker) allow() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.threshold <= 0 || b.failures < b.threshold {
+		return true
+	}
+	if time.Now().Before(b.openUntil) || b.probing {
+		return false
+	}
+	b.probing = true
+	return true
+}
+
+func (b *circuitBreaker) success() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.failures = 0
+	b.probing = false
+}
+
+// release は結果を数えずに試行を終える
+func (b *circuitBreaker) release() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.probing = false
+}
+
+func (b *circuitBreaker) failure() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.failures++
+	b.probing = false
+	if b.threshold > 0 && b.failures >= b.threshold {
+		b.openUntil = time.Now().Add(b.cooldown)
+	}
+}
@@ -0,0 +1,150 @@
+package infra
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/aki-13627/animalia/backend-go/internal/domain/models"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/repository/mock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHTTPRecommendationRepository_Timeline(t *testing.T) {
+	userID := uuid.New()
+	postIDs := []uuid.UUID{uuid.New(), uuid.New()}
+
+	// Test cases
+	testCases := []struct {
+		name             string
+		failures         int
+		failStatus       int
+		delay            time.Duration
+		maxResponseBytes int64
+		expectedRequests int
+		expectError      bool
+	}{
+		{
+			name:             "Success",
+			expectedRequests: 1,
+		},
+		{
+			name:             "Retry on server error",
+			failures:         2,
+			failStatus:       http.StatusServiceUnavailable,
+			expectedRequests: 3,
+		},
+		{
+			name:             "Retry on too many requests",
+			failures:         1,
+			failStatus:       http.StatusTooManyRequests,
+			expectedRequests: 2,
+		},
+		{
+			name:             "Give up after retries",
+			failures:         3,
+			failStatus:       http.StatusInternalServerError,
+			expectedRequests: 3,
+			expectError:      true,
+		},
+		{
+			name:             "No retry on client error",
+			failures:         1,
+			failStatus:       http.StatusBadRequest,
+			expectedRequests: 1,
+			expectError:      true,
+		},
+		{
+			name:             "Response too large",
+			maxResponseBytes: 16,
+			expectedRequests: 1,
+			expectError:      true,
+		},
+		{
+			name:             "Timeout",
+			delay:            time.Second,
+			expectedRequests: 3,
+			expectError:      true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			server := mock.NewFakeRecommendationServer(postIDs...)
+			defer server.Close()
+			server.FailNext(tc.failures, tc.failStatus)
+			server.SetDelay(tc.delay)
+
+			config := DefaultRecommendationConfig(server.URL)
+			config.Timeout = 50 * time.Millisecond
+			config.RetryBaseDelay = time.Millisecond
+			if tc.maxResponseBytes > 0 {
+				config.MaxResponseBytes = tc.maxResponseBytes
+			}
+
+			ids, err := NewHTTPRecommendationRepository(config).Timeline(context.Background(), userID)
+
+			assert.Equal(t, tc.expectedRequests, server.Requests())
+			if tc.expectError {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, postIDs, ids)
+			assert.Equal(t, userID.String(), server.LastUserID())
+		})
+	}
+}
+
+func TestHTTPRecommendationRepository_Timeline_CircuitBreaker(t *testing.T) {
+	recommendedID := uuid.New()
+	server := mock.NewFakeRecommendationServer(recommendedID)
+	defer server.Close()
+	server.FailNext(2, http.StatusInternalServerError)
+
+	config := DefaultRecommendationConfig(server.URL)
+	config.MaxRetries = 0
+	config.BreakerThreshold = 2
+	config.BreakerCooldown = 50 * time.Millisecond
+	repo := NewHTTPRecommendationRepository(config)
+
+	// 2回続けて失敗すると cooldown の間は呼び出さない
+	for range 2 {
+		_, err := repo.Timeline(context.Background(), uuid.New())
+		assert.Error(t, err)
+	}
+	_, err := repo.Timeline(context.Background(), uuid.New())
+	assert.ErrorIs(t, err, models.ErrRecommendationUnavailable)
+	assert.Equal(t, 2, server.Requests())
+
+	// cooldown の後は再び呼び出し、成功すれば元に戻る
+	time.Sleep(config.BreakerCooldown)
+	ids, err := repo.Timeline(context.Background(), uuid.New())
+	assert.NoError(t, err)
+	assert.Equal(t, []uuid.UUID{recommendedID}, ids)
+	assert.Equal(t, 3, server.Requests())
+}
+
+func TestHTTPRecommendationRepository_Timeline_Canceled(t *testing.T) {
+	server := mock.NewFakeRecommendationServer(uuid.New())
+	defer server.Close()
+	server.SetDelay(time.Second)
+
+	config := DefaultRecommendationConfig(server.URL)
+	config.MaxRetries = 0
+	config.BreakerThreshold = 1
+	repo := NewHTTPRecommendationRepository(config)
+
+	// 呼び出し元のキャンセルは推薦 API の障害として数えない
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	_, err := repo.Timeline(ctx, uuid.New())
+	assert.Error(t, err)
+
+	server.SetDelay(0)
+	_, err = repo.Timeline(context.Background(), uuid.New())
+	assert.NoError(t, err)
+	assert.Equal(t, 2, server.Requests())
+}
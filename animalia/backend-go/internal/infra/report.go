@@ -0,0 +1,190 @@
+package infra
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aki-13627/animalia/backend-go/ent"
+	"github.com/aki-13627/animalia/backend-go/ent/comment"
+	"github.com/aki-13627/animalia/backend-go/ent/post"
+	"github.com/aki-13627/animalia/backend-go/ent/report"
+	"github.com/aki-13627/animalia/backend-go/ent/user"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/models"
+	"github.com/google/uuid"
+)
+
+type ReportRepository struct {
+	db *ent.Client
+}
+
+func NewReportRepository(db *ent.Client) *ReportRepository {
+	return &ReportRepository{
+		db: db,
+	}
+}
+
+func (r *ReportRepository) Create(reporterID uuid.UUID, targetType report.TargetType, targetID uuid.UUID, reason report.Reason, detail string) (*ent.Report, int, error) {
+	ctx := context.Background()
+	tx, err := r.db.Tx(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if err := targetExists(ctx, tx, targetType, targetID); err != nil {
+		return nil, 0, rollback(tx, err)
+	}
+	created, err := tx.Report.Create().
+		SetReporterID(reporterID).
+		SetTargetType(targetType).
+		SetTargetID(targetID).
+		SetReason(reason).
+		SetDetail(detail).
+		Save(ctx)
+	if err != nil {
+		return nil, 0, rollback(tx, err)
+	}
+
+	// 同じユーザーの重複した通報で非表示にならないよう、通報した人数を数える
+	reporters, err := tx.Report.Query().
+		Where(report.TargetTypeEQ(targetType), report.TargetID(targetID), report.StatusEQ(report.StatusOpen)).
+		QueryReporter().
+		Count(ctx)
+	if err != nil {
+		return nil, 0, rollback(tx, err)
+	}
+	return created, reporters, tx.Commit()
+}
+
+func (r *ReportRepository) ExistsOpen(reporterID uuid.UUID, targetType report.TargetType, targetID uuid.UUID) (bool, error) {
+	return r.db.Report.Query().
+		Where(
+			report.HasReporterWith(user.ID(reporterID)),
+			report.TargetTypeEQ(targetType),
+			report.TargetID(targetID),
+			report.StatusEQ(report.StatusOpen),
+		).
+		Exist(context.Background())
+}
+
+func (r *ReportRepository) GetById(reportID uuid.UUID) (*ent.Report, error) {
+	return r.db.Report.Query().
+		Where(report.ID(reportID)).
+		WithReporter().
+		Only(context.Background())
+}
+
+func (r *ReportRepository) GetTargetAuthor(targetType report.TargetType, targetID uuid.UUID) (*ent.User, error) {
+	ctx := context.Background()
+	switch targetType {
+	case report.TargetTypePost:
+		return r.db.Post.Query().Where(post.ID(targetID)).QueryUser().Only(ctx)
+	case report.TargetTypeComment:
+		return r.db.Comment.Query().Where(comment.ID(targetID)).QueryUser().Only(ctx)
+	default:
+		return r.db.User.Get(ctx, targetID)
+	}
+}
+
+func (r *ReportRepository) AutoHide(targetType report.TargetType, targetID uuid.UUID, reportCount int) error {
+	ctx := context.Background()
+	tx, err := r.db.Tx(ctx)
+	if err != nil {
+		return err
+	}
+
+	hidden, err := setHidden(ctx, tx, targetType, targetID, true)
+	if err != nil {
+		return rollback(tx, err)
+	}
+	if hidden == 0 {
+		return tx.Rollback()
+	}
+	detail := fmt.Sprintf("%d reporters", reportCount)
+	if err := recordAudit(ctx, tx, nil, models.AuditActionAutoHide, string(targetType), targetID.String(), detail); err != nil {
+		return rollback(tx, err)
+	}
+	return tx.Commit()
+}
+
+func (r *ReportRepository) Resolve(actorID uuid.UUID, reportID uuid.UUID, decision models.ReportDecision) (int, error) {
+	ctx := context.Background()
+	tx, err := r.db.Tx(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	target, err := tx.Report.Get(ctx, reportID)
+	if err != nil {
+		return 0, rollback(tx, err)
+	}
+	resolved, err := tx.Report.Update().
+		Where(report.TargetTypeEQ(target.TargetType), report.TargetID(target.TargetID), report.StatusEQ(report.StatusOpen)).
+		SetStatus(decision.Status).
+		SetReviewedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		return 0, rollback(tx, err)
+	}
+
+	if target.TargetType != report.TargetTypeUser {
+		if _, err := setHidden(ctx, tx, target.TargetType, target.TargetID, decision.Hidden); err != nil {
+			return 0, rollback(tx, err)
+		}
+	}
+	if decision.SuspendUserID != nil {
+		err := tx.User.UpdateOneID(*decision.SuspendUserID).
+			SetSuspendedAt(time.Now()).
+			SetSuspensionReason(decision.Note).
+			Exec(ctx)
+		if err != nil {
+			return 0, rollback(tx, err)
+		}
+		if err := recordAudit(ctx, tx, &actorID, models.AuditActionSuspendUser, "user", decision.SuspendUserID.String(), decision.Note); err != nil {
+			return 0, rollback(tx, err)
+		}
+	}
+
+	detail := fmt.Sprintf("%s %s", target.TargetType, target.TargetID)
+	if decision.Note != "" {
+		detail += ": " + decision.Note
+	}
+	if err := recordAudit(ctx, tx, &actorID, decision.Action, "report", reportID.String(), detail); err != nil {
+		return 0, rollback(tx, err)
+	}
+	return resolved, tx.Commit()
+}
+
+// targetExists は通報の対象が存在し、削除されていないことを確認する。存在しなければ NotFound を返す
+func targetExists(ctx context.Context, tx *ent.Tx, targetType report.TargetType, targetID uuid.UUID) error {
+	var err error
+	switch targetType {
+	case report.TargetTypePost:
+		_, err = tx.Post.Query().Where(post.ID(targetID), post.DeletedAtIsNil()).OnlyID(ctx)
+	case report.TargetTypeComment:
+		_, err = tx.Comment.Query().Where(comment.ID(targetID), comment.DeletedAtIsNil()).OnlyID(ctx)
+	default:
+		_, err = tx.User.Query().Where(user.ID(targetID), user.DeletedAtIsNil()).OnlyID(ctx)
+	}
+	return err
+}
+
+// setHidden は投稿・コメントを非表示にするか、非表示を解除する。変更した件数を返す
+func setHidden(ctx context.Context, tx *ent.Tx, targetType report.TargetType, targetID uuid.UUID, hidden bool) (int, error) {
+	switch targetType {
+	case report.TargetTypePost:
+		update := tx.Post.Update().Where(post.ID(targetID))
+		if hidden {
+			return update.Where(post.HiddenAtIsNil()).SetHiddenAt(time.Now()).Save(ctx)
+		}
+		return update.Where(post.HiddenAtNotNil()).ClearHiddenAt().Save(ctx)
+	case report.TargetTypeComment:
+		update := tx.Comment.Update().Where(comment.ID(targetID))
+		if hidden {
+			return update.Where(comment.HiddenAtIsNil()).SetHiddenAt(time.Now()).Save(ctx)
+		}
+		return update.Where(comment.HiddenAtNotNil()).ClearHiddenAt().Save(ctx)
+	default:
+		return 0, fmt.Errorf("cannot hide %s", targetType)
+	}
+}
@@ -6,16 +6,23 @@ import (
 	"fmt"
 	"io"
 	"log"
+	"mime"
 	"mime/multipart"
+	"net/url"
+	"path"
 	"path/filepath"
 	"time"
 
+	"github.com/aki-13627/animalia/backend-go/internal/domain/models"
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/config"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
 	"github.com/google/uuid"
 )
 
+// PresignedURLExpiry は GetUrl で発行する閲覧用 URL の有効期限
+const PresignedURLExpiry = time.Hour
+
 type S3Repository struct {
 	s3Client   *s3.Client
 	bucketName string
@@ -97,12 +104,98 @@ func (r *S3Repository) UploadImage(file *multipart.FileHeader, directory string)
 	return fileKey, nil
 }
 
+func (r *S3Repository) PutObject(fileKey string, body []byte, contentType string) error {
+	_, err := r.s3Client.PutObject(context.TODO(), &s3.PutObjectInput{
+		Bucket:      aws.String(r.bucketName),
+		Key:         aws.String(fileKey),
+		Body:        bytes.NewReader(body),
+		ContentType: aws.String(contentType),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to upload file to S3: %w", err)
+	}
+	return nil
+}
+
+func (r *S3Repository) GetObject(fileKey string) ([]byte, error) {
+	output, err := r.s3Client.GetObject(context.TODO(), &s3.GetObjectInput{
+		Bucket: aws.String(r.bucketName),
+		Key:    aws.String(fileKey),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get object from S3: %w", err)
+	}
+	defer output.Body.Close()
+
+	body, err := io.ReadAll(output.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read object: %w", err)
+	}
+	return body, nil
+}
+
+func (r *S3Repository) StatObject(fileKey string) (*models.ObjectInfo, error) {
+	output, err := r.s3Client.HeadObject(context.TODO(), &s3.HeadObjectInput{
+		Bucket: aws.String(r.bucketName),
+		Key:    aws.String(fileKey),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to head object: %w", err)
+	}
+
+	return &models.ObjectInfo{
+		Key:          fileKey,
+		Size:         aws.ToInt64(output.ContentLength),
+		ContentType:  aws.ToString(output.ContentType),
+		LastModified: aws.ToTime(output.LastModified),
+	}, nil
+}
+
+func (r *S3Repository) ListObjects(prefix string) ([]models.ObjectInfo, error) {
+	var objects []models.ObjectInfo
+	paginator := s3.NewListObjectsV2Paginator(r.s3Client, &s3.ListObjectsV2Input{
+		Bucket: aws.String(r.bucketName),
+		Prefix: aws.String(prefix),
+	})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(context.TODO())
+		if err != nil {
+			return nil, fmt.Errorf("failed to list objects: %w", err)
+		}
+		for _, object := range page.Contents {
+			objects = append(objects, models.ObjectInfo{
+				Key:          aws.ToString(object.Key),
+				Size:         aws.ToInt64(object.Size),
+				LastModified: aws.ToTime(object.LastModified),
+			})
+		}
+	}
+	return objects, nil
+}
+
+func (r *S3Repository) ImportObject(srcKey string, directory string) (string, error) {
+	fileKey := fmt.Sprintf("%s/%s-%s", directory, uuid.New().String(), path.Base(srcKey))
+	_, err := r.s3Client.CopyObject(context.TODO(), &s3.CopyObjectInput{
+		Bucket:     aws.String(r.bucketName),
+		Key:        aws.String(fileKey),
+		CopySource: aws.String((&url.URL{Path: r.bucketName + "/" + srcKey}).EscapedPath()),
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to copy object: %w", err)
+	}
+
+	if err := r.DeleteImage(srcKey); err != nil {
+		log.Printf("Failed to delete imported object %s: %v", srcKey, err)
+	}
+	return fileKey, nil
+}
+
 func (r *S3Repository) GetUrl(fileKey string) (string, error) {
 	presigner := s3.NewPresignClient(r.s3Client)
 	presignedURL, err := presigner.PresignGetObject(context.TODO(), &s3.GetObjectInput{
 		Bucket: aws.String(r.bucketName),
 		Key:    aws.String(fileKey),
-	}, s3.WithPresignExpires(time.Hour))
+	}, s3.WithPresignExpires(PresignedURLExpiry))
 	if err != nil {
 		return "", fmt.Errorf("failed to generate presigned URL: %w", err)
 	}
@@ -110,6 +203,39 @@ func (r *S3Repository) GetUrl(fileKey string) (string, error) {
 	return presignedURL.URL, nil
 }
 
+func (r *S3Repository) GetDownloadUrl(fileKey string, filename string, expiry time.Duration) (string, error) {
+	presigner := s3.NewPresignClient(r.s3Client)
+	presignedURL, err := presigner.PresignGetObject(context.TODO(), &s3.GetObjectInput{
+		Bucket:                     aws.String(r.bucketName),
+		Key:                        aws.String(fileKey),
+		ResponseContentDisposition: aws.String(mime.FormatMediaType("attachment", map[string]string{"filename": filename})),
+	}, s3.WithPresignExpires(expiry))
+	if err != nil {
+		return "", fmt.Errorf("failed to generate presigned download URL: %w", err)
+	}
+
+	return presignedURL.URL, nil
+}
+
+func (r *S3Repository) GetUrls(fileKeys []string) (map[string]string, error) {
+	return getUrls(r, fileKeys)
+}
+
+func (r *S3Repository) GetUploadUrl(fileKey string, contentType string, size int64) (string, error) {
+	presigner := s3.NewPresignClient(r.s3Client)
+	presignedURL, err := presigner.PresignPutObject(context.TODO(), &s3.PutObjectInput{
+		Bucket:        aws.String(r.bucketName),
+		Key:           aws.String(fileKey),
+		ContentType:   aws.String(contentType),
+		ContentLength: aws.Int64(size),
+	}, s3.WithPresignExpires(15*time.Minute))
+	if err != nil {
+		return "", fmt.Errorf("failed to generate presigned upload URL: %w", err)
+	}
+
+	return presignedURL.URL, nil
+}
+
 func (r *S3Repository) DeleteImage(fileKey string) error {
 	_, err := r.s3Client.DeleteObject(context.TODO(), &s3.DeleteObjectInput{
 		Bucket: aws.String(r.bucketName),
@@ -0,0 +1,64 @@
+package infra
+
+import (
+	"context"
+
+	"github.com/aki-13627/animalia/backend-go/ent"
+	"github.com/aki-13627/animalia/backend-go/ent/comment"
+	"github.com/aki-13627/animalia/backend-go/ent/dailytask"
+	"github.com/aki-13627/animalia/backend-go/ent/followrelation"
+	"github.com/aki-13627/animalia/backend-go/ent/like"
+	"github.com/aki-13627/animalia/backend-go/ent/pet"
+	"github.com/aki-13627/animalia/backend-go/ent/post"
+	"github.com/aki-13627/animalia/backend-go/ent/postmedia"
+	"github.com/aki-13627/animalia/backend-go/ent/user"
+	"github.com/google/uuid"
+)
+
+type TakeoutRepository struct {
+	db *ent.Client
+}
+
+func NewTakeoutRepository(db *ent.Client) *TakeoutRepository {
+	return &TakeoutRepository{
+		db: db,
+	}
+}
+
+// selectPostID は関連する投稿の ID だけを読み込む。image_feature は未設定の投稿があり、読み込むと失敗する
+func selectPostID(q *ent.PostQuery) {
+	q.Select(post.FieldID)
+}
+
+func (r *TakeoutRepository) GetUserData(userID uuid.UUID) (*ent.User, error) {
+	return r.db.User.Query().
+		Where(user.ID(userID), user.DeletedAtIsNil()).
+		WithPosts(func(q *ent.PostQuery) {
+			q.Where(post.DeletedAtIsNil()).
+				WithMedia(func(mq *ent.PostMediaQuery) {
+					mq.Order(ent.Asc(postmedia.FieldPosition))
+				}).
+				WithDailyTask().
+				Order(ent.Asc(post.FieldCreatedAt)).
+				Select(post.FieldID, post.FieldCaption, post.FieldImageKey, post.FieldCreatedAt, post.FieldEditedAt, post.FieldVisibility)
+		}).
+		WithPets(func(q *ent.PetQuery) {
+			q.Where(pet.DeletedAtIsNil()).Order(ent.Asc(pet.FieldCreatedAt))
+		}).
+		WithComments(func(q *ent.CommentQuery) {
+			q.Where(comment.DeletedAtIsNil()).WithPost(selectPostID).Order(ent.Asc(comment.FieldCreatedAt))
+		}).
+		WithLikes(func(q *ent.LikeQuery) {
+			q.WithPost(selectPostID).Order(ent.Asc(like.FieldCreatedAt))
+		}).
+		WithFollowing(func(q *ent.FollowRelationQuery) {
+			q.WithTo().Order(ent.Asc(followrelation.FieldCreatedAt))
+		}).
+		WithFollowers(func(q *ent.FollowRelationQuery) {
+			q.WithFrom().Order(ent.Asc(followrelation.FieldCreatedAt))
+		}).
+		WithDailyTasks(func(q *ent.DailyTaskQuery) {
+			q.WithPost(selectPostID).Order(ent.Asc(dailytask.FieldCreatedAt))
+		}).
+		Only(context.Background())
+}
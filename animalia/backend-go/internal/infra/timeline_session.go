@@ -0,0 +1,124 @@
+package infra
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/aki-13627/animalia/backend-go/ent"
+	"github.com/aki-13627/animalia/backend-go/ent/timelinesession"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/models"
+	"github.com/google/uuid"
+)
+
+// TimelineSessionRepository はタイムラインのセッションを Postgres に保存する。
+// Lambda の複数インスタンスやコールドスタートをまたいでも同じセッションを参照できる
+type TimelineSessionRepository struct {
+	db *ent.Client
+}
+
+func NewTimelineSessionRepository(db *ent.Client) *TimelineSessionRepository {
+	return &TimelineSessionRepository{
+		db: db,
+	}
+}
+
+func (r *TimelineSessionRepository) Create(session models.TimelineSession) error {
+	ctx := context.Background()
+	tx, err := r.db.Tx(ctx)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.TimelineSession.Delete().
+		Where(timelinesession.UserID(session.UserID), timelinesession.ExpiresAtLTE(time.Now())).
+		Exec(ctx)
+	if err != nil {
+		return rollback(tx, fmt.Errorf("failed to delete expired timeline sessions: %w", err))
+	}
+	err = tx.TimelineSession.Create().
+		SetID(session.ID).
+		SetUserID(session.UserID).
+		SetPostIds(session.PostIDs).
+		SetExpiresAt(session.ExpiresAt).
+		Exec(ctx)
+	if err != nil {
+		return rollback(tx, fmt.Errorf("failed to create timeline session: %w", err))
+	}
+	return tx.Commit()
+}
+
+func (r *TimelineSessionRepository) Get(id uuid.UUID) (*models.TimelineSession, error) {
+	s, err := r.db.TimelineSession.Get(context.Background(), id)
+	if ent.IsNotFound(err) {
+		return nil, models.ErrTimelineSessionNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &models.TimelineSession{
+		ID:        s.ID,
+		UserID:    s.UserID,
+		PostIDs:   s.PostIds,
+		ExpiresAt: s.ExpiresAt,
+	}, nil
+}
+
+func (r *TimelineSessionRepository) DeleteExpired(now time.Time) (int, error) {
+	return r.db.TimelineSession.Delete().
+		Where(timelinesession.ExpiresAtLTE(now)).
+		Exec(context.Background())
+}
+
+// MemoryTimelineSessionRepository はタイムラインのセッションをプロセス内に保持する。
+// インスタンスをまたいで共有できないため、ローカルでの開発に使う
+type MemoryTimelineSessionRepository struct {
+	mu       sync.Mutex
+	sessions map[uuid.UUID]models.TimelineSession
+}
+
+func NewMemoryTimelineSessionRepository() *MemoryTimelineSessionRepository {
+	return &MemoryTimelineSessionRepository{
+		sessions: make(map[uuid.UUID]models.TimelineSession),
+	}
+}
+
+func (r *MemoryTimelineSessionRepository) Create(session models.TimelineSession) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	now := time.Now()
+	for id, s := range r.sessions {
+		if s.UserID == session.UserID && s.Expired(now) {
+			delete(r.sessions, id)
+		}
+	}
+	session.PostIDs = slices.Clone(session.PostIDs)
+	r.sessions[session.ID] = session
+	return nil
+}
+
+func (r *MemoryTimelineSessionRepository) Get(id uuid.UUID) (*models.TimelineSession, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	s, ok := r.sessions[id]
+	if !ok {
+		return nil, models.ErrTimelineSessionNotFound
+	}
+	s.PostIDs = slices.Clone(s.PostIDs)
+	return &s, nil
+}
+
+func (r *MemoryTimelineSessionRepository) DeleteExpired(now time.Time) (int, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	deleted := 0
+	for id, s := range r.sessions {
+		if s.Expired(now) {
+			delete(r.sessions, id)
+			deleted++
+		}
+	}
+	return deleted, nil
+}
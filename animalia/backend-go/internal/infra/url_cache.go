@@ -0,0 +1,175 @@
+package infra
+
+import (
+	"container/list"
+	"sync"
+	"time"
+
+	"github.com/aki-13627/animalia/backend-go/internal/domain/models"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/repository"
+)
+
+// urlCacheMargin は URL の有効期限が切れる前にキャッシュから外すまでの余裕
+const urlCacheMargin = 10 * time.Minute
+
+type cachedURL struct {
+	key       string
+	url       string
+	expiresAt time.Time
+}
+
+// URLCacheStats はキャッシュのヒット数・ミス数と現在の件数
+type URLCacheStats struct {
+	Hits   uint64 `json:"hits"`
+	Misses uint64 `json:"misses"`
+	Size   int    `json:"size"`
+}
+
+// CachedURLRepository は GetUrl で発行した署名付き URL を有効期限の少し前まで再利用する StorageRepository。
+// フィードやプロフィールでは同じアイコンや画像の URL を何度も発行するため、署名のコストを抑える。
+// 件数は capacity までに制限し、超えた場合は最も長く使われていないものから捨てる。
+type CachedURLRepository struct {
+	repository.StorageRepository
+
+	mu       sync.Mutex
+	capacity int
+	ttl      time.Duration
+	entries  map[string]*list.Element
+	lru      *list.List
+	hits     uint64
+	misses   uint64
+}
+
+func NewCachedURLRepository(inner repository.StorageRepository, capacity int) *CachedURLRepository {
+	return &CachedURLRepository{
+		StorageRepository: inner,
+		capacity:          capacity,
+		ttl:               PresignedURLExpiry - urlCacheMargin,
+		entries:           make(map[string]*list.Element, capacity),
+		lru:               list.New(),
+	}
+}
+
+func (r *CachedURLRepository) GetUrl(fileKey string) (string, error) {
+	if url, ok := r.lookup(fileKey); ok {
+		return url, nil
+	}
+
+	url, err := r.StorageRepository.GetUrl(fileKey)
+	if err != nil {
+		return "", err
+	}
+	r.store(fileKey, url)
+	return url, nil
+}
+
+func (r *CachedURLRepository) GetUrls(fileKeys []string) (map[string]string, error) {
+	urls := make(map[string]string, len(fileKeys))
+	var missing []string
+	for _, key := range fileKeys {
+		if _, ok := urls[key]; ok {
+			continue
+		}
+		if url, ok := r.lookup(key); ok {
+			urls[key] = url
+			continue
+		}
+		urls[key] = ""
+		missing = append(missing, key)
+	}
+	if len(missing) == 0 {
+		return urls, nil
+	}
+
+	fetched, err := r.StorageRepository.GetUrls(missing)
+	if err != nil {
+		return nil, err
+	}
+	for key, url := range fetched {
+		r.store(key, url)
+		urls[key] = url
+	}
+	return urls, nil
+}
+
+// DeleteImage は削除した画像の URL をキャッシュからも取り除く
+func (r *CachedURLRepository) DeleteImage(fileKey string) error {
+	r.mu.Lock()
+	for _, variant := range models.ImageVariants {
+		r.remove(models.ImageVariantKey(fileKey, variant))
+	}
+	r.remove(fileKey)
+	r.mu.Unlock()
+
+	return r.StorageRepository.DeleteImage(fileKey)
+}
+
+func (r *CachedURLRepository) Stats() URLCacheStats {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return URLCacheStats{
+		Hits:   r.hits,
+		Misses: r.misses,
+		Size:   r.lru.Len(),
+	}
+}
+
+func (r *CachedURLRepository) lookup(fileKey string) (string, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	elem, ok := r.entries[fileKey]
+	if ok {
+		entry := elem.Value.(*cachedURL)
+		if time.Now().Before(entry.expiresAt) {
+			r.lru.MoveToFront(elem)
+			r.hits++
+			return entry.url, true
+		}
+		r.remove(fileKey)
+	}
+	r.misses++
+	return "", false
+}
+
+func (r *CachedURLRepository) store(fileKey, url string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	entry := &cachedURL{key: fileKey, url: url, expiresAt: time.Now().Add(r.ttl)}
+	if elem, ok := r.entries[fileKey]; ok {
+		elem.Value = entry
+		r.lru.MoveToFront(elem)
+		return
+	}
+	r.entries[fileKey] = r.lru.PushFront(entry)
+	for r.lru.Len() > r.capacity {
+		oldest := r.lru.Back()
+		r.lru.Remove(oldest)
+		delete(r.entries, oldest.Value.(*cachedURL).key)
+	}
+}
+
+// remove は r.mu を保持した状態で呼び出す
+func (r *CachedURLRepository) remove(fileKey string) {
+	if elem, ok := r.entries[fileKey]; ok {
+		r.lru.Remove(elem)
+		delete(r.entries, fileKey)
+	}
+}
+
+// getUrls は GetUrl を1件ずつ呼び出して URL をまとめる（一括署名の API を持たないストレージ向け）
+func getUrls(r repository.StorageRepository, fileKeys []string) (map[string]string, error) {
+	urls := make(map[string]string, len(fileKeys))
+	for _, key := range fileKeys {
+		if _, ok := urls[key]; ok {
+			continue
+		}
+		url, err := r.GetUrl(key)
+		if err != nil {
+			return nil, err
+		}
+		urls[key] = url
+	}
+	return urls, nil
+}
@@ -0,0 +1,96 @@
+package infra
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/aki-13627/animalia/backend-go/internal/domain/repository/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+// countingStorage は GetUrl / GetUrls で署名したキーを記録し、呼び出しごとに異なる URL を返す
+func countingStorage(signed *[]string) *mock.MockStorageRepository {
+	sign := func(key string) string {
+		*signed = append(*signed, key)
+		return fmt.Sprintf("https://example.com/%s?n=%d", key, len(*signed))
+	}
+	return &mock.MockStorageRepository{
+		GetUrlFunc: func(fileKey string) (string, error) {
+			return sign(fileKey), nil
+		},
+		GetUrlsFunc: func(fileKeys []string) (map[string]string, error) {
+			urls := make(map[string]string, len(fileKeys))
+			for _, key := range fileKeys {
+				urls[key] = sign(key)
+			}
+			return urls, nil
+		},
+	}
+}
+
+func TestCachedURLRepository_Eviction(t *testing.T) {
+	var signed []string
+	repo := NewCachedURLRepository(countingStorage(&signed), 2)
+
+	a, _ := repo.GetUrl("a")
+	repo.GetUrl("b")
+	// a を使うと b が最も長く使われていないものになる
+	again, _ := repo.GetUrl("a")
+	assert.Equal(t, a, again)
+	repo.GetUrl("c")
+
+	repo.GetUrl("a")
+	repo.GetUrl("c")
+	repo.GetUrl("b")
+
+	assert.Equal(t, []string{"a", "b", "c", "b"}, signed)
+	assert.Equal(t, URLCacheStats{Hits: 3, Misses: 4, Size: 2}, repo.Stats())
+}
+
+func TestCachedURLRepository_TTL(t *testing.T) {
+	var signed []string
+	repo := NewCachedURLRepository(countingStorage(&signed), 10)
+	assert.Equal(t, PresignedURLExpiry-urlCacheMargin, repo.ttl)
+
+	first, _ := repo.GetUrl("posts/a/full.jpg")
+	// 有効期限の余裕の手前までは同じ URL を使い回す
+	repo.entries["posts/a/full.jpg"].Value.(*cachedURL).expiresAt = time.Now().Add(time.Minute)
+	reused, _ := repo.GetUrl("posts/a/full.jpg")
+	assert.Equal(t, first, reused)
+
+	// 余裕を切ったら署名し直す
+	repo.entries["posts/a/full.jpg"].Value.(*cachedURL).expiresAt = time.Now().Add(-time.Second)
+	renewed, _ := repo.GetUrl("posts/a/full.jpg")
+	assert.NotEqual(t, first, renewed)
+
+	assert.Equal(t, []string{"posts/a/full.jpg", "posts/a/full.jpg"}, signed)
+	assert.Equal(t, URLCacheStats{Hits: 1, Misses: 2, Size: 1}, repo.Stats())
+}
+
+func TestCachedURLRepository_GetUrls(t *testing.T) {
+	var signed []string
+	repo := NewCachedURLRepository(countingStorage(&signed), 10)
+	cached, _ := repo.GetUrl("a")
+
+	urls, err := repo.GetUrls([]string{"a", "b", "b", "c"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, cached, urls["a"])
+	assert.Len(t, urls, 3)
+	assert.Equal(t, []string{"a", "b", "c"}, signed)
+}
+
+func TestCachedURLRepository_DeleteImage(t *testing.T) {
+	var signed []string
+	storage := countingStorage(&signed)
+	storage.DeleteImageFunc = func(fileKey string) error {
+		return nil
+	}
+	repo := NewCachedURLRepository(storage, 10)
+	repo.GetUrls([]string{"posts/a/full.jpg", "posts/a/thumb.jpg"})
+
+	assert.NoError(t, repo.DeleteImage("posts/a/full.jpg"))
+
+	assert.Equal(t, 0, repo.Stats().Size)
+}
@@ -60,13 +60,13 @@ func (r *UserRepository) ExistsEmail(email string) (bool, error) {
 }
 
 func (r *UserRepository) FindByEmail(email string) (*ent.User, error) {
-	user, err := r.db.User.Query().Where(user.Email(email)).
+	user, err := r.db.User.Query().Where(user.Email(email), user.DeletedAtIsNil()).
 		WithFollowing(func(q *ent.FollowRelationQuery) {
-			q.WithTo()
+			q.Where(approved()).WithTo()
 		}).
 		WithFollowers(
 			func(q *ent.FollowRelationQuery) {
-				q.WithFrom()
+				q.Where(approved()).WithFrom()
 			}).
 		WithDailyTasks(func(q *ent.DailyTaskQuery) {
 			q.WithPost(func(pq *ent.PostQuery) {
@@ -84,13 +84,25 @@ func (r *UserRepository) FindByEmail(email string) (*ent.User, error) {
 	return user, nil
 }
 
+func (r *UserRepository) FindBySubject(subject string) (*ent.User, error) {
+	return r.db.User.Query().
+		Where(user.AuthSubject(subject), user.DeletedAtIsNil()).
+		Only(context.Background())
+}
+
+func (r *UserRepository) LinkSubject(id uuid.UUID, subject string) error {
+	return r.db.User.UpdateOneID(id).SetAuthSubject(subject).Exec(context.Background())
+}
+
 func (r *UserRepository) GetById(id string) (*ent.User, error) {
 	userUUID, err := uuid.Parse(id)
 	if err != nil {
 		return nil, err
 	}
 
-	user, err := r.db.User.Get(context.Background(), userUUID)
+	user, err := r.db.User.Query().
+		Where(user.ID(userUUID), user.DeletedAtIsNil()).
+		Only(context.Background())
 	if err != nil {
 		return nil, err
 	}
@@ -111,26 +123,63 @@ func (r *UserRepository) Update(id string, name string, description string, newI
 	return err
 }
 
-func (r *UserRepository) Follow(toId string, fromId string) error {
-	fromUUID, err := uuid.Parse(fromId)
+func (r *UserRepository) UpdateEmail(id uuid.UUID, email string, beforeCommit func() error) error {
+	ctx := context.Background()
+	tx, err := r.db.Tx(ctx)
 	if err != nil {
 		return err
 	}
 
+	exists, err := tx.User.Query().Where(user.Email(email), user.IDNEQ(id)).Exist(ctx)
+	if err != nil {
+		return rollback(tx, err)
+	}
+	if exists {
+		return rollback(tx, fmt.Errorf("このメールアドレスは既に登録されています"))
+	}
+	if err := tx.User.UpdateOneID(id).SetEmail(email).Exec(ctx); err != nil {
+		return rollback(tx, fmt.Errorf("failed to update email: %w", err))
+	}
+	if err := beforeCommit(); err != nil {
+		return rollback(tx, err)
+	}
+	return tx.Commit()
+}
+
+func (r *UserRepository) Follow(toId string, fromId string) (followrelation.Status, error) {
+	fromUUID, err := uuid.Parse(fromId)
+	if err != nil {
+		return "", err
+	}
+
 	toUUID, err := uuid.Parse(toId)
 	if err != nil {
-		return err
+		return "", err
+	}
+
+	ctx := context.Background()
+	target, err := r.db.User.Query().
+		Where(user.ID(toUUID), user.DeletedAtIsNil()).
+		Select(user.FieldIsPrivate).
+		Only(ctx)
+	if err != nil {
+		return "", err
+	}
+	status := followrelation.StatusApproved
+	if target.IsPrivate {
+		status = followrelation.StatusPending
 	}
 
 	_, err = r.db.FollowRelation.Create().
 		SetFromID(fromUUID).
 		SetToID(toUUID).
-		Save(context.Background())
+		SetStatus(status).
+		Save(ctx)
 	if err != nil {
-		return fmt.Errorf("failed to create follow relation in database: %w", err)
+		return "", fmt.Errorf("failed to create follow relation in database: %w", err)
 	}
 
-	return nil
+	return status, nil
 }
 
 func (r *UserRepository) Unfollow(toId string, fromId string) error {
@@ -158,3 +207,25 @@ func (r *UserRepository) Unfollow(toId string, fromId string) error {
 
 	return nil
 }
+
+func (r *UserRepository) SetPrivate(id uuid.UUID, isPrivate bool) error {
+	ctx := context.Background()
+	tx, err := r.db.Tx(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := tx.User.UpdateOneID(id).SetIsPrivate(isPrivate).Exec(ctx); err != nil {
+		return rollback(tx, err)
+	}
+	if !isPrivate {
+		_, err = tx.FollowRelation.Update().
+			Where(followrelation.HasToWith(user.ID(id)), followrelation.StatusEQ(followrelation.StatusPending)).
+			SetStatus(followrelation.StatusApproved).
+			Save(ctx)
+		if err != nil {
+			return rollback(tx, fmt.Errorf("failed to approve follow requests: %w", err))
+		}
+	}
+	return tx.Commit()
+}
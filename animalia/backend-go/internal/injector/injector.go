@@ -2,8 +2,11 @@ package injector
 
 import (
 	"context"
+	"crypto/rand"
 	"log"
 	"os"
+	"strconv"
+	"time"
 
 	"github.com/aki-13627/animalia/backend-go/ent"
 	"github.com/aki-13627/animalia/backend-go/internal/domain/middlewares"
@@ -16,6 +19,22 @@ import (
 
 var client *ent.Client
 
+var localStorageRepository *infra.LocalStorageRepository
+
+var uploadTokenSecretBytes []byte
+
+var passwordAuthRepository *infra.PasswordAuthRepository
+
+var storageRepository repository.StorageRepository
+
+var urlCacheRepository *infra.CachedURLRepository
+
+var tokenVerifier repository.TokenVerifier
+
+var timelineSessionRepository repository.TimelineSessionRepository
+
+var recommendationRepository repository.RecommendationRepository
+
 func InjectDB() *ent.Client {
 	if client == nil {
 		var err error
@@ -28,15 +47,65 @@ func InjectDB() *ent.Client {
 		if err := client.Schema.Create(context.Background()); err != nil {
 			log.Fatalf("failed creating schema resources: %v", err)
 		}
+		if err := infra.BackfillPostMedia(client); err != nil {
+			log.Fatalf("failed backfilling post media: %v", err)
+		}
 	}
 	return client
 }
 
+// UsePasswordAuth は AUTH_PROVIDER=password のときに Cognito の代わりに組み込みのパスワード認証を使う
+func UsePasswordAuth() bool {
+	return os.Getenv("AUTH_PROVIDER") == "password"
+}
+
+func InjectAuthRepository() repository.AuthRepository {
+	if UsePasswordAuth() {
+		return InjectPasswordAuthRepository()
+	}
+	return InjectCognitoRepository()
+}
+
 func InjectCognitoRepository() repository.AuthRepository {
 	authRepository := infra.NewCognitoRepository()
 	return authRepository
 }
 
+func InjectPasswordAuthRepository() *infra.PasswordAuthRepository {
+	if passwordAuthRepository == nil {
+		issuer := os.Getenv("AUTH_TOKEN_ISSUER")
+		if issuer == "" {
+			issuer = "animalia"
+		}
+		passwordAuthRepository = infra.NewPasswordAuthRepository(InjectDB(), InjectMailer(), mustSecretFromEnv("AUTH_TOKEN_SECRET"), issuer)
+	}
+	return passwordAuthRepository
+}
+
+// InjectMailer は MAILER=smtp のときに SMTP でメールを送り、それ以外はログに出力する
+func InjectMailer() repository.Mailer {
+	if os.Getenv("MAILER") == "smtp" {
+		port := os.Getenv("SMTP_PORT")
+		if port == "" {
+			port = "587"
+		}
+		return infra.NewSMTPMailer(os.Getenv("SMTP_HOST"), port, os.Getenv("SMTP_USERNAME"), os.Getenv("SMTP_PASSWORD"), os.Getenv("MAIL_FROM"))
+	}
+	return infra.NewLogMailer()
+}
+
+// InjectTokenVerifier は JWK セットのキャッシュを共有するため、プロセスで1つだけ生成する
+func InjectTokenVerifier() repository.TokenVerifier {
+	if tokenVerifier == nil {
+		if UsePasswordAuth() {
+			tokenVerifier = InjectPasswordAuthRepository()
+		} else {
+			tokenVerifier = infra.NewCognitoJWTVerifier()
+		}
+	}
+	return tokenVerifier
+}
+
 func InjectUserRepository() repository.UserRepository {
 	userRepository := infra.NewUserRepository(InjectDB())
 	return userRepository
@@ -47,6 +116,11 @@ func InjectFollowRelationRepository() repository.FollowRelationRepository {
 	return followRelationRepository
 }
 
+func InjectBlockRepository() repository.BlockRepository {
+	blockRepository := infra.NewBlockRepository(InjectDB())
+	return blockRepository
+}
+
 func InjectPostRepository() repository.PostRepository {
 	postRepository := infra.NewPostRepository(InjectDB())
 	return postRepository
@@ -57,11 +131,138 @@ func InjectPetRepository() repository.PetRepository {
 	return petRepository
 }
 
+// UseLocalStorage は STORAGE_BACKEND=local のときにローカルファイルシステムを使う
+func UseLocalStorage() bool {
+	return os.Getenv("STORAGE_BACKEND") == "local"
+}
+
+func InjectLocalStorageRepository() *infra.LocalStorageRepository {
+	if localStorageRepository == nil {
+		baseDir := os.Getenv("LOCAL_STORAGE_DIR")
+		if baseDir == "" {
+			baseDir = "./storage"
+		}
+		baseURL := os.Getenv("LOCAL_STORAGE_BASE_URL")
+		if baseURL == "" {
+			port := os.Getenv("PORT")
+			if port == "" {
+				port = "3000"
+			}
+			baseURL = "http://localhost:" + port
+		}
+		secret := []byte(os.Getenv("LOCAL_STORAGE_SECRET"))
+		if len(secret) == 0 {
+			// 未設定の場合はプロセスごとのランダムな鍵を使う（再起動で URL は無効になる）
+			log.Println("Warning: LOCAL_STORAGE_SECRET is not set, using a random secret")
+			secret = make([]byte, 32)
+			if _, err := rand.Read(secret); err != nil {
+				log.Fatalf("failed generating local storage secret: %v", err)
+			}
+		}
+		localStorageRepository = infra.NewLocalStorageRepository(baseDir, baseURL, secret)
+	}
+	return localStorageRepository
+}
+
+// secretFromEnv は環境変数 name の値を鍵として返す。未設定の場合はプロセスごとのランダムな鍵を使う
+func secretFromEnv(name string) []byte {
+	secret := []byte(os.Getenv(name))
+	if len(secret) == 0 {
+		log.Printf("Warning: %s is not set, using a random secret", name)
+		secret = make([]byte, 32)
+		if _, err := rand.Read(secret); err != nil {
+			log.Fatalf("failed generating %s: %v", name, err)
+		}
+	}
+	return secret
+}
+
+// mustSecretFromEnv は環境変数 name の値を鍵として返す。複数のインスタンスで同じ鍵を使う必要があるため、未設定なら起動を中止する
+func mustSecretFromEnv(name string) []byte {
+	secret := []byte(os.Getenv(name))
+	if len(secret) == 0 {
+		log.Fatalf("%s must be set", name)
+	}
+	return secret
+}
+
+// uploadTokenSecret はアップロードトークンを発行したインスタンスと検証するインスタンスが異なりうるため、
+// ローカルストレージ以外では UPLOAD_TOKEN_SECRET を必須にする
+func uploadTokenSecret() []byte {
+	if uploadTokenSecretBytes == nil {
+		if UseLocalStorage() {
+			uploadTokenSecretBytes = secretFromEnv("UPLOAD_TOKEN_SECRET")
+		} else {
+			uploadTokenSecretBytes = mustSecretFromEnv("UPLOAD_TOKEN_SECRET")
+		}
+	}
+	return uploadTokenSecretBytes
+}
+
+// InjectStorageRepository は URL のキャッシュをリクエスト間で共有するため、プロセスで1つだけ生成する
 func InjectStorageRepository() repository.StorageRepository {
-	storageRepository := infra.NewS3Repository(os.Getenv("AWS_S3_BUCKET_NAME"))
+	if storageRepository == nil {
+		var baseRepository repository.StorageRepository
+		if UseLocalStorage() {
+			baseRepository = InjectLocalStorageRepository()
+		} else {
+			baseRepository = infra.NewS3Repository(os.Getenv("AWS_S3_BUCKET_NAME"))
+		}
+
+		capacity := 10000
+		if v, err := strconv.Atoi(os.Getenv("URL_CACHE_SIZE")); err == nil && v > 0 {
+			capacity = v
+		}
+		urlCacheRepository = infra.NewCachedURLRepository(infra.NewImagePipelineRepository(baseRepository), capacity)
+		storageRepository = urlCacheRepository
+	}
 	return storageRepository
 }
 
+// URLCacheStats は署名付き URL のキャッシュのヒット数・ミス数と件数を返す
+func URLCacheStats() infra.URLCacheStats {
+	InjectStorageRepository()
+	return urlCacheRepository.Stats()
+}
+
+func InjectImageReferenceRepository() repository.ImageReferenceRepository {
+	imageReferenceRepository := infra.NewImageReferenceRepository(InjectDB())
+	return imageReferenceRepository
+}
+
+func InjectVideoRepository() repository.VideoRepository {
+	ffmpegPath := os.Getenv("FFMPEG_PATH")
+	if ffmpegPath == "" {
+		ffmpegPath = "ffmpeg"
+	}
+	ffprobePath := os.Getenv("FFPROBE_PATH")
+	if ffprobePath == "" {
+		ffprobePath = "ffprobe"
+	}
+	videoRepository := infra.NewFFmpegRepository(ffmpegPath, ffprobePath)
+	return videoRepository
+}
+
+func InjectAccountRepository() repository.AccountRepository {
+	accountRepository := infra.NewAccountRepository(InjectDB())
+	return accountRepository
+}
+
+func InjectTakeoutRepository() repository.TakeoutRepository {
+	takeoutRepository := infra.NewTakeoutRepository(InjectDB())
+	return takeoutRepository
+}
+
+func InjectAdminRepository() repository.AdminRepository {
+	adminRepository := infra.NewAdminRepository(InjectDB())
+	return adminRepository
+}
+
+func InjectReportRepository() repository.ReportRepository {
+	reportRepository := infra.NewReportRepository(InjectDB())
+	return reportRepository
+}
+
 func InjectLikeRepository() repository.LikeRepository {
 	likeRepository := infra.NewLikeRepository(InjectDB())
 	return likeRepository
@@ -78,7 +279,7 @@ func InjectDailyTaskRepository() repository.DailyTaskRepository {
 }
 
 func InjectAuthUsecase() usecase.AuthUsecase {
-	authUsecase := usecase.NewAuthUsecase(InjectCognitoRepository(), InjectUserRepository())
+	authUsecase := usecase.NewAuthUsecase(InjectAuthRepository(), InjectUserRepository(), InjectTokenVerifier())
 	return *authUsecase
 }
 
@@ -93,22 +294,56 @@ func InjectPetUsecase() usecase.PetUsecase {
 }
 
 func InjectStorageUsecase() usecase.StorageUsecase {
-	storageUsecase := usecase.NewStorageUsecase(InjectStorageRepository())
+	storageUsecase := usecase.NewStorageUsecase(InjectStorageRepository(), InjectVideoRepository(), uploadTokenSecret())
 	return *storageUsecase
 }
 
+func InjectImageGCUsecase() usecase.ImageGCUsecase {
+	imageGCUsecase := usecase.NewImageGCUsecase(InjectStorageRepository(), InjectImageReferenceRepository())
+	return *imageGCUsecase
+}
+
+func InjectAccountUsecase() usecase.AccountUsecase {
+	accountUsecase := usecase.NewAccountUsecase(InjectAccountRepository(), InjectAuthRepository(), InjectStorageRepository())
+	return *accountUsecase
+}
+
+func InjectTakeoutUsecase() usecase.TakeoutUsecase {
+	takeoutUsecase := usecase.NewTakeoutUsecase(InjectTakeoutRepository(), InjectStorageRepository())
+	return *takeoutUsecase
+}
+
+func InjectAdminUsecase() usecase.AdminUsecase {
+	adminUsecase := usecase.NewAdminUsecase(InjectAdminRepository())
+	return *adminUsecase
+}
+
+func InjectReportUsecase() usecase.ReportUsecase {
+	threshold := usecase.DefaultReportAutoHideThreshold
+	if v, err := strconv.Atoi(os.Getenv("REPORT_AUTO_HIDE_THRESHOLD")); err == nil {
+		threshold = v
+	}
+	reportUsecase := usecase.NewReportUsecase(InjectReportRepository(), threshold)
+	return *reportUsecase
+}
+
 func InjectUserUsecase() usecase.UserUsecase {
-	userUsecase := usecase.NewUserUsecase(InjectUserRepository(), InjectStorageRepository(), InjectPostRepository(), InjectPetRepository(), InjectFollowRelationRepository())
+	userUsecase := usecase.NewUserUsecase(InjectUserRepository(), InjectStorageRepository(), InjectPostRepository(), InjectPetRepository(), InjectFollowRelationRepository(), InjectBlockRepository())
 	return *userUsecase
 }
 
+func InjectBlockUsecase() usecase.BlockUsecase {
+	blockUsecase := usecase.NewBlockUsecase(InjectBlockRepository(), InjectStorageRepository())
+	return *blockUsecase
+}
+
 func InjectLikeUsecase() usecase.LikeUsecase {
-	likeUsecase := usecase.NewLikeUsecase(InjectLikeRepository())
+	likeUsecase := usecase.NewLikeUsecase(InjectLikeRepository(), InjectPostRepository(), InjectBlockRepository(), InjectStorageRepository())
 	return *likeUsecase
 }
 
 func InjectCommentUsecase() usecase.CommentUsecase {
-	commentUsecase := usecase.NewCommentUsecase(InjectCommentRepository(), InjectPostRepository(), InjectStorageRepository())
+	commentUsecase := usecase.NewCommentUsecase(InjectCommentRepository(), InjectPostRepository(), InjectStorageRepository(), InjectBlockRepository())
 	return *commentUsecase
 }
 
@@ -117,12 +352,66 @@ func InjectDailyTaskUsecase() usecase.DailyTaskUsecase {
 	return *dailytaskUsecase
 }
 
-func InjectCacheUsecase() usecase.CacheUsecase {
-	return usecase.NewCacheUsecase()
+// InjectTimelineSessionRepository は TIMELINE_SESSION_BACKEND=memory のときプロセス内にセッションを保持する。
+// 既定は Postgres で、複数のインスタンスやコールドスタートをまたいでセッションを共有できる
+func InjectTimelineSessionRepository() repository.TimelineSessionRepository {
+	if timelineSessionRepository == nil {
+		if os.Getenv("TIMELINE_SESSION_BACKEND") == "memory" {
+			timelineSessionRepository = infra.NewMemoryTimelineSessionRepository()
+		} else {
+			timelineSessionRepository = infra.NewTimelineSessionRepository(InjectDB())
+		}
+	}
+	return timelineSessionRepository
+}
+
+// InjectRecommendationRepository はサーキットブレーカーの状態をリクエスト間で共有するため、プロセスで1つだけ生成する
+func InjectRecommendationRepository() repository.RecommendationRepository {
+	if recommendationRepository == nil {
+		baseURL := os.Getenv("RECOMMENDATION_API_URL")
+		if baseURL == "" {
+			baseURL = "https://animalia-lnzk.onrender.com"
+		}
+		config := infra.DefaultRecommendationConfig(baseURL)
+		if v, err := time.ParseDuration(os.Getenv("RECOMMENDATION_TIMEOUT")); err == nil && v > 0 {
+			config.Timeout = v
+		}
+		if v, err := strconv.Atoi(os.Getenv("RECOMMENDATION_MAX_RETRIES")); err == nil && v >= 0 {
+			config.MaxRetries = v
+		}
+		recommendationRepository = infra.NewHTTPRecommendationRepository(config)
+	}
+	return recommendationRepository
+}
+
+func InjectFeedRepository() repository.FeedRepository {
+	feedRepository := infra.NewFeedRepository(InjectDB())
+	return feedRepository
+}
+
+// InjectTimelineUsecase は TIMELINE_RANKER=feed のとき推薦 API を呼ばずに Go のランキングでタイムラインを並べる
+func InjectTimelineUsecase() usecase.TimelineUsecase {
+	ttl := usecase.DefaultTimelineSessionTTL
+	if v, err := time.ParseDuration(os.Getenv("TIMELINE_SESSION_TTL")); err == nil && v > 0 {
+		ttl = v
+	}
+	ranker := usecase.RankerRecommendation
+	if os.Getenv("TIMELINE_RANKER") == string(usecase.RankerFeed) {
+		ranker = usecase.RankerFeed
+	}
+	timelineUsecase := usecase.NewTimelineUsecase(
+		InjectTimelineSessionRepository(),
+		InjectPostRepository(),
+		InjectRecommendationRepository(),
+		usecase.NewFeedRanker(InjectFeedRepository(), usecase.DefaultFeedWeights),
+		ranker,
+		ttl,
+	)
+	return *timelineUsecase
 }
 
 func InjectAuthHandler() handler.AuthHandler {
-	authHandler := handler.NewAuthHandler(InjectAuthUsecase(), InjectUserUsecase(), InjectStorageUsecase(), InjectDailyTaskUsecase())
+	authHandler := handler.NewAuthHandler(InjectAuthUsecase(), InjectUserUsecase(), InjectStorageUsecase(), InjectDailyTaskUsecase(), InjectAccountUsecase())
 	return *authHandler
 }
 
@@ -130,30 +419,65 @@ func InjectPostHandler() *handler.PostHandler {
 	return handler.NewPostHandler(
 		InjectPostUsecase(),
 		InjectStorageUsecase(),
-		InjectCacheUsecase(),
+		InjectTimelineUsecase(),
+		InjectPublicBaseURL(),
 	)
 }
 
+func InjectShareHandler() *handler.ShareHandler {
+	return handler.NewShareHandler(InjectPostUsecase(), InjectStorageUsecase(), InjectPublicBaseURL())
+}
+
+// InjectPublicBaseURL は共有ページの URL に使うベース URL。未設定の場合はリクエストのホストを使う
+func InjectPublicBaseURL() string {
+	return os.Getenv("PUBLIC_BASE_URL")
+}
+
+func InjectStorageHandler() handler.StorageHandler {
+	storageHandler := handler.NewStorageHandler(InjectStorageUsecase())
+	return *storageHandler
+}
+
 func InjectPetHandler() handler.PetHandler {
 	petHandler := handler.NewPetHandler(InjectPetUsecase(), InjectStorageUsecase())
 	return *petHandler
 }
 
 func InjectUserHandler() handler.UserHandler {
-	userHandler := handler.NewUserHandler(InjectUserUsecase(), InjectStorageUsecase())
+	userHandler := handler.NewUserHandler(InjectUserUsecase(), InjectStorageUsecase(), InjectAccountUsecase(), InjectTakeoutUsecase())
 	return *userHandler
 }
 
+func InjectBlockHandler() handler.BlockHandler {
+	blockHandler := handler.NewBlockHandler(InjectBlockUsecase())
+	return *blockHandler
+}
+
 func InjectLikeHandler() handler.LikeHandler {
 	likeHandler := handler.NewLikeHandler(InjectLikeUsecase())
 	return *likeHandler
 }
 
 func InjectCommentHandler() handler.CommentHandler {
-	commentHandler := handler.NewCommentHandler(InjectCommentUsecase(), InjectUserUsecase())
+	commentHandler := handler.NewCommentHandler(InjectCommentUsecase())
 	return *commentHandler
 }
 
+func InjectAdminHandler() handler.AdminHandler {
+	adminHandler := handler.NewAdminHandler(InjectAdminUsecase())
+	return *adminHandler
+}
+
+func InjectReportHandler() handler.ReportHandler {
+	reportHandler := handler.NewReportHandler(InjectReportUsecase())
+	return *reportHandler
+}
+
+func InjectSignedURLMiddleware() middlewares.SignedURLMiddleware {
+	signedURLMiddleware := middlewares.NewSignedURLMiddleware(InjectLocalStorageRepository(), infra.LocalStorageRoutePrefix)
+	return *signedURLMiddleware
+}
+
 func InjectAuthMiddleware() middlewares.AuthMiddleware {
 	authMiddleware := middlewares.NewAuthMiddleware(InjectAuthUsecase())
 	return *authMiddleware
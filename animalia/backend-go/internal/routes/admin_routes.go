@@ -0,0 +1,49 @@
+package routes
+
+import (
+	"github.com/aki-13627/animalia/backend-go/ent/user"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/middlewares"
+	"github.com/aki-13627/animalia/backend-go/internal/injector"
+	"github.com/labstack/echo/v4"
+)
+
+// SetupAdminRoutes sets up the admin routes. Moderators can triage reports; everything else requires an admin
+func SetupAdminRoutes(app *echo.Echo) {
+	adminHandler := injector.InjectAdminHandler()
+	reportHandler := injector.InjectReportHandler()
+	authMiddleware := injector.InjectAuthMiddleware()
+	adminGroup := app.Group("/admin", authMiddleware.Handler, middlewares.RequireRole(user.RoleModerator, user.RoleAdmin))
+	requireAdmin := middlewares.RequireRole(user.RoleAdmin)
+
+	// Reports
+	adminGroup.GET("/reports", adminHandler.ListReports)
+	adminGroup.POST("/reports/:id/dismiss", reportHandler.DismissReport)
+	adminGroup.POST("/reports/:id/hide", reportHandler.HideReported)
+	adminGroup.POST("/reports/:id/suspend-author", reportHandler.SuspendAuthor)
+
+	// Users
+	adminGroup.GET("/users", adminHandler.ListUsers, requireAdmin)
+	adminGroup.POST("/users/:id/suspend", adminHandler.SuspendUser, requireAdmin)
+	adminGroup.POST("/users/:id/unsuspend", adminHandler.UnsuspendUser, requireAdmin)
+
+	// Force delete content
+	adminGroup.DELETE("/posts/:id", adminHandler.DeletePost, requireAdmin)
+	adminGroup.DELETE("/comments/:id", adminHandler.DeleteComment, requireAdmin)
+
+	// Audit logs
+	adminGroup.GET("/audit-logs", adminHandler.ListAuditLogs, requireAdmin)
+
+	// List all routes
+	adminGroup.GET("/routes", func(c echo.Context) error {
+		routes := []map[string]string{}
+		for _, r := range app.Routes() {
+			routes = append(routes, map[string]string{
+				"method": r.Method,
+				"path":   r.Path,
+			})
+		}
+		return c.JSON(200, map[string]interface{}{
+			"routes": routes,
+		})
+	}, requireAdmin)
+}
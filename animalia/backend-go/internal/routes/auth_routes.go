@@ -31,4 +31,18 @@ func SetupAuthRoutes(app *echo.Echo) {
 
 	// Get session
 	authGroup.GET("/session", authHandler.GetSession)
+
+	// Reset a forgotten password
+	authGroup.POST("/forgot-password", authHandler.ForgotPassword)
+	authGroup.POST("/confirm-forgot-password", authHandler.ConfirmForgotPassword)
+
+	// Change password
+	authGroup.POST("/change-password", authHandler.ChangePassword, authMiddleware.Handler)
+
+	// Restore a deleted account within the grace period
+	authGroup.POST("/restore", authHandler.RestoreAccount)
+
+	// Change email
+	authGroup.POST("/change-email", authHandler.RequestEmailChange, authMiddleware.Handler)
+	authGroup.POST("/confirm-email-change", authHandler.ConfirmEmailChange, authMiddleware.Handler)
 }
@@ -15,4 +15,7 @@ func SetupCommentRoutes(app *echo.Echo) {
 
 	// Delete a comment
 	commentGroup.DELETE("", commentHandler.Delete)
+
+	// List comments on a post, newest first
+	app.GET("/posts/:id/comments", commentHandler.ListByPost, authMiddleware.Handler)
 }
@@ -18,4 +18,7 @@ func SetupLikeRoutes(app *echo.Echo) {
 
 	// Count likes for a post
 	likeGroup.GET("/count", likeHandler.Count)
+
+	// List users who liked a post, newest first
+	app.GET("/posts/:id/likes", likeHandler.ListByPost, authMiddleware.Handler)
 }
@@ -19,6 +19,18 @@ func SetupPostRoutes(app *echo.Echo) {
 	// Create a new post
 	postGroup.POST("", postHandler.CreatePost)
 
+	// Get a post with its comments and likes
+	postGroup.GET("/:id", postHandler.GetPost)
+
+	// Edit the caption of a post
+	postGroup.PUT("/:id", postHandler.UpdatePost)
+
+	// Get the caption history of a post
+	postGroup.GET("/:id/revisions", postHandler.GetRevisions)
+
+	// Change who can see a post
+	postGroup.PUT("/:id/visibility", postHandler.UpdateVisibility)
+
 	// Delete　a post
 	postGroup.DELETE("/delete", postHandler.DeletePost)
 }
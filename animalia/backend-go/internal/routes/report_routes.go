@@ -0,0 +1,16 @@
+package routes
+
+import (
+	"github.com/aki-13627/animalia/backend-go/internal/injector"
+	"github.com/labstack/echo/v4"
+)
+
+// SetupReportRoutes sets up the report routes
+func SetupReportRoutes(app *echo.Echo) {
+	reportHandler := injector.InjectReportHandler()
+	authMiddleware := injector.InjectAuthMiddleware()
+	reportGroup := app.Group("/reports", authMiddleware.Handler)
+
+	// Report a post, comment or user
+	reportGroup.POST("", reportHandler.CreateReport)
+}
@@ -0,0 +1,18 @@
+package routes
+
+import (
+	"github.com/aki-13627/animalia/backend-go/internal/injector"
+	"github.com/labstack/echo/v4"
+)
+
+// SetupShareRoutes sets up the public share pages used for link previews
+func SetupShareRoutes(app *echo.Echo) {
+	shareHandler := injector.InjectShareHandler()
+	shareGroup := app.Group("/share")
+
+	// Render Open Graph / Twitter card HTML for a public post
+	shareGroup.GET("/posts/:id", shareHandler.SharePost)
+
+	// Redirect to a freshly signed URL of the post's image
+	shareGroup.GET("/posts/:id/image", shareHandler.SharePostImage)
+}
@@ -0,0 +1,23 @@
+package routes
+
+import (
+	"github.com/aki-13627/animalia/backend-go/internal/injector"
+	"github.com/labstack/echo/v4"
+)
+
+// SetupStorageRoutes serves locally stored files when STORAGE_BACKEND=local
+func SetupStorageRoutes(app *echo.Echo) {
+	if !injector.UseLocalStorage() {
+		return
+	}
+	localStorage := injector.InjectLocalStorageRepository()
+	storageHandler := injector.InjectStorageHandler()
+	signedURLMiddleware := injector.InjectSignedURLMiddleware()
+	storageGroup := app.Group("/storage", signedURLMiddleware.Handler)
+
+	// Serve files with signed, expiring URLs
+	storageGroup.Static("/", localStorage.BaseDir())
+
+	// Accept direct uploads to presigned PUT URLs
+	storageGroup.PUT("/*", storageHandler.PutObject)
+}
@@ -0,0 +1,16 @@
+package routes
+
+import (
+	"github.com/aki-13627/animalia/backend-go/internal/injector"
+	"github.com/labstack/echo/v4"
+)
+
+// SetupUploadRoutes sets up the direct upload routes
+func SetupUploadRoutes(app *echo.Echo) {
+	storageHandler := injector.InjectStorageHandler()
+	authMiddleware := injector.InjectAuthMiddleware()
+	uploadGroup := app.Group("/uploads", authMiddleware.Handler)
+
+	// Issue a presigned PUT URL and an upload token
+	uploadGroup.POST("", storageHandler.IssueUpload)
+}
@@ -7,6 +7,7 @@ import (
 
 func SetupUserRoutes(app *echo.Echo) {
 	userHandler := injector.InjectUserHandler()
+	blockHandler := injector.InjectBlockHandler()
 	authMiddleware := injector.InjectAuthMiddleware()
 	userGroup := app.Group("/users", authMiddleware.Handler)
 
@@ -14,6 +15,18 @@ func SetupUserRoutes(app *echo.Echo) {
 
 	userGroup.PUT("/update", userHandler.UpdateUser)
 
+	userGroup.DELETE("/me", userHandler.DeleteMe)
+
+	userGroup.POST("/me/export", userHandler.ExportMe)
+
+	userGroup.PUT("/me/privacy", userHandler.UpdatePrivacy)
+
+	userGroup.GET("/me/follow-requests", userHandler.ListFollowRequests)
+
+	userGroup.POST("/me/follow-requests/:id/approve", userHandler.ApproveFollowRequest)
+
+	userGroup.POST("/me/follow-requests/:id/reject", userHandler.RejectFollowRequest)
+
 	userGroup.POST("/follow", userHandler.Follow)
 
 	userGroup.DELETE("/unfollow", userHandler.Unfollow)
@@ -25,4 +38,18 @@ func SetupUserRoutes(app *echo.Echo) {
 	userGroup.GET("/follower_users", userHandler.GetFollowerUsers)
 
 	userGroup.GET("/follows_users", userHandler.GetFollowsUsers)
+
+	userGroup.GET("/:id/posts", userHandler.GetUserPosts)
+
+	userGroup.GET("/me/blocks", blockHandler.ListBlocked)
+
+	userGroup.GET("/me/mutes", blockHandler.ListMuted)
+
+	userGroup.POST("/:id/block", blockHandler.Block)
+
+	userGroup.DELETE("/:id/block", blockHandler.Unblock)
+
+	userGroup.POST("/:id/mute", blockHandler.Mute)
+
+	userGroup.DELETE("/:id/mute", blockHandler.Unmute)
 }
@@ -0,0 +1,135 @@
+package usecase
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aki-13627/animalia/backend-go/ent"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/models"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/repository"
+	"github.com/labstack/gommon/log"
+)
+
+// AccountDeletionGracePeriod は退会してからアカウントを復元できる期間
+const AccountDeletionGracePeriod = 30 * 24 * time.Hour
+
+// storageDeletionBatchSize は1回の削除で処理するストレージのキーの件数
+const storageDeletionBatchSize = 500
+
+// ErrAccountNotRestorable は退会していない、もしくは猶予期間を過ぎたアカウントを復元しようとした場合のエラー
+var ErrAccountNotRestorable = errors.New("account is not restorable")
+
+type AccountUsecase struct {
+	accountRepository repository.AccountRepository
+	authRepository    repository.AuthRepository
+	storageRepository repository.StorageRepository
+}
+
+func NewAccountUsecase(accountRepository repository.AccountRepository, authRepository repository.AuthRepository, storageRepository repository.StorageRepository) *AccountUsecase {
+	return &AccountUsecase{
+		accountRepository: accountRepository,
+		authRepository:    authRepository,
+		storageRepository: storageRepository,
+	}
+}
+
+// Delete は認証済みユーザーを退会させ、すべての端末からサインアウトさせる。
+// 認証プロバイダのユーザーは猶予期間中の復元のため Purge まで残す
+func (u *AccountUsecase) Delete(principal *models.Principal, accessToken string) error {
+	// DB の精度に揃え、復元時に同じ日時で論理削除したものを特定できるようにする
+	deletedAt := time.Now().Truncate(time.Microsecond)
+	if err := u.accountRepository.Deactivate(principal.UserID, deletedAt, deletedAt.Add(AccountDeletionGracePeriod)); err != nil {
+		return err
+	}
+	if err := u.authRepository.SignOut(accessToken); err != nil {
+		log.Errorf("Failed to sign out deleted user %s: %v", principal.UserID, err)
+	}
+	return nil
+}
+
+// Restore はメールアドレスとパスワードで本人を確認し、猶予期間中のアカウントを復元する
+func (u *AccountUsecase) Restore(email, password string) (*models.AuthTokens, error) {
+	tokens, err := u.authRepository.SignIn(email, password)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := u.accountRepository.FindDeactivatedByEmail(email)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrAccountNotRestorable
+		}
+		return nil, err
+	}
+	if time.Since(user.DeletedAt) > AccountDeletionGracePeriod {
+		return nil, ErrAccountNotRestorable
+	}
+	if err := u.accountRepository.Restore(user.ID); err != nil {
+		return nil, err
+	}
+	return tokens, nil
+}
+
+// Purge は猶予期間を過ぎたアカウントを認証プロバイダとあわせて削除し、期限の来たストレージのキーを削除する
+func (u *AccountUsecase) Purge() (*models.AccountPurgeReport, error) {
+	now := time.Now()
+	report := &models.AccountPurgeReport{
+		StartedAt:      now,
+		GracePeriod:    AccountDeletionGracePeriod.String(),
+		FailedAccounts: []string{},
+		FailedObjects:  []string{},
+	}
+
+	users, err := u.accountRepository.ListPurgeable(now.Add(-AccountDeletionGracePeriod))
+	if err != nil {
+		return nil, err
+	}
+	for _, user := range users {
+		// プロバイダからの削除に失敗した場合は DB の削除をロールバックし、次回やり直す
+		err := u.accountRepository.Purge(user.ID, func() error {
+			if user.AuthSubject == nil {
+				// sub がなければプロバイダのユーザーを確実に特定できないため、削除しない
+				return errors.New("user has no auth subject")
+			}
+			return u.authRepository.DeleteUser(*user.AuthSubject)
+		})
+		if err != nil {
+			log.Errorf("Failed to purge user %s: %v", user.ID, err)
+			report.FailedAccounts = append(report.FailedAccounts, user.ID.String())
+			continue
+		}
+		report.PurgedAccounts++
+	}
+
+	failed := map[string]bool{}
+	for {
+		deletions, err := u.accountRepository.DueStorageDeletions(now, storageDeletionBatchSize)
+		if err != nil {
+			return nil, err
+		}
+		progressed := false
+		for _, deletion := range deletions {
+			if failed[deletion.Key] {
+				continue
+			}
+			if err := u.storageRepository.DeleteImage(deletion.Key); err != nil {
+				log.Errorf("Failed to delete object %s: %v", deletion.Key, err)
+				failed[deletion.Key] = true
+				report.FailedObjects = append(report.FailedObjects, deletion.Key)
+				continue
+			}
+			if err := u.accountRepository.DeleteStorageDeletion(deletion.ID); err != nil {
+				return nil, fmt.Errorf("failed to dequeue %s: %w", deletion.Key, err)
+			}
+			report.DeletedObjects++
+			progressed = true
+		}
+		// 失敗したキーはキューに残し、次回の実行で再試行する
+		if len(deletions) < storageDeletionBatchSize || !progressed {
+			break
+		}
+	}
+
+	return report, nil
+}
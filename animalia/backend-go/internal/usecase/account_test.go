@@ -0,0 +1,210 @@
+package usecase
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aki-13627/animalia/backend-go/ent"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/models"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/repository/mock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccountUsecase_Delete(t *testing.T) {
+	principal := &models.Principal{UserID: uuid.New(), Email: "user@example.com"}
+
+	// Test cases
+	testCases := []struct {
+		name            string
+		deactivateError error
+		signOutError    error
+		expectedError   error
+		expectSignOut   bool
+	}{
+		{
+			name:          "Success",
+			expectSignOut: true,
+		},
+		{
+			name:            "Deactivate error",
+			deactivateError: errors.New("database error"),
+			expectedError:   errors.New("database error"),
+		},
+		{
+			name:          "Sign out error is ignored",
+			signOutError:  errors.New("cognito error"),
+			expectSignOut: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			accountRepo := &mock.MockAccountRepository{
+				DeactivateFunc: func(userID uuid.UUID, deletedAt, deleteAfter time.Time) error {
+					assert.Equal(t, principal.UserID, userID)
+					assert.Equal(t, AccountDeletionGracePeriod, deleteAfter.Sub(deletedAt))
+					assert.Equal(t, deletedAt, deletedAt.Truncate(time.Microsecond))
+					return tc.deactivateError
+				},
+			}
+			signedOut := false
+			authRepo := &mock.MockAuthRepository{
+				SignOutFunc: func(token string) error {
+					assert.Equal(t, "access-token", token)
+					signedOut = true
+					return tc.signOutError
+				},
+			}
+
+			usecase := NewAccountUsecase(accountRepo, authRepo, nil)
+			err := usecase.Delete(principal, "access-token")
+
+			if tc.expectedError != nil {
+				assert.Error(t, err)
+				assert.Equal(t, tc.expectedError.Error(), err.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tc.expectSignOut, signedOut)
+		})
+	}
+}
+
+func TestAccountUsecase_Restore(t *testing.T) {
+	userID := uuid.New()
+
+	// Test cases
+	testCases := []struct {
+		name          string
+		signInError   error
+		user          *ent.User
+		findError     error
+		expectedError error
+		expectRestore bool
+	}{
+		{
+			name:          "Success",
+			user:          &ent.User{ID: userID, DeletedAt: time.Now().Add(-24 * time.Hour)},
+			expectRestore: true,
+		},
+		{
+			name:          "Invalid password",
+			signInError:   errors.New("invalid email or password"),
+			expectedError: errors.New("invalid email or password"),
+		},
+		{
+			name:          "Not deactivated",
+			findError:     &ent.NotFoundError{},
+			expectedError: ErrAccountNotRestorable,
+		},
+		{
+			name:          "Grace period expired",
+			user:          &ent.User{ID: userID, DeletedAt: time.Now().Add(-AccountDeletionGracePeriod - time.Hour)},
+			expectedError: ErrAccountNotRestorable,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			restored := false
+			accountRepo := &mock.MockAccountRepository{
+				FindDeactivatedByEmailFunc: func(email string) (*ent.User, error) {
+					assert.Equal(t, "user@example.com", email)
+					return tc.user, tc.findError
+				},
+				RestoreFunc: func(id uuid.UUID) error {
+					assert.Equal(t, userID, id)
+					restored = true
+					return nil
+				},
+			}
+			authRepo := &mock.MockAuthRepository{
+				SignInFunc: func(email, password string) (*models.AuthTokens, error) {
+					if tc.signInError != nil {
+						return nil, tc.signInError
+					}
+					return &models.AuthTokens{AccessToken: "access-token"}, nil
+				},
+			}
+
+			usecase := NewAccountUsecase(accountRepo, authRepo, nil)
+			tokens, err := usecase.Restore("user@example.com", "password")
+
+			if tc.expectedError != nil {
+				assert.Error(t, err)
+				assert.Equal(t, tc.expectedError.Error(), err.Error())
+				assert.Nil(t, tokens)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, "access-token", tokens.AccessToken)
+			}
+			assert.Equal(t, tc.expectRestore, restored)
+		})
+	}
+}
+
+func TestAccountUsecase_Purge(t *testing.T) {
+	purgedSubject := "purged-subject"
+	failedSubject := "failed-subject"
+	purgedUser := &ent.User{ID: uuid.New(), Email: "purged@example.com", AuthSubject: &purgedSubject}
+	failedUser := &ent.User{ID: uuid.New(), Email: "failed@example.com", AuthSubject: &failedSubject}
+	// sub を記録していないユーザーはプロバイダから削除できない
+	unlinkedUser := &ent.User{ID: uuid.New(), Email: "unlinked@example.com"}
+	deletions := []*ent.StorageDeletion{
+		{ID: uuid.New(), Key: "posts/a/full.jpg"},
+		{ID: uuid.New(), Key: "pets/b/full.jpg"},
+	}
+
+	committed := map[uuid.UUID]bool{}
+	accountRepo := &mock.MockAccountRepository{
+		ListPurgeableFunc: func(before time.Time) ([]*ent.User, error) {
+			assert.WithinDuration(t, time.Now().Add(-AccountDeletionGracePeriod), before, time.Minute)
+			return []*ent.User{purgedUser, failedUser, unlinkedUser}, nil
+		},
+		PurgeFunc: func(userID uuid.UUID, beforeCommit func() error) error {
+			if err := beforeCommit(); err != nil {
+				return err
+			}
+			committed[userID] = true
+			return nil
+		},
+		DueStorageDeletionsFunc: func(now time.Time, limit int) ([]*ent.StorageDeletion, error) {
+			return deletions, nil
+		},
+		DeleteStorageDeletionFunc: func(id uuid.UUID) error {
+			assert.Equal(t, deletions[0].ID, id)
+			return nil
+		},
+	}
+	authRepo := &mock.MockAuthRepository{
+		DeleteUserFunc: func(subject string) error {
+			// プロバイダからの削除に失敗したユーザーはコミットされない
+			if subject == failedSubject {
+				return errors.New("cognito error")
+			}
+			return nil
+		},
+	}
+	storageRepo := &mock.MockStorageRepository{
+		DeleteImageFunc: func(fileKey string) error {
+			if fileKey == deletions[1].Key {
+				return errors.New("s3 error")
+			}
+			return nil
+		},
+	}
+
+	usecase := NewAccountUsecase(accountRepo, authRepo, storageRepo)
+	report, err := usecase.Purge()
+
+	assert.NoError(t, err)
+	assert.Equal(t, 1, report.PurgedAccounts)
+	assert.Equal(t, []string{failedUser.ID.String(), unlinkedUser.ID.String()}, report.FailedAccounts)
+	assert.True(t, committed[purgedUser.ID])
+	assert.False(t, committed[failedUser.ID])
+	assert.False(t, committed[unlinkedUser.ID])
+	assert.Equal(t, 1, report.DeletedObjects)
+	assert.Equal(t, []string{deletions[1].Key}, report.FailedObjects)
+}
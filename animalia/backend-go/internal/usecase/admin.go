@@ -0,0 +1,112 @@
+package usecase
+
+import (
+	"fmt"
+
+	"github.com/aki-13627/animalia/backend-go/ent/report"
+	"github.com/aki-13627/animalia/backend-go/ent/user"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/models"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/repository"
+)
+
+type AdminUsecase struct {
+	adminRepository repository.AdminRepository
+}
+
+func NewAdminUsecase(adminRepository repository.AdminRepository) *AdminUsecase {
+	return &AdminUsecase{
+		adminRepository: adminRepository,
+	}
+}
+
+func (u *AdminUsecase) SearchUsers(query string, limit, offset int) ([]models.AdminUserResponse, error) {
+	users, err := u.adminRepository.SearchUsers(query, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	resp := make([]models.AdminUserResponse, 0, len(users))
+	for _, user := range users {
+		resp = append(resp, models.NewAdminUserResponse(user))
+	}
+	return resp, nil
+}
+
+// SetSuspended はアカウントを停止・再開する。自分自身と他の管理者は停止できない
+func (u *AdminUsecase) SetSuspended(actor *models.Principal, userID string, suspended bool, reason string) (*models.AdminUserResponse, error) {
+	id, err := parseResourceID(userID)
+	if err != nil {
+		return nil, err
+	}
+	target, err := u.adminRepository.GetUser(id)
+	if err != nil {
+		return nil, notFoundOr(err)
+	}
+	if suspended && (target.ID == actor.UserID || target.Role == user.RoleAdmin) {
+		return nil, ErrForbidden
+	}
+
+	updated, err := u.adminRepository.SetSuspended(&actor.UserID, id, suspended, reason)
+	if err != nil {
+		return nil, notFoundOr(err)
+	}
+	resp := models.NewAdminUserResponse(updated)
+	return &resp, nil
+}
+
+// SetRole はメンテナンスコマンドからユーザーのロールを変更する
+func (u *AdminUsecase) SetRole(email string, role user.Role) (*models.AdminUserResponse, error) {
+	if err := user.RoleValidator(role); err != nil {
+		return nil, err
+	}
+	updated, err := u.adminRepository.SetRole(nil, email, role)
+	if err != nil {
+		return nil, notFoundOr(err)
+	}
+	resp := models.NewAdminUserResponse(updated)
+	return &resp, nil
+}
+
+func (u *AdminUsecase) ForceDeletePost(actor *models.Principal, postID string, reason string) error {
+	id, err := parseResourceID(postID)
+	if err != nil {
+		return err
+	}
+	return notFoundOr(u.adminRepository.ForceDeletePost(&actor.UserID, id, reason))
+}
+
+func (u *AdminUsecase) ForceDeleteComment(actor *models.Principal, commentID string, reason string) error {
+	id, err := parseResourceID(commentID)
+	if err != nil {
+		return err
+	}
+	return notFoundOr(u.adminRepository.ForceDeleteComment(&actor.UserID, id, reason))
+}
+
+func (u *AdminUsecase) ListReports(status string, limit, offset int) ([]models.ReportResponse, error) {
+	if status != "" {
+		if err := report.StatusValidator(report.Status(status)); err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidArgument, err)
+		}
+	}
+	reports, err := u.adminRepository.ListReports(report.Status(status), limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	resp := make([]models.ReportResponse, 0, len(reports))
+	for _, r := range reports {
+		resp = append(resp, models.NewReportResponse(r))
+	}
+	return resp, nil
+}
+
+func (u *AdminUsecase) ListAuditLogs(limit, offset int) ([]models.AuditLogResponse, error) {
+	logs, err := u.adminRepository.ListAuditLogs(limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	resp := make([]models.AuditLogResponse, 0, len(logs))
+	for _, l := range logs {
+		resp = append(resp, models.NewAuditLogResponse(l))
+	}
+	return resp, nil
+}
@@ -0,0 +1,203 @@
+package usecase
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aki-13627/animalia/backend-go/ent"
+	"github.com/aki-13627/animalia/backend-go/ent/report"
+	"github.com/aki-13627/animalia/backend-go/ent/user"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/models"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/repository/mock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAdminUsecase_SetSuspended(t *testing.T) {
+	actor := &models.Principal{UserID: uuid.New(), Role: user.RoleAdmin}
+	targetID := uuid.New()
+
+	// Test cases
+	testCases := []struct {
+		name          string
+		userID        string
+		target        *ent.User
+		suspended     bool
+		expectedError error
+		expectUpdated bool
+	}{
+		{
+			name:          "Suspend a user",
+			userID:        targetID.String(),
+			target:        &ent.User{ID: targetID, Role: user.RoleUser},
+			suspended:     true,
+			expectUpdated: true,
+		},
+		{
+			name:          "Unsuspend a user",
+			userID:        targetID.String(),
+			target:        &ent.User{ID: targetID, Role: user.RoleUser, SuspendedAt: time.Now()},
+			expectUpdated: true,
+		},
+		{
+			name:          "Cannot suspend yourself",
+			userID:        actor.UserID.String(),
+			target:        &ent.User{ID: actor.UserID, Role: user.RoleAdmin},
+			suspended:     true,
+			expectedError: ErrForbidden,
+		},
+		{
+			name:          "Cannot suspend an admin",
+			userID:        targetID.String(),
+			target:        &ent.User{ID: targetID, Role: user.RoleAdmin},
+			suspended:     true,
+			expectedError: ErrForbidden,
+		},
+		{
+			name:          "Unknown user",
+			userID:        targetID.String(),
+			suspended:     true,
+			expectedError: ErrNotFound,
+		},
+		{
+			name:          "Invalid ID",
+			userID:        "not-a-uuid",
+			suspended:     true,
+			expectedError: ErrNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			updated := false
+			adminRepo := &mock.MockAdminRepository{
+				GetUserFunc: func(userID uuid.UUID) (*ent.User, error) {
+					if tc.target == nil {
+						return nil, &ent.NotFoundError{}
+					}
+					return tc.target, nil
+				},
+				SetSuspendedFunc: func(actorID *uuid.UUID, userID uuid.UUID, suspended bool, reason string) (*ent.User, error) {
+					assert.Equal(t, actor.UserID, *actorID)
+					assert.Equal(t, tc.suspended, suspended)
+					assert.Equal(t, "spam", reason)
+					updated = true
+					return tc.target, nil
+				},
+			}
+
+			usecase := NewAdminUsecase(adminRepo)
+			resp, err := usecase.SetSuspended(actor, tc.userID, tc.suspended, "spam")
+
+			if tc.expectedError != nil {
+				assert.ErrorIs(t, err, tc.expectedError)
+				assert.Nil(t, resp)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.target.ID, resp.ID)
+			}
+			assert.Equal(t, tc.expectUpdated, updated)
+		})
+	}
+}
+
+func TestAdminUsecase_SetRole(t *testing.T) {
+	// Test cases
+	testCases := []struct {
+		name          string
+		role          user.Role
+		repoError     error
+		expectedError error
+	}{
+		{
+			name: "Promote to moderator",
+			role: user.RoleModerator,
+		},
+		{
+			name:          "Unknown role",
+			role:          user.Role("owner"),
+			expectedError: errors.New(`user: invalid enum value for role field: "owner"`),
+		},
+		{
+			name:          "Unknown user",
+			role:          user.RoleAdmin,
+			repoError:     &ent.NotFoundError{},
+			expectedError: ErrNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			adminRepo := &mock.MockAdminRepository{
+				SetRoleFunc: func(actorID *uuid.UUID, email string, role user.Role) (*ent.User, error) {
+					assert.Nil(t, actorID)
+					assert.Equal(t, "test@example.com", email)
+					if tc.repoError != nil {
+						return nil, tc.repoError
+					}
+					return &ent.User{ID: uuid.New(), Email: email, Role: role}, nil
+				},
+			}
+
+			usecase := NewAdminUsecase(adminRepo)
+			resp, err := usecase.SetRole("test@example.com", tc.role)
+
+			if tc.expectedError != nil {
+				assert.Error(t, err)
+				if errors.Is(tc.expectedError, ErrNotFound) {
+					assert.ErrorIs(t, err, ErrNotFound)
+				} else {
+					assert.Equal(t, tc.expectedError.Error(), err.Error())
+				}
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.role, resp.Role)
+			}
+		})
+	}
+}
+
+func TestAdminUsecase_ListReports(t *testing.T) {
+	// Test cases
+	testCases := []struct {
+		name          string
+		status        string
+		expectedError error
+	}{
+		{
+			name:   "All reports",
+			status: "",
+		},
+		{
+			name:   "Open reports",
+			status: "open",
+		},
+		{
+			name:          "Unknown status",
+			status:        "closed",
+			expectedError: ErrInvalidArgument,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			adminRepo := &mock.MockAdminRepository{
+				ListReportsFunc: func(status report.Status, limit, offset int) ([]*ent.Report, error) {
+					assert.Equal(t, report.Status(tc.status), status)
+					return []*ent.Report{{ID: uuid.New(), Status: report.StatusOpen}}, nil
+				},
+			}
+
+			usecase := NewAdminUsecase(adminRepo)
+			reports, err := usecase.ListReports(tc.status, 50, 0)
+
+			if tc.expectedError != nil {
+				assert.ErrorIs(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Len(t, reports, 1)
+			}
+		})
+	}
+}
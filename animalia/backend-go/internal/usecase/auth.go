@@ -1,19 +1,33 @@
 package usecase
 
 import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/aki-13627/animalia/backend-go/ent"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/models"
 	"github.com/aki-13627/animalia/backend-go/internal/domain/repository"
-	"github.com/aws/aws-sdk-go-v2/service/cognitoidentityprovider"
+	"github.com/labstack/gommon/log"
 )
 
+// ErrAccountSuspended は管理者に停止されたアカウントでアクセスした場合のエラー
+var ErrAccountSuspended = errors.New("account is suspended")
+
+// ErrEmailAlreadyUsed は変更先のメールアドレスが他のユーザーに使われている場合のエラー
+var ErrEmailAlreadyUsed = errors.New("email already used")
+
 type AuthUsecase struct {
 	authRepository repository.AuthRepository
 	userRepository repository.UserRepository
+	tokenVerifier  repository.TokenVerifier
 }
 
-func NewAuthUsecase(authRepository repository.AuthRepository, userRepository repository.UserRepository) *AuthUsecase {
+func NewAuthUsecase(authRepository repository.AuthRepository, userRepository repository.UserRepository, tokenVerifier repository.TokenVerifier) *AuthUsecase {
 	return &AuthUsecase{
 		authRepository: authRepository,
 		userRepository: userRepository,
+		tokenVerifier:  tokenVerifier,
 	}
 }
 
@@ -25,11 +39,11 @@ func (u *AuthUsecase) CreateUser(name, email, password string) error {
 	return u.authRepository.CreateUser(name, email, password)
 }
 
-func (u *AuthUsecase) SignIn(email, password string) (*cognitoidentityprovider.InitiateAuthOutput, error) {
+func (u *AuthUsecase) SignIn(email, password string) (*models.AuthTokens, error) {
 	return u.authRepository.SignIn(email, password)
 }
 
-func (u *AuthUsecase) RefreshToken(refreshToken string) (*cognitoidentityprovider.InitiateAuthOutput, error) {
+func (u *AuthUsecase) RefreshToken(refreshToken string) (*models.AuthTokens, error) {
 	return u.authRepository.RefreshToken(refreshToken)
 }
 
@@ -37,6 +51,112 @@ func (u *AuthUsecase) GetUserEmail(accessToken string) (string, error) {
 	return u.authRepository.GetUserEmail(accessToken)
 }
 
+// Authenticate はトークンをローカルで検証し、対応するユーザーを取得する。
+// ユーザーはメールアドレスを変更しても変わらない sub で探す
+func (u *AuthUsecase) Authenticate(token string) (*models.Principal, error) {
+	claims, err := u.tokenVerifier.Verify(token)
+	if err != nil {
+		return nil, err
+	}
+	if claims.Subject == "" {
+		return nil, errors.New("token has no subject")
+	}
+
+	user, err := u.userRepository.FindBySubject(claims.Subject)
+	if ent.IsNotFound(err) {
+		user, err = u.linkSubject(token, claims)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find user: %w", err)
+	}
+	if !user.SuspendedAt.IsZero() {
+		return nil, ErrAccountSuspended
+	}
+	return &models.Principal{
+		UserID: user.ID,
+		Email:  user.Email,
+		Role:   user.Role,
+	}, nil
+}
+
+// linkSubject は sub がまだ記録されていないユーザーをメールアドレスで探し、sub を記録する
+func (u *AuthUsecase) linkSubject(token string, claims *models.TokenClaims) (*ent.User, error) {
+	email := claims.Email
+	if email == "" && strings.Contains(claims.Username, "@") {
+		// ユーザー名にメールアドレスを使っているため、アクセストークンの username から取得できる
+		email = claims.Username
+	}
+	if email == "" {
+		// ユーザー名が sub の場合のみ Cognito に問い合わせる
+		var err error
+		email, err = u.authRepository.GetUserEmail(token)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	user, err := u.userRepository.FindByEmail(email)
+	if err != nil {
+		return nil, err
+	}
+	// 別の sub が記録されたユーザーは、同じアドレスで作り直された別のアカウントとみなす
+	if user.AuthSubject != nil && *user.AuthSubject != claims.Subject {
+		return nil, errors.New("user is linked to another subject")
+	}
+	if err := u.userRepository.LinkSubject(user.ID, claims.Subject); err != nil {
+		return nil, err
+	}
+	return user, nil
+}
+
 func (u *AuthUsecase) SignOut(accessToken string) error {
 	return u.authRepository.SignOut(accessToken)
 }
+
+func (u *AuthUsecase) ForgotPassword(email string) error {
+	return u.authRepository.ForgotPassword(email)
+}
+
+func (u *AuthUsecase) ConfirmForgotPassword(email, code, newPassword string) error {
+	return u.authRepository.ConfirmForgotPassword(email, code, newPassword)
+}
+
+func (u *AuthUsecase) ChangePassword(accessToken, oldPassword, newPassword string) error {
+	return u.authRepository.ChangePassword(accessToken, oldPassword, newPassword)
+}
+
+// RequestEmailChange は新しいメールアドレスに確認コードを送る
+func (u *AuthUsecase) RequestEmailChange(principal *models.Principal, accessToken, newEmail string) error {
+	if newEmail == principal.Email {
+		return errors.New("new email is the same as the current one")
+	}
+	exists, err := u.userRepository.ExistsEmail(newEmail)
+	if err != nil {
+		return err
+	}
+	if exists {
+		return ErrEmailAlreadyUsed
+	}
+	return u.authRepository.RequestEmailChange(accessToken, newEmail)
+}
+
+// ConfirmEmailChange は User.email と認証プロバイダのメールアドレスをまとめて変更する。
+// プロバイダでの確認に失敗した場合は User.email の更新をロールバックし、
+// プロバイダの変更後にコミットできなかった場合はプロバイダを元のアドレスに戻す
+func (u *AuthUsecase) ConfirmEmailChange(principal *models.Principal, accessToken, newEmail, code string) error {
+	confirmed := false
+	err := u.userRepository.UpdateEmail(principal.UserID, newEmail, func() error {
+		if err := u.authRepository.ConfirmEmailChange(accessToken, newEmail, code); err != nil {
+			return err
+		}
+		confirmed = true
+		return nil
+	})
+	if err != nil && confirmed {
+		if rerr := u.authRepository.RestoreEmail(accessToken, principal.Email); rerr != nil {
+			log.Errorf("Failed to restore email of user %s: %v", principal.UserID, rerr)
+			return fmt.Errorf("%w: restoring email: %v", err, rerr)
+		}
+	}
+	return err
+}
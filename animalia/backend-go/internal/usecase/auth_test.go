@@ -0,0 +1,336 @@
+package usecase
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aki-13627/animalia/backend-go/ent"
+	"github.com/aki-13627/animalia/backend-go/ent/user"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/models"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/repository/mock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAuthUsecase_RequestEmailChange(t *testing.T) {
+	principal := &models.Principal{UserID: uuid.New(), Email: "old@example.com"}
+
+	// Test cases
+	testCases := []struct {
+		name            string
+		newEmail        string
+		exists          bool
+		providerError   error
+		expectedError   error
+		expectRequested bool
+	}{
+		{
+			name:            "Success",
+			newEmail:        "new@example.com",
+			expectRequested: true,
+		},
+		{
+			name:          "Same email",
+			newEmail:      "old@example.com",
+			expectedError: errors.New("new email is the same as the current one"),
+		},
+		{
+			name:          "Email already used",
+			newEmail:      "taken@example.com",
+			exists:        true,
+			expectedError: ErrEmailAlreadyUsed,
+		},
+		{
+			name:            "Provider error",
+			newEmail:        "new@example.com",
+			providerError:   errors.New("cognito error"),
+			expectedError:   errors.New("cognito error"),
+			expectRequested: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			requested := false
+			authRepo := &mock.MockAuthRepository{
+				RequestEmailChangeFunc: func(accessToken, newEmail string) error {
+					assert.Equal(t, "access-token", accessToken)
+					assert.Equal(t, tc.newEmail, newEmail)
+					requested = true
+					return tc.providerError
+				},
+			}
+			userRepo := &mock.MockUserRepository{
+				ExistsEmailFunc: func(email string) (bool, error) {
+					assert.Equal(t, tc.newEmail, email)
+					return tc.exists, nil
+				},
+			}
+
+			usecase := NewAuthUsecase(authRepo, userRepo, nil)
+			err := usecase.RequestEmailChange(principal, "access-token", tc.newEmail)
+
+			if tc.expectedError != nil {
+				assert.Error(t, err)
+				assert.Equal(t, tc.expectedError.Error(), err.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tc.expectRequested, requested)
+		})
+	}
+}
+
+func TestAuthUsecase_ConfirmEmailChange(t *testing.T) {
+	principal := &models.Principal{UserID: uuid.New(), Email: "old@example.com"}
+
+	// Test cases
+	testCases := []struct {
+		name          string
+		providerError error
+		commitError   error
+		restoreError  error
+		expectedError error
+		// expectRolledBack は User.email の更新が取り消されること
+		expectRolledBack bool
+		expectRestored   bool
+	}{
+		{
+			name: "Success",
+		},
+		{
+			name:             "Invalid code rolls back the database",
+			providerError:    errors.New("code mismatch"),
+			expectedError:    errors.New("code mismatch"),
+			expectRolledBack: true,
+		},
+		{
+			name:           "Commit failure restores the provider",
+			commitError:    errors.New("commit failed"),
+			expectedError:  errors.New("commit failed"),
+			expectRestored: true,
+		},
+		{
+			name:           "Restore failure",
+			commitError:    errors.New("commit failed"),
+			restoreError:   errors.New("cognito error"),
+			expectedError:  errors.New("commit failed: restoring email: cognito error"),
+			expectRestored: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			restored := false
+			authRepo := &mock.MockAuthRepository{
+				ConfirmEmailChangeFunc: func(accessToken, newEmail, code string) error {
+					assert.Equal(t, "access-token", accessToken)
+					assert.Equal(t, "new@example.com", newEmail)
+					assert.Equal(t, "123456", code)
+					return tc.providerError
+				},
+				RestoreEmailFunc: func(accessToken, email string) error {
+					assert.Equal(t, "old@example.com", email)
+					restored = true
+					return tc.restoreError
+				},
+			}
+			rolledBack := false
+			userRepo := &mock.MockUserRepository{
+				// トランザクションの代わりに beforeCommit の結果でロールバック・コミットを判定する
+				UpdateEmailFunc: func(id uuid.UUID, email string, beforeCommit func() error) error {
+					assert.Equal(t, principal.UserID, id)
+					assert.Equal(t, "new@example.com", email)
+					if err := beforeCommit(); err != nil {
+						rolledBack = true
+						return err
+					}
+					return tc.commitError
+				},
+			}
+
+			usecase := NewAuthUsecase(authRepo, userRepo, nil)
+			err := usecase.ConfirmEmailChange(principal, "access-token", "new@example.com", "123456")
+
+			if tc.expectedError != nil {
+				assert.Error(t, err)
+				assert.Equal(t, tc.expectedError.Error(), err.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tc.expectRolledBack, rolledBack)
+			assert.Equal(t, tc.expectRestored, restored)
+		})
+	}
+}
+
+func TestAuthUsecase_Authenticate(t *testing.T) {
+	userID := uuid.New()
+	subject := uuid.NewString()
+	linked := &ent.User{ID: userID, Email: "user@example.com", AuthSubject: &subject, Role: user.RoleUser}
+	other := uuid.NewString()
+	suspended := &ent.User{ID: userID, Email: "user@example.com", AuthSubject: &subject, SuspendedAt: time.Now()}
+
+	// Test cases
+	testCases := []struct {
+		name           string
+		claims         *models.TokenClaims
+		verifyError    error
+		bySubject      *ent.User
+		byEmail        *ent.User
+		expectedLookup string
+		expectLinked   bool
+		expectedError  error
+	}{
+		{
+			name:      "Linked user",
+			claims:    &models.TokenClaims{Subject: subject, Username: "user@example.com"},
+			bySubject: linked,
+		},
+		{
+			name:           "First sign-in links the subject",
+			claims:         &models.TokenClaims{Subject: subject, Username: "user@example.com"},
+			byEmail:        &ent.User{ID: userID, Email: "user@example.com", Role: user.RoleUser},
+			expectedLookup: "user@example.com",
+			expectLinked:   true,
+		},
+		{
+			name:           "Email from ID token",
+			claims:         &models.TokenClaims{Subject: subject, Email: "user@example.com"},
+			byEmail:        &ent.User{ID: userID, Email: "user@example.com", Role: user.RoleUser},
+			expectedLookup: "user@example.com",
+			expectLinked:   true,
+		},
+		{
+			name:           "Email linked to another subject",
+			claims:         &models.TokenClaims{Subject: subject, Username: "user@example.com"},
+			byEmail:        &ent.User{ID: userID, Email: "user@example.com", AuthSubject: &other},
+			expectedLookup: "user@example.com",
+			expectedError:  errors.New("failed to find user: user is linked to another subject"),
+		},
+		{
+			name:          "Suspended user",
+			claims:        &models.TokenClaims{Subject: subject, Username: "user@example.com"},
+			bySubject:     suspended,
+			expectedError: ErrAccountSuspended,
+		},
+		{
+			name:          "No subject",
+			claims:        &models.TokenClaims{Username: "user@example.com"},
+			expectedError: errors.New("token has no subject"),
+		},
+		{
+			name:          "Invalid token",
+			verifyError:   errors.New("token is expired"),
+			expectedError: errors.New("token is expired"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			verifier := &mock.MockTokenVerifier{
+				VerifyFunc: func(token string) (*models.TokenClaims, error) {
+					assert.Equal(t, "access-token", token)
+					return tc.claims, tc.verifyError
+				},
+			}
+			linkedSubject := ""
+			userRepo := &mock.MockUserRepository{
+				FindBySubjectFunc: func(subject string) (*ent.User, error) {
+					if tc.bySubject == nil {
+						return nil, &ent.NotFoundError{}
+					}
+					return tc.bySubject, nil
+				},
+				FindByEmailFunc: func(email string) (*ent.User, error) {
+					assert.Equal(t, tc.expectedLookup, email)
+					return tc.byEmail, nil
+				},
+				LinkSubjectFunc: func(id uuid.UUID, subject string) error {
+					assert.Equal(t, userID, id)
+					linkedSubject = subject
+					return nil
+				},
+			}
+
+			usecase := NewAuthUsecase(&mock.MockAuthRepository{}, userRepo, verifier)
+			principal, err := usecase.Authenticate("access-token")
+
+			if tc.expectedError != nil {
+				assert.Error(t, err)
+				assert.Equal(t, tc.expectedError.Error(), err.Error())
+				assert.Nil(t, principal)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, userID, principal.UserID)
+				assert.Equal(t, "user@example.com", principal.Email)
+				assert.Equal(t, user.RoleUser, principal.Role)
+			}
+			if tc.expectLinked {
+				assert.Equal(t, subject, linkedSubject)
+			} else {
+				assert.Empty(t, linkedSubject)
+			}
+		})
+	}
+}
+
+// Cognito のユーザー名はメールアドレスを変更しても変わらないため、
+// 変更後もアクセストークンの username には古いアドレスが入ったままになる
+func TestAuthUsecase_Authenticate_AfterEmailChange(t *testing.T) {
+	subject := uuid.NewString()
+	current := &ent.User{ID: uuid.New(), Email: "old@example.com", Role: user.RoleUser}
+	users := map[string]*ent.User{current.Email: current}
+
+	verifier := &mock.MockTokenVerifier{
+		VerifyFunc: func(token string) (*models.TokenClaims, error) {
+			return &models.TokenClaims{Subject: subject, Username: "old@example.com"}, nil
+		},
+	}
+	authRepo := &mock.MockAuthRepository{
+		ConfirmEmailChangeFunc: func(accessToken, newEmail, code string) error {
+			return nil
+		},
+	}
+	userRepo := &mock.MockUserRepository{
+		FindBySubjectFunc: func(s string) (*ent.User, error) {
+			for _, u := range users {
+				if u.AuthSubject != nil && *u.AuthSubject == s {
+					return u, nil
+				}
+			}
+			return nil, &ent.NotFoundError{}
+		},
+		FindByEmailFunc: func(email string) (*ent.User, error) {
+			if u, ok := users[email]; ok {
+				return u, nil
+			}
+			return nil, &ent.NotFoundError{}
+		},
+		LinkSubjectFunc: func(id uuid.UUID, s string) error {
+			current.AuthSubject = &s
+			return nil
+		},
+		UpdateEmailFunc: func(id uuid.UUID, email string, beforeCommit func() error) error {
+			if err := beforeCommit(); err != nil {
+				return err
+			}
+			delete(users, current.Email)
+			current.Email = email
+			users[email] = current
+			return nil
+		},
+	}
+	usecase := NewAuthUsecase(authRepo, userRepo, verifier)
+
+	principal, err := usecase.Authenticate("access-token")
+	assert.NoError(t, err)
+	assert.NoError(t, usecase.ConfirmEmailChange(principal, "access-token", "new@example.com", "123456"))
+
+	principal, err = usecase.Authenticate("access-token")
+
+	assert.NoError(t, err)
+	assert.Equal(t, current.ID, principal.UserID)
+	assert.Equal(t, "new@example.com", principal.Email)
+}
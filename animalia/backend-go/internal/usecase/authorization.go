@@ -0,0 +1,54 @@
+package usecase
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/aki-13627/animalia/backend-go/ent"
+	"github.com/google/uuid"
+)
+
+var (
+	// ErrForbidden はリソースの所有者以外による操作 (403)
+	ErrForbidden = errors.New("forbidden")
+	// ErrNotFound は操作対象のリソースが存在しない (404)
+	ErrNotFound = errors.New("not found")
+	// ErrInvalidArgument はリクエストのパラメータが不正 (400)
+	ErrInvalidArgument = errors.New("invalid argument")
+	// ErrSessionExpired はタイムラインのセッションが期限切れか存在しない (410)
+	ErrSessionExpired = errors.New("timeline session expired")
+)
+
+// AuthorizeUser は userID が認証済みユーザー本人であることを確認する
+func AuthorizeUser(actorID uuid.UUID, userID string) error {
+	id, err := uuid.Parse(userID)
+	if err != nil {
+		return fmt.Errorf("%w: invalid user id %q", ErrNotFound, userID)
+	}
+	return authorizeOwner(actorID, id)
+}
+
+// authorizeOwner は ownerID が認証済みユーザー本人であることを確認する
+func authorizeOwner(actorID, ownerID uuid.UUID) error {
+	if actorID == uuid.Nil || actorID != ownerID {
+		return ErrForbidden
+	}
+	return nil
+}
+
+// parseResourceID はリソースの ID を解析する。不正な ID は存在しないものとして扱う
+func parseResourceID(id string) (uuid.UUID, error) {
+	parsed, err := uuid.Parse(id)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("%w: invalid id %q", ErrNotFound, id)
+	}
+	return parsed, nil
+}
+
+// notFoundOr は ent の NotFound を ErrNotFound に変換する
+func notFoundOr(err error) error {
+	if ent.IsNotFound(err) {
+		return fmt.Errorf("%w: %v", ErrNotFound, err)
+	}
+	return err
+}
@@ -0,0 +1,113 @@
+package usecase
+
+import (
+	"fmt"
+
+	"github.com/aki-13627/animalia/backend-go/ent"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/models"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/repository"
+	"github.com/google/uuid"
+)
+
+type BlockUsecase struct {
+	blockRepository   repository.BlockRepository
+	storageRepository repository.StorageRepository
+}
+
+func NewBlockUsecase(blockRepository repository.BlockRepository, storageRepository repository.StorageRepository) *BlockUsecase {
+	return &BlockUsecase{
+		blockRepository:   blockRepository,
+		storageRepository: storageRepository,
+	}
+}
+
+// Block は userId のユーザーをブロックする。2人の間のフォロー関係は両方向とも解除する
+func (u *BlockUsecase) Block(actorID uuid.UUID, userId string) error {
+	id, err := u.parseTarget(actorID, userId)
+	if err != nil {
+		return err
+	}
+	return notFoundOr(u.blockRepository.Block(actorID, id))
+}
+
+func (u *BlockUsecase) Unblock(actorID uuid.UUID, userId string) error {
+	id, err := u.parseTarget(actorID, userId)
+	if err != nil {
+		return err
+	}
+	return u.blockRepository.Unblock(actorID, id)
+}
+
+// Mute は userId のユーザーの投稿とコメントを自分のフィードに表示しないようにする。相手からは変化が分からない
+func (u *BlockUsecase) Mute(actorID uuid.UUID, userId string) error {
+	id, err := u.parseTarget(actorID, userId)
+	if err != nil {
+		return err
+	}
+	return notFoundOr(u.blockRepository.Mute(actorID, id))
+}
+
+func (u *BlockUsecase) Unmute(actorID uuid.UUID, userId string) error {
+	id, err := u.parseTarget(actorID, userId)
+	if err != nil {
+		return err
+	}
+	return u.blockRepository.Unmute(actorID, id)
+}
+
+func (u *BlockUsecase) ListBlocked(actorID uuid.UUID) ([]models.UserBaseResponse, error) {
+	users, err := u.blockRepository.ListBlocked(actorID)
+	if err != nil {
+		return nil, err
+	}
+	return userBaseResponses(u.storageRepository, users)
+}
+
+func (u *BlockUsecase) ListMuted(actorID uuid.UUID) ([]models.UserBaseResponse, error) {
+	users, err := u.blockRepository.ListMuted(actorID)
+	if err != nil {
+		return nil, err
+	}
+	return userBaseResponses(u.storageRepository, users)
+}
+
+// parseTarget はブロック・ミュートの対象を解析する。自分自身は対象にできない
+func (u *BlockUsecase) parseTarget(actorID uuid.UUID, userId string) (uuid.UUID, error) {
+	id, err := parseResourceID(userId)
+	if err != nil {
+		return uuid.Nil, err
+	}
+	if id == actorID {
+		return uuid.Nil, fmt.Errorf("%w: cannot block or mute yourself", ErrInvalidArgument)
+	}
+	return id, nil
+}
+
+// userBaseResponses はユーザー一覧をアイコン URL 付きのレスポンスにする
+func userBaseResponses(storageRepository repository.StorageRepository, users []*ent.User) ([]models.UserBaseResponse, error) {
+	iconKeys := make([]string, 0, len(users))
+	for _, user := range users {
+		iconKeys = append(iconKeys, user.IconImageKey)
+	}
+	iconURLs, err := getUrls(storageRepository, iconKeys)
+	if err != nil {
+		return nil, err
+	}
+	resp := make([]models.UserBaseResponse, 0, len(users))
+	for _, user := range users {
+		resp = append(resp, models.NewUserBaseResponse(user, iconURLs[user.IconImageKey]))
+	}
+	return resp, nil
+}
+
+// checkNotBlocked は actorID と otherID の間にブロックがあれば ErrForbidden を返す
+func checkNotBlocked(blockRepository repository.BlockRepository, actorID, otherID uuid.UUID) error {
+	blocked, err := blockRepository.IsBlocked(actorID, otherID)
+	if err != nil {
+		return err
+	}
+	if blocked {
+		return ErrForbidden
+	}
+	return nil
+}
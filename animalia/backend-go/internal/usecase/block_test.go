@@ -0,0 +1,146 @@
+package usecase
+
+import (
+	"testing"
+
+	"github.com/aki-13627/animalia/backend-go/ent"
+	"github.com/aki-13627/animalia/backend-go/ent/followrelation"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/repository/mock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBlockUsecase_Block(t *testing.T) {
+	actorID := uuid.New()
+	targetID := uuid.New()
+
+	// Test cases
+	testCases := []struct {
+		name          string
+		userID        string
+		mockError     error
+		expectBlocked bool
+		expectedError error
+	}{
+		{
+			name:          "Success",
+			userID:        targetID.String(),
+			expectBlocked: true,
+		},
+		{
+			name:          "Cannot block yourself",
+			userID:        actorID.String(),
+			expectedError: ErrInvalidArgument,
+		},
+		{
+			name:          "Unknown user",
+			userID:        targetID.String(),
+			mockError:     &ent.NotFoundError{},
+			expectBlocked: true,
+			expectedError: ErrNotFound,
+		},
+		{
+			name:          "Invalid ID",
+			userID:        "invalid",
+			expectedError: ErrNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			blocked := false
+			blockRepo := &mock.MockBlockRepository{
+				BlockFunc: func(blockerID, blockedID uuid.UUID) error {
+					assert.Equal(t, actorID, blockerID)
+					assert.Equal(t, targetID, blockedID)
+					blocked = true
+					return tc.mockError
+				},
+			}
+
+			usecase := NewBlockUsecase(blockRepo, &mock.MockStorageRepository{})
+			err := usecase.Block(actorID, tc.userID)
+
+			if tc.expectedError != nil {
+				assert.ErrorIs(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tc.expectBlocked, blocked)
+		})
+	}
+}
+
+func TestUserUsecase_Follow_Blocked(t *testing.T) {
+	actorID := uuid.New()
+	targetID := uuid.New()
+
+	// Test cases
+	testCases := []struct {
+		name           string
+		blocked        bool
+		expectFollowed bool
+		expectedError  error
+	}{
+		{
+			name:           "Success",
+			expectFollowed: true,
+		},
+		{
+			name:          "Blocked",
+			blocked:       true,
+			expectedError: ErrForbidden,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			followed := false
+			userRepo := &mock.MockUserRepository{
+				FollowFunc: func(toId string, fromId string) (followrelation.Status, error) {
+					followed = true
+					return followrelation.StatusApproved, nil
+				},
+			}
+			blockRepo := &mock.MockBlockRepository{
+				IsBlockedFunc: func(userID, otherID uuid.UUID) (bool, error) {
+					assert.Equal(t, actorID, userID)
+					assert.Equal(t, targetID, otherID)
+					return tc.blocked, nil
+				},
+			}
+
+			usecase := NewUserUsecase(userRepo, nil, nil, nil, nil, blockRepo)
+			_, err := usecase.Follow(actorID, targetID.String(), actorID.String())
+
+			if tc.expectedError != nil {
+				assert.ErrorIs(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tc.expectFollowed, followed)
+		})
+	}
+}
+
+func TestUserUsecase_GetByEmail_Blocked(t *testing.T) {
+	viewerID := uuid.New()
+	targetID := uuid.New()
+
+	userRepo := &mock.MockUserRepository{
+		FindByEmailFunc: func(email string) (*ent.User, error) {
+			return &ent.User{ID: targetID, Email: email}, nil
+		},
+	}
+	blockRepo := &mock.MockBlockRepository{
+		BlockedUserIDsFunc: func(userID uuid.UUID) (map[uuid.UUID]bool, error) {
+			assert.Equal(t, viewerID, userID)
+			return map[uuid.UUID]bool{targetID: true}, nil
+		},
+	}
+
+	usecase := NewUserUsecase(userRepo, nil, nil, nil, nil, blockRepo)
+	_, err := usecase.GetByEmail(viewerID, "blocked@example.com")
+
+	assert.ErrorIs(t, err, ErrNotFound)
+}
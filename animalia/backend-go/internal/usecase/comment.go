@@ -13,22 +13,35 @@ type CommentUsecase struct {
 	commentRepository repository.CommentRepository
 	postRepository    repository.PostRepository
 	storageRepository repository.StorageRepository
+	blockRepository   repository.BlockRepository
 }
 
-func NewCommentUsecase(commentRepository repository.CommentRepository, postRepository repository.PostRepository, storageRepository repository.StorageRepository) *CommentUsecase {
+func NewCommentUsecase(commentRepository repository.CommentRepository, postRepository repository.PostRepository, storageRepository repository.StorageRepository, blockRepository repository.BlockRepository) *CommentUsecase {
 	return &CommentUsecase{
 		commentRepository: commentRepository,
 		postRepository:    postRepository,
 		storageRepository: storageRepository,
+		blockRepository:   blockRepository,
 	}
 }
 
+// Create は投稿にコメントする。投稿者との間にブロックがある場合はコメントできない。
+// 公開範囲のため見えない投稿は存在しないものとして扱う
 func (u *CommentUsecase) Create(userID uuid.UUID, postId uuid.UUID, content string) (*models.CommentResponse, error) {
 	post, err := u.postRepository.GetById(postId)
 	if err != nil {
 		log.Errorf("Failed to find post with id %s: %v", postId, err)
 		return nil, fmt.Errorf("post not found")
 	}
+	if post.Edges.User == nil {
+		return nil, fmt.Errorf("post edges not loaded")
+	}
+	if err := checkNotBlocked(u.blockRepository, userID, post.Edges.User.ID); err != nil {
+		return nil, err
+	}
+	if err := checkPostVisible(u.postRepository, userID, post.ID); err != nil {
+		return nil, err
+	}
 	comment, err := u.commentRepository.Create(userID, post.ID, content)
 	if err != nil {
 		return nil, err
@@ -52,8 +65,51 @@ func (u *CommentUsecase) Create(userID uuid.UUID, postId uuid.UUID, content stri
 	return &commentResponse, nil
 }
 
-func (u *CommentUsecase) Delete(commentId string) error {
-	err := u.commentRepository.Delete(commentId)
+// ListByPost は投稿のコメントを新しい順に1ページ分返す。閲覧できない投稿は ErrNotFound になる
+func (u *CommentUsecase) ListByPost(viewerID uuid.UUID, postId string, page models.PageRequest) ([]models.CommentResponse, string, error) {
+	id, err := viewablePost(u.postRepository, u.blockRepository, viewerID, postId)
+	if err != nil {
+		return nil, "", err
+	}
+	comments, next, err := u.commentRepository.ListByPost(id, viewerID, page)
+	if err != nil {
+		return nil, "", err
+	}
+	iconKeys := make([]string, 0, len(comments))
+	for _, comment := range comments {
+		iconKeys = append(iconKeys, comment.Edges.User.IconImageKey)
+	}
+	iconURLs, err := getUrls(u.storageRepository, iconKeys)
+	if err != nil {
+		return nil, "", err
+	}
+	resp := make([]models.CommentResponse, len(comments))
+	for i, comment := range comments {
+		resp[i] = models.NewCommentResponse(comment, comment.Edges.User, iconURLs[comment.Edges.User.IconImageKey])
+	}
+	return resp, next, nil
+}
+
+// Delete はコメントを削除する。コメントの投稿者と、コメント先の投稿の投稿者が削除できる
+func (u *CommentUsecase) Delete(actorID uuid.UUID, commentId string) error {
+	id, err := parseResourceID(commentId)
+	if err != nil {
+		return err
+	}
+	comment, err := u.commentRepository.GetById(id)
+	if err != nil {
+		return notFoundOr(err)
+	}
+	if comment.Edges.User == nil || comment.Edges.Post == nil || comment.Edges.Post.Edges.User == nil {
+		return fmt.Errorf("comment edges not loaded")
+	}
+	if authorizeOwner(actorID, comment.Edges.User.ID) != nil {
+		if err := authorizeOwner(actorID, comment.Edges.Post.Edges.User.ID); err != nil {
+			return err
+		}
+	}
+
+	err = u.commentRepository.Delete(commentId)
 	if err != nil {
 		return err
 	}
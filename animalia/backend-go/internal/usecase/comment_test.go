@@ -24,6 +24,7 @@ func TestCommentUsecase_Create(t *testing.T) {
 		mockIconURL    string
 		mockError      error
 		mockStorageErr error
+		blocked        bool
 		expectedResult *models.CommentResponse
 		expectedError  bool
 	}{
@@ -87,6 +88,13 @@ func TestCommentUsecase_Create(t *testing.T) {
 			expectedResult: nil,
 			expectedError:  true,
 		},
+		{
+			name:           "Blocked by post owner",
+			content:        "Test comment",
+			blocked:        true,
+			expectedResult: nil,
+			expectedError:  true,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -97,6 +105,7 @@ func TestCommentUsecase_Create(t *testing.T) {
 			tc.userID = userUUID.String()
 			tc.postID = postUUID.String()
 
+			created := false
 			mockCommentRepo := &mock.MockCommentRepository{
 				CreateFunc: func(userId uuid.UUID, postId uuid.UUID, content string) (*ent.Comment, error) {
 					expectedUserID, _ := uuid.Parse(tc.userID)
@@ -106,13 +115,23 @@ func TestCommentUsecase_Create(t *testing.T) {
 					assert.Equal(t, expectedPostID, postId)
 					assert.Equal(t, tc.content, content)
 
+					created = true
 					return tc.mockComment, tc.mockError
 				},
 			}
 
+			postOwnerID := uuid.New()
 			mockPostRepo := &mock.MockPostRepository{
 				GetByIdFunc: func(postId uuid.UUID) (*ent.Post, error) {
-					return &ent.Post{ID: postId}, nil
+					return &ent.Post{ID: postId, Edges: ent.PostEdges{User: &ent.User{ID: postOwnerID}}}, nil
+				},
+			}
+
+			mockBlockRepo := &mock.MockBlockRepository{
+				IsBlockedFunc: func(userID, otherID uuid.UUID) (bool, error) {
+					assert.Equal(t, userUUID, userID)
+					assert.Equal(t, postOwnerID, otherID)
+					return tc.blocked, nil
 				},
 			}
 
@@ -125,10 +144,14 @@ func TestCommentUsecase_Create(t *testing.T) {
 				},
 			}
 
-			usecase := NewCommentUsecase(mockCommentRepo, mockPostRepo, mockStorageRepo)
+			usecase := NewCommentUsecase(mockCommentRepo, mockPostRepo, mockStorageRepo, mockBlockRepo)
 
 			result, err := usecase.Create(userUUID, postUUID, tc.content)
 
+			assert.Equal(t, !tc.blocked, created)
+			if tc.blocked {
+				assert.ErrorIs(t, err, ErrForbidden)
+			}
 			if tc.expectedError {
 				assert.Error(t, err)
 				assert.Nil(t, result)
@@ -146,34 +169,82 @@ func TestCommentUsecase_Create(t *testing.T) {
 }
 
 func TestCommentUsecase_Delete(t *testing.T) {
+	authorID := uuid.New()
+	postOwnerID := uuid.New()
+
 	// Test cases
 	testCases := []struct {
 		name          string
+		actorID       uuid.UUID
 		commentID     string
+		mockGetError  error
 		mockError     error
-		expectedError bool
+		expectDelete  bool
+		expectedError error
 	}{
 		{
-			name:          "Success",
+			name:         "Success by author",
+			actorID:      authorID,
+			commentID:    uuid.New().String(),
+			expectDelete: true,
+		},
+		{
+			name:         "Success by post owner",
+			actorID:      postOwnerID,
+			commentID:    uuid.New().String(),
+			expectDelete: true,
+		},
+		{
+			name:          "Forbidden",
+			actorID:       uuid.New(),
 			commentID:     uuid.New().String(),
-			mockError:     nil,
-			expectedError: false,
+			expectedError: ErrForbidden,
+		},
+		{
+			name:          "Not found",
+			actorID:       authorID,
+			commentID:     uuid.New().String(),
+			mockGetError:  &ent.NotFoundError{},
+			expectedError: ErrNotFound,
+		},
+		{
+			name:          "Invalid ID",
+			actorID:       authorID,
+			commentID:     "invalid",
+			expectedError: ErrNotFound,
 		},
 		{
 			name:          "Error",
+			actorID:       authorID,
 			commentID:     uuid.New().String(),
 			mockError:     errors.New("database error"),
-			expectedError: true,
+			expectDelete:  true,
+			expectedError: errors.New("database error"),
 		},
 	}
 
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
 			// Create mock repository
+			deleted := false
 			mockCommentRepo := &mock.MockCommentRepository{
+				GetByIdFunc: func(commentId uuid.UUID) (*ent.Comment, error) {
+					assert.Equal(t, tc.commentID, commentId.String())
+					if tc.mockGetError != nil {
+						return nil, tc.mockGetError
+					}
+					return &ent.Comment{
+						ID: commentId,
+						Edges: ent.CommentEdges{
+							User: &ent.User{ID: authorID},
+							Post: &ent.Post{Edges: ent.PostEdges{User: &ent.User{ID: postOwnerID}}},
+						},
+					}, nil
+				},
 				DeleteFunc: func(commentId string) error {
 					// Verify input parameters
 					assert.Equal(t, tc.commentID, commentId)
+					deleted = true
 					return tc.mockError
 				},
 			}
@@ -183,17 +254,21 @@ func TestCommentUsecase_Delete(t *testing.T) {
 			mockStorageRepo := &mock.MockStorageRepository{}
 
 			// Create usecase with mock repositories
-			usecase := NewCommentUsecase(mockCommentRepo, mockPostRepo, mockStorageRepo)
+			usecase := NewCommentUsecase(mockCommentRepo, mockPostRepo, mockStorageRepo, &mock.MockBlockRepository{})
 
 			// Call the method
-			err := usecase.Delete(tc.commentID)
+			err := usecase.Delete(tc.actorID, tc.commentID)
 
 			// Check error
-			if tc.expectedError {
+			if tc.expectedError != nil {
 				assert.Error(t, err)
+				if tc.mockError == nil {
+					assert.ErrorIs(t, err, tc.expectedError)
+				}
 			} else {
 				assert.NoError(t, err)
 			}
+			assert.Equal(t, tc.expectDelete, deleted)
 		})
 	}
 }
@@ -233,3 +308,92 @@ func createMockUser(id uuid.UUID, email, name, bio, iconKey string) *ent.User {
 func stringPtr(s string) *string {
 	return &s
 }
+
+func TestCommentUsecase_ListByPost(t *testing.T) {
+	viewerID := uuid.New()
+	postOwnerID := uuid.New()
+	postID := uuid.New()
+
+	// Test cases
+	testCases := []struct {
+		name          string
+		postID        string
+		blocked       bool
+		hidden        bool
+		expectedCount int
+		expectedError error
+	}{
+		{
+			name:          "Success",
+			postID:        postID.String(),
+			expectedCount: 2,
+		},
+		{
+			name:          "Blocked by post owner",
+			postID:        postID.String(),
+			blocked:       true,
+			expectedError: ErrNotFound,
+		},
+		{
+			name:          "Post not visible",
+			postID:        postID.String(),
+			hidden:        true,
+			expectedError: ErrNotFound,
+		},
+		{
+			name:          "Invalid ID",
+			postID:        "invalid",
+			expectedError: ErrNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			page := models.PageRequest{Limit: DefaultPageLimit}
+			mockCommentRepo := &mock.MockCommentRepository{
+				ListByPostFunc: func(postId, viewer uuid.UUID, p models.PageRequest) ([]*ent.Comment, string, error) {
+					assert.Equal(t, postID, postId)
+					assert.Equal(t, viewerID, viewer)
+					assert.Equal(t, page, p)
+					return []*ent.Comment{
+						{ID: uuid.New(), Content: "first", Edges: ent.CommentEdges{User: &ent.User{ID: uuid.New(), IconImageKey: "icon-key"}}},
+						{ID: uuid.New(), Content: "second", Edges: ent.CommentEdges{User: &ent.User{ID: uuid.New()}}},
+					}, "next", nil
+				},
+			}
+			mockPostRepo := &mock.MockPostRepository{
+				GetByIdFunc: func(postId uuid.UUID) (*ent.Post, error) {
+					return &ent.Post{ID: postId, Edges: ent.PostEdges{User: &ent.User{ID: postOwnerID}}}, nil
+				},
+				IsVisibleFunc: func(postId, viewer uuid.UUID) (bool, error) {
+					return !tc.hidden, nil
+				},
+			}
+			mockStorageRepo := &mock.MockStorageRepository{
+				GetUrlsFunc: func(fileKeys []string) (map[string]string, error) {
+					assert.Equal(t, []string{"icon-key"}, fileKeys)
+					return map[string]string{"icon-key": "https://example.com/icon.jpg"}, nil
+				},
+			}
+			mockBlockRepo := &mock.MockBlockRepository{
+				IsBlockedFunc: func(userID, otherID uuid.UUID) (bool, error) {
+					return tc.blocked, nil
+				},
+			}
+
+			usecase := NewCommentUsecase(mockCommentRepo, mockPostRepo, mockStorageRepo, mockBlockRepo)
+			comments, next, err := usecase.ListByPost(viewerID, tc.postID, page)
+
+			if tc.expectedError != nil {
+				assert.ErrorIs(t, err, tc.expectedError)
+				assert.Nil(t, comments)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Len(t, comments, tc.expectedCount)
+			assert.Equal(t, "next", next)
+			assert.Equal(t, "https://example.com/icon.jpg", *comments[0].User.IconImageUrl)
+			assert.Nil(t, comments[1].User.IconImageUrl)
+		})
+	}
+}
@@ -0,0 +1,114 @@
+package usecase
+
+import (
+	"math"
+	"slices"
+	"time"
+
+	"github.com/aki-13627/animalia/backend-go/internal/domain/models"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/repository"
+	"github.com/google/uuid"
+)
+
+const (
+	// feedCandidateWindow より古い投稿は候補にしない
+	feedCandidateWindow = 14 * 24 * time.Hour
+	// feedCandidateLimit は新しい順に集める候補の数
+	feedCandidateLimit = 300
+	// feedVelocityWindow の間のいいねとコメントの数を勢いとして数える
+	feedVelocityWindow = 24 * time.Hour
+	// feedRecencyHalfLife ごとに新しさのスコアが半分になる
+	feedRecencyHalfLife = 24 * time.Hour
+)
+
+// FeedWeights はフォールバックのランキングで各指標のスコアに掛ける重み。各スコアは 0 から 1 の範囲になる
+type FeedWeights struct {
+	Recency    float64
+	Following  float64
+	Velocity   float64
+	Similarity float64
+}
+
+var DefaultFeedWeights = FeedWeights{
+	Recency:    0.35,
+	Following:  0.25,
+	Velocity:   0.2,
+	Similarity: 0.2,
+}
+
+// FeedRanker は推薦 API を使わずにタイムラインを並べる。推薦 API が使えないときのフォールバックと、
+// TIMELINE_RANKER=feed のときの主なランキングとして使う
+type FeedRanker struct {
+	feedRepository repository.FeedRepository
+	weights        FeedWeights
+}
+
+func NewFeedRanker(feedRepository repository.FeedRepository, weights FeedWeights) *FeedRanker {
+	return &FeedRanker{
+		feedRepository: feedRepository,
+		weights:        weights,
+	}
+}
+
+// Rank は viewerID 向けの投稿 ID をスコアの高い順に返す。スコアは新しさ、フォローしているユーザーの投稿か、
+// 直近のいいね・コメントの勢い、最近いいねした投稿との画像の類似度を重み付けして足したもの
+func (r *FeedRanker) Rank(viewerID uuid.UUID) ([]uuid.UUID, error) {
+	now := time.Now()
+	candidates, err := r.feedRepository.FeedCandidates(viewerID, now.Add(-feedCandidateWindow), now.Add(-feedVelocityWindow), feedCandidateLimit)
+	if err != nil {
+		return nil, err
+	}
+	return rankFeed(candidates, r.weights, now), nil
+}
+
+func rankFeed(candidates []models.FeedCandidate, weights FeedWeights, now time.Time) []uuid.UUID {
+	maxInteractions := 0
+	for _, c := range candidates {
+		maxInteractions = max(maxInteractions, c.Interactions)
+	}
+
+	type scored struct {
+		candidate models.FeedCandidate
+		score     float64
+	}
+	ranked := make([]scored, len(candidates))
+	for i, c := range candidates {
+		recency := 1.0
+		if age := now.Sub(c.CreatedAt); age > 0 {
+			recency = math.Exp2(-float64(age) / float64(feedRecencyHalfLife))
+		}
+		var following float64
+		if c.FromFollowing {
+			following = 1
+		}
+		var velocity float64
+		if maxInteractions > 0 {
+			velocity = math.Log1p(float64(c.Interactions)) / math.Log1p(float64(maxInteractions))
+		}
+		similarity := max(c.Similarity, 0)
+
+		ranked[i] = scored{
+			candidate: c,
+			score: weights.Recency*recency +
+				weights.Following*following +
+				weights.Velocity*velocity +
+				weights.Similarity*similarity,
+		}
+	}
+	// 同じスコアなら新しい投稿を先にする
+	slices.SortStableFunc(ranked, func(a, b scored) int {
+		if a.score != b.score {
+			if a.score > b.score {
+				return -1
+			}
+			return 1
+		}
+		return b.candidate.CreatedAt.Compare(a.candidate.CreatedAt)
+	})
+
+	postIDs := make([]uuid.UUID, len(ranked))
+	for i, s := range ranked {
+		postIDs[i] = s.candidate.PostID
+	}
+	return postIDs
+}
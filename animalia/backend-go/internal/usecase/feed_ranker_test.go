@@ -0,0 +1,90 @@
+package usecase
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aki-13627/animalia/backend-go/internal/domain/models"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/repository/mock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFeedRanker_Rank(t *testing.T) {
+	viewerID := uuid.New()
+	now := time.Now()
+	fresh := uuid.New()
+	followed := uuid.New()
+	popular := uuid.New()
+	similar := uuid.New()
+	stale := uuid.New()
+
+	testCases := []struct {
+		name          string
+		candidates    []models.FeedCandidate
+		repoErr       error
+		expectedOrder []uuid.UUID
+		expectError   bool
+	}{
+		{
+			name: "Blend of signals",
+			candidates: []models.FeedCandidate{
+				{PostID: stale, CreatedAt: now.Add(-10 * 24 * time.Hour)},
+				{PostID: fresh, CreatedAt: now},
+				{PostID: followed, CreatedAt: now.Add(-48 * time.Hour), FromFollowing: true},
+				{PostID: popular, CreatedAt: now.Add(-48 * time.Hour), Interactions: 20},
+				{PostID: similar, CreatedAt: now.Add(-72 * time.Hour), Similarity: 0.9},
+			},
+			expectedOrder: []uuid.UUID{fresh, followed, popular, similar, stale},
+		},
+		{
+			name: "Newer post first among followed users",
+			candidates: []models.FeedCandidate{
+				{PostID: stale, CreatedAt: now.Add(-time.Hour), FromFollowing: true},
+				{PostID: fresh, CreatedAt: now.Add(-time.Minute), FromFollowing: true},
+			},
+			expectedOrder: []uuid.UUID{fresh, stale},
+		},
+		{
+			name: "Negative similarity is ignored",
+			candidates: []models.FeedCandidate{
+				{PostID: fresh, CreatedAt: now.Add(-time.Hour)},
+				{PostID: similar, CreatedAt: now.Add(-59 * time.Minute), Similarity: -1},
+			},
+			expectedOrder: []uuid.UUID{similar, fresh},
+		},
+		{
+			name:          "No candidates",
+			expectedOrder: []uuid.UUID{},
+		},
+		{
+			name:        "Repository error",
+			repoErr:     errors.New("database error"),
+			expectError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			feedRepo := &mock.MockFeedRepository{
+				FeedCandidatesFunc: func(viewer uuid.UUID, since, interactionsSince time.Time, limit int) ([]models.FeedCandidate, error) {
+					assert.Equal(t, viewerID, viewer)
+					assert.WithinDuration(t, time.Now().Add(-feedCandidateWindow), since, time.Minute)
+					assert.WithinDuration(t, time.Now().Add(-feedVelocityWindow), interactionsSince, time.Minute)
+					assert.Equal(t, feedCandidateLimit, limit)
+					return tc.candidates, tc.repoErr
+				},
+			}
+
+			postIDs, err := NewFeedRanker(feedRepo, DefaultFeedWeights).Rank(viewerID)
+
+			if tc.expectError {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedOrder, postIDs)
+		})
+	}
+}
@@ -0,0 +1,89 @@
+package usecase
+
+import (
+	"time"
+
+	"github.com/aki-13627/animalia/backend-go/internal/domain/models"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/repository"
+)
+
+// ImageGCPrefixes は孤立画像を探索するストレージ上のディレクトリ。
+// uploads/ には直接アップロードされたまま投稿などに取り込まれなかったファイルが残る
+var ImageGCPrefixes = []string{"posts/", "pets/", "profile/", "uploads/"}
+
+type ImageGCUsecase struct {
+	storageRepository        repository.StorageRepository
+	imageReferenceRepository repository.ImageReferenceRepository
+}
+
+func NewImageGCUsecase(storageRepository repository.StorageRepository, imageReferenceRepository repository.ImageReferenceRepository) *ImageGCUsecase {
+	return &ImageGCUsecase{
+		storageRepository:        storageRepository,
+		imageReferenceRepository: imageReferenceRepository,
+	}
+}
+
+// CollectOrphans はどのテーブルからも参照されていない画像を探し、dryRun でなければ削除する。
+// アップロード直後で DB への保存が終わっていない画像を消さないよう、
+// 最終更新から gracePeriod を経過していないオブジェクトは対象外にする。
+func (u *ImageGCUsecase) CollectOrphans(dryRun bool, gracePeriod time.Duration) (*models.ImageGCReport, error) {
+	now := time.Now()
+	report := &models.ImageGCReport{
+		StartedAt:   now,
+		DryRun:      dryRun,
+		GracePeriod: gracePeriod.String(),
+		Prefixes:    ImageGCPrefixes,
+		Orphans:     []models.OrphanedImage{},
+	}
+
+	keys, err := u.imageReferenceRepository.ReferencedImageKeys()
+	if err != nil {
+		return nil, err
+	}
+	// 参照されているキーの各バリアントも参照済みとして扱う
+	referenced := make(map[string]struct{}, len(keys)*len(models.ImageVariants))
+	for _, key := range keys {
+		referenced[key] = struct{}{}
+		for _, variant := range models.ImageVariants {
+			referenced[models.ImageVariantKey(key, variant)] = struct{}{}
+		}
+	}
+
+	cutoff := now.Add(-gracePeriod)
+	for _, prefix := range ImageGCPrefixes {
+		objects, err := u.storageRepository.ListObjects(prefix)
+		if err != nil {
+			return nil, err
+		}
+		for _, object := range objects {
+			report.Scanned++
+			if _, ok := referenced[object.Key]; ok {
+				report.Referenced++
+				continue
+			}
+			if object.LastModified.After(cutoff) {
+				report.SkippedRecent++
+				continue
+			}
+
+			orphan := models.OrphanedImage{
+				Key:          object.Key,
+				Size:         object.Size,
+				LastModified: object.LastModified,
+			}
+			report.OrphanBytes += object.Size
+			if !dryRun {
+				if err := u.storageRepository.DeleteImage(object.Key); err != nil {
+					orphan.Error = err.Error()
+					report.Failed++
+				} else {
+					orphan.Deleted = true
+					report.Deleted++
+				}
+			}
+			report.Orphans = append(report.Orphans, orphan)
+		}
+	}
+
+	return report, nil
+}
@@ -0,0 +1,114 @@
+package usecase
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aki-13627/animalia/backend-go/internal/domain/models"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/repository/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestImageGCUsecase_CollectOrphans(t *testing.T) {
+	old := time.Now().Add(-7 * 24 * time.Hour)
+	recent := time.Now().Add(-time.Hour)
+	objects := map[string][]models.ObjectInfo{
+		"posts/": {
+			{Key: "posts/a/full.jpg", Size: 300, LastModified: old},
+			{Key: "posts/a/medium.jpg", Size: 200, LastModified: old},
+			{Key: "posts/a/thumb.jpg", Size: 100, LastModified: old},
+			{Key: "posts/b/full.jpg", Size: 300, LastModified: old},
+			{Key: "posts/c/full.jpg", Size: 300, LastModified: recent},
+		},
+		"pets/": {
+			{Key: "pets/legacy-dog.jpg", Size: 50, LastModified: old},
+			{Key: "pets/orphan-cat.jpg", Size: 70, LastModified: old},
+		},
+		"profile/": {},
+		"uploads/": {
+			{Key: "uploads/posts/abandoned", Size: 40, LastModified: old},
+			{Key: "uploads/posts/in-progress", Size: 40, LastModified: recent},
+		},
+	}
+
+	// Test cases
+	testCases := []struct {
+		name            string
+		dryRun          bool
+		deleteError     error
+		expectedDeleted []string
+		expectedReport  models.ImageGCReport
+	}{
+		{
+			name:   "Dry run",
+			dryRun: true,
+			expectedReport: models.ImageGCReport{
+				Scanned: 9, Referenced: 4, SkippedRecent: 2, OrphanBytes: 410,
+			},
+		},
+		{
+			name:            "Delete orphans",
+			expectedDeleted: []string{"posts/b/full.jpg", "pets/orphan-cat.jpg", "uploads/posts/abandoned"},
+			expectedReport: models.ImageGCReport{
+				Scanned: 9, Referenced: 4, SkippedRecent: 2, OrphanBytes: 410, Deleted: 3,
+			},
+		},
+		{
+			name:            "Delete error",
+			deleteError:     errors.New("delete error"),
+			expectedDeleted: []string{"posts/b/full.jpg", "pets/orphan-cat.jpg", "uploads/posts/abandoned"},
+			expectedReport: models.ImageGCReport{
+				Scanned: 9, Referenced: 4, SkippedRecent: 2, OrphanBytes: 410, Failed: 3,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var deleted []string
+			mockStorageRepo := &mock.MockStorageRepository{
+				ListObjectsFunc: func(prefix string) ([]models.ObjectInfo, error) {
+					return objects[prefix], nil
+				},
+				DeleteImageFunc: func(fileKey string) error {
+					deleted = append(deleted, fileKey)
+					return tc.deleteError
+				},
+			}
+			mockImageReferenceRepo := &mock.MockImageReferenceRepository{
+				ReferencedImageKeysFunc: func() ([]string, error) {
+					return []string{"posts/a/full.jpg", "pets/legacy-dog.jpg"}, nil
+				},
+			}
+			usecase := NewImageGCUsecase(mockStorageRepo, mockImageReferenceRepo)
+
+			report, err := usecase.CollectOrphans(tc.dryRun, 72*time.Hour)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedDeleted, deleted)
+			assert.Equal(t, tc.dryRun, report.DryRun)
+			assert.Equal(t, tc.expectedReport.Scanned, report.Scanned)
+			assert.Equal(t, tc.expectedReport.Referenced, report.Referenced)
+			assert.Equal(t, tc.expectedReport.SkippedRecent, report.SkippedRecent)
+			assert.Equal(t, tc.expectedReport.OrphanBytes, report.OrphanBytes)
+			assert.Equal(t, tc.expectedReport.Deleted, report.Deleted)
+			assert.Equal(t, tc.expectedReport.Failed, report.Failed)
+			assert.Len(t, report.Orphans, 3)
+		})
+	}
+}
+
+func TestImageGCUsecase_CollectOrphans_ReferenceError(t *testing.T) {
+	mockImageReferenceRepo := &mock.MockImageReferenceRepository{
+		ReferencedImageKeysFunc: func() ([]string, error) {
+			return nil, errors.New("database error")
+		},
+	}
+	usecase := NewImageGCUsecase(&mock.MockStorageRepository{}, mockImageReferenceRepo)
+
+	report, err := usecase.CollectOrphans(false, time.Hour)
+
+	assert.Error(t, err)
+	assert.Nil(t, report)
+}
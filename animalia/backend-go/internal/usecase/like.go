@@ -1,23 +1,59 @@
 package usecase
 
-import "github.com/aki-13627/animalia/backend-go/internal/domain/repository"
+import (
+	"fmt"
+
+	"github.com/aki-13627/animalia/backend-go/internal/domain/models"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/repository"
+	"github.com/google/uuid"
+)
 
 type LikeUsecase struct {
-	likeRepository repository.LikeRepository
+	likeRepository    repository.LikeRepository
+	postRepository    repository.PostRepository
+	blockRepository   repository.BlockRepository
+	storageRepository repository.StorageRepository
 }
 
-func NewLikeUsecase(likeRepository repository.LikeRepository) *LikeUsecase {
+func NewLikeUsecase(likeRepository repository.LikeRepository, postRepository repository.PostRepository, blockRepository repository.BlockRepository, storageRepository repository.StorageRepository) *LikeUsecase {
 	return &LikeUsecase{
-		likeRepository: likeRepository,
+		likeRepository:    likeRepository,
+		postRepository:    postRepository,
+		blockRepository:   blockRepository,
+		storageRepository: storageRepository,
 	}
 }
 
-func (u *LikeUsecase) Create(userID, postID string) error {
-	err := u.likeRepository.Create(userID, postID)
-	return err
+// Create は投稿にいいねする。投稿者との間にブロックがある場合はいいねできない。
+// 公開範囲のため見えない投稿は存在しないものとして扱う
+func (u *LikeUsecase) Create(actorID uuid.UUID, userID, postID string) error {
+	if err := AuthorizeUser(actorID, userID); err != nil {
+		return err
+	}
+	id, err := parseResourceID(postID)
+	if err != nil {
+		return err
+	}
+	post, err := u.postRepository.GetById(id)
+	if err != nil {
+		return notFoundOr(err)
+	}
+	if post.Edges.User == nil {
+		return fmt.Errorf("post edges not loaded")
+	}
+	if err := checkNotBlocked(u.blockRepository, actorID, post.Edges.User.ID); err != nil {
+		return err
+	}
+	if err := checkPostVisible(u.postRepository, actorID, id); err != nil {
+		return err
+	}
+	return u.likeRepository.Create(userID, postID)
 }
 
-func (u *LikeUsecase) Delete(userID, postId string) error {
+func (u *LikeUsecase) Delete(actorID uuid.UUID, userID, postId string) error {
+	if err := AuthorizeUser(actorID, userID); err != nil {
+		return err
+	}
 	return u.likeRepository.Delete(userID, postId)
 }
 
@@ -28,3 +64,28 @@ func (u *LikeUsecase) Count(postId string) (int, error) {
 	}
 	return count, nil
 }
+
+// ListByPost は投稿にいいねしたユーザーを新しい順に1ページ分返す。閲覧できない投稿は ErrNotFound になる
+func (u *LikeUsecase) ListByPost(viewerID uuid.UUID, postId string, page models.PageRequest) ([]models.LikeResponse, string, error) {
+	id, err := viewablePost(u.postRepository, u.blockRepository, viewerID, postId)
+	if err != nil {
+		return nil, "", err
+	}
+	likes, next, err := u.likeRepository.ListByPost(id, viewerID, page)
+	if err != nil {
+		return nil, "", err
+	}
+	iconKeys := make([]string, 0, len(likes))
+	for _, like := range likes {
+		iconKeys = append(iconKeys, like.Edges.User.IconImageKey)
+	}
+	iconURLs, err := getUrls(u.storageRepository, iconKeys)
+	if err != nil {
+		return nil, "", err
+	}
+	resp := make([]models.LikeResponse, len(likes))
+	for i, like := range likes {
+		resp[i] = models.NewLikeResponse(like, iconURLs[like.Edges.User.IconImageKey])
+	}
+	return resp, next, nil
+}
@@ -4,34 +4,60 @@ import (
 	"errors"
 	"testing"
 
+	"github.com/aki-13627/animalia/backend-go/ent"
 	"github.com/aki-13627/animalia/backend-go/internal/domain/repository/mock"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 )
 
 func TestLikeUsecase_Create(t *testing.T) {
+	actorID := uuid.New()
+	postOwnerID := uuid.New()
+
 	// Test cases
 	testCases := []struct {
 		name          string
 		userID        string
 		postID        string
 		mockError     error
+		blocked       bool
+		hidden        bool
 		expectedError error
 	}{
 		{
 			name:          "Success",
-			userID:        uuid.New().String(),
+			userID:        actorID.String(),
 			postID:        uuid.New().String(),
 			mockError:     nil,
 			expectedError: nil,
 		},
 		{
 			name:          "Error",
-			userID:        uuid.New().String(),
+			userID:        actorID.String(),
 			postID:        uuid.New().String(),
 			mockError:     errors.New("database error"),
 			expectedError: errors.New("database error"),
 		},
+		{
+			name:          "Forbidden",
+			userID:        uuid.New().String(),
+			postID:        uuid.New().String(),
+			expectedError: ErrForbidden,
+		},
+		{
+			name:          "Blocked by post owner",
+			userID:        actorID.String(),
+			postID:        uuid.New().String(),
+			blocked:       true,
+			expectedError: ErrForbidden,
+		},
+		{
+			name:          "Post not visible",
+			userID:        actorID.String(),
+			postID:        uuid.New().String(),
+			hidden:        true,
+			expectedError: ErrNotFound,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -45,12 +71,30 @@ func TestLikeUsecase_Create(t *testing.T) {
 					return tc.mockError
 				},
 			}
+			mockPostRepo := &mock.MockPostRepository{
+				GetByIdFunc: func(postId uuid.UUID) (*ent.Post, error) {
+					assert.Equal(t, tc.postID, postId.String())
+					return &ent.Post{ID: postId, Edges: ent.PostEdges{User: &ent.User{ID: postOwnerID}}}, nil
+				},
+				IsVisibleFunc: func(postId, viewerID uuid.UUID) (bool, error) {
+					assert.Equal(t, tc.postID, postId.String())
+					assert.Equal(t, actorID, viewerID)
+					return !tc.hidden, nil
+				},
+			}
+			mockBlockRepo := &mock.MockBlockRepository{
+				IsBlockedFunc: func(userID, otherID uuid.UUID) (bool, error) {
+					assert.Equal(t, actorID, userID)
+					assert.Equal(t, postOwnerID, otherID)
+					return tc.blocked, nil
+				},
+			}
 
 			// Create usecase with mock repository
-			usecase := NewLikeUsecase(mockRepo)
+			usecase := NewLikeUsecase(mockRepo, mockPostRepo, mockBlockRepo, &mock.MockStorageRepository{})
 
 			// Call the method
-			err := usecase.Create(tc.userID, tc.postID)
+			err := usecase.Create(actorID, tc.userID, tc.postID)
 
 			// Check error
 			if tc.expectedError != nil {
@@ -64,6 +108,8 @@ func TestLikeUsecase_Create(t *testing.T) {
 }
 
 func TestLikeUsecase_Delete(t *testing.T) {
+	actorID := uuid.New()
+
 	// Test cases
 	testCases := []struct {
 		name          string
@@ -74,18 +120,24 @@ func TestLikeUsecase_Delete(t *testing.T) {
 	}{
 		{
 			name:          "Success",
-			userID:        uuid.New().String(),
+			userID:        actorID.String(),
 			postID:        uuid.New().String(),
 			mockError:     nil,
 			expectedError: nil,
 		},
 		{
 			name:          "Error",
-			userID:        uuid.New().String(),
+			userID:        actorID.String(),
 			postID:        uuid.New().String(),
 			mockError:     errors.New("database error"),
 			expectedError: errors.New("database error"),
 		},
+		{
+			name:          "Forbidden",
+			userID:        uuid.New().String(),
+			postID:        uuid.New().String(),
+			expectedError: ErrForbidden,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -101,10 +153,10 @@ func TestLikeUsecase_Delete(t *testing.T) {
 			}
 
 			// Create usecase with mock repository
-			usecase := NewLikeUsecase(mockRepo)
+			usecase := NewLikeUsecase(mockRepo, &mock.MockPostRepository{}, &mock.MockBlockRepository{}, &mock.MockStorageRepository{})
 
 			// Call the method
-			err := usecase.Delete(tc.userID, tc.postID)
+			err := usecase.Delete(actorID, tc.userID, tc.postID)
 
 			// Check error
 			if tc.expectedError != nil {
@@ -157,7 +209,7 @@ func TestLikeUsecase_Count(t *testing.T) {
 			}
 
 			// Create usecase with mock repository
-			usecase := NewLikeUsecase(mockRepo)
+			usecase := NewLikeUsecase(mockRepo, &mock.MockPostRepository{}, &mock.MockBlockRepository{}, &mock.MockStorageRepository{})
 
 			// Call the method
 			count, err := usecase.Count(tc.postID)
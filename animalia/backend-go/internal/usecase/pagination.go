@@ -0,0 +1,30 @@
+package usecase
+
+import (
+	"fmt"
+
+	"github.com/aki-13627/animalia/backend-go/internal/domain/models"
+)
+
+const (
+	DefaultPageLimit = 20
+	MaxPageLimit     = 100
+)
+
+// NewPageRequest はクエリの cursor と limit から一覧の取得範囲を作る。
+// limit が 0 以下なら既定値、上限を超えれば上限にする。cursor が不正なら ErrInvalidArgument を返す
+func NewPageRequest(cursor string, limit int) (models.PageRequest, error) {
+	c, err := models.DecodeCursor(cursor)
+	if err != nil {
+		return models.PageRequest{}, fmt.Errorf("%w: %v", ErrInvalidArgument, err)
+	}
+	return models.PageRequest{Cursor: c, Limit: pageLimit(limit)}, nil
+}
+
+// pageLimit は 0 以下なら既定値、上限を超えれば上限にした件数を返す
+func pageLimit(limit int) int {
+	if limit <= 0 {
+		return DefaultPageLimit
+	}
+	return min(limit, MaxPageLimit)
+}
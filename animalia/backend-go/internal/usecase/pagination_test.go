@@ -0,0 +1,65 @@
+package usecase
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aki-13627/animalia/backend-go/internal/domain/models"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewPageRequest(t *testing.T) {
+	cursor := models.Cursor{CreatedAt: time.Date(2025, 4, 1, 12, 0, 0, 0, time.UTC), ID: uuid.New()}
+
+	// Test cases
+	testCases := []struct {
+		name           string
+		cursor         string
+		limit          int
+		expectedCursor *models.Cursor
+		expectedLimit  int
+		expectedError  error
+	}{
+		{
+			name:          "Default limit",
+			expectedLimit: DefaultPageLimit,
+		},
+		{
+			name:          "Limit capped",
+			limit:         MaxPageLimit + 1,
+			expectedLimit: MaxPageLimit,
+		},
+		{
+			name:           "With cursor",
+			cursor:         cursor.Encode(),
+			limit:          10,
+			expectedCursor: &cursor,
+			expectedLimit:  10,
+		},
+		{
+			name:          "Invalid cursor",
+			cursor:        "not-a-cursor",
+			expectedError: ErrInvalidArgument,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			page, err := NewPageRequest(tc.cursor, tc.limit)
+
+			if tc.expectedError != nil {
+				assert.ErrorIs(t, err, tc.expectedError)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedLimit, page.Limit)
+			if tc.expectedCursor == nil {
+				assert.Nil(t, page.Cursor)
+			} else {
+				assert.True(t, tc.expectedCursor.CreatedAt.Equal(page.Cursor.CreatedAt))
+				assert.Equal(t, tc.expectedCursor.ID, page.Cursor.ID)
+			}
+		})
+	}
+}
@@ -1,8 +1,11 @@
 package usecase
 
 import (
+	"fmt"
+
 	"github.com/aki-13627/animalia/backend-go/ent"
 	"github.com/aki-13627/animalia/backend-go/internal/domain/repository"
+	"github.com/google/uuid"
 )
 
 type PetUsecase struct {
@@ -19,14 +22,39 @@ func (u *PetUsecase) GetByOwner(ownerID string) ([]*ent.Pet, error) {
 	return u.petRepository.GetByOwner(ownerID)
 }
 
-func (u *PetUsecase) Create(name, petType, species, birthDay, fileKey, userID string) (*ent.Pet, error) {
+func (u *PetUsecase) Create(actorID uuid.UUID, name, petType, species, birthDay, fileKey, userID string) (*ent.Pet, error) {
+	if err := AuthorizeUser(actorID, userID); err != nil {
+		return nil, err
+	}
 	return u.petRepository.Create(name, petType, species, birthDay, fileKey, userID)
 }
 
-func (u *PetUsecase) Update(petId, name, petType, species, birthDay string) error {
+func (u *PetUsecase) Update(actorID uuid.UUID, petId, name, petType, species, birthDay string) error {
+	if err := u.authorizePet(actorID, petId); err != nil {
+		return err
+	}
 	return u.petRepository.Update(petId, name, petType, species, birthDay)
 }
 
-func (u *PetUsecase) Delete(petId string) error {
+func (u *PetUsecase) Delete(actorID uuid.UUID, petId string) error {
+	if err := u.authorizePet(actorID, petId); err != nil {
+		return err
+	}
 	return u.petRepository.Delete(petId)
 }
+
+// authorizePet はペットが存在し、飼い主が認証済みユーザー本人であることを確認する
+func (u *PetUsecase) authorizePet(actorID uuid.UUID, petId string) error {
+	id, err := parseResourceID(petId)
+	if err != nil {
+		return err
+	}
+	pet, err := u.petRepository.GetById(id)
+	if err != nil {
+		return notFoundOr(err)
+	}
+	if pet.Edges.Owner == nil {
+		return fmt.Errorf("owner edge not loaded")
+	}
+	return authorizeOwner(actorID, pet.Edges.Owner.ID)
+}
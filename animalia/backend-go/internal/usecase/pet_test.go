@@ -94,6 +94,8 @@ func TestPetUsecase_GetByOwner(t *testing.T) {
 }
 
 func TestPetUsecase_Create(t *testing.T) {
+	actorID := uuid.New()
+
 	// Test cases
 	testCases := []struct {
 		name          string
@@ -115,7 +117,7 @@ func TestPetUsecase_Create(t *testing.T) {
 			species:  "Golden Retriever",
 			birthDay: "2020-01-01",
 			fileKey:  "pet-image-key",
-			userID:   uuid.New().String(),
+			userID:   actorID.String(),
 			mockPet: &ent.Pet{
 				ID:       uuid.New(),
 				Name:     "Fluffy",
@@ -134,12 +136,25 @@ func TestPetUsecase_Create(t *testing.T) {
 			species:       "Golden Retriever",
 			birthDay:      "2020-01-01",
 			fileKey:       "pet-image-key",
-			userID:        uuid.New().String(),
+			userID:        actorID.String(),
 			mockPet:       nil,
 			mockError:     errors.New("database error"),
 			expectedPet:   nil,
 			expectedError: errors.New("database error"),
 		},
+		{
+			name:          "Forbidden",
+			petName:       "Fluffy",
+			petType:       "Dog",
+			species:       "Golden Retriever",
+			birthDay:      "2020-01-01",
+			fileKey:       "pet-image-key",
+			userID:        uuid.New().String(),
+			mockPet:       nil,
+			mockError:     nil,
+			expectedPet:   nil,
+			expectedError: ErrForbidden,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -162,7 +177,7 @@ func TestPetUsecase_Create(t *testing.T) {
 			usecase := NewPetUsecase(mockRepo)
 
 			// Call the method
-			pet, err := usecase.Create(tc.petName, tc.petType, tc.species, tc.birthDay, tc.fileKey, tc.userID)
+			pet, err := usecase.Create(actorID, tc.petName, tc.petType, tc.species, tc.birthDay, tc.fileKey, tc.userID)
 
 			// Check error
 			if tc.expectedError != nil {
@@ -186,19 +201,24 @@ func TestPetUsecase_Create(t *testing.T) {
 }
 
 func TestPetUsecase_Update(t *testing.T) {
+	ownerID := uuid.New()
+
 	// Test cases
 	testCases := []struct {
 		name          string
+		actorID       uuid.UUID
 		petID         string
 		petName       string
 		petType       string
 		species       string
 		birthDay      string
+		mockGetError  error
 		mockError     error
 		expectedError error
 	}{
 		{
 			name:          "Success",
+			actorID:       ownerID,
 			petID:         uuid.New().String(),
 			petName:       "Fluffy",
 			petType:       "Dog",
@@ -209,6 +229,7 @@ func TestPetUsecase_Update(t *testing.T) {
 		},
 		{
 			name:          "Error",
+			actorID:       ownerID,
 			petID:         uuid.New().String(),
 			petName:       "Fluffy",
 			petType:       "Dog",
@@ -217,12 +238,40 @@ func TestPetUsecase_Update(t *testing.T) {
 			mockError:     errors.New("database error"),
 			expectedError: errors.New("database error"),
 		},
+		{
+			name:          "Forbidden",
+			actorID:       uuid.New(),
+			petID:         uuid.New().String(),
+			petName:       "Fluffy",
+			petType:       "Dog",
+			species:       "Golden Retriever",
+			birthDay:      "2020-01-01",
+			expectedError: ErrForbidden,
+		},
+		{
+			name:          "Not found",
+			actorID:       ownerID,
+			petID:         uuid.New().String(),
+			petName:       "Fluffy",
+			petType:       "Dog",
+			species:       "Golden Retriever",
+			birthDay:      "2020-01-01",
+			mockGetError:  &ent.NotFoundError{},
+			expectedError: ErrNotFound,
+		},
 	}
 
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
 			// Create mock repository
 			mockRepo := &mock.MockPetRepository{
+				GetByIdFunc: func(petID uuid.UUID) (*ent.Pet, error) {
+					assert.Equal(t, tc.petID, petID.String())
+					if tc.mockGetError != nil {
+						return nil, tc.mockGetError
+					}
+					return &ent.Pet{ID: petID, Edges: ent.PetEdges{Owner: &ent.User{ID: ownerID}}}, nil
+				},
 				UpdateFunc: func(petID, name, petType, species, birthDay string) error {
 					// Verify input parameters
 					assert.Equal(t, tc.petID, petID)
@@ -238,12 +287,16 @@ func TestPetUsecase_Update(t *testing.T) {
 			usecase := NewPetUsecase(mockRepo)
 
 			// Call the method
-			err := usecase.Update(tc.petID, tc.petName, tc.petType, tc.species, tc.birthDay)
+			err := usecase.Update(tc.actorID, tc.petID, tc.petName, tc.petType, tc.species, tc.birthDay)
 
 			// Check error
 			if tc.expectedError != nil {
 				assert.Error(t, err)
-				assert.Equal(t, tc.expectedError.Error(), err.Error())
+				if tc.mockError == nil {
+					assert.ErrorIs(t, err, tc.expectedError)
+				} else {
+					assert.Equal(t, tc.expectedError.Error(), err.Error())
+				}
 			} else {
 				assert.NoError(t, err)
 			}
@@ -252,31 +305,57 @@ func TestPetUsecase_Update(t *testing.T) {
 }
 
 func TestPetUsecase_Delete(t *testing.T) {
+	ownerID := uuid.New()
+
 	// Test cases
 	testCases := []struct {
 		name          string
+		actorID       uuid.UUID
 		petID         string
+		mockGetError  error
 		mockError     error
 		expectedError error
 	}{
 		{
 			name:          "Success",
+			actorID:       ownerID,
 			petID:         uuid.New().String(),
 			mockError:     nil,
 			expectedError: nil,
 		},
 		{
 			name:          "Error",
+			actorID:       ownerID,
 			petID:         uuid.New().String(),
 			mockError:     errors.New("database error"),
 			expectedError: errors.New("database error"),
 		},
+		{
+			name:          "Forbidden",
+			actorID:       uuid.New(),
+			petID:         uuid.New().String(),
+			expectedError: ErrForbidden,
+		},
+		{
+			name:          "Not found",
+			actorID:       ownerID,
+			petID:         uuid.New().String(),
+			mockGetError:  &ent.NotFoundError{},
+			expectedError: ErrNotFound,
+		},
 	}
 
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
 			// Create mock repository
 			mockRepo := &mock.MockPetRepository{
+				GetByIdFunc: func(petID uuid.UUID) (*ent.Pet, error) {
+					assert.Equal(t, tc.petID, petID.String())
+					if tc.mockGetError != nil {
+						return nil, tc.mockGetError
+					}
+					return &ent.Pet{ID: petID, Edges: ent.PetEdges{Owner: &ent.User{ID: ownerID}}}, nil
+				},
 				DeleteFunc: func(petID string) error {
 					// Verify input parameters
 					assert.Equal(t, tc.petID, petID)
@@ -288,12 +367,16 @@ func TestPetUsecase_Delete(t *testing.T) {
 			usecase := NewPetUsecase(mockRepo)
 
 			// Call the method
-			err := usecase.Delete(tc.petID)
+			err := usecase.Delete(tc.actorID, tc.petID)
 
 			// Check error
 			if tc.expectedError != nil {
 				assert.Error(t, err)
-				assert.Equal(t, tc.expectedError.Error(), err.Error())
+				if tc.mockError == nil {
+					assert.ErrorIs(t, err, tc.expectedError)
+				} else {
+					assert.Equal(t, tc.expectedError.Error(), err.Error())
+				}
 			} else {
 				assert.NoError(t, err)
 			}
@@ -1,8 +1,23 @@
 package usecase
 
 import (
+	"errors"
+	"fmt"
+	"strings"
+
 	"github.com/aki-13627/animalia/backend-go/ent"
+	"github.com/aki-13627/animalia/backend-go/ent/post"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/models"
 	"github.com/aki-13627/animalia/backend-go/internal/domain/repository"
+	"github.com/google/uuid"
+)
+
+// MaxPostMedia は1つの投稿に添付できる画像・動画の上限
+const MaxPostMedia = 10
+
+var (
+	ErrNoPostMedia      = errors.New("post must have at least one image or video")
+	ErrTooManyPostMedia = errors.New("too many media items in a post")
 )
 
 type PostUsecase struct {
@@ -15,18 +30,166 @@ func NewPostUsecase(postRepository repository.PostRepository) *PostUsecase {
 	}
 }
 
-func (u *PostUsecase) GetAllPosts() ([]*ent.Post, error) {
-	return u.postRepository.GetAllPosts()
+// GetAllPosts は viewerID がブロック・ミュートしているユーザーと、viewerID から見えない投稿を除いて返す
+func (u *PostUsecase) GetAllPosts(viewerID uuid.UUID, page models.PageRequest) ([]*ent.Post, string, error) {
+	return u.postRepository.GetAllPosts(viewerID, page)
 }
 
-func (u *PostUsecase) CreatePost(caption, userId, fileKey string, dailyTaskId *string) (*ent.Post, error) {
-	return u.postRepository.CreatePost(caption, userId, fileKey, dailyTaskId)
+// CreatePost は投稿を作成する。visibility を省略した場合は public にする
+func (u *PostUsecase) CreatePost(actorID uuid.UUID, caption, userId, visibility string, media []models.MediaItem, dailyTaskId *string) (*ent.Post, error) {
+	if err := AuthorizeUser(actorID, userId); err != nil {
+		return nil, err
+	}
+	v, err := parseVisibility(visibility)
+	if err != nil {
+		return nil, err
+	}
+	if len(media) == 0 {
+		return nil, ErrNoPostMedia
+	}
+	if len(media) > MaxPostMedia {
+		return nil, ErrTooManyPostMedia
+	}
+	return u.postRepository.CreatePost(caption, userId, v, media, dailyTaskId)
+}
+
+// UpdatePost は投稿のキャプションを編集する。投稿者本人に限る。編集前の版は履歴に残る
+func (u *PostUsecase) UpdatePost(actorID uuid.UUID, postId, caption string) error {
+	if strings.TrimSpace(caption) == "" {
+		return fmt.Errorf("%w: caption is required", ErrInvalidArgument)
+	}
+	if err := u.authorizePost(actorID, postId); err != nil {
+		return err
+	}
+	return u.postRepository.UpdatePost(actorID, postId, caption)
+}
+
+// GetPost は viewerID から見える投稿をコメント・いいね付きで返す。見えない投稿は ErrNotFound にする
+func (u *PostUsecase) GetPost(viewerID uuid.UUID, postId string) (*ent.Post, error) {
+	id, err := parseResourceID(postId)
+	if err != nil {
+		return nil, err
+	}
+	post, err := u.postRepository.GetDetail(id, viewerID)
+	if err != nil {
+		return nil, notFoundOr(err)
+	}
+	return post, nil
+}
+
+// GetSharedPost は共有ページに表示する投稿を返す。公開アカウントの public の投稿に限る
+func (u *PostUsecase) GetSharedPost(postId string) (*ent.Post, error) {
+	return u.GetPost(uuid.Nil, postId)
+}
+
+// GetRevisions は投稿の編集履歴を古い順に返す。viewerID から見えない投稿は ErrNotFound にする
+func (u *PostUsecase) GetRevisions(viewerID uuid.UUID, postId string) ([]models.PostRevisionResponse, error) {
+	id, err := parseResourceID(postId)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := u.postRepository.GetById(id); err != nil {
+		return nil, notFoundOr(err)
+	}
+	if err := checkPostVisible(u.postRepository, viewerID, id); err != nil {
+		return nil, err
+	}
+	revisions, err := u.postRepository.GetRevisions(id)
+	if err != nil {
+		return nil, err
+	}
+	resp := make([]models.PostRevisionResponse, len(revisions))
+	for i, revision := range revisions {
+		resp[i] = models.NewPostRevisionResponse(revision)
+	}
+	return resp, nil
 }
 
-func (u *PostUsecase) UpdatePost(postId, caption string) error {
-	return u.postRepository.UpdatePost(postId, caption)
+// UpdateVisibility は投稿の公開範囲を変更する。投稿者本人に限る
+func (u *PostUsecase) UpdateVisibility(actorID uuid.UUID, postId, visibility string) error {
+	if visibility == "" {
+		return fmt.Errorf("%w: visibility is required", ErrInvalidArgument)
+	}
+	v, err := parseVisibility(visibility)
+	if err != nil {
+		return err
+	}
+	if err := u.authorizePost(actorID, postId); err != nil {
+		return err
+	}
+	id, err := parseResourceID(postId)
+	if err != nil {
+		return err
+	}
+	return notFoundOr(u.postRepository.UpdateVisibility(id, v))
 }
 
-func (u *PostUsecase) DeletePost(postId string) error {
+func (u *PostUsecase) DeletePost(actorID uuid.UUID, postId string) error {
+	if err := u.authorizePost(actorID, postId); err != nil {
+		return err
+	}
 	return u.postRepository.DeletePost(postId)
 }
+
+// authorizePost は投稿が存在し、投稿者が認証済みユーザー本人であることを確認する
+func (u *PostUsecase) authorizePost(actorID uuid.UUID, postId string) error {
+	id, err := parseResourceID(postId)
+	if err != nil {
+		return err
+	}
+	post, err := u.postRepository.GetById(id)
+	if err != nil {
+		return notFoundOr(err)
+	}
+	if post.Edges.User == nil {
+		return fmt.Errorf("user edge not loaded")
+	}
+	return authorizeOwner(actorID, post.Edges.User.ID)
+}
+
+// parseVisibility は投稿の公開範囲を解析する。空の場合は public にする
+func parseVisibility(visibility string) (post.Visibility, error) {
+	if visibility == "" {
+		return post.VisibilityPublic, nil
+	}
+	v := post.Visibility(visibility)
+	if err := post.VisibilityValidator(v); err != nil {
+		return "", fmt.Errorf("%w: %v", ErrInvalidArgument, err)
+	}
+	return v, nil
+}
+
+// viewablePost は viewerID が閲覧できる投稿の ID を返す。
+// 投稿者との間にブロックがある場合や公開範囲外の投稿は存在しないものとして扱う
+func viewablePost(postRepository repository.PostRepository, blockRepository repository.BlockRepository, viewerID uuid.UUID, postId string) (uuid.UUID, error) {
+	id, err := parseResourceID(postId)
+	if err != nil {
+		return uuid.Nil, err
+	}
+	post, err := postRepository.GetById(id)
+	if err != nil {
+		return uuid.Nil, notFoundOr(err)
+	}
+	if post.Edges.User == nil {
+		return uuid.Nil, fmt.Errorf("post edges not loaded")
+	}
+	if err := checkNotBlocked(blockRepository, viewerID, post.Edges.User.ID); err != nil {
+		return uuid.Nil, ErrNotFound
+	}
+	if err := checkPostVisible(postRepository, viewerID, id); err != nil {
+		return uuid.Nil, err
+	}
+	return id, nil
+}
+
+// checkPostVisible は投稿が viewerID から見えなければ ErrNotFound を返す
+func checkPostVisible(postRepository repository.PostRepository, viewerID, postID uuid.UUID) error {
+	visible, err := postRepository.IsVisible(postID, viewerID)
+	if err != nil {
+		return err
+	}
+	if !visible {
+		return ErrNotFound
+	}
+	return nil
+}
@@ -5,12 +5,16 @@ import (
 	"testing"
 
 	"github.com/aki-13627/animalia/backend-go/ent"
+	"github.com/aki-13627/animalia/backend-go/ent/post"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/models"
 	"github.com/aki-13627/animalia/backend-go/internal/domain/repository/mock"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 )
 
 func TestPostUsecase_GetAllPosts(t *testing.T) {
+	actorID := uuid.New()
+
 	// Test cases
 	testCases := []struct {
 		name          string
@@ -39,8 +43,10 @@ func TestPostUsecase_GetAllPosts(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			// Create mock repository
 			mockRepo := &mock.MockPostRepository{
-				GetAllPostsFunc: func() ([]*ent.Post, error) {
-					return tc.mockPosts, tc.mockError
+				GetAllPostsFunc: func(viewerID uuid.UUID, page models.PageRequest) ([]*ent.Post, string, error) {
+					assert.Equal(t, actorID, viewerID)
+					assert.Equal(t, DefaultPageLimit, page.Limit)
+					return tc.mockPosts, "", tc.mockError
 				},
 			}
 
@@ -48,7 +54,7 @@ func TestPostUsecase_GetAllPosts(t *testing.T) {
 			usecase := NewPostUsecase(mockRepo)
 
 			// Call the method
-			posts, err := usecase.GetAllPosts()
+			posts, _, err := usecase.GetAllPosts(actorID, models.PageRequest{Limit: DefaultPageLimit})
 
 			// Check error
 			if tc.expectedError != nil {
@@ -68,13 +74,16 @@ func TestPostUsecase_GetAllPosts(t *testing.T) {
 }
 
 func TestPostUsecase_CreatePost(t *testing.T) {
+	actorID := uuid.New()
+
 	// Test cases
 	testCases := []struct {
 		name           string
 		caption        string
 		userId         string
-		fileKey        string
+		media          []models.MediaItem
 		dailyTaskId    *string
+		visibility     string
 		mockPost       *ent.Post
 		mockError      error
 		expectedPost   *ent.Post
@@ -83,8 +92,8 @@ func TestPostUsecase_CreatePost(t *testing.T) {
 		{
 			name:          "Success",
 			caption:       "Test caption",
-			userId:        uuid.New().String(),
-			fileKey:       "test-file-key",
+			userId:        actorID.String(),
+			media:         []models.MediaItem{{Type: models.MediaTypeImage, Key: "test-file-key"}},
 			dailyTaskId:   nil,
 			mockPost:      &ent.Post{ID: uuid.New(), Caption: "Test caption"},
 			mockError:     nil,
@@ -94,8 +103,8 @@ func TestPostUsecase_CreatePost(t *testing.T) {
 		{
 			name:          "Success with dailyTaskId",
 			caption:       "Test caption",
-			userId:        uuid.New().String(),
-			fileKey:       "test-file-key",
+			userId:        actorID.String(),
+			media:         []models.MediaItem{{Type: models.MediaTypeImage, Key: "test-file-key"}},
 			dailyTaskId:   func() *string { s := "task-id"; return &s }(),
 			mockPost:      &ent.Post{ID: uuid.New(), Caption: "Test caption"},
 			mockError:     nil,
@@ -105,25 +114,77 @@ func TestPostUsecase_CreatePost(t *testing.T) {
 		{
 			name:          "Error",
 			caption:       "Test caption",
-			userId:        uuid.New().String(),
-			fileKey:       "test-file-key",
+			userId:        actorID.String(),
+			media:         []models.MediaItem{{Type: models.MediaTypeImage, Key: "test-file-key"}},
 			dailyTaskId:   nil,
 			mockPost:      nil,
 			mockError:     errors.New("database error"),
 			expectedPost:  nil,
 			expectedError: errors.New("database error"),
 		},
+		{
+			name:          "Multiple images",
+			caption:       "Test caption",
+			userId:        actorID.String(),
+			media: []models.MediaItem{
+				{Type: models.MediaTypeImage, Key: "first-file-key"},
+				{Type: models.MediaTypeVideo, Key: "second-file-key", PosterKey: "second-poster-key"},
+				{Type: models.MediaTypeImage, Key: "third-file-key"},
+			},
+			mockPost:      &ent.Post{ID: uuid.New(), Caption: "Test caption"},
+			expectedPost:  &ent.Post{ID: uuid.New(), Caption: "Test caption"},
+			expectedError: nil,
+		},
+		{
+			name:          "Followers only",
+			caption:       "Test caption",
+			userId:        actorID.String(),
+			media:         []models.MediaItem{{Type: models.MediaTypeImage, Key: "test-file-key"}},
+			visibility:    "followers",
+			mockPost:      &ent.Post{ID: uuid.New(), Caption: "Test caption"},
+			expectedPost:  &ent.Post{ID: uuid.New(), Caption: "Test caption"},
+			expectedError: nil,
+		},
+		{
+			name:          "No images",
+			caption:       "Test caption",
+			userId:        actorID.String(),
+			media:         []models.MediaItem{},
+			expectedPost:  nil,
+			expectedError: ErrNoPostMedia,
+		},
+		{
+			name:          "Too many images",
+			caption:       "Test caption",
+			userId:        actorID.String(),
+			media:         make([]models.MediaItem, MaxPostMedia+1),
+			expectedPost:  nil,
+			expectedError: ErrTooManyPostMedia,
+		},
+		{
+			name:          "Forbidden",
+			caption:       "Test caption",
+			userId:        uuid.New().String(),
+			media:         []models.MediaItem{{Type: models.MediaTypeImage, Key: "test-file-key"}},
+			expectedPost:  nil,
+			expectedError: ErrForbidden,
+		},
 	}
 
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
 			// Create mock repository
 			mockRepo := &mock.MockPostRepository{
-				CreatePostFunc: func(caption, userId, fileKey string, dailyTaskId *string) (*ent.Post, error) {
+				CreatePostFunc: func(caption, userId string, visibility post.Visibility, media []models.MediaItem, dailyTaskId *string) (*ent.Post, error) {
 					// Verify input parameters
+					expectedVisibility := post.VisibilityPublic
+					if tc.visibility != "" {
+						expectedVisibility = post.Visibility(tc.visibility)
+					}
 					assert.Equal(t, tc.caption, caption)
 					assert.Equal(t, tc.userId, userId)
-					assert.Equal(t, tc.fileKey, fileKey)
+					assert.Equal(t, expectedVisibility, visibility)
+					assert.Equal(t, tc.media, media)
 					assert.Equal(t, tc.dailyTaskId, dailyTaskId)
 					return tc.mockPost, tc.mockError
 				},
@@ -133,7 +194,7 @@ func TestPostUsecase_CreatePost(t *testing.T) {
 			usecase := NewPostUsecase(mockRepo)
 
 			// Call the method
-			post, err := usecase.CreatePost(tc.caption, tc.userId, tc.fileKey, tc.dailyTaskId)
+			post, err := usecase.CreatePost(actorID, tc.caption, tc.userId, tc.visibility, tc.media, tc.dailyTaskId)
 
 			// Check error
 			if tc.expectedError != nil {
@@ -155,16 +216,21 @@ func TestPostUsecase_CreatePost(t *testing.T) {
 }
 
 func TestPostUsecase_UpdatePost(t *testing.T) {
+	ownerID := uuid.New()
+
 	// Test cases
 	testCases := []struct {
 		name          string
+		actorID       uuid.UUID
 		postId        string
 		caption       string
+		mockGetError  error
 		mockError     error
 		expectedError error
 	}{
 		{
 			name:          "Success",
+			actorID:       ownerID,
 			postId:        uuid.New().String(),
 			caption:       "Updated caption",
 			mockError:     nil,
@@ -172,19 +238,50 @@ func TestPostUsecase_UpdatePost(t *testing.T) {
 		},
 		{
 			name:          "Error",
+			actorID:       ownerID,
 			postId:        uuid.New().String(),
 			caption:       "Updated caption",
 			mockError:     errors.New("database error"),
 			expectedError: errors.New("database error"),
 		},
+		{
+			name:          "Forbidden",
+			actorID:       uuid.New(),
+			postId:        uuid.New().String(),
+			caption:       "Updated caption",
+			expectedError: ErrForbidden,
+		},
+		{
+			name:          "Not found",
+			actorID:       ownerID,
+			postId:        uuid.New().String(),
+			caption:       "Updated caption",
+			mockGetError:  &ent.NotFoundError{},
+			expectedError: ErrNotFound,
+		},
+		{
+			name:          "Empty caption",
+			actorID:       ownerID,
+			postId:        uuid.New().String(),
+			caption:       "  ",
+			expectedError: ErrInvalidArgument,
+		},
 	}
 
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
 			// Create mock repository
 			mockRepo := &mock.MockPostRepository{
-				UpdatePostFunc: func(postId, caption string) error {
+				GetByIdFunc: func(postId uuid.UUID) (*ent.Post, error) {
+					assert.Equal(t, tc.postId, postId.String())
+					if tc.mockGetError != nil {
+						return nil, tc.mockGetError
+					}
+					return &ent.Post{ID: postId, Edges: ent.PostEdges{User: &ent.User{ID: ownerID}}}, nil
+				},
+				UpdatePostFunc: func(editorID uuid.UUID, postId, caption string) error {
 					// Verify input parameters
+					assert.Equal(t, tc.actorID, editorID)
 					assert.Equal(t, tc.postId, postId)
 					assert.Equal(t, tc.caption, caption)
 					return tc.mockError
@@ -195,12 +292,16 @@ func TestPostUsecase_UpdatePost(t *testing.T) {
 			usecase := NewPostUsecase(mockRepo)
 
 			// Call the method
-			err := usecase.UpdatePost(tc.postId, tc.caption)
+			err := usecase.UpdatePost(tc.actorID, tc.postId, tc.caption)
 
 			// Check error
 			if tc.expectedError != nil {
 				assert.Error(t, err)
-				assert.Equal(t, tc.expectedError.Error(), err.Error())
+				if tc.mockError == nil {
+					assert.ErrorIs(t, err, tc.expectedError)
+				} else {
+					assert.Equal(t, tc.expectedError.Error(), err.Error())
+				}
 			} else {
 				assert.NoError(t, err)
 			}
@@ -208,32 +309,260 @@ func TestPostUsecase_UpdatePost(t *testing.T) {
 	}
 }
 
+func TestPostUsecase_GetPost(t *testing.T) {
+	viewerID := uuid.New()
+	postID := uuid.New()
+
+	// Test cases
+	testCases := []struct {
+		name          string
+		postId        string
+		mockError     error
+		expectedError error
+	}{
+		{
+			name:   "Success",
+			postId: postID.String(),
+		},
+		{
+			name:          "Not visible",
+			postId:        postID.String(),
+			mockError:     &ent.NotFoundError{},
+			expectedError: ErrNotFound,
+		},
+		{
+			name:          "Invalid ID",
+			postId:        "invalid",
+			expectedError: ErrNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockRepo := &mock.MockPostRepository{
+				GetDetailFunc: func(postId, viewer uuid.UUID) (*ent.Post, error) {
+					assert.Equal(t, postID, postId)
+					assert.Equal(t, viewerID, viewer)
+					if tc.mockError != nil {
+						return nil, tc.mockError
+					}
+					return &ent.Post{ID: postId, Caption: "caption"}, nil
+				},
+			}
+
+			usecase := NewPostUsecase(mockRepo)
+			post, err := usecase.GetPost(viewerID, tc.postId)
+
+			if tc.expectedError != nil {
+				assert.ErrorIs(t, err, tc.expectedError)
+				assert.Nil(t, post)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, postID, post.ID)
+			}
+		})
+	}
+}
+
+func TestPostUsecase_GetSharedPost(t *testing.T) {
+	postID := uuid.New()
+	mockRepo := &mock.MockPostRepository{
+		GetDetailFunc: func(postId, viewerID uuid.UUID) (*ent.Post, error) {
+			// 共有ページは未ログインの閲覧者として取得する
+			assert.Equal(t, uuid.Nil, viewerID)
+			return &ent.Post{ID: postId}, nil
+		},
+	}
+
+	usecase := NewPostUsecase(mockRepo)
+	post, err := usecase.GetSharedPost(postID.String())
+
+	assert.NoError(t, err)
+	assert.Equal(t, postID, post.ID)
+}
+
+func TestPostUsecase_GetRevisions(t *testing.T) {
+	viewerID := uuid.New()
+	postID := uuid.New()
+	editor := &ent.User{ID: uuid.New(), Name: "editor"}
+
+	// Test cases
+	testCases := []struct {
+		name          string
+		postId        string
+		hidden        bool
+		expectedLen   int
+		expectedError error
+	}{
+		{
+			name:        "Success",
+			postId:      postID.String(),
+			expectedLen: 2,
+		},
+		{
+			name:          "Post not visible",
+			postId:        postID.String(),
+			hidden:        true,
+			expectedError: ErrNotFound,
+		},
+		{
+			name:          "Invalid ID",
+			postId:        "invalid",
+			expectedError: ErrNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockRepo := &mock.MockPostRepository{
+				GetByIdFunc: func(postId uuid.UUID) (*ent.Post, error) {
+					return &ent.Post{ID: postId}, nil
+				},
+				IsVisibleFunc: func(postId, viewer uuid.UUID) (bool, error) {
+					assert.Equal(t, viewerID, viewer)
+					return !tc.hidden, nil
+				},
+				GetRevisionsFunc: func(postId uuid.UUID) ([]*ent.PostRevision, error) {
+					assert.Equal(t, postID, postId)
+					return []*ent.PostRevision{
+						{ID: uuid.New(), Caption: "original", Edges: ent.PostRevisionEdges{Editor: editor}},
+						{ID: uuid.New(), Caption: "edited", Edges: ent.PostRevisionEdges{Editor: editor}},
+					}, nil
+				},
+			}
+
+			usecase := NewPostUsecase(mockRepo)
+			revisions, err := usecase.GetRevisions(viewerID, tc.postId)
+
+			if tc.expectedError != nil {
+				assert.ErrorIs(t, err, tc.expectedError)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Len(t, revisions, tc.expectedLen)
+			assert.Equal(t, "edited", revisions[1].Caption)
+			assert.Equal(t, "editor", revisions[1].EditorName)
+		})
+	}
+}
+
+func TestPostUsecase_UpdateVisibility(t *testing.T) {
+	ownerID := uuid.New()
+	postID := uuid.New()
+
+	// Test cases
+	testCases := []struct {
+		name          string
+		actorID       uuid.UUID
+		visibility    string
+		expectUpdated bool
+		expectedError error
+	}{
+		{
+			name:          "Success",
+			actorID:       ownerID,
+			visibility:    "only_me",
+			expectUpdated: true,
+		},
+		{
+			name:          "Invalid visibility",
+			actorID:       ownerID,
+			visibility:    "friends",
+			expectedError: ErrInvalidArgument,
+		},
+		{
+			name:          "Missing visibility",
+			actorID:       ownerID,
+			expectedError: ErrInvalidArgument,
+		},
+		{
+			name:          "Forbidden",
+			actorID:       uuid.New(),
+			visibility:    "followers",
+			expectedError: ErrForbidden,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			updated := false
+			mockRepo := &mock.MockPostRepository{
+				GetByIdFunc: func(postId uuid.UUID) (*ent.Post, error) {
+					return &ent.Post{ID: postId, Edges: ent.PostEdges{User: &ent.User{ID: ownerID}}}, nil
+				},
+				UpdateVisibilityFunc: func(postId uuid.UUID, visibility post.Visibility) error {
+					assert.Equal(t, postID, postId)
+					assert.Equal(t, post.Visibility(tc.visibility), visibility)
+					updated = true
+					return nil
+				},
+			}
+
+			usecase := NewPostUsecase(mockRepo)
+			err := usecase.UpdateVisibility(tc.actorID, postID.String(), tc.visibility)
+
+			if tc.expectedError != nil {
+				assert.ErrorIs(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tc.expectUpdated, updated)
+		})
+	}
+}
+
 func TestPostUsecase_DeletePost(t *testing.T) {
+	ownerID := uuid.New()
+
 	// Test cases
 	testCases := []struct {
 		name          string
+		actorID       uuid.UUID
 		postId        string
+		mockGetError  error
 		mockError     error
 		expectedError error
 	}{
 		{
 			name:          "Success",
+			actorID:       ownerID,
 			postId:        uuid.New().String(),
 			mockError:     nil,
 			expectedError: nil,
 		},
 		{
 			name:          "Error",
+			actorID:       ownerID,
 			postId:        uuid.New().String(),
 			mockError:     errors.New("database error"),
 			expectedError: errors.New("database error"),
 		},
+		{
+			name:          "Forbidden",
+			actorID:       uuid.New(),
+			postId:        uuid.New().String(),
+			expectedError: ErrForbidden,
+		},
+		{
+			name:          "Not found",
+			actorID:       ownerID,
+			postId:        uuid.New().String(),
+			mockGetError:  &ent.NotFoundError{},
+			expectedError: ErrNotFound,
+		},
 	}
 
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
 			// Create mock repository
 			mockRepo := &mock.MockPostRepository{
+				GetByIdFunc: func(postId uuid.UUID) (*ent.Post, error) {
+					assert.Equal(t, tc.postId, postId.String())
+					if tc.mockGetError != nil {
+						return nil, tc.mockGetError
+					}
+					return &ent.Post{ID: postId, Edges: ent.PostEdges{User: &ent.User{ID: ownerID}}}, nil
+				},
 				DeletePostFunc: func(postId string) error {
 					// Verify input parameters
 					assert.Equal(t, tc.postId, postId)
@@ -245,12 +574,16 @@ func TestPostUsecase_DeletePost(t *testing.T) {
 			usecase := NewPostUsecase(mockRepo)
 
 			// Call the method
-			err := usecase.DeletePost(tc.postId)
+			err := usecase.DeletePost(tc.actorID, tc.postId)
 
 			// Check error
 			if tc.expectedError != nil {
 				assert.Error(t, err)
-				assert.Equal(t, tc.expectedError.Error(), err.Error())
+				if tc.mockError == nil {
+					assert.ErrorIs(t, err, tc.expectedError)
+				} else {
+					assert.Equal(t, tc.expectedError.Error(), err.Error())
+				}
 			} else {
 				assert.NoError(t, err)
 			}
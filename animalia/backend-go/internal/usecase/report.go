@@ -0,0 +1,157 @@
+package usecase
+
+import (
+	"errors"
+	"fmt"
+	"unicode/utf8"
+
+	"github.com/aki-13627/animalia/backend-go/ent"
+	"github.com/aki-13627/animalia/backend-go/ent/report"
+	"github.com/aki-13627/animalia/backend-go/ent/user"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/models"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/repository"
+	"github.com/google/uuid"
+	"github.com/labstack/gommon/log"
+)
+
+const (
+	// DefaultReportAutoHideThreshold は投稿・コメントを自動で非表示にする通報者数の既定値
+	DefaultReportAutoHideThreshold = 3
+	// MaxReportDetailLength は通報の詳細の最大文字数
+	MaxReportDetailLength = 1000
+)
+
+// ErrAlreadyReported は同じ対象に未対応の通報をすでにしている場合のエラー (409)
+var ErrAlreadyReported = errors.New("already reported")
+
+type ReportUsecase struct {
+	reportRepository repository.ReportRepository
+	// autoHideThreshold は未対応の通報がこの人数に達した投稿・コメントを非表示にする。0 以下なら自動で非表示にしない
+	autoHideThreshold int
+}
+
+func NewReportUsecase(reportRepository repository.ReportRepository, autoHideThreshold int) *ReportUsecase {
+	return &ReportUsecase{
+		reportRepository:  reportRepository,
+		autoHideThreshold: autoHideThreshold,
+	}
+}
+
+// File は投稿・コメント・ユーザーを通報する。通報者数がしきい値に達した投稿・コメントはレビューまで非表示にする
+func (u *ReportUsecase) File(reporter *models.Principal, targetType, targetID, reason, detail string) (*models.ReportResponse, error) {
+	if err := report.TargetTypeValidator(report.TargetType(targetType)); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidArgument, err)
+	}
+	if err := report.ReasonValidator(report.Reason(reason)); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidArgument, err)
+	}
+	if utf8.RuneCountInString(detail) > MaxReportDetailLength {
+		return nil, fmt.Errorf("%w: detail is too long", ErrInvalidArgument)
+	}
+	id, err := parseResourceID(targetID)
+	if err != nil {
+		return nil, err
+	}
+	if report.TargetType(targetType) == report.TargetTypeUser && id == reporter.UserID {
+		return nil, fmt.Errorf("%w: cannot report yourself", ErrInvalidArgument)
+	}
+
+	exists, err := u.reportRepository.ExistsOpen(reporter.UserID, report.TargetType(targetType), id)
+	if err != nil {
+		return nil, err
+	}
+	if exists {
+		return nil, ErrAlreadyReported
+	}
+
+	created, reporters, err := u.reportRepository.Create(reporter.UserID, report.TargetType(targetType), id, report.Reason(reason), detail)
+	if err != nil {
+		return nil, notFoundOr(err)
+	}
+	if u.autoHideThreshold > 0 && reporters >= u.autoHideThreshold && created.TargetType != report.TargetTypeUser {
+		// 通報自体は受け付けているため、非表示にできなくてもエラーにしない
+		if err := u.reportRepository.AutoHide(created.TargetType, created.TargetID, reporters); err != nil {
+			log.Errorf("Failed to auto hide %s %s: %v", created.TargetType, created.TargetID, err)
+		}
+	}
+
+	resp := models.NewReportResponse(created)
+	return &resp, nil
+}
+
+// Dismiss は通報を却下し、自動で非表示にした対象を元に戻す
+func (u *ReportUsecase) Dismiss(actor *models.Principal, reportID, note string) (int, error) {
+	r, err := u.getReport(reportID)
+	if err != nil {
+		return 0, err
+	}
+	return u.resolve(actor, r.ID, models.ReportDecision{
+		Action: models.AuditActionDismissReport,
+		Status: report.StatusDismissed,
+		Note:   note,
+	})
+}
+
+// Hide は通報された投稿・コメントを非表示にする
+func (u *ReportUsecase) Hide(actor *models.Principal, reportID, note string) (int, error) {
+	r, err := u.getReport(reportID)
+	if err != nil {
+		return 0, err
+	}
+	if r.TargetType == report.TargetTypeUser {
+		return 0, fmt.Errorf("%w: cannot hide a user", ErrInvalidArgument)
+	}
+	return u.resolve(actor, r.ID, models.ReportDecision{
+		Action: models.AuditActionHideReported,
+		Status: report.StatusActioned,
+		Hidden: true,
+		Note:   note,
+	})
+}
+
+// SuspendAuthor は通報された投稿・コメントを非表示にし、投稿者のアカウントを停止する。
+// ユーザーへの通報ではそのユーザーを停止する。自分自身と管理者は停止できない
+func (u *ReportUsecase) SuspendAuthor(actor *models.Principal, reportID, note string) (int, error) {
+	r, err := u.getReport(reportID)
+	if err != nil {
+		return 0, err
+	}
+	author, err := u.reportRepository.GetTargetAuthor(r.TargetType, r.TargetID)
+	if err != nil {
+		return 0, notFoundOr(err)
+	}
+	if author.ID == actor.UserID || author.Role == user.RoleAdmin {
+		return 0, ErrForbidden
+	}
+	return u.resolve(actor, r.ID, models.ReportDecision{
+		Action:        models.AuditActionSuspendAuthor,
+		Status:        report.StatusActioned,
+		Hidden:        true,
+		SuspendUserID: &author.ID,
+		Note:          note,
+	})
+}
+
+// getReport は未対応の通報を返す。処理済みの通報は再度処理できない
+func (u *ReportUsecase) getReport(reportID string) (*ent.Report, error) {
+	id, err := parseResourceID(reportID)
+	if err != nil {
+		return nil, err
+	}
+	r, err := u.reportRepository.GetById(id)
+	if err != nil {
+		return nil, notFoundOr(err)
+	}
+	if r.Status != report.StatusOpen {
+		return nil, fmt.Errorf("%w: report is already %s", ErrInvalidArgument, r.Status)
+	}
+	return r, nil
+}
+
+func (u *ReportUsecase) resolve(actor *models.Principal, reportID uuid.UUID, decision models.ReportDecision) (int, error) {
+	resolved, err := u.reportRepository.Resolve(actor.UserID, reportID, decision)
+	if err != nil {
+		return 0, notFoundOr(err)
+	}
+	return resolved, nil
+}
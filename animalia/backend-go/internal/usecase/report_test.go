@@ -0,0 +1,265 @@
+package usecase
+
+import (
+	"testing"
+
+	"github.com/aki-13627/animalia/backend-go/ent"
+	"github.com/aki-13627/animalia/backend-go/ent/report"
+	"github.com/aki-13627/animalia/backend-go/ent/user"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/models"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/repository/mock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReportUsecase_File(t *testing.T) {
+	reporter := &models.Principal{UserID: uuid.New()}
+	targetID := uuid.New()
+
+	// Test cases
+	testCases := []struct {
+		name          string
+		targetType    string
+		targetID      string
+		reason        string
+		exists        bool
+		notFound      bool
+		reporters     int
+		expectedError error
+		expectCreated bool
+		expectHidden  bool
+	}{
+		{
+			name:          "Below the threshold",
+			targetType:    "post",
+			targetID:      targetID.String(),
+			reason:        "spam",
+			reporters:     2,
+			expectCreated: true,
+		},
+		{
+			name:          "Reaching the threshold hides the post",
+			targetType:    "post",
+			targetID:      targetID.String(),
+			reason:        "spam",
+			reporters:     3,
+			expectCreated: true,
+			expectHidden:  true,
+		},
+		{
+			name:          "Reaching the threshold hides the comment",
+			targetType:    "comment",
+			targetID:      targetID.String(),
+			reason:        "harassment",
+			reporters:     4,
+			expectCreated: true,
+			expectHidden:  true,
+		},
+		{
+			name:          "Users are never hidden",
+			targetType:    "user",
+			targetID:      targetID.String(),
+			reason:        "harassment",
+			reporters:     5,
+			expectCreated: true,
+		},
+		{
+			name:          "Unknown target type",
+			targetType:    "pet",
+			targetID:      targetID.String(),
+			reason:        "spam",
+			expectedError: ErrInvalidArgument,
+		},
+		{
+			name:          "Unknown reason",
+			targetType:    "post",
+			targetID:      targetID.String(),
+			reason:        "boring",
+			expectedError: ErrInvalidArgument,
+		},
+		{
+			name:          "Reporting yourself",
+			targetType:    "user",
+			targetID:      reporter.UserID.String(),
+			reason:        "other",
+			expectedError: ErrInvalidArgument,
+		},
+		{
+			name:          "Already reported",
+			targetType:    "post",
+			targetID:      targetID.String(),
+			reason:        "spam",
+			exists:        true,
+			expectedError: ErrAlreadyReported,
+		},
+		{
+			name:          "Unknown target",
+			targetType:    "post",
+			targetID:      targetID.String(),
+			reason:        "spam",
+			notFound:      true,
+			expectedError: ErrNotFound,
+			expectCreated: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			created := false
+			hidden := false
+			reportRepo := &mock.MockReportRepository{
+				ExistsOpenFunc: func(reporterID uuid.UUID, targetType report.TargetType, targetID uuid.UUID) (bool, error) {
+					assert.Equal(t, reporter.UserID, reporterID)
+					return tc.exists, nil
+				},
+				CreateFunc: func(reporterID uuid.UUID, targetType report.TargetType, targetID uuid.UUID, reason report.Reason, detail string) (*ent.Report, int, error) {
+					created = true
+					if tc.notFound {
+						return nil, 0, &ent.NotFoundError{}
+					}
+					return &ent.Report{ID: uuid.New(), TargetType: targetType, TargetID: targetID, Reason: reason, Status: report.StatusOpen}, tc.reporters, nil
+				},
+				AutoHideFunc: func(targetType report.TargetType, id uuid.UUID, reportCount int) error {
+					assert.Equal(t, report.TargetType(tc.targetType), targetType)
+					assert.Equal(t, targetID, id)
+					assert.Equal(t, tc.reporters, reportCount)
+					hidden = true
+					return nil
+				},
+			}
+
+			usecase := NewReportUsecase(reportRepo, DefaultReportAutoHideThreshold)
+			resp, err := usecase.File(reporter, tc.targetType, tc.targetID, tc.reason, "")
+
+			if tc.expectedError != nil {
+				assert.ErrorIs(t, err, tc.expectedError)
+				assert.Nil(t, resp)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, report.StatusOpen, resp.Status)
+			}
+			assert.Equal(t, tc.expectCreated, created)
+			assert.Equal(t, tc.expectHidden, hidden)
+		})
+	}
+}
+
+func TestReportUsecase_Resolve(t *testing.T) {
+	moderator := &models.Principal{UserID: uuid.New(), Role: user.RoleModerator}
+	reportID := uuid.New()
+	authorID := uuid.New()
+
+	// Test cases
+	testCases := []struct {
+		name             string
+		action           func(u *ReportUsecase) (int, error)
+		targetType       report.TargetType
+		status           report.Status
+		author           *ent.User
+		expectedError    error
+		expectedDecision *models.ReportDecision
+	}{
+		{
+			name:       "Dismiss unhides the post",
+			action:     func(u *ReportUsecase) (int, error) { return u.Dismiss(moderator, reportID.String(), "ok") },
+			targetType: report.TargetTypePost,
+			status:     report.StatusOpen,
+			expectedDecision: &models.ReportDecision{
+				Action: models.AuditActionDismissReport,
+				Status: report.StatusDismissed,
+				Note:   "ok",
+			},
+		},
+		{
+			name:       "Hide a comment",
+			action:     func(u *ReportUsecase) (int, error) { return u.Hide(moderator, reportID.String(), "") },
+			targetType: report.TargetTypeComment,
+			status:     report.StatusOpen,
+			expectedDecision: &models.ReportDecision{
+				Action: models.AuditActionHideReported,
+				Status: report.StatusActioned,
+				Hidden: true,
+			},
+		},
+		{
+			name:          "Cannot hide a user",
+			action:        func(u *ReportUsecase) (int, error) { return u.Hide(moderator, reportID.String(), "") },
+			targetType:    report.TargetTypeUser,
+			status:        report.StatusOpen,
+			expectedError: ErrInvalidArgument,
+		},
+		{
+			name:       "Suspend the author",
+			action:     func(u *ReportUsecase) (int, error) { return u.SuspendAuthor(moderator, reportID.String(), "spam") },
+			targetType: report.TargetTypePost,
+			status:     report.StatusOpen,
+			author:     &ent.User{ID: authorID, Role: user.RoleUser},
+			expectedDecision: &models.ReportDecision{
+				Action:        models.AuditActionSuspendAuthor,
+				Status:        report.StatusActioned,
+				Hidden:        true,
+				SuspendUserID: &authorID,
+				Note:          "spam",
+			},
+		},
+		{
+			name:          "Cannot suspend an admin",
+			action:        func(u *ReportUsecase) (int, error) { return u.SuspendAuthor(moderator, reportID.String(), "") },
+			targetType:    report.TargetTypePost,
+			status:        report.StatusOpen,
+			author:        &ent.User{ID: authorID, Role: user.RoleAdmin},
+			expectedError: ErrForbidden,
+		},
+		{
+			name:          "Cannot suspend yourself",
+			action:        func(u *ReportUsecase) (int, error) { return u.SuspendAuthor(moderator, reportID.String(), "") },
+			targetType:    report.TargetTypeUser,
+			status:        report.StatusOpen,
+			author:        &ent.User{ID: moderator.UserID, Role: user.RoleModerator},
+			expectedError: ErrForbidden,
+		},
+		{
+			name:          "Already resolved",
+			action:        func(u *ReportUsecase) (int, error) { return u.Dismiss(moderator, reportID.String(), "") },
+			targetType:    report.TargetTypePost,
+			status:        report.StatusActioned,
+			expectedError: ErrInvalidArgument,
+		},
+		{
+			name:          "Invalid report ID",
+			action:        func(u *ReportUsecase) (int, error) { return u.Dismiss(moderator, "not-a-uuid", "") },
+			expectedError: ErrNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var decision *models.ReportDecision
+			reportRepo := &mock.MockReportRepository{
+				GetByIdFunc: func(id uuid.UUID) (*ent.Report, error) {
+					assert.Equal(t, reportID, id)
+					return &ent.Report{ID: id, TargetType: tc.targetType, TargetID: uuid.New(), Status: tc.status}, nil
+				},
+				GetTargetAuthorFunc: func(targetType report.TargetType, targetID uuid.UUID) (*ent.User, error) {
+					return tc.author, nil
+				},
+				ResolveFunc: func(actorID uuid.UUID, id uuid.UUID, d models.ReportDecision) (int, error) {
+					assert.Equal(t, moderator.UserID, actorID)
+					assert.Equal(t, reportID, id)
+					decision = &d
+					return 2, nil
+				},
+			}
+
+			resolved, err := tc.action(NewReportUsecase(reportRepo, DefaultReportAutoHideThreshold))
+
+			if tc.expectedError != nil {
+				assert.ErrorIs(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, 2, resolved)
+			}
+			assert.Equal(t, tc.expectedDecision, decision)
+		})
+	}
+}
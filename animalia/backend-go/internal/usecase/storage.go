@@ -1,20 +1,92 @@
 package usecase
 
 import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
 	"mime/multipart"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
 
+	"github.com/aki-13627/animalia/backend-go/internal/domain/models"
 	"github.com/aki-13627/animalia/backend-go/internal/domain/repository"
+	"github.com/google/uuid"
+	"github.com/labstack/gommon/log"
 )
 
+var (
+	ErrInvalidUploadRequest = errors.New("invalid upload request")
+	ErrInvalidUploadToken   = errors.New("invalid upload token")
+	ErrUploadMismatch       = errors.New("uploaded object does not match the upload token")
+)
+
+// UploadDirectories は直接アップロードを許可するディレクトリ
+var UploadDirectories = []string{"posts", "pets", "profile"}
+
+var uploadContentTypes = []string{"image/jpeg", "image/png", "image/webp"}
+
+var videoContentTypes = []string{"video/mp4", "video/quicktime"}
+
+var videoExtensions = map[string]string{
+	"video/mp4":       ".mp4",
+	"video/quicktime": ".mov",
+}
+
+const uploadTokenTTL = 15 * time.Minute
+
 type StorageUsecase struct {
 	storageRepository repository.StorageRepository
+	videoRepository   repository.VideoRepository
+	uploadTokenSecret []byte
 }
 
-func NewStorageUsecase(storageRepository repository.StorageRepository) *StorageUsecase {
-	return &StorageUsecase{storageRepository: storageRepository}
+// uploadTokenClaims はアップロードトークンに署名して埋め込む内容
+type uploadTokenClaims struct {
+	Key         string `json:"key"`
+	Directory   string `json:"dir"`
+	ContentType string `json:"ct"`
+	Size        int64  `json:"size"`
+	Email       string `json:"sub"`
+	ExpiresAt   int64  `json:"exp"`
 }
 
+func NewStorageUsecase(storageRepository repository.StorageRepository, videoRepository repository.VideoRepository, uploadTokenSecret []byte) *StorageUsecase {
+	return &StorageUsecase{
+		storageRepository: storageRepository,
+		videoRepository:   videoRepository,
+		uploadTokenSecret: uploadTokenSecret,
+	}
+}
+
+// UploadImage はファイルの中身を検証してからアップロードする
 func (u *StorageUsecase) UploadImage(file *multipart.FileHeader, directory string) (string, error) {
+	limit, ok := uploadLimits[directory]
+	if !ok {
+		return "", fmt.Errorf("%w: unknown directory %q", ErrInvalidUploadRequest, directory)
+	}
+	if file.Size > limit.MaxBytes {
+		return "", fmt.Errorf("%w: %d bytes exceeds %d bytes", ErrFileTooLarge, file.Size, limit.MaxBytes)
+	}
+
+	src, err := file.Open()
+	if err != nil {
+		return "", fmt.Errorf("failed to open file: %w", err)
+	}
+	data, err := io.ReadAll(io.LimitReader(src, limit.MaxBytes+1))
+	src.Close()
+	if err != nil {
+		return "", fmt.Errorf("failed to read file: %w", err)
+	}
+	if _, err := ValidateImage(data, directory); err != nil {
+		return "", err
+	}
+
 	return u.storageRepository.UploadImage(file, directory)
 }
 
@@ -22,6 +94,331 @@ func (u *StorageUsecase) GetUrl(fileKey string) (string, error) {
 	return u.storageRepository.GetUrl(fileKey)
 }
 
+// GetUrls は複数のキーの URL をまとめて取得する。空のキーは無視する。
+func (u *StorageUsecase) GetUrls(fileKeys []string) (map[string]string, error) {
+	return getUrls(u.storageRepository, fileKeys)
+}
+
+// GetImageUrls は画像キーから各バリアントの URL を取得する
+func (u *StorageUsecase) GetImageUrls(fileKey string) (models.ImageURLs, error) {
+	return getImageUrls(u.storageRepository, fileKey)
+}
+
+// GetImageUrlsBatch は複数の画像キーについて各バリアントの URL をまとめて取得する
+func (u *StorageUsecase) GetImageUrlsBatch(fileKeys []string) (map[string]models.ImageURLs, error) {
+	return getImageUrlsBatch(u.storageRepository, fileKeys)
+}
+
+// PutObject は署名付き URL 経由で送られたオブジェクトをそのまま保存する（ローカルストレージ用）
+func (u *StorageUsecase) PutObject(fileKey string, body []byte, contentType string) error {
+	return u.storageRepository.PutObject(fileKey, body, contentType)
+}
+
+// UploadMedia は中身から画像か動画かを判定してアップロードする。
+// 動画の場合はポスターフレームも生成して保存する。
+func (u *StorageUsecase) UploadMedia(file *multipart.FileHeader, directory string) (models.MediaItem, error) {
+	src, err := file.Open()
+	if err != nil {
+		return models.MediaItem{}, fmt.Errorf("failed to open file: %w", err)
+	}
+	data, err := io.ReadAll(io.LimitReader(src, MaxUploadBytes+1))
+	src.Close()
+	if err != nil {
+		return models.MediaItem{}, fmt.Errorf("failed to read file: %w", err)
+	}
+
+	if sniffVideoType(data) != "" {
+		return u.storeVideo(data, directory)
+	}
+	key, err := u.UploadImage(file, directory)
+	if err != nil {
+		return models.MediaItem{}, err
+	}
+	return models.MediaItem{Type: models.MediaTypeImage, Key: key}, nil
+}
+
+// DeleteMedia は画像もしくは動画とそのポスターフレームを削除する
+func (u *StorageUsecase) DeleteMedia(item models.MediaItem) error {
+	if item.PosterKey != "" {
+		if err := u.storageRepository.DeleteImage(item.PosterKey); err != nil {
+			return err
+		}
+	}
+	return u.storageRepository.DeleteImage(item.Key)
+}
+
 func (u *StorageUsecase) DeleteImage(fileKey string) error {
 	return u.storageRepository.DeleteImage(fileKey)
 }
+
+// IssueUpload は directory 配下に直接アップロードするための署名付き URL とトークンを発行する
+func (u *StorageUsecase) IssueUpload(email, directory, contentType string, size int64) (*models.UploadTicket, error) {
+	if !slices.Contains(UploadDirectories, directory) {
+		return nil, fmt.Errorf("%w: unknown directory %q", ErrInvalidUploadRequest, directory)
+	}
+	if size <= 0 {
+		return nil, fmt.Errorf("%w: invalid size %d", ErrInvalidUploadRequest, size)
+	}
+	switch {
+	case slices.Contains(uploadContentTypes, contentType):
+		if limit := uploadLimits[directory]; size > limit.MaxBytes {
+			return nil, fmt.Errorf("%w: %d bytes exceeds %d bytes", ErrFileTooLarge, size, limit.MaxBytes)
+		}
+	case slices.Contains(videoContentTypes, contentType):
+		limit, ok := videoUploadLimits[directory]
+		if !ok {
+			return nil, fmt.Errorf("%w: videos are not allowed in %q", ErrUnsupportedMediaType, directory)
+		}
+		if size > limit.MaxBytes {
+			return nil, fmt.Errorf("%w: %d bytes exceeds %d bytes", ErrFileTooLarge, size, limit.MaxBytes)
+		}
+	default:
+		return nil, fmt.Errorf("%w: unsupported content type %q", ErrInvalidUploadRequest, contentType)
+	}
+
+	key := fmt.Sprintf("uploads/%s/%s", directory, uuid.New().String())
+	uploadURL, err := u.storageRepository.GetUploadUrl(key, contentType, size)
+	if err != nil {
+		return nil, err
+	}
+
+	expiresAt := time.Now().Add(uploadTokenTTL)
+	token, err := u.signUploadToken(uploadTokenClaims{
+		Key:         key,
+		Directory:   directory,
+		ContentType: contentType,
+		Size:        size,
+		Email:       email,
+		ExpiresAt:   expiresAt.Unix(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.UploadTicket{
+		UploadURL:   uploadURL,
+		Method:      http.MethodPut,
+		Headers:     map[string]string{"Content-Type": contentType},
+		UploadToken: token,
+		ExpiresAt:   expiresAt,
+	}, nil
+}
+
+// ConsumeUpload はトークンを検証し、アップロード済みの画像を directory に取り込んでキーを返す
+func (u *StorageUsecase) ConsumeUpload(email, directory, token string) (string, error) {
+	claims, err := u.verifyUpload(email, directory, token)
+	if err != nil {
+		return "", err
+	}
+	if !slices.Contains(uploadContentTypes, claims.ContentType) {
+		return "", fmt.Errorf("%w: %q is not an image", ErrUnsupportedMediaType, claims.ContentType)
+	}
+	return u.importImage(claims, directory)
+}
+
+// ConsumeMediaUpload はトークンを検証し、アップロード済みの画像もしくは動画を directory に取り込む
+func (u *StorageUsecase) ConsumeMediaUpload(email, directory, token string) (models.MediaItem, error) {
+	claims, err := u.verifyUpload(email, directory, token)
+	if err != nil {
+		return models.MediaItem{}, err
+	}
+
+	if !slices.Contains(videoContentTypes, claims.ContentType) {
+		key, err := u.importImage(claims, directory)
+		if err != nil {
+			return models.MediaItem{}, err
+		}
+		return models.MediaItem{Type: models.MediaTypeImage, Key: key}, nil
+	}
+
+	data, err := u.storageRepository.GetObject(claims.Key)
+	if err != nil {
+		return models.MediaItem{}, err
+	}
+	if contentType := sniffVideoType(data); contentType != claims.ContentType {
+		return models.MediaItem{}, fmt.Errorf("%w: declared %q but detected %q", ErrUnsupportedMediaType, claims.ContentType, contentType)
+	}
+	item, err := u.storeVideo(data, directory)
+	if err != nil {
+		return models.MediaItem{}, err
+	}
+	if err := u.storageRepository.DeleteImage(claims.Key); err != nil {
+		log.Errorf("Failed to delete imported object %s: %v", claims.Key, err)
+	}
+	return item, nil
+}
+
+// verifyUpload はトークンを検証し、アップロード済みのオブジェクトがトークンの内容と一致するかを確かめる
+func (u *StorageUsecase) verifyUpload(email, directory, token string) (*uploadTokenClaims, error) {
+	claims, err := u.parseUploadToken(token)
+	if err != nil {
+		return nil, err
+	}
+	if claims.Directory != directory || claims.Email != email {
+		return nil, fmt.Errorf("%w: token is not valid for this request", ErrInvalidUploadToken)
+	}
+
+	info, err := u.storageRepository.StatObject(claims.Key)
+	if err != nil {
+		return nil, fmt.Errorf("%w: object not found: %v", ErrUploadMismatch, err)
+	}
+	if info.Size != claims.Size {
+		return nil, fmt.Errorf("%w: size %d != %d", ErrUploadMismatch, info.Size, claims.Size)
+	}
+	// 動画はストレージによって Content-Type を判定できないことがあるため、中身の検証だけを行う
+	if !slices.Contains(videoContentTypes, claims.ContentType) && !strings.EqualFold(info.ContentType, claims.ContentType) {
+		return nil, fmt.Errorf("%w: content type %q != %q", ErrUploadMismatch, info.ContentType, claims.ContentType)
+	}
+	return claims, nil
+}
+
+func (u *StorageUsecase) importImage(claims *uploadTokenClaims, directory string) (string, error) {
+	// Content-Type はクライアントの申告なので、取り込む前に中身を検証する
+	data, err := u.storageRepository.GetObject(claims.Key)
+	if err != nil {
+		return "", err
+	}
+	contentType, err := ValidateImage(data, directory)
+	if err != nil {
+		return "", err
+	}
+	if contentType != claims.ContentType {
+		return "", fmt.Errorf("%w: declared %q but detected %q", ErrUnsupportedMediaType, claims.ContentType, contentType)
+	}
+
+	return u.storageRepository.ImportObject(claims.Key, directory)
+}
+
+// storeVideo は動画を検証して保存し、ポスターフレームを画像として取り込む
+func (u *StorageUsecase) storeVideo(data []byte, directory string) (models.MediaItem, error) {
+	contentType, err := ValidateVideo(data, directory)
+	if err != nil {
+		return models.MediaItem{}, err
+	}
+	info, err := u.videoRepository.Probe(data)
+	if err != nil {
+		return models.MediaItem{}, fmt.Errorf("%w: %v", ErrInvalidVideo, err)
+	}
+	if err := ValidateVideoInfo(info, directory); err != nil {
+		return models.MediaItem{}, err
+	}
+	poster, err := u.videoRepository.PosterFrame(data)
+	if err != nil {
+		return models.MediaItem{}, fmt.Errorf("%w: %v", ErrInvalidVideo, err)
+	}
+
+	// ポスターフレームは画像と同じ処理でバリアントを生成する
+	posterSrc := fmt.Sprintf("uploads/posters/%s.jpg", uuid.New().String())
+	if err := u.storageRepository.PutObject(posterSrc, poster, "image/jpeg"); err != nil {
+		return models.MediaItem{}, err
+	}
+	posterKey, err := u.storageRepository.ImportObject(posterSrc, directory)
+	if err != nil {
+		return models.MediaItem{}, err
+	}
+
+	videoKey := fmt.Sprintf("%s/%s/video%s", directory, uuid.New().String(), videoExtensions[contentType])
+	if err := u.storageRepository.PutObject(videoKey, data, contentType); err != nil {
+		if err := u.storageRepository.DeleteImage(posterKey); err != nil {
+			log.Errorf("Failed to clean up poster %s: %v", posterKey, err)
+		}
+		return models.MediaItem{}, err
+	}
+
+	log.Infof("Stored video %s (%dx%d, %s)", videoKey, info.Width, info.Height, info.Duration)
+	return models.MediaItem{Type: models.MediaTypeVideo, Key: videoKey, PosterKey: posterKey}, nil
+}
+
+func (u *StorageUsecase) signUploadToken(claims uploadTokenClaims) (string, error) {
+	payload, err := json.Marshal(claims)
+	if err != nil {
+		return "", err
+	}
+	encoded := base64.RawURLEncoding.EncodeToString(payload)
+	return encoded + "." + base64.RawURLEncoding.EncodeToString(u.uploadTokenMAC(encoded)), nil
+}
+
+func (u *StorageUsecase) parseUploadToken(token string) (*uploadTokenClaims, error) {
+	encoded, signature, ok := strings.Cut(token, ".")
+	if !ok {
+		return nil, ErrInvalidUploadToken
+	}
+	given, err := base64.RawURLEncoding.DecodeString(signature)
+	if err != nil || !hmac.Equal(given, u.uploadTokenMAC(encoded)) {
+		return nil, ErrInvalidUploadToken
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, ErrInvalidUploadToken
+	}
+	var claims uploadTokenClaims
+	if err := json.Unmarshal(payload, &claims); err != nil {
+		return nil, ErrInvalidUploadToken
+	}
+	if time.Now().Unix() > claims.ExpiresAt {
+		return nil, fmt.Errorf("%w: token has expired", ErrInvalidUploadToken)
+	}
+	return &claims, nil
+}
+
+func (u *StorageUsecase) uploadTokenMAC(encoded string) []byte {
+	h := hmac.New(sha256.New, u.uploadTokenSecret)
+	h.Write([]byte(encoded))
+	return h.Sum(nil)
+}
+
+func getImageUrls(storageRepository repository.StorageRepository, fileKey string) (models.ImageURLs, error) {
+	imageURLs, err := getImageUrlsBatch(storageRepository, []string{fileKey})
+	if err != nil {
+		return models.ImageURLs{}, err
+	}
+	return imageURLs[fileKey], nil
+}
+
+// getUrls は空でないキーの URL をまとめて取得する。空のキーは結果に含めない。
+func getUrls(storageRepository repository.StorageRepository, fileKeys []string) (map[string]string, error) {
+	keys := make([]string, 0, len(fileKeys))
+	seen := make(map[string]struct{}, len(fileKeys))
+	for _, key := range fileKeys {
+		if _, ok := seen[key]; ok || key == "" {
+			continue
+		}
+		seen[key] = struct{}{}
+		keys = append(keys, key)
+	}
+	if len(keys) == 0 {
+		return map[string]string{}, nil
+	}
+	return storageRepository.GetUrls(keys)
+}
+
+// getImageUrlsBatch は複数の画像キーについて、各バリアントの URL をまとめて取得する
+func getImageUrlsBatch(storageRepository repository.StorageRepository, fileKeys []string) (map[string]models.ImageURLs, error) {
+	variantKeys := make([]string, 0, len(fileKeys)*len(models.ImageVariants))
+	for _, key := range fileKeys {
+		if key == "" {
+			continue
+		}
+		for _, variant := range models.ImageVariants {
+			variantKeys = append(variantKeys, models.ImageVariantKey(key, variant))
+		}
+	}
+	urls, err := getUrls(storageRepository, variantKeys)
+	if err != nil {
+		return nil, err
+	}
+
+	imageURLs := make(map[string]models.ImageURLs, len(fileKeys))
+	for _, key := range fileKeys {
+		if key == "" {
+			continue
+		}
+		imageURLs[key] = models.ImageURLs{
+			Thumb:  urls[models.ImageVariantKey(key, models.ImageVariantThumb)],
+			Medium: urls[models.ImageVariantKey(key, models.ImageVariantMedium)],
+			Full:   urls[models.ImageVariantKey(key, models.ImageVariantFull)],
+		}
+	}
+	return imageURLs, nil
+}
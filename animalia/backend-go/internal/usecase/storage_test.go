@@ -0,0 +1,292 @@
+package usecase
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aki-13627/animalia/backend-go/internal/domain/models"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/repository/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStorageUsecase_IssueAndConsumeUpload(t *testing.T) {
+	// Test cases
+	testCases := []struct {
+		name          string
+		consumeEmail  string
+		consumeDir    string
+		tamperToken   bool
+		objectInfo    *models.ObjectInfo
+		objectData    []byte
+		statError     error
+		expectedKey   string
+		expectedError error
+	}{
+		{
+			name:        "Success",
+			consumeDir:  "posts",
+			objectInfo:  &models.ObjectInfo{Size: 1024, ContentType: "image/jpeg"},
+			expectedKey: "posts/imported.jpg",
+		},
+		{
+			name:          "Directory mismatch",
+			consumeDir:    "pets",
+			objectInfo:    &models.ObjectInfo{Size: 1024, ContentType: "image/jpeg"},
+			expectedError: ErrInvalidUploadToken,
+		},
+		{
+			name:          "Other user",
+			consumeEmail:  "other@example.com",
+			consumeDir:    "posts",
+			objectInfo:    &models.ObjectInfo{Size: 1024, ContentType: "image/jpeg"},
+			expectedError: ErrInvalidUploadToken,
+		},
+		{
+			name:          "Tampered token",
+			consumeDir:    "posts",
+			tamperToken:   true,
+			objectInfo:    &models.ObjectInfo{Size: 1024, ContentType: "image/jpeg"},
+			expectedError: ErrInvalidUploadToken,
+		},
+		{
+			name:          "Object not uploaded",
+			consumeDir:    "posts",
+			statError:     errors.New("not found"),
+			expectedError: ErrUploadMismatch,
+		},
+		{
+			name:          "Size mismatch",
+			consumeDir:    "posts",
+			objectInfo:    &models.ObjectInfo{Size: 4096, ContentType: "image/jpeg"},
+			expectedError: ErrUploadMismatch,
+		},
+		{
+			name:          "Content type mismatch",
+			consumeDir:    "posts",
+			objectInfo:    &models.ObjectInfo{Size: 1024, ContentType: "text/html"},
+			expectedError: ErrUploadMismatch,
+		},
+		{
+			name:          "Declared type differs from content",
+			consumeDir:    "posts",
+			objectInfo:    &models.ObjectInfo{Size: 1024, ContentType: "image/jpeg"},
+			objectData:    testImage(t, "png", 16, 16),
+			expectedError: ErrUnsupportedMediaType,
+		},
+		{
+			name:          "Polyglot content",
+			consumeDir:    "posts",
+			objectInfo:    &models.ObjectInfo{Size: 1024, ContentType: "image/jpeg"},
+			objectData:    append(testImage(t, "jpeg", 16, 16), []byte("PK\x03\x04payload")...),
+			expectedError: ErrInvalidImage,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var uploadKey string
+			mockRepo := &mock.MockStorageRepository{
+				GetUploadUrlFunc: func(fileKey string, contentType string, size int64) (string, error) {
+					uploadKey = fileKey
+					return "https://example.com/upload", nil
+				},
+				StatObjectFunc: func(fileKey string) (*models.ObjectInfo, error) {
+					assert.Equal(t, uploadKey, fileKey)
+					return tc.objectInfo, tc.statError
+				},
+				GetObjectFunc: func(fileKey string) ([]byte, error) {
+					assert.Equal(t, uploadKey, fileKey)
+					if tc.objectData != nil {
+						return tc.objectData, nil
+					}
+					return testImage(t, "jpeg", 16, 16), nil
+				},
+				ImportObjectFunc: func(srcKey string, directory string) (string, error) {
+					assert.Equal(t, uploadKey, srcKey)
+					return directory + "/imported.jpg", nil
+				},
+			}
+			usecase := NewStorageUsecase(mockRepo, &mock.MockVideoRepository{}, []byte("secret"))
+
+			ticket, err := usecase.IssueUpload("test@example.com", "posts", "image/jpeg", 1024)
+			assert.NoError(t, err)
+			assert.Contains(t, uploadKey, "uploads/posts/")
+
+			token := ticket.UploadToken
+			if tc.tamperToken {
+				token = "x" + token
+			}
+			email := "test@example.com"
+			if tc.consumeEmail != "" {
+				email = tc.consumeEmail
+			}
+
+			key, err := usecase.ConsumeUpload(email, tc.consumeDir, token)
+			if tc.expectedError != nil {
+				assert.ErrorIs(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedKey, key)
+			}
+		})
+	}
+}
+
+func TestStorageUsecase_IssueUpload_InvalidRequest(t *testing.T) {
+	usecase := NewStorageUsecase(&mock.MockStorageRepository{}, &mock.MockVideoRepository{}, []byte("secret"))
+
+	_, err := usecase.IssueUpload("test@example.com", "exports", "image/jpeg", 1024)
+	assert.ErrorIs(t, err, ErrInvalidUploadRequest)
+
+	_, err = usecase.IssueUpload("test@example.com", "posts", "text/html", 1024)
+	assert.ErrorIs(t, err, ErrInvalidUploadRequest)
+
+	_, err = usecase.IssueUpload("test@example.com", "posts", "image/jpeg", 0)
+	assert.ErrorIs(t, err, ErrInvalidUploadRequest)
+
+	_, err = usecase.IssueUpload("test@example.com", "profile", "image/jpeg", 10<<20)
+	assert.ErrorIs(t, err, ErrFileTooLarge)
+}
+
+func TestStorageUsecase_GetImageUrlsBatch(t *testing.T) {
+	var requested [][]string
+	mockRepo := &mock.MockStorageRepository{
+		GetUrlsFunc: func(fileKeys []string) (map[string]string, error) {
+			requested = append(requested, fileKeys)
+			urls := make(map[string]string, len(fileKeys))
+			for _, key := range fileKeys {
+				urls[key] = "https://example.com/" + key
+			}
+			return urls, nil
+		},
+	}
+	usecase := NewStorageUsecase(mockRepo, &mock.MockVideoRepository{}, []byte("secret"))
+
+	imageURLs, err := usecase.GetImageUrlsBatch([]string{"posts/a/full.jpg", "", "pets/legacy.jpg", "posts/a/full.jpg"})
+
+	assert.NoError(t, err)
+	// 重複と空のキーを除いて1回で取得する
+	assert.Equal(t, [][]string{{
+		"posts/a/thumb.jpg", "posts/a/medium.jpg", "posts/a/full.jpg", "pets/legacy.jpg",
+	}}, requested)
+	assert.Equal(t, models.ImageURLs{
+		Thumb:  "https://example.com/posts/a/thumb.jpg",
+		Medium: "https://example.com/posts/a/medium.jpg",
+		Full:   "https://example.com/posts/a/full.jpg",
+	}, imageURLs["posts/a/full.jpg"])
+	assert.Equal(t, "https://example.com/pets/legacy.jpg", imageURLs["pets/legacy.jpg"].Thumb)
+	assert.NotContains(t, imageURLs, "")
+}
+
+func TestStorageUsecase_GetUrls_Empty(t *testing.T) {
+	usecase := NewStorageUsecase(&mock.MockStorageRepository{}, &mock.MockVideoRepository{}, []byte("secret"))
+
+	urls, err := usecase.GetUrls([]string{"", ""})
+
+	assert.NoError(t, err)
+	assert.Empty(t, urls)
+}
+
+func TestStorageUsecase_ConsumeMediaUpload_Video(t *testing.T) {
+	video := append([]byte("\x00\x00\x00\x18ftypmp42\x00\x00\x00\x00isommp42"), make([]byte, 1000)...)
+
+	// Test cases
+	testCases := []struct {
+		name          string
+		videoInfo     *models.VideoInfo
+		probeError    error
+		expectedError error
+	}{
+		{
+			name:      "Success",
+			videoInfo: &models.VideoInfo{Duration: 12 * time.Second, Width: 720, Height: 1280},
+		},
+		{
+			name:          "Too long",
+			videoInfo:     &models.VideoInfo{Duration: 45 * time.Second, Width: 720, Height: 1280},
+			expectedError: ErrFileTooLarge,
+		},
+		{
+			name:          "No video stream",
+			videoInfo:     &models.VideoInfo{Duration: 12 * time.Second},
+			expectedError: ErrInvalidVideo,
+		},
+		{
+			name:          "Probe error",
+			probeError:    errors.New("moov atom not found"),
+			expectedError: ErrInvalidVideo,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var uploadKey string
+			stored := map[string][]byte{}
+			mockRepo := &mock.MockStorageRepository{
+				GetUploadUrlFunc: func(fileKey string, contentType string, size int64) (string, error) {
+					uploadKey = fileKey
+					return "https://example.com/upload", nil
+				},
+				StatObjectFunc: func(fileKey string) (*models.ObjectInfo, error) {
+					return &models.ObjectInfo{Size: int64(len(video)), ContentType: "application/octet-stream"}, nil
+				},
+				GetObjectFunc: func(fileKey string) ([]byte, error) {
+					return video, nil
+				},
+				PutObjectFunc: func(fileKey string, body []byte, contentType string) error {
+					stored[fileKey] = body
+					return nil
+				},
+				ImportObjectFunc: func(srcKey string, directory string) (string, error) {
+					assert.Contains(t, srcKey, "uploads/posters/")
+					return directory + "/poster/full.jpg", nil
+				},
+				DeleteImageFunc: func(fileKey string) error {
+					assert.Equal(t, uploadKey, fileKey)
+					return nil
+				},
+			}
+			mockVideoRepo := &mock.MockVideoRepository{
+				ProbeFunc: func(data []byte) (*models.VideoInfo, error) {
+					return tc.videoInfo, tc.probeError
+				},
+				PosterFrameFunc: func(data []byte) ([]byte, error) {
+					return testImage(t, "jpeg", 16, 16), nil
+				},
+			}
+			usecase := NewStorageUsecase(mockRepo, mockVideoRepo, []byte("secret"))
+
+			ticket, err := usecase.IssueUpload("test@example.com", "posts", "video/mp4", int64(len(video)))
+			assert.NoError(t, err)
+
+			item, err := usecase.ConsumeMediaUpload("test@example.com", "posts", ticket.UploadToken)
+			if tc.expectedError != nil {
+				assert.ErrorIs(t, err, tc.expectedError)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, models.MediaTypeVideo, item.Type)
+			assert.Equal(t, "posts/poster/full.jpg", item.PosterKey)
+			assert.Regexp(t, `^posts/[0-9a-f-]+/video\.mp4$`, item.Key)
+			assert.Equal(t, video, stored[item.Key])
+		})
+	}
+}
+
+func TestStorageUsecase_IssueUpload_Video(t *testing.T) {
+	usecase := NewStorageUsecase(&mock.MockStorageRepository{
+		GetUploadUrlFunc: func(fileKey string, contentType string, size int64) (string, error) {
+			return "https://example.com/upload", nil
+		},
+	}, &mock.MockVideoRepository{}, []byte("secret"))
+
+	_, err := usecase.IssueUpload("test@example.com", "posts", "video/quicktime", 50<<20)
+	assert.NoError(t, err)
+
+	_, err = usecase.IssueUpload("test@example.com", "posts", "video/mp4", 200<<20)
+	assert.ErrorIs(t, err, ErrFileTooLarge)
+
+	_, err = usecase.IssueUpload("test@example.com", "pets", "video/mp4", 1<<20)
+	assert.ErrorIs(t, err, ErrUnsupportedMediaType)
+}
@@ -0,0 +1,295 @@
+package usecase
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"path"
+	"time"
+
+	"github.com/aki-13627/animalia/backend-go/ent"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/models"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/repository"
+	"github.com/google/uuid"
+	"github.com/labstack/gommon/log"
+)
+
+const (
+	// TakeoutPrefix はエクスポートした ZIP を保存するストレージ上のディレクトリ
+	TakeoutPrefix = "exports/"
+	// TakeoutURLExpiry はダウンロード用 URL の有効期限
+	TakeoutURLExpiry = time.Hour
+	// TakeoutRetention はエクスポートした ZIP をストレージに残しておく期間
+	TakeoutRetention = 7 * 24 * time.Hour
+)
+
+type TakeoutUsecase struct {
+	takeoutRepository repository.TakeoutRepository
+	storageRepository repository.StorageRepository
+}
+
+func NewTakeoutUsecase(takeoutRepository repository.TakeoutRepository, storageRepository repository.StorageRepository) *TakeoutUsecase {
+	return &TakeoutUsecase{
+		takeoutRepository: takeoutRepository,
+		storageRepository: storageRepository,
+	}
+}
+
+// Export はユーザーのデータを JSON とメディアファイルの ZIP にまとめて保存し、ダウンロード用 URL を返す
+func (u *TakeoutUsecase) Export(userID uuid.UUID) (*models.TakeoutExport, error) {
+	user, err := u.takeoutRepository.GetUserData(userID)
+	if err != nil {
+		return nil, notFoundOr(err)
+	}
+
+	now := time.Now()
+	archive, err := u.buildArchive(user)
+	if err != nil {
+		return nil, err
+	}
+
+	key := fmt.Sprintf("%s%s/%s.zip", TakeoutPrefix, userID, uuid.New())
+	if err := u.storageRepository.PutObject(key, archive, "application/zip"); err != nil {
+		return nil, fmt.Errorf("failed to store export: %w", err)
+	}
+	filename := fmt.Sprintf("animalia-%s.zip", now.Format("20060102"))
+	url, err := u.storageRepository.GetDownloadUrl(key, filename, TakeoutURLExpiry)
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.TakeoutExport{
+		URL:       url,
+		ExpiresAt: now.Add(TakeoutURLExpiry),
+	}, nil
+}
+
+// DeleteExpired は作成から retention を過ぎたエクスポートを削除し、削除した件数を返す
+func (u *TakeoutUsecase) DeleteExpired(retention time.Duration) (int, error) {
+	objects, err := u.storageRepository.ListObjects(TakeoutPrefix)
+	if err != nil {
+		return 0, err
+	}
+
+	cutoff := time.Now().Add(-retention)
+	deleted := 0
+	for _, object := range objects {
+		if object.LastModified.After(cutoff) {
+			continue
+		}
+		if err := u.storageRepository.DeleteImage(object.Key); err != nil {
+			log.Errorf("Failed to delete export %s: %v", object.Key, err)
+			continue
+		}
+		deleted++
+	}
+	return deleted, nil
+}
+
+// takeoutArchive は ZIP への書き込みと、同じメディアファイルを二重に含めないための記録を持つ
+type takeoutArchive struct {
+	storageRepository repository.StorageRepository
+	zip               *zip.Writer
+	files             map[string]string
+	missing           []string
+}
+
+// addMedia はストレージのオブジェクトを media/ 配下に追加し、ZIP 内のパスを返す。
+// 取得できなかったキーは missing_files.json に記録し、エクスポート自体は続ける
+func (a *takeoutArchive) addMedia(key string) (string, error) {
+	if key == "" {
+		return "", nil
+	}
+	if name, ok := a.files[key]; ok {
+		return name, nil
+	}
+
+	body, err := a.storageRepository.GetObject(key)
+	if err != nil {
+		log.Warnf("Failed to fetch %s for export: %v", key, err)
+		a.missing = append(a.missing, key)
+		a.files[key] = ""
+		return "", nil
+	}
+	name := path.Join("media", key)
+	w, err := a.zip.Create(name)
+	if err != nil {
+		return "", err
+	}
+	if _, err := w.Write(body); err != nil {
+		return "", err
+	}
+	a.files[key] = name
+	return name, nil
+}
+
+func (a *takeoutArchive) addJSON(name string, v interface{}) error {
+	w, err := a.zip.Create(name)
+	if err != nil {
+		return err
+	}
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(v)
+}
+
+func (u *TakeoutUsecase) buildArchive(user *ent.User) ([]byte, error) {
+	var buf bytes.Buffer
+	a := &takeoutArchive{
+		storageRepository: u.storageRepository,
+		zip:               zip.NewWriter(&buf),
+		files:             map[string]string{},
+		missing:           []string{},
+	}
+
+	iconFile, err := a.addMedia(user.IconImageKey)
+	if err != nil {
+		return nil, err
+	}
+	profile := models.TakeoutProfile{
+		ID:        user.ID,
+		Name:      user.Name,
+		Email:     user.Email,
+		Bio:       user.Bio,
+		IconFile:  iconFile,
+		CreatedAt: user.CreatedAt,
+	}
+
+	posts := make([]models.TakeoutPost, 0, len(user.Edges.Posts))
+	for _, p := range user.Edges.Posts {
+		item := models.TakeoutPost{
+			ID:        p.ID,
+			Caption:   p.Caption,
+			Media:     []models.TakeoutMedia{},
+			CreatedAt: p.CreatedAt,
+		}
+		if p.Edges.DailyTask != nil {
+			item.DailyTaskID = &p.Edges.DailyTask.ID
+		}
+		for _, m := range p.Edges.Media {
+			file, err := a.addMedia(m.ImageKey)
+			if err != nil {
+				return nil, err
+			}
+			posterFile, err := a.addMedia(m.PosterKey)
+			if err != nil {
+				return nil, err
+			}
+			item.Media = append(item.Media, models.TakeoutMedia{
+				Type:       m.Type.String(),
+				File:       file,
+				PosterFile: posterFile,
+			})
+		}
+		// post_media が作られる前の投稿
+		if len(p.Edges.Media) == 0 {
+			file, err := a.addMedia(p.ImageKey)
+			if err != nil {
+				return nil, err
+			}
+			item.Media = append(item.Media, models.TakeoutMedia{Type: "image", File: file})
+		}
+		posts = append(posts, item)
+	}
+
+	pets := make([]models.TakeoutPet, 0, len(user.Edges.Pets))
+	for _, p := range user.Edges.Pets {
+		imageFile, err := a.addMedia(p.ImageKey)
+		if err != nil {
+			return nil, err
+		}
+		pets = append(pets, models.TakeoutPet{
+			ID:        p.ID,
+			Name:      p.Name,
+			BirthDay:  p.BirthDay,
+			Type:      p.Type,
+			Species:   p.Species,
+			ImageFile: imageFile,
+			CreatedAt: p.CreatedAt,
+		})
+	}
+
+	comments := make([]models.TakeoutComment, 0, len(user.Edges.Comments))
+	for _, c := range user.Edges.Comments {
+		item := models.TakeoutComment{
+			ID:        c.ID,
+			Content:   c.Content,
+			CreatedAt: c.CreatedAt,
+		}
+		if c.Edges.Post != nil {
+			item.PostID = c.Edges.Post.ID
+		}
+		comments = append(comments, item)
+	}
+
+	likes := make([]models.TakeoutLike, 0, len(user.Edges.Likes))
+	for _, l := range user.Edges.Likes {
+		item := models.TakeoutLike{CreatedAt: l.CreatedAt}
+		if l.Edges.Post != nil {
+			item.PostID = l.Edges.Post.ID
+		}
+		likes = append(likes, item)
+	}
+
+	following := make([]models.TakeoutFollow, 0, len(user.Edges.Following))
+	for _, f := range user.Edges.Following {
+		if f.Edges.To == nil {
+			continue
+		}
+		following = append(following, models.TakeoutFollow{
+			UserID:    f.Edges.To.ID,
+			Name:      f.Edges.To.Name,
+			CreatedAt: f.CreatedAt,
+		})
+	}
+	followers := make([]models.TakeoutFollow, 0, len(user.Edges.Followers))
+	for _, f := range user.Edges.Followers {
+		if f.Edges.From == nil {
+			continue
+		}
+		followers = append(followers, models.TakeoutFollow{
+			UserID:    f.Edges.From.ID,
+			Name:      f.Edges.From.Name,
+			CreatedAt: f.CreatedAt,
+		})
+	}
+
+	dailyTasks := make([]models.TakeoutDailyTask, 0, len(user.Edges.DailyTasks))
+	for _, d := range user.Edges.DailyTasks {
+		item := models.TakeoutDailyTask{
+			ID:        d.ID,
+			Type:      d.Type,
+			CreatedAt: d.CreatedAt,
+		}
+		if d.Edges.Post != nil {
+			item.PostID = &d.Edges.Post.ID
+		}
+		dailyTasks = append(dailyTasks, item)
+	}
+
+	documents := []struct {
+		name  string
+		value interface{}
+	}{
+		{"profile.json", profile},
+		{"posts.json", posts},
+		{"pets.json", pets},
+		{"comments.json", comments},
+		{"likes.json", likes},
+		{"following.json", following},
+		{"followers.json", followers},
+		{"daily_tasks.json", dailyTasks},
+		{"missing_files.json", a.missing},
+	}
+	for _, doc := range documents {
+		if err := a.addJSON(doc.name, doc.value); err != nil {
+			return nil, fmt.Errorf("failed to write %s: %w", doc.name, err)
+		}
+	}
+
+	if err := a.zip.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,168 @@
+package usecase
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aki-13627/animalia/backend-go/ent"
+	"github.com/aki-13627/animalia/backend-go/ent/postmedia"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/models"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/repository/mock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+// readZip は ZIP 内のファイル名から中身へのマップを返す
+func readZip(t *testing.T, data []byte) map[string][]byte {
+	reader, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	assert.NoError(t, err)
+	files := map[string][]byte{}
+	for _, f := range reader.File {
+		rc, err := f.Open()
+		assert.NoError(t, err)
+		body, err := io.ReadAll(rc)
+		assert.NoError(t, err)
+		rc.Close()
+		files[f.Name] = body
+	}
+	return files
+}
+
+func TestTakeoutUsecase_Export(t *testing.T) {
+	userID := uuid.New()
+	friend := &ent.User{ID: uuid.New(), Name: "friend"}
+	post := &ent.Post{ID: uuid.New(), Caption: "hello", ImageKey: "posts/a/full.jpg"}
+	post.Edges.Media = []*ent.PostMedia{
+		{Type: postmedia.TypeImage, ImageKey: "posts/a/full.jpg"},
+		{Type: postmedia.TypeVideo, ImageKey: "posts/b.mp4", PosterKey: "posts/missing/full.jpg"},
+	}
+	user := &ent.User{ID: userID, Name: "user", Email: "user@example.com", IconImageKey: "profile/c/full.jpg"}
+	user.Edges.Posts = []*ent.Post{post}
+	user.Edges.Pets = []*ent.Pet{{ID: uuid.New(), Name: "pochi", ImageKey: "pets/d/full.jpg"}}
+	comment := &ent.Comment{ID: uuid.New(), Content: "nice"}
+	comment.Edges.Post = &ent.Post{ID: uuid.New()}
+	user.Edges.Comments = []*ent.Comment{comment}
+	follow := &ent.FollowRelation{CreatedAt: time.Now()}
+	follow.Edges.To = friend
+	user.Edges.Following = []*ent.FollowRelation{follow}
+
+	objects := map[string][]byte{
+		"posts/a/full.jpg":   []byte("post image"),
+		"posts/b.mp4":        []byte("video"),
+		"profile/c/full.jpg": []byte("icon"),
+		"pets/d/full.jpg":    []byte("pet"),
+	}
+	var storedKey string
+	var stored []byte
+	storageRepo := &mock.MockStorageRepository{
+		GetObjectFunc: func(fileKey string) ([]byte, error) {
+			body, ok := objects[fileKey]
+			if !ok {
+				return nil, errors.New("not found")
+			}
+			return body, nil
+		},
+		PutObjectFunc: func(fileKey string, body []byte, contentType string) error {
+			assert.Equal(t, "application/zip", contentType)
+			storedKey, stored = fileKey, body
+			return nil
+		},
+		GetDownloadUrlFunc: func(fileKey string, filename string, expiry time.Duration) (string, error) {
+			assert.Equal(t, storedKey, fileKey)
+			assert.True(t, strings.HasSuffix(filename, ".zip"))
+			assert.Equal(t, TakeoutURLExpiry, expiry)
+			return "https://example.com/" + fileKey, nil
+		},
+	}
+	takeoutRepo := &mock.MockTakeoutRepository{
+		GetUserDataFunc: func(id uuid.UUID) (*ent.User, error) {
+			assert.Equal(t, userID, id)
+			return user, nil
+		},
+	}
+
+	usecase := NewTakeoutUsecase(takeoutRepo, storageRepo)
+	export, err := usecase.Export(userID)
+
+	assert.NoError(t, err)
+	assert.True(t, strings.HasPrefix(storedKey, TakeoutPrefix+userID.String()+"/"))
+	assert.Equal(t, "https://example.com/"+storedKey, export.URL)
+
+	files := readZip(t, stored)
+	for key, body := range objects {
+		assert.Equal(t, body, files["media/"+key], key)
+	}
+
+	var posts []models.TakeoutPost
+	assert.NoError(t, json.Unmarshal(files["posts.json"], &posts))
+	assert.Len(t, posts, 1)
+	assert.Equal(t, "hello", posts[0].Caption)
+	assert.Equal(t, []models.TakeoutMedia{
+		{Type: "image", File: "media/posts/a/full.jpg"},
+		{Type: "video", File: "media/posts/b.mp4"},
+	}, posts[0].Media)
+
+	var profile models.TakeoutProfile
+	assert.NoError(t, json.Unmarshal(files["profile.json"], &profile))
+	assert.Equal(t, "media/profile/c/full.jpg", profile.IconFile)
+
+	var following []models.TakeoutFollow
+	assert.NoError(t, json.Unmarshal(files["following.json"], &following))
+	assert.Equal(t, friend.ID, following[0].UserID)
+
+	var comments []models.TakeoutComment
+	assert.NoError(t, json.Unmarshal(files["comments.json"], &comments))
+	assert.Equal(t, comment.Edges.Post.ID, comments[0].PostID)
+
+	var missing []string
+	assert.NoError(t, json.Unmarshal(files["missing_files.json"], &missing))
+	assert.Equal(t, []string{"posts/missing/full.jpg"}, missing)
+
+	for _, name := range []string{"pets.json", "likes.json", "followers.json", "daily_tasks.json"} {
+		assert.Contains(t, files, name)
+	}
+}
+
+func TestTakeoutUsecase_Export_NotFound(t *testing.T) {
+	takeoutRepo := &mock.MockTakeoutRepository{
+		GetUserDataFunc: func(id uuid.UUID) (*ent.User, error) {
+			return nil, &ent.NotFoundError{}
+		},
+	}
+
+	usecase := NewTakeoutUsecase(takeoutRepo, &mock.MockStorageRepository{})
+	_, err := usecase.Export(uuid.New())
+
+	assert.ErrorIs(t, err, ErrNotFound)
+}
+
+func TestTakeoutUsecase_DeleteExpired(t *testing.T) {
+	now := time.Now()
+	deleted := []string{}
+	storageRepo := &mock.MockStorageRepository{
+		ListObjectsFunc: func(prefix string) ([]models.ObjectInfo, error) {
+			assert.Equal(t, TakeoutPrefix, prefix)
+			return []models.ObjectInfo{
+				{Key: "exports/a/old.zip", LastModified: now.Add(-8 * 24 * time.Hour)},
+				{Key: "exports/a/new.zip", LastModified: now.Add(-time.Hour)},
+			}, nil
+		},
+		DeleteImageFunc: func(fileKey string) error {
+			deleted = append(deleted, fileKey)
+			return nil
+		},
+	}
+
+	usecase := NewTakeoutUsecase(&mock.MockTakeoutRepository{}, storageRepo)
+	count, err := usecase.DeleteExpired(TakeoutRetention)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 1, count)
+	assert.Equal(t, []string{"exports/a/old.zip"}, deleted)
+}
@@ -0,0 +1,172 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/aki-13627/animalia/backend-go/ent"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/models"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/repository"
+	"github.com/google/uuid"
+	"github.com/labstack/gommon/log"
+)
+
+// DefaultTimelineSessionTTL はタイムラインのセッションの既定の有効期間
+const DefaultTimelineSessionTTL = 30 * time.Minute
+
+// TimelineRanker はタイムラインの並びを決める方法
+type TimelineRanker string
+
+const (
+	// RankerRecommendation は推薦 API を使い、失敗したときは FeedRanker で並べる
+	RankerRecommendation TimelineRanker = "recommendation"
+	// RankerFeed は推薦 API を呼ばずに FeedRanker で並べる
+	RankerFeed TimelineRanker = "feed"
+)
+
+type TimelineUsecase struct {
+	timelineSessionRepository repository.TimelineSessionRepository
+	postRepository            repository.PostRepository
+	recommendationRepository  repository.RecommendationRepository
+	feedRanker                *FeedRanker
+	ranker                    TimelineRanker
+	ttl                       time.Duration
+}
+
+func NewTimelineUsecase(
+	timelineSessionRepository repository.TimelineSessionRepository,
+	postRepository repository.PostRepository,
+	recommendationRepository repository.RecommendationRepository,
+	feedRanker *FeedRanker,
+	ranker TimelineRanker,
+	ttl time.Duration,
+) *TimelineUsecase {
+	return &TimelineUsecase{
+		timelineSessionRepository: timelineSessionRepository,
+		postRepository:            postRepository,
+		recommendationRepository:  recommendationRepository,
+		feedRanker:                feedRanker,
+		ranker:                    ranker,
+		ttl:                       ttl,
+	}
+}
+
+// StartSession は viewerID 向けの投稿を並べ、その順のままタイムラインのセッションを作る
+func (u *TimelineUsecase) StartSession(ctx context.Context, viewerID uuid.UUID) (*models.TimelineSession, error) {
+	recommended, err := u.rank(ctx, viewerID)
+	if err != nil {
+		return nil, err
+	}
+	// 推薦結果は DB と同期しておらず公開範囲も考慮されないため、表示しない投稿をセッションに入れる前に除く。
+	// ページごとに DB から読み直す際にも同じ条件で除かれる
+	postIDs := recommended
+	if len(recommended) > 0 {
+		hidden, err := u.postRepository.GetHiddenIDs(viewerID, recommended, nil)
+		if err != nil {
+			return nil, err
+		}
+		postIDs = make([]uuid.UUID, 0, len(recommended))
+		for _, id := range recommended {
+			if !hidden[id] {
+				postIDs = append(postIDs, id)
+			}
+		}
+	}
+
+	session := models.TimelineSession{
+		ID:        uuid.New(),
+		UserID:    viewerID,
+		PostIDs:   postIDs,
+		ExpiresAt: time.Now().Add(u.ttl),
+	}
+	if err := u.timelineSessionRepository.Create(session); err != nil {
+		return nil, err
+	}
+	return &session, nil
+}
+
+// rank は設定したランキングで viewerID 向けの投稿 ID を並べる。推薦 API が失敗した場合は FeedRanker の結果を返す
+func (u *TimelineUsecase) rank(ctx context.Context, viewerID uuid.UUID) ([]uuid.UUID, error) {
+	if u.ranker == RankerFeed {
+		return u.feedRanker.Rank(viewerID)
+	}
+	postIDs, err := u.recommendationRepository.Timeline(ctx, viewerID)
+	if err == nil {
+		return postIDs, nil
+	}
+	if ctx.Err() != nil {
+		return nil, err
+	}
+	log.Warnf("Recommendation service failed, falling back to the feed ranker: %v", err)
+	return u.feedRanker.Rank(viewerID)
+}
+
+// Page はセッションの cursor の次から limit 件の投稿を推薦順に返す。cursor は前のページの最後の投稿 ID で、空なら先頭から返す。
+// 投稿はページごとに DB から読み直すため、セッションの作成後に削除・非表示になった投稿やブロックしたユーザーの投稿は含まない。
+// 期限切れ・存在しないセッションは ErrSessionExpired、他人のセッションは ErrNotFound になる。続きがなければ nextCursor は空
+func (u *TimelineUsecase) Page(viewerID uuid.UUID, sessionId, cursor string, limit int) ([]*ent.Post, string, error) {
+	id, err := uuid.Parse(sessionId)
+	if err != nil {
+		return nil, "", fmt.Errorf("%w: invalid session id %q", ErrInvalidArgument, sessionId)
+	}
+	session, err := u.timelineSessionRepository.Get(id)
+	if errors.Is(err, models.ErrTimelineSessionNotFound) {
+		return nil, "", ErrSessionExpired
+	}
+	if err != nil {
+		return nil, "", err
+	}
+	if session.UserID != viewerID {
+		return nil, "", ErrNotFound
+	}
+	if session.Expired(time.Now()) {
+		return nil, "", ErrSessionExpired
+	}
+
+	start := 0
+	if cursor != "" {
+		cursorID, err := uuid.Parse(cursor)
+		if err != nil {
+			return nil, "", fmt.Errorf("%w: invalid cursor %q", ErrInvalidArgument, cursor)
+		}
+		i := slices.Index(session.PostIDs, cursorID)
+		if i < 0 {
+			return nil, "", fmt.Errorf("%w: cursor %q is not in the session", ErrInvalidArgument, cursor)
+		}
+		start = i + 1
+	}
+	end := min(start+pageLimit(limit), len(session.PostIDs))
+	ids := session.PostIDs[start:end]
+	if len(ids) == 0 {
+		return []*ent.Post{}, "", nil
+	}
+
+	posts, err := u.postRepository.GetByIDs(ids, viewerID)
+	if err != nil {
+		return nil, "", err
+	}
+	byID := make(map[uuid.UUID]*ent.Post, len(posts))
+	for _, post := range posts {
+		byID[post.ID] = post
+	}
+	ordered := make([]*ent.Post, 0, len(ids))
+	for _, id := range ids {
+		if post, ok := byID[id]; ok {
+			ordered = append(ordered, post)
+		}
+	}
+
+	var next string
+	if end < len(session.PostIDs) {
+		next = ids[len(ids)-1].String()
+	}
+	return ordered, next, nil
+}
+
+// DeleteExpired は期限切れのセッションを削除し、削除した件数を返す
+func (u *TimelineUsecase) DeleteExpired() (int, error) {
+	return u.timelineSessionRepository.DeleteExpired(time.Now())
+}
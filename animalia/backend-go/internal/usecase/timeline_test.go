@@ -0,0 +1,232 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aki-13627/animalia/backend-go/ent"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/models"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/repository/mock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTimelineUsecase_StartSession(t *testing.T) {
+	viewerID := uuid.New()
+	postIDs := []uuid.UUID{uuid.New(), uuid.New(), uuid.New()}
+	hiddenID := postIDs[1]
+	fallbackIDs := []uuid.UUID{uuid.New(), hiddenID}
+
+	// Test cases
+	testCases := []struct {
+		name              string
+		recommendationErr error
+		ranker            TimelineRanker
+		expectRequest     bool
+		expectFallback    bool
+	}{
+		{
+			name:          "Success",
+			expectRequest: true,
+		},
+		{
+			name:              "Fall back on recommendation error",
+			recommendationErr: errors.New("recommendation service returned status 500"),
+			expectRequest:     true,
+			expectFallback:    true,
+		},
+		{
+			name:              "Fall back while recommendation is unavailable",
+			recommendationErr: models.ErrRecommendationUnavailable,
+			expectRequest:     true,
+			expectFallback:    true,
+		},
+		{
+			name:           "Feed ranker as primary",
+			ranker:         RankerFeed,
+			expectFallback: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			requested := false
+			recommendationRepo := &mock.MockRecommendationRepository{
+				TimelineFunc: func(ctx context.Context, userID uuid.UUID) ([]uuid.UUID, error) {
+					requested = true
+					assert.Equal(t, viewerID, userID)
+					if tc.recommendationErr != nil {
+						return nil, tc.recommendationErr
+					}
+					return postIDs, nil
+				},
+			}
+			var created *models.TimelineSession
+			sessionRepo := &mock.MockTimelineSessionRepository{
+				CreateFunc: func(session models.TimelineSession) error {
+					created = &session
+					return nil
+				},
+			}
+			postRepo := &mock.MockPostRepository{
+				GetHiddenIDsFunc: func(viewer uuid.UUID, ids, commentIds []uuid.UUID) (map[uuid.UUID]bool, error) {
+					assert.Equal(t, viewerID, viewer)
+					return map[uuid.UUID]bool{hiddenID: true}, nil
+				},
+			}
+			feedRepo := &mock.MockFeedRepository{
+				FeedCandidatesFunc: func(viewer uuid.UUID, since, interactionsSince time.Time, limit int) ([]models.FeedCandidate, error) {
+					assert.Equal(t, viewerID, viewer)
+					return []models.FeedCandidate{
+						{PostID: fallbackIDs[0], CreatedAt: time.Now()},
+						{PostID: fallbackIDs[1], CreatedAt: time.Now().Add(-time.Hour)},
+					}, nil
+				},
+			}
+			ranker := tc.ranker
+			if ranker == "" {
+				ranker = RankerRecommendation
+			}
+
+			usecase := NewTimelineUsecase(sessionRepo, postRepo, recommendationRepo, NewFeedRanker(feedRepo, DefaultFeedWeights), ranker, time.Hour)
+			session, err := usecase.StartSession(context.Background(), viewerID)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectRequest, requested)
+			assert.Equal(t, created.ID, session.ID)
+			assert.Equal(t, viewerID, created.UserID)
+			assert.WithinDuration(t, time.Now().Add(time.Hour), created.ExpiresAt, time.Minute)
+			if tc.expectFallback {
+				assert.Equal(t, []uuid.UUID{fallbackIDs[0]}, created.PostIDs)
+			} else {
+				assert.Equal(t, []uuid.UUID{postIDs[0], postIDs[2]}, created.PostIDs)
+			}
+		})
+	}
+}
+
+func TestTimelineUsecase_Page(t *testing.T) {
+	viewerID := uuid.New()
+	sessionID := uuid.New()
+	postIDs := []uuid.UUID{uuid.New(), uuid.New(), uuid.New(), uuid.New(), uuid.New()}
+	// 3件目はセッションの作成後に削除された
+	deletedID := postIDs[2]
+
+	// Test cases
+	testCases := []struct {
+		name           string
+		sessionID      string
+		cursor         string
+		limit          int
+		owner          uuid.UUID
+		expiresAt      time.Time
+		missing        bool
+		expectedIDs    []uuid.UUID
+		expectedCursor string
+		expectedError  error
+	}{
+		{
+			name:           "First page",
+			sessionID:      sessionID.String(),
+			limit:          2,
+			expectedIDs:    postIDs[:2],
+			expectedCursor: postIDs[1].String(),
+		},
+		{
+			name:           "Next page skips deleted posts",
+			sessionID:      sessionID.String(),
+			cursor:         postIDs[1].String(),
+			limit:          2,
+			expectedIDs:    []uuid.UUID{postIDs[3]},
+			expectedCursor: postIDs[3].String(),
+		},
+		{
+			name:        "Last page",
+			sessionID:   sessionID.String(),
+			cursor:      postIDs[3].String(),
+			limit:       2,
+			expectedIDs: []uuid.UUID{postIDs[4]},
+		},
+		{
+			name:          "Expired session",
+			sessionID:     sessionID.String(),
+			expiresAt:     time.Now().Add(-time.Minute),
+			expectedError: ErrSessionExpired,
+		},
+		{
+			name:          "Unknown session",
+			sessionID:     uuid.New().String(),
+			missing:       true,
+			expectedError: ErrSessionExpired,
+		},
+		{
+			name:          "Session of another user",
+			sessionID:     sessionID.String(),
+			owner:         uuid.New(),
+			expectedError: ErrNotFound,
+		},
+		{
+			name:          "Cursor not in session",
+			sessionID:     sessionID.String(),
+			cursor:        uuid.New().String(),
+			expectedError: ErrInvalidArgument,
+		},
+		{
+			name:          "Invalid session ID",
+			sessionID:     "invalid",
+			expectedError: ErrInvalidArgument,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			owner := viewerID
+			if tc.owner != uuid.Nil {
+				owner = tc.owner
+			}
+			expiresAt := time.Now().Add(time.Hour)
+			if !tc.expiresAt.IsZero() {
+				expiresAt = tc.expiresAt
+			}
+			sessionRepo := &mock.MockTimelineSessionRepository{
+				GetFunc: func(id uuid.UUID) (*models.TimelineSession, error) {
+					assert.Equal(t, tc.sessionID, id.String())
+					if tc.missing {
+						return nil, models.ErrTimelineSessionNotFound
+					}
+					return &models.TimelineSession{ID: id, UserID: owner, PostIDs: postIDs, ExpiresAt: expiresAt}, nil
+				},
+			}
+			postRepo := &mock.MockPostRepository{
+				GetByIDsFunc: func(ids []uuid.UUID, viewer uuid.UUID) ([]*ent.Post, error) {
+					assert.Equal(t, viewerID, viewer)
+					// DB からは推薦順と関係なく返る
+					posts := []*ent.Post{}
+					for i := len(ids) - 1; i >= 0; i-- {
+						if ids[i] != deletedID {
+							posts = append(posts, &ent.Post{ID: ids[i]})
+						}
+					}
+					return posts, nil
+				},
+			}
+
+			usecase := NewTimelineUsecase(sessionRepo, postRepo, &mock.MockRecommendationRepository{}, nil, RankerRecommendation, time.Hour)
+			posts, next, err := usecase.Page(viewerID, tc.sessionID, tc.cursor, tc.limit)
+
+			if tc.expectedError != nil {
+				assert.ErrorIs(t, err, tc.expectedError)
+				return
+			}
+			assert.NoError(t, err)
+			ids := make([]uuid.UUID, len(posts))
+			for i, post := range posts {
+				ids[i] = post.ID
+			}
+			assert.Equal(t, tc.expectedIDs, ids)
+			assert.Equal(t, tc.expectedCursor, next)
+		})
+	}
+}
@@ -0,0 +1,302 @@
+package usecase
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"image"
+	_ "image/jpeg"
+	_ "image/png"
+	"time"
+
+	"github.com/aki-13627/animalia/backend-go/internal/domain/models"
+	_ "golang.org/x/image/webp"
+)
+
+var (
+	// ErrFileTooLarge はファイルサイズもしくはピクセル数が上限を超えている (413)
+	ErrFileTooLarge = errors.New("file is too large")
+	// ErrUnsupportedMediaType は対応していない形式のファイル (415)
+	ErrUnsupportedMediaType = errors.New("unsupported media type")
+	// ErrInvalidImage は壊れている、もしくは不正な内容を含む画像 (400)
+	ErrInvalidImage = errors.New("invalid image")
+	// ErrInvalidVideo は壊れている、もしくは解析できない動画 (400)
+	ErrInvalidVideo = errors.New("invalid video")
+)
+
+// UploadLimit はディレクトリごとのアップロード上限
+type UploadLimit struct {
+	MaxBytes  int64
+	MaxWidth  int
+	MaxHeight int
+}
+
+var uploadLimits = map[string]UploadLimit{
+	"posts":   {MaxBytes: 20 << 20, MaxWidth: 8192, MaxHeight: 8192},
+	"pets":    {MaxBytes: 10 << 20, MaxWidth: 6000, MaxHeight: 6000},
+	"profile": {MaxBytes: 5 << 20, MaxWidth: 4096, MaxHeight: 4096},
+}
+
+// VideoUploadLimit はディレクトリごとの動画のアップロード上限
+type VideoUploadLimit struct {
+	MaxBytes    int64
+	MaxDuration time.Duration
+}
+
+// 動画は投稿にだけ添付できる
+var videoUploadLimits = map[string]VideoUploadLimit{
+	"posts": {MaxBytes: 100 << 20, MaxDuration: 30 * time.Second},
+}
+
+// MaxUploadBytes はすべてのディレクトリ・種類を通したアップロードの上限サイズ
+const MaxUploadBytes = 100 << 20
+
+// videoDurationTolerance は端末ごとのエンコードの誤差として許容する長さ
+const videoDurationTolerance = time.Second
+
+const (
+	// maxImagePixels はデコード時のメモリ使用量を抑えるためのピクセル数の上限
+	maxImagePixels = 50_000_000
+	// maxPixelsPerByte を超える圧縮率の画像は展開爆弾とみなす
+	maxPixelsPerByte = 2000
+)
+
+// 画像に埋め込まれていると別形式として解釈されうるシグネチャ
+var polyglotSignatures = [][]byte{
+	[]byte("<script"),
+	[]byte("<html"),
+	[]byte("<svg"),
+	[]byte("<?php"),
+	[]byte("<?xml"),
+	[]byte("PK\x03\x04"),
+	[]byte("%PDF-"),
+}
+
+// ValidateImage はファイルの中身から画像形式を判定し、directory ごとの上限と安全性を検証する。
+// 判定した Content-Type を返す。
+func ValidateImage(data []byte, directory string) (string, error) {
+	limit, ok := uploadLimits[directory]
+	if !ok {
+		return "", fmt.Errorf("%w: unknown directory %q", ErrInvalidUploadRequest, directory)
+	}
+	if len(data) == 0 {
+		return "", fmt.Errorf("%w: file is empty", ErrInvalidImage)
+	}
+	if int64(len(data)) > limit.MaxBytes {
+		return "", fmt.Errorf("%w: %d bytes exceeds %d bytes", ErrFileTooLarge, len(data), limit.MaxBytes)
+	}
+
+	contentType := sniffImageType(data)
+	if contentType == "" {
+		return "", ErrUnsupportedMediaType
+	}
+
+	config, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return "", fmt.Errorf("%w: %v", ErrInvalidImage, err)
+	}
+	if config.Width <= 0 || config.Height <= 0 {
+		return "", fmt.Errorf("%w: invalid dimensions", ErrInvalidImage)
+	}
+	if config.Width > limit.MaxWidth || config.Height > limit.MaxHeight {
+		return "", fmt.Errorf("%w: %dx%d exceeds %dx%d", ErrFileTooLarge, config.Width, config.Height, limit.MaxWidth, limit.MaxHeight)
+	}
+	pixels := int64(config.Width) * int64(config.Height)
+	if pixels > maxImagePixels {
+		return "", fmt.Errorf("%w: %d pixels", ErrFileTooLarge, pixels)
+	}
+	if pixels > 1_000_000 && pixels/int64(len(data)) > maxPixelsPerByte {
+		return "", fmt.Errorf("%w: suspicious compression ratio", ErrInvalidImage)
+	}
+
+	if hasTrailingData(data, contentType) {
+		return "", fmt.Errorf("%w: unexpected data after end of image", ErrInvalidImage)
+	}
+	// 圧縮された画像データは偶然シグネチャと一致することがあるため、ヘッダ・メタデータ・末尾だけを調べる
+	for _, region := range metadataRegions(data, contentType) {
+		lower := bytes.ToLower(region)
+		for _, signature := range polyglotSignatures {
+			if bytes.Contains(lower, bytes.ToLower(signature)) {
+				return "", fmt.Errorf("%w: embedded %q signature", ErrInvalidImage, signature)
+			}
+		}
+	}
+
+	return contentType, nil
+}
+
+// ValidateVideo はファイルの中身から動画形式を判定し、directory ごとのサイズ上限を検証する。
+// 長さの検証は動画の解析後に ValidateVideoInfo で行う。判定した Content-Type を返す。
+func ValidateVideo(data []byte, directory string) (string, error) {
+	limit, ok := videoUploadLimits[directory]
+	if !ok {
+		return "", fmt.Errorf("%w: videos are not allowed in %q", ErrUnsupportedMediaType, directory)
+	}
+	if len(data) == 0 {
+		return "", fmt.Errorf("%w: file is empty", ErrInvalidVideo)
+	}
+	if int64(len(data)) > limit.MaxBytes {
+		return "", fmt.Errorf("%w: %d bytes exceeds %d bytes", ErrFileTooLarge, len(data), limit.MaxBytes)
+	}
+
+	contentType := sniffVideoType(data)
+	if contentType == "" {
+		return "", ErrUnsupportedMediaType
+	}
+	return contentType, nil
+}
+
+// ValidateVideoInfo は解析した動画の長さと映像の有無を検証する
+func ValidateVideoInfo(info *models.VideoInfo, directory string) error {
+	limit, ok := videoUploadLimits[directory]
+	if !ok {
+		return fmt.Errorf("%w: videos are not allowed in %q", ErrUnsupportedMediaType, directory)
+	}
+	if info.Width <= 0 || info.Height <= 0 || info.Duration <= 0 {
+		return fmt.Errorf("%w: no video stream", ErrInvalidVideo)
+	}
+	if info.Duration > limit.MaxDuration+videoDurationTolerance {
+		return fmt.Errorf("%w: duration %s exceeds %s", ErrFileTooLarge, info.Duration, limit.MaxDuration)
+	}
+	return nil
+}
+
+// sniffVideoType は ISO BMFF の ftyp ボックスから対応している動画形式を判定する
+func sniffVideoType(data []byte) string {
+	if len(data) < 12 || string(data[4:8]) != "ftyp" {
+		return ""
+	}
+	switch string(data[8:12]) {
+	case "qt  ":
+		return "video/quicktime"
+	case "isom", "iso2", "mp41", "mp42", "avc1", "M4V ", "MSNV":
+		return "video/mp4"
+	default:
+		return ""
+	}
+}
+
+// sniffImageType はマジックバイトから対応している画像形式を判定する
+func sniffImageType(data []byte) string {
+	switch {
+	case bytes.HasPrefix(data, []byte{0xFF, 0xD8, 0xFF}):
+		return "image/jpeg"
+	case bytes.HasPrefix(data, []byte("\x89PNG\r\n\x1a\n")):
+		return "image/png"
+	case len(data) >= 12 && string(data[:4]) == "RIFF" && string(data[8:12]) == "WEBP":
+		return "image/webp"
+	default:
+		return ""
+	}
+}
+
+// trailingScanBytes は埋め込みのシグネチャを調べる末尾のバイト数
+const trailingScanBytes = 64
+
+// metadataRegions は画像のうち、画素の圧縮データを除いたヘッダ・メタデータと末尾のバイト列を返す。
+// 構造を解析できない場合はファイル全体を返す
+func metadataRegions(data []byte, contentType string) [][]byte {
+	var regions [][]byte
+	var ok bool
+	switch contentType {
+	case "image/jpeg":
+		regions, ok = jpegMetadata(data)
+	case "image/png":
+		regions, ok = pngMetadata(data)
+	case "image/webp":
+		regions, ok = webpMetadata(data)
+	}
+	if !ok {
+		return [][]byte{data}
+	}
+	return append(regions, data[max(0, len(data)-trailingScanBytes):])
+}
+
+// jpegMetadata は SOI からスキャンの開始 (SOS) までの APPn・COM などのセグメントを返す
+func jpegMetadata(data []byte) ([][]byte, bool) {
+	pos := 2
+	for pos+4 <= len(data) {
+		if data[pos] != 0xFF {
+			return nil, false
+		}
+		length := int(binary.BigEndian.Uint16(data[pos+2 : pos+4]))
+		if length < 2 || pos+2+length > len(data) {
+			return nil, false
+		}
+		if data[pos+1] == 0xDA {
+			return [][]byte{data[:pos+2+length]}, true
+		}
+		pos += 2 + length
+	}
+	return nil, false
+}
+
+// pngMetadata は IDAT 以外のチャンクを返す
+func pngMetadata(data []byte) ([][]byte, bool) {
+	regions := [][]byte{data[:8]}
+	pos := 8
+	for pos+12 <= len(data) {
+		length := int(binary.BigEndian.Uint32(data[pos : pos+4]))
+		end := pos + 12 + length
+		if end > len(data) {
+			return nil, false
+		}
+		chunkType := string(data[pos+4 : pos+8])
+		if chunkType != "IDAT" {
+			regions = append(regions, data[pos:end])
+		}
+		if chunkType == "IEND" {
+			return regions, true
+		}
+		pos = end
+	}
+	return nil, false
+}
+
+// webpMetadata は画素データ (VP8, VP8L, ALPH, ANMF) 以外のチャンクを返す
+func webpMetadata(data []byte) ([][]byte, bool) {
+	regions := [][]byte{data[:12]}
+	limit := min(len(data), int(binary.LittleEndian.Uint32(data[4:8]))+8)
+	pos := 12
+	for pos+8 <= limit {
+		length := int(binary.LittleEndian.Uint32(data[pos+4 : pos+8]))
+		end := pos + 8 + length + length%2
+		if end > limit {
+			return nil, false
+		}
+		switch string(data[pos : pos+4]) {
+		case "VP8 ", "VP8L", "ALPH", "ANMF":
+		default:
+			regions = append(regions, data[pos:end])
+		}
+		pos = end
+	}
+	return regions, pos == limit
+}
+
+// hasTrailingData は画像の終端以降に余分なデータが付加されていないかを調べる
+func hasTrailingData(data []byte, contentType string) bool {
+	var end int
+	switch contentType {
+	case "image/jpeg":
+		i := bytes.LastIndex(data, []byte{0xFF, 0xD9})
+		if i < 0 {
+			return true
+		}
+		end = i + 2
+	case "image/png":
+		i := bytes.LastIndex(data, []byte("IEND"))
+		if i < 0 {
+			return true
+		}
+		end = i + 8 // chunk type + CRC
+	case "image/webp":
+		end = int(binary.LittleEndian.Uint32(data[4:8])) + 8
+	}
+	if end > len(data) {
+		return true
+	}
+	// 末尾のパディングは許容する
+	return len(bytes.Trim(data[end:], "\x00")) > 0
+}
@@ -0,0 +1,262 @@
+package usecase
+
+import (
+	"bytes"
+	"encoding/binary"
+	"hash/crc32"
+	"image"
+	"image/jpeg"
+	"image/png"
+	"math/rand/v2"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// testImage は検証用の単色画像をエンコードして返す
+func testImage(t *testing.T, format string, width, height int) []byte {
+	t.Helper()
+	img := image.NewRGBA(image.Rect(0, 0, width, height))
+	var buf bytes.Buffer
+	var err error
+	switch format {
+	case "png":
+		err = png.Encode(&buf, img)
+	default:
+		err = jpeg.Encode(&buf, img, nil)
+	}
+	if err != nil {
+		t.Fatalf("failed to encode test image: %v", err)
+	}
+	return buf.Bytes()
+}
+
+// pngWithChunks は IHDR と任意のチャンク、IEND だけを持つ PNG を返す（ピクセルデータは不要な検証用）
+func pngWithChunks(width, height uint32, chunks ...string) []byte {
+	var buf bytes.Buffer
+	buf.WriteString("\x89PNG\r\n\x1a\n")
+	writeChunk := func(chunkType string, data []byte) {
+		binary.Write(&buf, binary.BigEndian, uint32(len(data)))
+		buf.WriteString(chunkType)
+		buf.Write(data)
+		binary.Write(&buf, binary.BigEndian, crc32.ChecksumIEEE(append([]byte(chunkType), data...)))
+	}
+	ihdr := make([]byte, 13)
+	binary.BigEndian.PutUint32(ihdr[0:4], width)
+	binary.BigEndian.PutUint32(ihdr[4:8], height)
+	ihdr[8], ihdr[9] = 8, 2
+	writeChunk("IHDR", ihdr)
+	for _, chunk := range chunks {
+		writeChunk("tEXt", []byte(chunk))
+	}
+	writeChunk("IEND", nil)
+	return buf.Bytes()
+}
+
+// noisyJPEG はランダムな画素の JPEG を返す。圧縮データは高いエントロピーを持つ
+func noisyJPEG(t *testing.T, width, height int) []byte {
+	t.Helper()
+	img := image.NewRGBA(image.Rect(0, 0, width, height))
+	rng := rand.New(rand.NewPCG(1, 2))
+	for i := range img.Pix {
+		img.Pix[i] = uint8(rng.IntN(256))
+	}
+	var buf bytes.Buffer
+	if err := jpeg.Encode(&buf, img, &jpeg.Options{Quality: 100}); err != nil {
+		t.Fatalf("failed to encode test image: %v", err)
+	}
+	return buf.Bytes()
+}
+
+// withScanData は JPEG の圧縮データの中ほどを payload で上書きする。
+// 圧縮データが偶然シグネチャと同じバイト列になった場合を再現する
+func withScanData(data []byte, payload string) []byte {
+	out := bytes.Clone(data)
+	copy(out[len(out)/2:], payload)
+	return out
+}
+
+// withJPEGComment は SOI の直後に COM セグメントを挿入する
+func withJPEGComment(data []byte, comment string) []byte {
+	segment := []byte{0xFF, 0xFE, 0, 0}
+	binary.BigEndian.PutUint16(segment[2:4], uint16(len(comment)+2))
+	segment = append(segment, comment...)
+	out := append(bytes.Clone(data[:2]), segment...)
+	return append(out, data[2:]...)
+}
+
+func TestValidateImage(t *testing.T) {
+	photo := noisyJPEG(t, 1600, 1200)
+
+	// Test cases
+	testCases := []struct {
+		name                string
+		data                []byte
+		directory           string
+		expectedContentType string
+		expectedError       error
+	}{
+		{
+			name:                "JPEG",
+			data:                testImage(t, "jpeg", 64, 48),
+			directory:           "posts",
+			expectedContentType: "image/jpeg",
+		},
+		{
+			name:                "PNG",
+			data:                testImage(t, "png", 64, 48),
+			directory:           "pets",
+			expectedContentType: "image/png",
+		},
+		{
+			name:          "Unknown directory",
+			data:          testImage(t, "jpeg", 64, 48),
+			directory:     "exports",
+			expectedError: ErrInvalidUploadRequest,
+		},
+		{
+			name:          "Empty file",
+			data:          []byte{},
+			directory:     "posts",
+			expectedError: ErrInvalidImage,
+		},
+		{
+			name:          "Too large for directory",
+			data:          append(testImage(t, "jpeg", 64, 48), make([]byte, 5<<20)...),
+			directory:     "profile",
+			expectedError: ErrFileTooLarge,
+		},
+		{
+			name:          "HTML disguised as image",
+			data:          []byte("<html><body>hello</body></html>"),
+			directory:     "posts",
+			expectedError: ErrUnsupportedMediaType,
+		},
+		{
+			name:          "HEIC",
+			data:          []byte("\x00\x00\x00\x18ftypheic\x00\x00\x00\x00mif1heic"),
+			directory:     "posts",
+			expectedError: ErrUnsupportedMediaType,
+		},
+		{
+			name:          "Truncated header",
+			data:          []byte("\x89PNG\r\n\x1a\n"),
+			directory:     "posts",
+			expectedError: ErrInvalidImage,
+		},
+		{
+			name:          "Dimensions exceed limit",
+			data:          pngWithChunks(5000, 100),
+			directory:     "profile",
+			expectedError: ErrFileTooLarge,
+		},
+		{
+			name:          "Decompression bomb",
+			data:          pngWithChunks(8192, 8192),
+			directory:     "posts",
+			expectedError: ErrFileTooLarge,
+		},
+		{
+			name:          "Suspicious compression ratio",
+			data:          pngWithChunks(4000, 4000),
+			directory:     "posts",
+			expectedError: ErrInvalidImage,
+		},
+		{
+			name:          "Trailing ZIP archive",
+			data:          append(testImage(t, "jpeg", 64, 48), []byte("PK\x03\x04payload")...),
+			directory:     "posts",
+			expectedError: ErrInvalidImage,
+		},
+		{
+			name:                "Large high-entropy JPEG",
+			data:                photo,
+			directory:           "posts",
+			expectedContentType: "image/jpeg",
+		},
+		{
+			name:                "Signature bytes in compressed data",
+			data:                withScanData(photo, "<svg%PDF-PK\x03\x04<html"),
+			directory:           "posts",
+			expectedContentType: "image/jpeg",
+		},
+		{
+			name:          "Script in JPEG comment",
+			data:          withJPEGComment(testImage(t, "jpeg", 64, 48), "<svg onload=alert(1)>"),
+			directory:     "posts",
+			expectedError: ErrInvalidImage,
+		},
+		{
+			name:          "Embedded script",
+			data:          pngWithChunks(64, 48, "Comment\x00<script>alert(1)</script>"),
+			directory:     "posts",
+			expectedError: ErrInvalidImage,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			contentType, err := ValidateImage(tc.data, tc.directory)
+			if tc.expectedError != nil {
+				assert.ErrorIs(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedContentType, contentType)
+			}
+		})
+	}
+}
+
+func TestValidateVideo(t *testing.T) {
+	// Test cases
+	testCases := []struct {
+		name                string
+		data                []byte
+		directory           string
+		expectedContentType string
+		expectedError       error
+	}{
+		{
+			name:                "MP4",
+			data:                []byte("\x00\x00\x00\x18ftypmp42\x00\x00\x00\x00isommp42"),
+			directory:           "posts",
+			expectedContentType: "video/mp4",
+		},
+		{
+			name:                "MOV",
+			data:                []byte("\x00\x00\x00\x14ftypqt  \x00\x00\x00\x00qt  "),
+			directory:           "posts",
+			expectedContentType: "video/quicktime",
+		},
+		{
+			name:          "HEIC",
+			data:          []byte("\x00\x00\x00\x18ftypheic\x00\x00\x00\x00mif1heic"),
+			directory:     "posts",
+			expectedError: ErrUnsupportedMediaType,
+		},
+		{
+			name:          "Not allowed in directory",
+			data:          []byte("\x00\x00\x00\x18ftypmp42\x00\x00\x00\x00isommp42"),
+			directory:     "profile",
+			expectedError: ErrUnsupportedMediaType,
+		},
+		{
+			name:          "Too large",
+			data:          append([]byte("\x00\x00\x00\x18ftypmp42\x00\x00\x00\x00isommp42"), make([]byte, 100<<20)...),
+			directory:     "posts",
+			expectedError: ErrFileTooLarge,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			contentType, err := ValidateVideo(tc.data, tc.directory)
+			if tc.expectedError != nil {
+				assert.ErrorIs(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedContentType, contentType)
+			}
+		})
+	}
+}
@@ -1,9 +1,14 @@
 package usecase
 
 import (
+	"fmt"
+	"slices"
+
 	"github.com/aki-13627/animalia/backend-go/ent"
+	"github.com/aki-13627/animalia/backend-go/ent/followrelation"
 	"github.com/aki-13627/animalia/backend-go/internal/domain/models"
 	"github.com/aki-13627/animalia/backend-go/internal/domain/repository"
+	"github.com/google/uuid"
 	"github.com/labstack/gommon/log"
 )
 
@@ -13,15 +18,17 @@ type UserUsecase struct {
 	postRepository           repository.PostRepository
 	petRepository            repository.PetRepository
 	followRelationRepository repository.FollowRelationRepository
+	blockRepository          repository.BlockRepository
 }
 
-func NewUserUsecase(userRepository repository.UserRepository, storageRepository repository.StorageRepository, postRepository repository.PostRepository, petRepository repository.PetRepository, followRelationRepository repository.FollowRelationRepository) *UserUsecase {
+func NewUserUsecase(userRepository repository.UserRepository, storageRepository repository.StorageRepository, postRepository repository.PostRepository, petRepository repository.PetRepository, followRelationRepository repository.FollowRelationRepository, blockRepository repository.BlockRepository) *UserUsecase {
 	return &UserUsecase{
 		userRepository:           userRepository,
 		storageRepository:        storageRepository,
 		postRepository:           postRepository,
 		petRepository:            petRepository,
 		followRelationRepository: followRelationRepository,
+		blockRepository:          blockRepository,
 	}
 }
 
@@ -29,7 +36,10 @@ func (u *UserUsecase) CreateUser(name, email string) (*ent.User, error) {
 	return u.userRepository.Create(name, email)
 }
 
-func (u *UserUsecase) Update(id string, name string, description string, newImageKey string) error {
+func (u *UserUsecase) Update(actorID uuid.UUID, id string, name string, description string, newImageKey string) error {
+	if err := AuthorizeUser(actorID, id); err != nil {
+		return err
+	}
 	return u.userRepository.Update(id, name, description, newImageKey)
 }
 
@@ -41,122 +51,192 @@ func (u *UserUsecase) FindByEmail(email string) (*ent.User, error) {
 	return u.userRepository.FindByEmail(email)
 }
 
-func (u *UserUsecase) GetByEmail(email string) (models.UserResponse, error) {
+// GetByEmail は viewerID から見たユーザーのプロフィールを返す。2人の間にブロックがあれば ErrNotFound にする。
+// 非公開アカウントを承認済みのフォロワー以外が見た場合は基本情報だけを返す。
+// 本人のプロフィールを返す場合は viewerID に uuid.Nil を渡してもよい
+func (u *UserUsecase) GetByEmail(viewerID uuid.UUID, email string) (models.UserResponse, error) {
 	user, err := u.userRepository.FindByEmail(email)
 	if err != nil {
 		return models.UserResponse{}, err
 	}
+	if viewerID == uuid.Nil {
+		// 本人には公開範囲を限定した投稿も見せる
+		viewerID = user.ID
+	}
 
-	iconURL := ""
-	if user.IconImageKey != "" {
-		url, err := u.storageRepository.GetUrl(user.IconImageKey)
+	blocked := map[uuid.UUID]bool{}
+	var followStatus followrelation.Status
+	if viewerID != user.ID {
+		blocked, err = u.blockRepository.BlockedUserIDs(viewerID)
+		if err != nil {
+			return models.UserResponse{}, err
+		}
+		if blocked[user.ID] {
+			return models.UserResponse{}, fmt.Errorf("%w: user %s is blocked", ErrNotFound, user.ID)
+		}
+		followStatus, err = u.followRelationRepository.Status(viewerID, user.ID)
 		if err != nil {
-			log.Errorf("Failed to get url: %v", err)
 			return models.UserResponse{}, err
 		}
-		iconURL = url
+	}
+	// 互いに相手が見えないよう、ブロックしているユーザーはフォロー一覧からも除く
+	user.Edges.Followers = slices.DeleteFunc(user.Edges.Followers, func(r *ent.FollowRelation) bool {
+		return blocked[r.Edges.From.ID]
+	})
+	user.Edges.Following = slices.DeleteFunc(user.Edges.Following, func(r *ent.FollowRelation) bool {
+		return blocked[r.Edges.To.ID]
+	})
+	if user.IsPrivate && viewerID != user.ID && followStatus != followrelation.StatusApproved {
+		return u.restrictedProfile(user, followStatus)
 	}
 
-	posts, err := u.postRepository.GetPostsByUser(user.ID)
+	// 2ページ目以降は GET /users/:id/posts で取得する
+	posts, postsNextCursor, err := u.postRepository.GetPostsByUser(user.ID, viewerID, models.PageRequest{Limit: DefaultPageLimit})
 	if err != nil {
 		log.Errorf("Failed to get posts by user: %v", err)
 		return models.UserResponse{}, err
 	}
-	postResponses := make([]models.PostResponse, len(posts))
-	for i, post := range posts {
-		imageURL, err := u.storageRepository.GetUrl(post.ImageKey)
-		if err != nil {
-			log.Errorf("Failed to get url: %v", err)
-			return models.UserResponse{}, err
+	pets, err := u.petRepository.GetByOwner(user.ID.String())
+	if err != nil {
+		return models.UserResponse{}, err
+	}
+
+	// 画像（バリアント付き）と、アイコン・動画の URL をそれぞれ一括で取得する
+	imageKeys := []string{user.IconImageKey}
+	fileKeys := []string{}
+	for _, post := range posts {
+		imageKeys = append(imageKeys, post.ImageKey)
+		for _, item := range models.PostMediaItems(post) {
+			imageKeys = append(imageKeys, item.PreviewKey())
+			if item.Type == models.MediaTypeVideo {
+				fileKeys = append(fileKeys, item.Key)
+			}
 		}
+		for _, comment := range post.Edges.Comments {
+			fileKeys = append(fileKeys, comment.Edges.User.IconImageKey)
+		}
+		for _, like := range post.Edges.Likes {
+			fileKeys = append(fileKeys, like.Edges.User.IconImageKey)
+		}
+	}
+	for _, pet := range pets {
+		imageKeys = append(imageKeys, pet.ImageKey)
+	}
+	for _, followersRelation := range user.Edges.Followers {
+		fileKeys = append(fileKeys, followersRelation.Edges.From.IconImageKey)
+	}
+	for _, followsRelation := range user.Edges.Following {
+		fileKeys = append(fileKeys, followsRelation.Edges.To.IconImageKey)
+	}
+
+	imageURLs, err := getImageUrlsBatch(u.storageRepository, imageKeys)
+	if err != nil {
+		log.Errorf("Failed to get url: %v", err)
+		return models.UserResponse{}, err
+	}
+	fileURLs, err := getUrls(u.storageRepository, fileKeys)
+	if err != nil {
+		log.Errorf("Failed to get icon urls: %v", err)
+		return models.UserResponse{}, err
+	}
 
+	iconURL := ""
+	var userIconURLs *models.ImageURLs
+	if user.IconImageKey != "" {
+		urls := imageURLs[user.IconImageKey]
+		iconURL = urls.Full
+		userIconURLs = &urls
+	}
+
+	postResponses := make([]models.PostResponse, len(posts))
+	for i, post := range posts {
 		commentResponses := make([]models.CommentResponse, len(post.Edges.Comments))
 		for j, comment := range post.Edges.Comments {
-			commentUserImageURL := ""
-			if comment.Edges.User.IconImageKey != "" {
-				commentUserImageURL, err = u.storageRepository.GetUrl(comment.Edges.User.IconImageKey)
-				if err != nil {
-					log.Errorf("Failed to get comment user url: %v", err)
-					return models.UserResponse{}, err
-				}
-			}
-			commentResponses[j] = models.NewCommentResponse(comment, comment.Edges.User, commentUserImageURL)
+			commentResponses[j] = models.NewCommentResponse(comment, comment.Edges.User, fileURLs[comment.Edges.User.IconImageKey])
 		}
 		likeResponses := make([]models.LikeResponse, len(post.Edges.Likes))
 		for j, like := range post.Edges.Likes {
-			likeUserImageURL := ""
-			if like.Edges.User.IconImageKey != "" {
-				likeUserImageURL, err = u.storageRepository.GetUrl(like.Edges.User.IconImageKey)
-				if err != nil {
-					log.Errorf("Failed to get like user url: %v", err)
-					return models.UserResponse{}, err
-				}
-			}
-			likeResponses[j] = models.NewLikeResponse(like, likeUserImageURL)
+			likeResponses[j] = models.NewLikeResponse(like, fileURLs[like.Edges.User.IconImageKey])
 		}
-		postResponses[i] = models.NewPostResponse(post, imageURL, iconURL, commentResponses, likeResponses)
+		postResponses[i] = models.NewPostResponse(post, imageURLs, fileURLs, iconURL, commentResponses, likeResponses)
 	}
 
-	pets, err := u.petRepository.GetByOwner(user.ID.String())
-	if err != nil {
-		return models.UserResponse{}, err
-	}
 	petResponses := make([]models.PetResponse, len(pets))
 	for i, pet := range pets {
-		imageURL, err := u.storageRepository.GetUrl(pet.ImageKey)
-		if err != nil {
-			log.Errorf("Failed to get url: %v", err)
-			return models.UserResponse{}, err
-		}
-		petResponses[i] = models.NewPetResponse(pet, imageURL)
+		petResponses[i] = models.NewPetResponse(pet, imageURLs[pet.ImageKey])
 	}
 
 	followers := make([]models.UserBaseResponse, 0)
 	for _, followersRelation := range user.Edges.Followers {
 		follower := followersRelation.Edges.From
-
-		imageUrl := ""
-		if follower.IconImageKey != "" {
-			url, err := u.storageRepository.GetUrl(follower.IconImageKey)
-			if err != nil {
-				log.Warnf("Failed to get icon URL for follower %s: %v", follower.Name, err)
-			} else {
-				imageUrl = url
-			}
-		}
-
-		followers = append(followers, models.NewUserBaseResponse(follower, imageUrl))
+		followers = append(followers, models.NewUserBaseResponse(follower, fileURLs[follower.IconImageKey]))
 	}
 
 	follows := make([]models.UserBaseResponse, 0)
 	for _, followsRelation := range user.Edges.Following {
 		follow := followsRelation.Edges.To
-
-		imageUrl := ""
-		if follow.IconImageKey != "" {
-			url, err := u.storageRepository.GetUrl(follow.IconImageKey)
-			if err != nil {
-				log.Warnf("Failed to get icon URL for follow %s: %v", follow.Name, err)
-			} else {
-				imageUrl = url
-			}
-		}
-
-		follows = append(follows, models.NewUserBaseResponse(follow, imageUrl))
+		follows = append(follows, models.NewUserBaseResponse(follow, fileURLs[follow.IconImageKey]))
 	}
 
 	dailyTask := user.Edges.DailyTasks[0]
 	dailyTaskResoponse := models.NewDailyTaskResponse(dailyTask)
 
-	userResponse := models.NewUserResponse(user, iconURL, postResponses, petResponses, followers, follows, dailyTaskResoponse)
+	userResponse := models.NewUserResponse(user, userIconURLs, postResponses, petResponses, followers, follows, dailyTaskResoponse)
+	userResponse.FollowStatus = followStatus
+	userResponse.PostsNextCursor = postsNextCursor
 	return userResponse, nil
 }
 
-func (u *UserUsecase) Follow(toId string, fromId string) error {
-	return u.userRepository.Follow(toId, fromId)
+// GetPostsByUser は userId のユーザーの投稿を新しい順に1ページ分返す。
+// ブロックがあれば ErrNotFound、承認済みのフォロワーでない非公開アカウントなら ErrForbidden を返す
+func (u *UserUsecase) GetPostsByUser(viewerID uuid.UUID, userId string, page models.PageRequest) ([]*ent.Post, string, error) {
+	if err := u.authorizeView(viewerID, userId); err != nil {
+		return nil, "", err
+	}
+	id, err := parseResourceID(userId)
+	if err != nil {
+		return nil, "", err
+	}
+	return u.postRepository.GetPostsByUser(id, viewerID, page)
 }
 
-func (u *UserUsecase) Unfollow(toId string, fromId string) error {
+// restrictedProfile は非公開アカウントの基本情報（名前・自己紹介・アイコン・フォロー数）だけを返す
+func (u *UserUsecase) restrictedProfile(user *ent.User, followStatus followrelation.Status) (models.UserResponse, error) {
+	var iconURLs *models.ImageURLs
+	if user.IconImageKey != "" {
+		imageURLs, err := getImageUrlsBatch(u.storageRepository, []string{user.IconImageKey})
+		if err != nil {
+			log.Errorf("Failed to get url: %v", err)
+			return models.UserResponse{}, err
+		}
+		urls := imageURLs[user.IconImageKey]
+		iconURLs = &urls
+	}
+	userResponse := models.NewRestrictedUserResponse(user, iconURLs, len(user.Edges.Followers), len(user.Edges.Following))
+	userResponse.FollowStatus = followStatus
+	return userResponse, nil
+}
+
+// Follow は fromId のユーザーが toId のユーザーをフォローする。fromId は認証済みユーザー本人に限る。
+// 2人の間にブロックがある場合はフォローできない。toId が非公開アカウントの場合は承認待ち（pending）になる
+func (u *UserUsecase) Follow(actorID uuid.UUID, toId string, fromId string) (followrelation.Status, error) {
+	if err := AuthorizeUser(actorID, fromId); err != nil {
+		return "", err
+	}
+	if err := u.authorizeInteraction(actorID, toId); err != nil {
+		return "", err
+	}
+	status, err := u.userRepository.Follow(toId, fromId)
+	if err != nil {
+		return "", notFoundOr(err)
+	}
+	return status, nil
+}
+
+func (u *UserUsecase) Unfollow(actorID uuid.UUID, toId string, fromId string) error {
+	if err := AuthorizeUser(actorID, fromId); err != nil {
+		return err
+	}
 	return u.userRepository.Unfollow(toId, fromId)
 }
 
@@ -168,10 +248,93 @@ func (u *UserUsecase) FollowerCount(id string) (int, error) {
 	return u.followRelationRepository.CountFollowers(id)
 }
 
-func (u *UserUsecase) FollowingUsers(id string) ([]*ent.User, error) {
-	return u.followRelationRepository.Followings(id)
+// FollowingUsers は id のユーザーがフォローしているユーザーを返す。非公開アカウントの場合は本人と承認済みのフォロワーに限る
+func (u *UserUsecase) FollowingUsers(viewerID uuid.UUID, id string, page models.PageRequest) ([]*ent.User, string, error) {
+	if err := u.authorizeView(viewerID, id); err != nil {
+		return nil, "", err
+	}
+	return u.followRelationRepository.Followings(id, page)
+}
+
+// Followers は id のユーザーのフォロワーを返す。非公開アカウントの場合は本人と承認済みのフォロワーに限る
+func (u *UserUsecase) Followers(viewerID uuid.UUID, id string, page models.PageRequest) ([]*ent.User, string, error) {
+	if err := u.authorizeView(viewerID, id); err != nil {
+		return nil, "", err
+	}
+	return u.followRelationRepository.Followers(id, page)
+}
+
+// SetPrivate はアカウントの公開設定を変更する。公開に戻すと承認待ちのフォローリクエストはすべて承認される
+func (u *UserUsecase) SetPrivate(actorID uuid.UUID, isPrivate bool) error {
+	return u.userRepository.SetPrivate(actorID, isPrivate)
 }
 
-func (u *UserUsecase) Followers(id string) ([]*ent.User, error) {
-	return u.followRelationRepository.Followers(id)
+// ListFollowRequests は actorID への承認待ちのフォローリクエストを送ったユーザーを返す
+func (u *UserUsecase) ListFollowRequests(actorID uuid.UUID) ([]models.UserBaseResponse, error) {
+	users, err := u.followRelationRepository.PendingRequests(actorID)
+	if err != nil {
+		return nil, err
+	}
+	return userBaseResponses(u.storageRepository, users)
+}
+
+// ApproveFollowRequest は requesterId のユーザーから actorID へのフォローリクエストを承認する
+func (u *UserUsecase) ApproveFollowRequest(actorID uuid.UUID, requesterId string) error {
+	requesterID, err := parseResourceID(requesterId)
+	if err != nil {
+		return err
+	}
+	return notFoundOr(u.followRelationRepository.Approve(requesterID, actorID))
+}
+
+// RejectFollowRequest は requesterId のユーザーから actorID へのフォローリクエストを削除する
+func (u *UserUsecase) RejectFollowRequest(actorID uuid.UUID, requesterId string) error {
+	requesterID, err := parseResourceID(requesterId)
+	if err != nil {
+		return err
+	}
+	return notFoundOr(u.followRelationRepository.Reject(requesterID, actorID))
+}
+
+// authorizeView は viewerID が userId のユーザーのフォロー一覧などを見られることを確認する。
+// ブロックがあれば ErrNotFound、承認済みのフォロワーでない非公開アカウントなら ErrForbidden を返す
+func (u *UserUsecase) authorizeView(viewerID uuid.UUID, userId string) error {
+	id, err := parseResourceID(userId)
+	if err != nil {
+		return err
+	}
+	if id == viewerID {
+		return nil
+	}
+	blocked, err := u.blockRepository.IsBlocked(viewerID, id)
+	if err != nil {
+		return err
+	}
+	if blocked {
+		return fmt.Errorf("%w: user %s is blocked", ErrNotFound, id)
+	}
+	user, err := u.userRepository.GetById(userId)
+	if err != nil {
+		return notFoundOr(err)
+	}
+	if !user.IsPrivate {
+		return nil
+	}
+	status, err := u.followRelationRepository.Status(viewerID, id)
+	if err != nil {
+		return err
+	}
+	if status != followrelation.StatusApproved {
+		return fmt.Errorf("%w: user %s is private", ErrForbidden, id)
+	}
+	return nil
+}
+
+// authorizeInteraction は actorID と userId の間にブロックがないことを確認する
+func (u *UserUsecase) authorizeInteraction(actorID uuid.UUID, userId string) error {
+	id, err := parseResourceID(userId)
+	if err != nil {
+		return err
+	}
+	return checkNotBlocked(u.blockRepository, actorID, id)
 }
@@ -0,0 +1,209 @@
+package usecase
+
+import (
+	"testing"
+
+	"github.com/aki-13627/animalia/backend-go/ent"
+	"github.com/aki-13627/animalia/backend-go/ent/followrelation"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/models"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/repository/mock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUserUsecase_GetByEmail_Private(t *testing.T) {
+	viewerID := uuid.New()
+	targetID := uuid.New()
+
+	// Test cases
+	testCases := []struct {
+		name         string
+		followStatus followrelation.Status
+	}{
+		{
+			name: "Not following",
+		},
+		{
+			name:         "Pending request",
+			followStatus: followrelation.StatusPending,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			userRepo := &mock.MockUserRepository{
+				FindByEmailFunc: func(email string) (*ent.User, error) {
+					return &ent.User{
+						ID:        targetID,
+						Email:     email,
+						Name:      "private",
+						IsPrivate: true,
+						Edges: ent.UserEdges{
+							Followers: []*ent.FollowRelation{
+								{Edges: ent.FollowRelationEdges{From: &ent.User{ID: uuid.New()}}},
+							},
+						},
+					}, nil
+				},
+			}
+			postRepo := &mock.MockPostRepository{
+				GetPostsByUserFunc: func(userId, viewerID uuid.UUID, page models.PageRequest) ([]*ent.Post, string, error) {
+					t.Fatal("posts of a private account must not be loaded")
+					return nil, "", nil
+				},
+			}
+			followRelationRepo := &mock.MockFollowRelationRepository{
+				StatusFunc: func(fromID, toID uuid.UUID) (followrelation.Status, error) {
+					assert.Equal(t, viewerID, fromID)
+					assert.Equal(t, targetID, toID)
+					return tc.followStatus, nil
+				},
+			}
+			blockRepo := &mock.MockBlockRepository{
+				BlockedUserIDsFunc: func(userID uuid.UUID) (map[uuid.UUID]bool, error) {
+					return map[uuid.UUID]bool{}, nil
+				},
+			}
+
+			usecase := NewUserUsecase(userRepo, nil, postRepo, nil, followRelationRepo, blockRepo)
+			resp, err := usecase.GetByEmail(viewerID, "private@example.com")
+
+			assert.NoError(t, err)
+			assert.True(t, resp.Restricted)
+			assert.True(t, resp.IsPrivate)
+			assert.Equal(t, "private", resp.Name)
+			assert.Equal(t, tc.followStatus, resp.FollowStatus)
+			assert.Equal(t, 1, resp.FollowersCount)
+			assert.Empty(t, resp.Posts)
+			assert.Empty(t, resp.Followers)
+		})
+	}
+}
+
+func TestUserUsecase_Followers_Private(t *testing.T) {
+	viewerID := uuid.New()
+	targetID := uuid.New()
+
+	// Test cases
+	testCases := []struct {
+		name          string
+		userID        uuid.UUID
+		isPrivate     bool
+		followStatus  followrelation.Status
+		expectedError error
+	}{
+		{
+			name:   "Public account",
+			userID: targetID,
+		},
+		{
+			name:         "Approved follower",
+			userID:       targetID,
+			isPrivate:    true,
+			followStatus: followrelation.StatusApproved,
+		},
+		{
+			name:          "Pending request",
+			userID:        targetID,
+			isPrivate:     true,
+			followStatus:  followrelation.StatusPending,
+			expectedError: ErrForbidden,
+		},
+		{
+			name:          "Not following",
+			userID:        targetID,
+			isPrivate:     true,
+			expectedError: ErrForbidden,
+		},
+		{
+			name:      "Own account",
+			userID:    viewerID,
+			isPrivate: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			userRepo := &mock.MockUserRepository{
+				GetByIdFunc: func(id string) (*ent.User, error) {
+					return &ent.User{ID: tc.userID, IsPrivate: tc.isPrivate}, nil
+				},
+			}
+			followRelationRepo := &mock.MockFollowRelationRepository{
+				StatusFunc: func(fromID, toID uuid.UUID) (followrelation.Status, error) {
+					return tc.followStatus, nil
+				},
+				FollowersFunc: func(userId string, page models.PageRequest) ([]*ent.User, string, error) {
+					return []*ent.User{{ID: uuid.New()}}, "", nil
+				},
+			}
+
+			usecase := NewUserUsecase(userRepo, nil, nil, nil, followRelationRepo, &mock.MockBlockRepository{})
+			users, _, err := usecase.Followers(viewerID, tc.userID.String(), models.PageRequest{Limit: DefaultPageLimit})
+
+			if tc.expectedError != nil {
+				assert.ErrorIs(t, err, tc.expectedError)
+				assert.Nil(t, users)
+			} else {
+				assert.NoError(t, err)
+				assert.Len(t, users, 1)
+			}
+		})
+	}
+}
+
+func TestUserUsecase_ApproveFollowRequest(t *testing.T) {
+	actorID := uuid.New()
+	requesterID := uuid.New()
+
+	// Test cases
+	testCases := []struct {
+		name           string
+		requesterID    string
+		mockError      error
+		expectApproved bool
+		expectedError  error
+	}{
+		{
+			name:           "Success",
+			requesterID:    requesterID.String(),
+			expectApproved: true,
+		},
+		{
+			name:           "No pending request",
+			requesterID:    requesterID.String(),
+			mockError:      &ent.NotFoundError{},
+			expectApproved: true,
+			expectedError:  ErrNotFound,
+		},
+		{
+			name:          "Invalid ID",
+			requesterID:   "invalid",
+			expectedError: ErrNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			approved := false
+			followRelationRepo := &mock.MockFollowRelationRepository{
+				ApproveFunc: func(fromID, toID uuid.UUID) error {
+					assert.Equal(t, requesterID, fromID)
+					assert.Equal(t, actorID, toID)
+					approved = true
+					return tc.mockError
+				},
+			}
+
+			usecase := NewUserUsecase(nil, nil, nil, nil, followRelationRepo, nil)
+			err := usecase.ApproveFollowRequest(actorID, tc.requesterID)
+
+			if tc.expectedError != nil {
+				assert.ErrorIs(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tc.expectApproved, approved)
+		})
+	}
+}